@@ -2,6 +2,7 @@
 
 use glide_core::ConnectionRequest;
 use glide_core::client::Client as GlideClient;
+use glide_core::client::DEFAULT_CONNECTION_TIMEOUT;
 use glide_core::cluster_scan_container::get_cluster_scan_cursor;
 use glide_core::command_request::SimpleRoutes;
 use glide_core::command_request::{Routes, SlotTypes};
@@ -24,13 +25,16 @@ use redis::cluster_routing::{
 use redis::cluster_routing::{ResponsePolicy, Routable};
 use redis::{ClusterScanArgs, RedisError};
 use redis::{Cmd, Pipeline, PipelineRetryStrategy, RedisResult, Value};
+use std::collections::HashMap;
 use std::ffi::CStr;
 use std::future::Future;
+use std::io;
 use std::mem::ManuallyDrop;
 use std::slice::from_raw_parts;
 use std::str;
 use std::str::FromStr;
-use std::sync::Arc;
+use std::sync::{Arc, LazyLock, Mutex, Weak};
+use std::time::Duration;
 use std::{
     ffi::{CString, c_void},
     mem,
@@ -38,6 +42,7 @@ use std::{
 };
 use tokio::runtime::Builder;
 use tokio::runtime::Runtime;
+use tokio::sync::oneshot;
 
 #[repr(C)]
 pub struct ScriptHashBuffer {
@@ -393,6 +398,42 @@ pub struct ClientAdapter {
 struct CommandExecutionCore {
     client: GlideClient,
     client_type: ClientType,
+    /// Whether the requests are rejected once the client reached its limit of inflight requests, which is only the case
+    /// when the limit is set in the connection request.
+    limit_inflight_requests: bool,
+    /// The senders which cancel the pending requests of an async client, by request ID. Only the requests which can be
+    /// cancelled by the caller are registered.
+    cancel_senders: Mutex<HashMap<usize, oneshot::Sender<()>>>,
+}
+
+/// The clients by the pointer returned by [`create_client`], so that [`cancel_request`] doesn't dereference the pointer of
+/// a client which may be closed concurrently. It's only locked when a client is created or closed, or when a request is
+/// cancelled.
+static CLIENTS: LazyLock<Mutex<HashMap<usize, Weak<CommandExecutionCore>>>> =
+    LazyLock::new(Default::default);
+
+/// Releases the inflight request reserved for a request once it's dropped, also when it's cancelled or times out.
+struct InflightRequestGuard(GlideClient);
+
+impl Drop for InflightRequestGuard {
+    fn drop(&mut self) {
+        self.0.release_inflight_request();
+    }
+}
+
+/// Fails a request with a timeout error if it isn't done within `timeout_ms` milliseconds, which aborts it if it's still
+/// queued. A `timeout_ms` of `0` means that the request only has the timeout of the client.
+async fn with_request_timeout<Fut>(timeout_ms: u32, request_future: Fut) -> RedisResult<Value>
+where
+    Fut: Future<Output = RedisResult<Value>>,
+{
+    if timeout_ms == 0 {
+        return request_future.await;
+    }
+    match tokio::time::timeout(Duration::from_millis(timeout_ms.into()), request_future).await {
+        Ok(result) => result,
+        Err(_) => Err(io::Error::from(io::ErrorKind::TimedOut).into()),
+    }
 }
 
 impl ClientAdapter {
@@ -400,19 +441,70 @@ impl ClientAdapter {
     ///
     /// For async clients, spawns the future and returns null immediately.
     /// For sync clients, blocks on the future and returns a `CommandResult`.
+    ///
+    /// The request is rejected with a [`RequestErrorType::InflightRequestsLimit`] error without being executed when the
+    /// client reached its limit of inflight requests, if the limit is set. The request of an async client can be
+    /// cancelled with [`cancel_request`] if `cancellable` is set, which fails it with an error.
     #[must_use]
-    fn execute_request<Fut>(&self, request_id: usize, request_future: Fut) -> *mut CommandResult
+    fn execute_request<Fut>(
+        &self,
+        request_id: usize,
+        cancellable: bool,
+        request_future: Fut,
+    ) -> *mut CommandResult
     where
         Fut: Future<Output = RedisResult<Value>> + Send + 'static,
     {
+        let guard = if self.core.limit_inflight_requests {
+            if !self.core.client.reserve_inflight_request() {
+                return unsafe {
+                    self.handle_custom_error(
+                        "Reached maximum inflight requests".to_string(),
+                        RequestErrorType::InflightRequestsLimit,
+                        request_id,
+                    )
+                };
+            }
+            Some(InflightRequestGuard(self.core.client.clone()))
+        } else {
+            None
+        };
+        let request_future = async move {
+            let _guard = guard;
+            request_future.await
+        };
         match self.core.client_type {
             ClientType::AsyncClient {
                 success_callback,
                 failure_callback,
             } => {
+                let cancellation = cancellable.then(|| {
+                    let (cancel_sender, cancel_receiver) = oneshot::channel();
+                    self.core
+                        .cancel_senders
+                        .lock()
+                        .unwrap()
+                        .insert(request_id, cancel_sender);
+                    (self.core.clone(), cancel_receiver)
+                });
                 // Spawn the request for async client
                 self.runtime.spawn(async move {
-                    let result = request_future.await;
+                    let result = match cancellation {
+                        Some((core, cancel_receiver)) => {
+                            // Dropping the request future aborts the request if it's still queued
+                            let result = tokio::select! {
+                                result = request_future => result,
+                                Ok(()) = cancel_receiver => Err(RedisError::from((
+                                    ErrorKind::ClientError,
+                                    "The request was cancelled",
+                                ))),
+                            };
+                            // The request ID can be reused by the caller once the callback is called
+                            core.cancel_senders.lock().unwrap().remove(&request_id);
+                            result
+                        }
+                        None => request_future.await,
+                    };
                     let _ = Self::handle_result(
                         result,
                         Some(success_callback),
@@ -498,7 +590,7 @@ impl ClientAdapter {
     #[must_use]
     unsafe fn handle_redis_error(&self, err: RedisError, request_id: usize) -> *mut CommandResult {
         let error_string = errors::error_message(&err);
-        let error_type = errors::error_type(&err);
+        let error_type = ffi_error_type(&err);
         unsafe { Self::handle_custom_error(self, error_string, error_type, request_id) }
     }
 
@@ -702,13 +794,66 @@ unsafe fn process_push_notification(
     }
 }
 
+/// Processes an invalidation push of the client-side caching, and calls the callback once per invalidated key.
+///
+/// The key is passed as the message of the callback. When the server invalidates all the keys, e.g. after a FLUSHALL,
+/// the callback is called once with a null message.
+///
+/// # Safety
+/// The same requirements as [`process_push_notification`] apply.
+unsafe fn process_invalidation(
+    push_msg: redis::PushInfo,
+    pubsub_callback: PubSubCallback,
+    client_adapter_ptr: usize,
+) {
+    let keys = match push_msg.data.into_iter().next() {
+        Some(Value::Array(keys)) => keys,
+        _ => {
+            unsafe {
+                pubsub_callback(
+                    client_adapter_ptr,
+                    PushKind::PushInvalidate,
+                    std::ptr::null(),
+                    0,
+                    std::ptr::null(),
+                    0,
+                    std::ptr::null(),
+                    0,
+                );
+            }
+            return;
+        }
+    };
+    for key in keys {
+        let Value::BulkString(key) = key else {
+            continue;
+        };
+        let (key_ptr, key_len) = convert_vec_to_pointer(key);
+        unsafe {
+            pubsub_callback(
+                client_adapter_ptr,
+                PushKind::PushInvalidate,
+                key_ptr,
+                key_len,
+                std::ptr::null(),
+                0,
+                std::ptr::null(),
+                0,
+            );
+            let _ = Vec::from_raw_parts(key_ptr, key_len as usize, key_len as usize);
+        }
+    }
+}
+
 fn create_client_internal(
     connection_request_bytes: &[u8],
     client_type: ClientType,
-    pubsub_callback: PubSubCallback,
+    pubsub_callback: Option<PubSubCallback>,
 ) -> Result<*const ClientAdapter, String> {
     let request = connection_request::ConnectionRequest::parse_from_bytes(connection_request_bytes)
         .map_err(|err| err.to_string())?;
+    // The core applies a default limit otherwise, which the clients of the FFI don't expect
+    let limit_inflight_requests = request.inflight_requests_limit != 0;
     // TODO: optimize this using multiple threads instead of a single worker thread (e.g. by pinning each go thread to a rust thread)
     let runtime = Builder::new_multi_thread()
         .enable_all()
@@ -720,12 +865,9 @@ fn create_client_internal(
             errors::error_message(&redis_error)
         })?;
 
-    let is_subscriber = request.pubsub_subscriptions.is_some() && pubsub_callback as usize != 0;
+    // The pushes are only forwarded to the clients that subscribe or cache, which provide a callback.
     let (push_tx, mut push_rx) = tokio::sync::mpsc::unbounded_channel();
-    let tx = match is_subscriber {
-        true => Some(push_tx),
-        false => None,
-    };
+    let tx = pubsub_callback.map(|_| push_tx);
 
     let client = runtime
         .block_on(GlideClient::new(ConnectionRequest::from(request), tx))
@@ -735,16 +877,42 @@ fn create_client_internal(
     let core = Arc::new(CommandExecutionCore {
         client,
         client_type,
+        limit_inflight_requests,
+        cancel_senders: Default::default(),
     });
+    let weak_core = Arc::downgrade(&core);
     let client_adapter = Arc::new(ClientAdapter { runtime, core });
     // Clone client_adapter before moving it into the async block
     let client_adapter_ptr = Arc::as_ptr(&client_adapter).addr();
+    CLIENTS
+        .lock()
+        .unwrap()
+        .insert(client_adapter_ptr, weak_core);
 
     // If pubsub_callback is provided (not null), spawn a task to handle push notifications
-    if is_subscriber {
+    if let Some(pubsub_callback) = pubsub_callback {
         client_adapter.runtime.spawn(async move {
             while let Some(push_msg) = push_rx.recv().await {
-                if push_msg.kind == redis::PushKind::Message
+                if push_msg.kind == redis::PushKind::Disconnection {
+                    // Disconnections carry no data, they let the caller restore the subscriptions made after the client
+                    // was created, which aren't restored by the core on reconnection.
+                    unsafe {
+                        pubsub_callback(
+                            client_adapter_ptr,
+                            PushKind::PushDisconnection,
+                            std::ptr::null(),
+                            0,
+                            std::ptr::null(),
+                            0,
+                            std::ptr::null(),
+                            0,
+                        );
+                    }
+                } else if push_msg.kind == redis::PushKind::Invalidate {
+                    unsafe {
+                        process_invalidation(push_msg, pubsub_callback, client_adapter_ptr);
+                    }
+                } else if push_msg.kind == redis::PushKind::Message
                     || push_msg.kind == redis::PushKind::PMessage
                     || push_msg.kind == redis::PushKind::SMessage
                 {
@@ -767,6 +935,8 @@ fn create_client_internal(
 /// `connection_request_len` is the number of bytes in `connection_request_bytes`.
 /// `success_callback` is the callback that will be called when a command succeeds.
 /// `failure_callback` is the callback that will be called when a command fails.
+/// `pubsub_callback` is the callback that will be called with the push notifications. It's null for the clients that neither
+/// subscribe nor cache, which then don't receive them.
 ///
 /// # Safety
 ///
@@ -781,7 +951,7 @@ pub unsafe extern "C-unwind" fn create_client(
     connection_request_bytes: *const u8,
     connection_request_len: usize,
     client_type: *const ClientType,
-    pubsub_callback: PubSubCallback,
+    pubsub_callback: Option<PubSubCallback>,
 ) -> *const ConnectionResponse {
     assert!(!connection_request_bytes.is_null());
     let request_bytes =
@@ -803,6 +973,29 @@ pub unsafe extern "C-unwind" fn create_client(
     Box::into_raw(Box::new(response))
 }
 
+/// Cancels a pending request of an async client, which then fails with an error passed to the `failure_callback`. The
+/// request is aborted if it's still queued, otherwise its response is discarded. Nothing happens if the request is
+/// already done.
+///
+/// `client_adapter_ptr` is the pointer returned in the `ConnectionResponse` from [`create_client`]. It only identifies the
+/// client and isn't dereferenced, so this function can be called concurrently with [`close_client`], or after it. Only
+/// the requests sent with `cancellable` set can be cancelled.
+#[unsafe(no_mangle)]
+pub extern "C" fn cancel_request(client_adapter_ptr: *const c_void, request_id: usize) {
+    let core = CLIENTS
+        .lock()
+        .unwrap()
+        .get(&(client_adapter_ptr as usize))
+        .and_then(Weak::upgrade);
+    let Some(core) = core else {
+        return;
+    };
+    let cancel_sender = core.cancel_senders.lock().unwrap().remove(&request_id);
+    if let Some(cancel_sender) = cancel_sender {
+        let _ = cancel_sender.send(());
+    }
+}
+
 /// Closes the given `GlideClient`, freeing it from the heap.
 ///
 /// `client_adapter_ptr` is a pointer to a valid `GlideClient` returned in the `ConnectionResponse` from [`create_client`].
@@ -820,6 +1013,11 @@ pub unsafe extern "C-unwind" fn create_client(
 #[unsafe(no_mangle)]
 pub unsafe extern "C" fn close_client(client_adapter_ptr: *const c_void) {
     assert!(!client_adapter_ptr.is_null());
+    // The pending requests can't be cancelled anymore, and the pointer may identify a new client once freed.
+    CLIENTS
+        .lock()
+        .unwrap()
+        .remove(&(client_adapter_ptr as usize));
     // This will bring the strong count down to 0 once all client requests are done.
     unsafe { Arc::decrement_strong_count(client_adapter_ptr as *const ClientAdapter) };
 }
@@ -852,6 +1050,12 @@ pub unsafe extern "C" fn free_connection_response(
     }
 }
 
+/// Returns the connection timeout in milliseconds of the clients whose `ConnectionRequest` doesn't set it.
+#[unsafe(no_mangle)]
+pub extern "C" fn default_connection_timeout_ms() -> u32 {
+    DEFAULT_CONNECTION_TIMEOUT.as_millis() as u32
+}
+
 /// Provides the string mapping for the ResponseType enum.
 ///
 /// Important: the returned pointer is a pointer to a constant string and should not be freed.
@@ -1077,6 +1281,18 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
             // Return as Ok to continue transaction processing
             Ok(command_response)
         }
+        // Replies to the (un)subscribe commands are pushes, returned as arrays of their data.
+        Value::Push { kind: _, data } => {
+            let vec: Result<Vec<CommandResponse>, RedisError> = data
+                .into_iter()
+                .map(valkey_value_to_command_response)
+                .collect();
+            let (vec_ptr, len) = convert_vec_to_pointer(vec?);
+            command_response.array_value = vec_ptr;
+            command_response.array_value_len = len;
+            command_response.response_type = ResponseType::Array;
+            Ok(command_response)
+        }
         // TODO: Add support for other return types.
         _ => todo!(),
     };
@@ -1090,6 +1306,7 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
 /// * `client_adapter_ptr` must not be `null` and must be obtained from the `ConnectionResponse` returned from [`create_client`].
 /// * `client_adapter_ptr` must be able to be safely casted to a valid [`Arc<ClientAdapter>`] via [`Arc::from_raw`]. See the safety documentation of [`std::sync::Arc::from_raw`].
 /// * `request_id` must be a request ID from the foreign language and must be valid until either `success_callback` or `failure_callback` is finished.
+/// * `cancellable` is whether the request can be cancelled with [`cancel_request`], e.g. when the caller can abort it.
 /// * `args` is an optional bytes pointers array. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
 /// * `args_len` is an optional bytes length array. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
 /// * `arg_count` the number of elements in `args` and `args_len`. It must also not be greater than the max value of a signed pointer-sized integer.
@@ -1098,18 +1315,21 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
 /// * `route_bytes` is an optional array of bytes that will be parsed into a Protobuf `Routes` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
 /// * `route_bytes_len` is the number of bytes in `route_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
 /// * `route_bytes_len` must be 0 if `route_bytes` is null.
+/// * `timeout_ms` is the timeout of the request in milliseconds, e.g. the time left until the deadline of the caller, or `0` to only use the request timeout of the client.
 /// * `span_ptr` is a valid pointer to [`Arc<GlideSpan>`], a span created by [`create_otel_span`] or `0`. The span must be valid until the command is finished.
 /// * This function should only be called should with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
 #[unsafe(no_mangle)]
 pub unsafe extern "C-unwind" fn command(
     client_adapter_ptr: *const c_void,
     request_id: usize,
+    cancellable: bool,
     command_type: RequestType,
     arg_count: c_ulong,
     args: *const usize,
     args_len: *const c_ulong,
     route_bytes: *const u8,
     route_bytes_len: usize,
+    timeout_ms: u32,
     span_ptr: u64,
 ) -> *mut CommandResult {
     let client_adapter = unsafe {
@@ -1159,9 +1379,9 @@ pub unsafe extern "C-unwind" fn command(
 
     let child_span = create_child_span(cmd.span().as_ref(), "send_command");
     let mut client = client_adapter.core.client.clone();
-    let result = client_adapter.execute_request(request_id, async move {
+    let result = client_adapter.execute_request(request_id, cancellable, async move {
         let routing_info = get_route(route, Some(&cmd))?;
-        client.send_command(&cmd, routing_info).await
+        with_request_timeout(timeout_ms, client.send_command(&cmd, routing_info)).await
     });
     if let Ok(span) = child_span {
         span.end();
@@ -1253,7 +1473,7 @@ fn create_error_result_with_custom_error(
 /// This function will panic if the error message cannot be converted into a `CString`.
 fn to_c_error(err: RedisError) -> (*const c_char, RequestErrorType) {
     let message = errors::error_message(&err);
-    let error_type = errors::error_type(&err);
+    let error_type = ffi_error_type(&err);
 
     let c_err_str = CString::into_raw(
         CString::new(message).expect("Couldn't convert error message to CString"),
@@ -1261,6 +1481,16 @@ fn to_c_error(err: RedisError) -> (*const c_char, RequestErrorType) {
     (c_err_str, error_type)
 }
 
+/// Returns the [`RequestErrorType`] of an error, which also identifies the NOSCRIPT errors of the server, so that the
+/// clients don't depend on the error message.
+fn ffi_error_type(err: &RedisError) -> RequestErrorType {
+    if err.kind() == ErrorKind::NoScriptError {
+        RequestErrorType::NoScript
+    } else {
+        errors::error_type(err)
+    }
+}
+
 fn get_route(route: Routes, cmd: Option<&Cmd>) -> RedisResult<Option<RoutingInfo>> {
     use glide_core::command_request::routes::Value;
     let route = match route.value {
@@ -1350,6 +1580,7 @@ fn get_slot_addr(slot_type: &protobuf::EnumOrUnknown<SlotTypes>) -> RedisResult<
 ///
 /// `client_adapter_ptr` is a pointer to a valid `GlideClusterClient` returned in the `ConnectionResponse` from [`create_client`].
 /// `request_id` is a unique identifier for a valid payload buffer which is created in the client.
+/// `cancellable` is whether the request can be cancelled with [`cancel_request`].
 /// `cursor` is a cursor string.
 /// `arg_count` keeps track of how many option arguments are passed in the client.
 /// `args` is a pointer to C string representation of the string args.
@@ -1369,6 +1600,7 @@ fn get_slot_addr(slot_type: &protobuf::EnumOrUnknown<SlotTypes>) -> RedisResult<
 pub unsafe extern "C-unwind" fn request_cluster_scan(
     client_adapter_ptr: *const c_void,
     request_id: usize,
+    cancellable: bool,
     cursor: *const c_char,
     arg_count: c_ulong,
     args: *const usize,
@@ -1485,7 +1717,7 @@ pub unsafe extern "C-unwind" fn request_cluster_scan(
         Err(_error) => ScanStateRC::new(),
     };
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_request(request_id, async move {
+    client_adapter.execute_request(request_id, cancellable, async move {
         client
             .cluster_scan(&scan_state_cursor, cluster_scan_args)
             .await
@@ -1496,6 +1728,7 @@ pub unsafe extern "C-unwind" fn request_cluster_scan(
 ///
 /// `client_adapter_ptr` is a pointer to a valid `GlideClusterClient` returned in the `ConnectionResponse` from [`create_client`].
 /// `request_id` is a unique identifier for a valid payload buffer which is created in the client.
+/// `cancellable` is whether the request can be cancelled with [`cancel_request`].
 /// `password` is a pointer to C string representation of the password.
 /// `immediate_auth` is a boolean flag to indicate if the password should be updated immediately.
 /// `success_callback` is the callback that will be called when a command succeeds.
@@ -1511,6 +1744,7 @@ pub unsafe extern "C-unwind" fn request_cluster_scan(
 pub unsafe extern "C-unwind" fn update_connection_password(
     client_adapter_ptr: *const c_void,
     request_id: usize,
+    cancellable: bool,
     password: *const c_char,
     immediate_auth: bool,
 ) -> *mut CommandResult {
@@ -1533,7 +1767,7 @@ pub unsafe extern "C-unwind" fn update_connection_password(
         Some(password.to_string())
     };
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_request(request_id, async move {
+    client_adapter.execute_request(request_id, cancellable, async move {
         client
             .update_connection_password(password_option, immediate_auth)
             .await
@@ -1555,12 +1789,14 @@ pub unsafe extern "C-unwind" fn update_connection_password(
 /// * `args_len`: Array of lengths for each argument.
 /// * `route_bytes`: Optional array of bytes for routing information.
 /// * `route_bytes_len`: Length of the route_bytes array.
+/// * `timeout_ms`: Timeout of the request in milliseconds, or `0` to only use the request timeout of the client.
 ///
 /// # Safety
 ///
 /// * `client_adapter_ptr` must not be `null` and must be obtained from the `ConnectionResponse` returned from [`create_client`].
 /// * `client_adapter_ptr` must be able to be safely casted to a valid [`Arc<ClientAdapter>`] via [`Arc::from_raw`].
 /// * `request_id` must be valid until either `success_callback` or `failure_callback` is finished.
+/// * `cancellable` is whether the request can be cancelled with [`cancel_request`].
 /// * `hash` must be a valid null-terminated C string.
 /// * `keys` is an optional bytes pointers array. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
 /// * `keys_len` is an optional bytes length array. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
@@ -1578,6 +1814,7 @@ pub unsafe extern "C-unwind" fn update_connection_password(
 pub unsafe extern "C-unwind" fn invoke_script(
     client_adapter_ptr: *const c_void,
     request_id: usize,
+    cancellable: bool,
     hash: *const c_char,
     keys_count: c_ulong,
     keys: *const usize,
@@ -1587,6 +1824,7 @@ pub unsafe extern "C-unwind" fn invoke_script(
     args_len: *const c_ulong,
     route_bytes: *const u8,
     route_bytes_len: usize,
+    timeout_ms: u32,
 ) -> *mut CommandResult {
     let client_adapter = unsafe {
         // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
@@ -1635,11 +1873,13 @@ pub unsafe extern "C-unwind" fn invoke_script(
     };
 
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_request(request_id, async move {
+    client_adapter.execute_request(request_id, cancellable, async move {
         let routing_info = get_route(route, None)?;
-        client
-            .invoke_script(hash_str, &keys_vec, &args_vec, routing_info)
-            .await
+        with_request_timeout(
+            timeout_ms,
+            client.invoke_script(hash_str, &keys_vec, &args_vec, routing_info),
+        )
+        .await
     })
 }
 
@@ -1727,14 +1967,18 @@ pub struct BatchOptionsInfo {
 /// * `batch_ptr` must not be `null`.
 /// * `batch_ptr` must be able to be safely casted to a valid [`BatchInfo`]. See the safety documentation of [`create_pipeline`].
 /// * `options_ptr` could be `null`, but if it is not `null`, it must be a valid [`BatchOptionsInfo`] pointer. See the safety documentation of [`get_pipeline_options`].
+/// * `timeout_ms` is the timeout of the request in milliseconds, e.g. the time left until the deadline of the caller, or `0` to only use the timeout of the options or of the client.
+/// * `cancellable` is whether the batch can be cancelled with [`cancel_request`].
 #[allow(rustdoc::private_intra_doc_links)]
 #[unsafe(no_mangle)]
 pub unsafe extern "C" fn batch(
     client_ptr: *const c_void,
     callback_index: usize,
+    cancellable: bool,
     batch_ptr: *const BatchInfo,
     raise_on_error: bool,
     options_ptr: *const BatchOptionsInfo,
+    timeout_ms: u32,
     span_ptr: u64,
 ) -> *mut CommandResult {
     let client_adapter = unsafe {
@@ -1763,22 +2007,25 @@ pub unsafe extern "C" fn batch(
     let child_span = create_child_span(pipeline.span().as_ref(), "send_batch");
     let (routing, timeout, pipeline_retry_strategy) = unsafe { get_pipeline_options(options_ptr) };
 
-    let result = client_adapter.execute_request(callback_index, async move {
-        if pipeline.is_atomic() {
-            client
-                .send_transaction(&pipeline, routing, timeout, raise_on_error)
-                .await
-        } else {
-            client
-                .send_pipeline(
-                    &pipeline,
-                    routing,
-                    raise_on_error,
-                    timeout,
-                    pipeline_retry_strategy,
-                )
-                .await
-        }
+    let result = client_adapter.execute_request(callback_index, cancellable, async move {
+        let batch_future = async {
+            if pipeline.is_atomic() {
+                client
+                    .send_transaction(&pipeline, routing, timeout, raise_on_error)
+                    .await
+            } else {
+                client
+                    .send_pipeline(
+                        &pipeline,
+                        routing,
+                        raise_on_error,
+                        timeout,
+                        pipeline_retry_strategy,
+                    )
+                    .await
+            }
+        };
+        with_request_timeout(timeout_ms, batch_future).await
     });
 
     if let Ok(span) = child_span {
@@ -2146,6 +2393,47 @@ pub unsafe extern "C" fn free_c_string(s: *mut c_char) {
     };
 }
 
+/// A mirror of [`logger_core::Level`]
+#[repr(C)]
+#[derive(Debug, Clone, Copy)]
+pub enum LogLevel {
+    LogError = 0,
+    LogWarn,
+    LogInfo,
+    LogDebug,
+    LogTrace,
+    LogOff,
+}
+
+impl From<LogLevel> for logger_core::Level {
+    fn from(val: LogLevel) -> Self {
+        match val {
+            LogLevel::LogError => logger_core::Level::Error,
+            LogLevel::LogWarn => logger_core::Level::Warn,
+            LogLevel::LogInfo => logger_core::Level::Info,
+            LogLevel::LogDebug => logger_core::Level::Debug,
+            LogLevel::LogTrace => logger_core::Level::Trace,
+            LogLevel::LogOff => logger_core::Level::Off,
+        }
+    }
+}
+
+/// Logs a message of the caller with the logger of the core, so that the logs of the caller and of the core end up in
+/// the same place.
+///
+/// # Safety
+/// * `identifier` and `message` must be valid pointers to null-terminated C strings.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn log_message(
+    level: LogLevel,
+    identifier: *const c_char,
+    message: *const c_char,
+) {
+    let identifier = unsafe { CStr::from_ptr(identifier) }.to_string_lossy();
+    let message = unsafe { CStr::from_ptr(message) }.to_string_lossy();
+    logger_core::log(level.into(), identifier, message);
+}
+
 /// This function converts a raw pointer to a GlideSpan into a safe Rust reference.
 /// It handles the unsafe pointer operations internally, incrementing the reference count
 /// to ensure the span remains valid while in use.
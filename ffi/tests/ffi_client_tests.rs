@@ -167,6 +167,7 @@ fn execute_command(
         command(
             client_ptr,
             index,
+            false,
             command_type,
             arg_count,
             command_ptr,
@@ -174,6 +175,7 @@ fn execute_command(
             route_bytes,
             route_len,
             0,
+            0,
         )
     };
     if command_res_ptr.is_null() {
@@ -243,19 +245,7 @@ fn test_ffi_client_command_execution(#[values(false, true)] async_client: bool)
             connection_request_ptr,
             connection_request_len,
             client_type,
-            std::mem::transmute::<
-                *mut c_void,
-                unsafe extern "C-unwind" fn(
-                    client_ptr: usize,
-                    kind: PushKind,
-                    message: *const u8,
-                    message_len: i64,
-                    channel: *const u8,
-                    channel_len: i64,
-                    pattern: *const u8,
-                    pattern_len: i64,
-                ),
-            >(std::ptr::null_mut()),
+            None,
         );
 
         assert!(!response_ptr.is_null(), "Failed to create client");
@@ -126,7 +126,10 @@ impl RedisRuntime for Tokio {
 
         Ok(tls_connector
             .connect(
-                rustls_pki_types::ServerName::try_from(hostname)?.to_owned(),
+                rustls_pki_types::ServerName::try_from(TlsConnParams::server_name_or(
+                    tls_params, hostname,
+                ))?
+                .to_owned(),
                 connect_tcp(&socket_addr).await?,
             )
             .await
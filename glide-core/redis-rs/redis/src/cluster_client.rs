@@ -31,6 +31,7 @@ struct BuilderParams {
     read_from_replicas: ReadFromReplicaStrategy,
     tls: Option<TlsMode>,
     certs: Option<TlsCertificates>,
+    tls_params: Option<TlsConnParams>,
     retries_configuration: RetryParams,
     connection_timeout: Option<Duration>,
     #[cfg(feature = "cluster-async")]
@@ -148,10 +149,13 @@ pub struct ClusterParams {
 
 impl ClusterParams {
     fn from(value: BuilderParams) -> RedisResult<Self> {
-        let tls_params = {
-            let retrieved_tls_params = value.certs.clone().map(retrieve_tls_certificates);
+        let tls_params = match value.tls_params {
+            Some(tls_params) => Some(tls_params),
+            None => {
+                let retrieved_tls_params = value.certs.clone().map(retrieve_tls_certificates);
 
-            retrieved_tls_params.transpose()?
+                retrieved_tls_params.transpose()?
+            }
         };
 
         Ok(Self {
@@ -369,6 +373,14 @@ impl ClusterClientBuilder {
         self
     }
 
+    /// Sets the TLS parameters of the connections, e.g. parsed from PEM certificates with
+    /// [`retrieve_tls_certificates`]. The parameters take precedence over the certificates set with
+    /// [`ClusterClientBuilder::certs`], and don't change the TLS mode.
+    pub fn tls_params(mut self, tls_params: TlsConnParams) -> ClusterClientBuilder {
+        self.builder_params.tls_params = Some(tls_params);
+        self
+    }
+
     /// Enables reading from replicas for all new connections (default is disabled).
     ///
     /// If enabled, then read queries will go to the replica nodes & write queries will go to the
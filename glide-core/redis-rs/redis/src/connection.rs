@@ -603,15 +603,17 @@ impl ActualConnection {
             } => {
                 let host: &str = host;
                 let config = create_rustls_config(insecure, tls_params.as_ref().cloned())?;
-                let server_name = rustls_pki_types::ServerName::try_from(host)
-                    .map_err(|e| {
-                        RedisError::from((
-                            ErrorKind::InvalidClientConfig,
-                            "Invalid hostname for TLS",
-                            format!("{e}"),
-                        ))
-                    })?
-                    .to_owned();
+                let server_name = rustls_pki_types::ServerName::try_from(
+                    TlsConnParams::server_name_or(tls_params, host),
+                )
+                .map_err(|e| {
+                    RedisError::from((
+                        ErrorKind::InvalidClientConfig,
+                        "Invalid hostname for TLS",
+                        format!("{e}"),
+                    ))
+                })?
+                .to_owned();
                 let conn =
                     rustls::ClientConnection::new(Arc::new(config), server_name).map_err(|e| {
                         RedisError::from((
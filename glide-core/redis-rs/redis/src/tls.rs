@@ -56,9 +56,8 @@ pub(crate) fn inner_build_with_tls(
     Ok(Client { connection_info })
 }
 
-pub(crate) fn retrieve_tls_certificates(
-    certificates: TlsCertificates,
-) -> RedisResult<TlsConnParams> {
+/// Parses the PEM `certificates` into the TLS parameters of a connection.
+pub fn retrieve_tls_certificates(certificates: TlsCertificates) -> RedisResult<TlsConnParams> {
     let TlsCertificates {
         client_tls,
         root_cert,
@@ -110,6 +109,7 @@ pub(crate) fn retrieve_tls_certificates(
     Ok(TlsConnParams {
         client_tls_params,
         root_cert_store,
+        server_name: None,
     })
 }
 
@@ -139,4 +139,20 @@ impl Clone for ClientTlsParams {
 pub struct TlsConnParams {
     pub(crate) client_tls_params: Option<ClientTlsParams>,
     pub(crate) root_cert_store: Option<RootCertStore>,
+    pub(crate) server_name: Option<String>,
+}
+
+impl TlsConnParams {
+    /// Sets the name verified against the certificate of the server, instead of the host of the connection.
+    pub fn server_name(mut self, server_name: String) -> Self {
+        self.server_name = Some(server_name);
+        self
+    }
+
+    pub(crate) fn server_name_or<'a>(params: &'a Option<TlsConnParams>, host: &'a str) -> &'a str {
+        params
+            .as_ref()
+            .and_then(|params| params.server_name.as_deref())
+            .unwrap_or(host)
+    }
 }
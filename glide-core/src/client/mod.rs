@@ -158,9 +158,45 @@ pub(super) fn get_redis_connection_info(
     }
 }
 
+/// Parses the certificates of the TLS configuration of the request into the TLS parameters of the connections.
+pub(super) fn get_tls_params(
+    request: &ConnectionRequest,
+) -> RedisResult<Option<redis::TlsConnParams>> {
+    let Some(tls_configuration) = &request.tls_configuration else {
+        return Ok(None);
+    };
+    let client_tls = match (
+        &tls_configuration.client_cert,
+        &tls_configuration.client_key,
+    ) {
+        (Some(client_cert), Some(client_key)) => Some(redis::ClientTlsConfig {
+            client_cert: client_cert.clone(),
+            client_key: client_key.clone(),
+        }),
+        (None, None) => None,
+        _ => {
+            return Err(RedisError::from((
+                ErrorKind::InvalidClientConfig,
+                "The client certificate and key of the TLS configuration must be set together",
+            )));
+        }
+    };
+    let root_cert = (!tls_configuration.root_certs.is_empty())
+        .then(|| tls_configuration.root_certs.join(&b"\n"[..]));
+    let tls_params = redis::retrieve_tls_certificates(redis::TlsCertificates {
+        client_tls,
+        root_cert,
+    })?;
+    Ok(Some(match &tls_configuration.server_name {
+        Some(server_name) => tls_params.server_name(server_name.clone()),
+        None => tls_params,
+    }))
+}
+
 pub(super) fn get_connection_info(
     address: &NodeAddress,
     tls_mode: TlsMode,
+    tls_params: Option<redis::TlsConnParams>,
     redis_connection_info: redis::RedisConnectionInfo,
 ) -> redis::ConnectionInfo {
     let addr = if tls_mode != TlsMode::NoTls {
@@ -168,7 +204,7 @@ pub(super) fn get_connection_info(
             host: address.host.to_string(),
             port: get_port(address),
             insecure: tls_mode == TlsMode::InsecureTls,
-            tls_params: None,
+            tls_params,
         }
     } else {
         redis::ConnectionAddr::Tcp(address.host.to_string(), get_port(address))
@@ -317,6 +353,7 @@ fn get_request_timeout(cmd: &Cmd, default_timeout: Duration) -> RedisResult<Opti
             .map(|idx| get_timeout_from_cmd_arg(cmd, idx + 1, TimeUnit::Milliseconds))
             .unwrap_or(Ok(RequestTimeoutOption::ClientConfig)),
         b"WAIT" => get_timeout_from_cmd_arg(cmd, 2, TimeUnit::Milliseconds),
+        b"WAITAOF" => get_timeout_from_cmd_arg(cmd, 3, TimeUnit::Milliseconds),
         _ => Ok(RequestTimeoutOption::ClientConfig),
     }?;
 
@@ -873,11 +910,19 @@ async fn create_cluster_client(
 ) -> RedisResult<redis::cluster_async::ClusterConnection> {
     // TODO - implement timeout for each connection attempt
     let tls_mode = request.tls_mode.unwrap_or_default();
+    let tls_params = get_tls_params(&request)?;
     let redis_connection_info = get_redis_connection_info(&request);
     let initial_nodes: Vec<_> = request
         .addresses
         .into_iter()
-        .map(|address| get_connection_info(&address, tls_mode, redis_connection_info.clone()))
+        .map(|address| {
+            get_connection_info(
+                &address,
+                tls_mode,
+                tls_params.clone(),
+                redis_connection_info.clone(),
+            )
+        })
         .collect();
     let periodic_topology_checks = match request.periodic_checks {
         Some(PeriodicCheck::Disabled) => None,
@@ -912,6 +957,9 @@ async fn create_cluster_client(
             redis::cluster::TlsMode::Insecure
         };
         builder = builder.tls(tls);
+        if let Some(tls_params) = tls_params {
+            builder = builder.tls_params(tls_params);
+        }
     }
     if let Some(pubsub_subscriptions) = redis_connection_info.pubsub_subscriptions.clone() {
         builder = builder.pubsub_subscriptions(pubsub_subscriptions);
@@ -1108,8 +1156,26 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
         request.inflight_requests_limit,
     );
 
+    // The certificates and the keys are omitted
+    let tls_configuration = request
+        .tls_configuration
+        .as_ref()
+        .map(|tls_configuration| {
+            let server_name = tls_configuration
+                .server_name
+                .as_ref()
+                .map(|server_name| format!(", server name: {server_name}"))
+                .unwrap_or_default();
+            format!(
+                "\nTLS configuration: {} root certificates, client certificate: {}{server_name}",
+                tls_configuration.root_certs.len(),
+                tls_configuration.client_cert.is_some(),
+            )
+        })
+        .unwrap_or_default();
+
     format!(
-        "\nAddresses: {addresses}{tls_mode}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
+        "\nAddresses: {addresses}{tls_mode}{tls_configuration}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
     )
 }
 
@@ -196,11 +196,13 @@ async fn create_connection(
 fn get_client(
     address: &NodeAddress,
     tls_mode: TlsMode,
+    tls_params: Option<redis::TlsConnParams>,
     redis_connection_info: redis::RedisConnectionInfo,
 ) -> redis::Client {
     redis::Client::open(super::get_connection_info(
         address,
         tls_mode,
+        tls_params,
         redis_connection_info,
     ))
     .unwrap() // can unwrap, because [open] fails only on trying to convert input to ConnectionInfo, and we pass ConnectionInfo.
@@ -214,11 +216,13 @@ impl ConnectionBackend {
 }
 
 impl ReconnectingConnection {
+    #[allow(clippy::too_many_arguments)]
     pub(super) async fn new(
         address: &NodeAddress,
         connection_retry_strategy: RetryStrategy,
         redis_connection_info: RedisConnectionInfo,
         tls_mode: TlsMode,
+        tls_params: Option<redis::TlsConnParams>,
         push_sender: Option<mpsc::UnboundedSender<PushInfo>>,
         discover_az: bool,
         connection_timeout: Duration,
@@ -228,7 +232,7 @@ impl ReconnectingConnection {
             format!("Attempting connection to {address}"),
         );
 
-        let connection_info = get_client(address, tls_mode, redis_connection_info);
+        let connection_info = get_client(address, tls_mode, tls_params, redis_connection_info);
         let backend = ConnectionBackend {
             connection_info: RwLock::new(connection_info),
             connection_available_signal: ManualResetEvent::new(true),
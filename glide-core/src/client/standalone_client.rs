@@ -134,6 +134,8 @@ impl StandaloneClient {
         };
 
         let tls_mode = connection_request.tls_mode;
+        let tls_params = super::get_tls_params(&connection_request)
+            .map_err(|err| StandaloneClientConnectionError::FailedConnection(vec![(None, err)]))?;
         let node_count = connection_request.addresses.len();
         // randomize pubsub nodes, maybe a batter option is to always use the primary
         let pubsub_node_index = rand::thread_rng().gen_range(0..node_count);
@@ -159,11 +161,12 @@ impl StandaloneClient {
                 let retry = retry_strategy;
                 let sender = push_sender.clone();
                 let tls = tls_mode.unwrap_or(TlsMode::NoTls);
+                let tls_params = tls_params.clone();
                 let discover = discover_az;
                 let timeout = connection_timeout;
                 async move {
                     get_connection_and_replication_info(
-                        &address, &retry, &info, tls, &sender, discover, timeout,
+                        &address, &retry, &info, tls, tls_params, &sender, discover, timeout,
                     )
                     .await
                     .map_err(|err| (format!("{}:{}", address.host, address.port), err))
@@ -633,11 +636,13 @@ impl StandaloneClient {
     }
 }
 
+#[allow(clippy::too_many_arguments)]
 async fn get_connection_and_replication_info(
     address: &NodeAddress,
     retry_strategy: &RetryStrategy,
     connection_info: &redis::RedisConnectionInfo,
     tls_mode: TlsMode,
+    tls_params: Option<redis::TlsConnParams>,
     push_sender: &Option<mpsc::UnboundedSender<PushInfo>>,
     discover_az: bool,
     connection_timeout: Duration,
@@ -647,6 +652,7 @@ async fn get_connection_and_replication_info(
         *retry_strategy,
         connection_info.clone(),
         tls_mode,
+        tls_params,
         push_sender.clone(),
         discover_az,
         connection_timeout,
@@ -26,6 +26,7 @@ pub struct ConnectionRequest {
     pub pubsub_subscriptions: Option<redis::PubSubSubscriptionInfo>,
     pub inflight_requests_limit: Option<u32>,
     pub lazy_connect: bool,
+    pub tls_configuration: Option<TlsConfiguration>,
 }
 
 #[derive(PartialEq, Eq, Clone, Default, Debug)]
@@ -72,6 +73,18 @@ pub enum TlsMode {
     SecureTls,
 }
 
+/// Certificates and server name used by the TLS connections. All certificates and keys are in PEM format.
+#[derive(PartialEq, Eq, Clone, Default, Debug)]
+pub struct TlsConfiguration {
+    /// Root certificates trusted instead of the certificates of the platform.
+    pub root_certs: Vec<Vec<u8>>,
+    /// Certificate chain and private key of the client, for mutual TLS.
+    pub client_cert: Option<Vec<u8>>,
+    pub client_key: Option<Vec<u8>>,
+    /// Name verified against the certificates of the servers, instead of their hosts.
+    pub server_name: Option<String>,
+}
+
 #[derive(PartialEq, Eq, Clone, Copy, Debug)]
 #[repr(C)]
 pub struct ConnectionRetryStrategy {
@@ -224,6 +237,22 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
 
         let inflight_requests_limit = none_if_zero(value.inflight_requests_limit);
         let lazy_connect = value.lazy_connect;
+        let tls_configuration =
+            value
+                .tls_configuration
+                .0
+                .map(|tls_configuration| TlsConfiguration {
+                    root_certs: tls_configuration
+                        .root_certs
+                        .iter()
+                        .map(|cert| cert.to_vec())
+                        .collect(),
+                    client_cert: (!tls_configuration.client_cert.is_empty())
+                        .then(|| tls_configuration.client_cert.to_vec()),
+                    client_key: (!tls_configuration.client_key.is_empty())
+                        .then(|| tls_configuration.client_key.to_vec()),
+                    server_name: chars_to_string_option(&tls_configuration.server_name),
+                });
 
         ConnectionRequest {
             read_from,
@@ -241,6 +270,7 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
             pubsub_subscriptions,
             inflight_requests_limit,
             lazy_connect,
+            tls_configuration,
         }
     }
 }
@@ -9,6 +9,8 @@ pub enum RequestErrorType {
     ExecAbort = 1,
     Timeout = 2,
     Disconnect = 3,
+    InflightRequestsLimit = 4,
+    NoScript = 5,
 }
 
 pub fn error_type(error: &RedisError) -> RequestErrorType {
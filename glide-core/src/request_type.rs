@@ -144,6 +144,17 @@ pub enum RequestType {
     HSetNX = 614,
     HStrlen = 615,
     HVals = 616,
+    HSetEx = 617,
+    HGetEx = 618,
+    HExpire = 619,
+    HExpireAt = 620,
+    HPExpire = 621,
+    HPExpireAt = 622,
+    HPersist = 623,
+    HTtl = 624,
+    HPTtl = 625,
+    HExpireTime = 626,
+    HPExpireTime = 627,
 
     //// HyperLogLog commands
     PfAdd = 701,
@@ -423,6 +434,16 @@ pub enum RequestType {
     FtInfo = 2111,
     FtProfile = 2112,
     FtSearch = 2113,
+
+    //// Bloom filter commands
+    BfAdd = 2201,
+    BfCard = 2202,
+    BfExists = 2203,
+    BfInfo = 2204,
+    BfInsert = 2205,
+    BfMAdd = 2206,
+    BfMExists = 2207,
+    BfReserve = 2208,
 }
 
 fn get_two_word_command(first: &str, second: &str) -> Cmd {
@@ -447,6 +468,7 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::ConfigSet => RequestType::ConfigSet,
             ProtobufRequestType::ConfigResetStat => RequestType::ConfigResetStat,
             ProtobufRequestType::ConfigRewrite => RequestType::ConfigRewrite,
+            ProtobufRequestType::ClientCaching => RequestType::ClientCaching,
             ProtobufRequestType::ClientGetName => RequestType::ClientGetName,
             ProtobufRequestType::ClientGetRedir => RequestType::ClientGetRedir,
             ProtobufRequestType::ClientId => RequestType::ClientId,
@@ -459,6 +481,8 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::ClientReply => RequestType::ClientReply,
             ProtobufRequestType::ClientSetInfo => RequestType::ClientSetInfo,
             ProtobufRequestType::ClientSetName => RequestType::ClientSetName,
+            ProtobufRequestType::ClientTracking => RequestType::ClientTracking,
+            ProtobufRequestType::ClientTrackingInfo => RequestType::ClientTrackingInfo,
             ProtobufRequestType::ClientUnblock => RequestType::ClientUnblock,
             ProtobufRequestType::ClientUnpause => RequestType::ClientUnpause,
             ProtobufRequestType::Expire => RequestType::Expire,
@@ -555,6 +579,12 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::SMIsMember => RequestType::SMIsMember,
             ProtobufRequestType::ZUnionStore => RequestType::ZUnionStore,
             ProtobufRequestType::LastSave => RequestType::LastSave,
+            ProtobufRequestType::LatencyDoctor => RequestType::LatencyDoctor,
+            ProtobufRequestType::LatencyGraph => RequestType::LatencyGraph,
+            ProtobufRequestType::LatencyHistogram => RequestType::LatencyHistogram,
+            ProtobufRequestType::LatencyHistory => RequestType::LatencyHistory,
+            ProtobufRequestType::LatencyLatest => RequestType::LatencyLatest,
+            ProtobufRequestType::LatencyReset => RequestType::LatencyReset,
             ProtobufRequestType::GeoAdd => RequestType::GeoAdd,
             ProtobufRequestType::GeoHash => RequestType::GeoHash,
             ProtobufRequestType::ObjectEncoding => RequestType::ObjectEncoding,
@@ -564,6 +594,30 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::SDiff => RequestType::SDiff,
             ProtobufRequestType::ObjectRefCount => RequestType::ObjectRefCount,
             ProtobufRequestType::Lolwut => RequestType::Lolwut,
+            ProtobufRequestType::CommandCount => RequestType::CommandCount,
+            ProtobufRequestType::CommandDocs => RequestType::CommandDocs,
+            ProtobufRequestType::CommandGetKeys => RequestType::CommandGetKeys,
+            ProtobufRequestType::CommandGetKeysAndFlags => RequestType::CommandGetKeysAndFlags,
+            ProtobufRequestType::CommandInfo => RequestType::CommandInfo,
+            ProtobufRequestType::CommandList => RequestType::CommandList,
+            ProtobufRequestType::ModuleList => RequestType::ModuleList,
+            ProtobufRequestType::ModuleLoad => RequestType::ModuleLoad,
+            ProtobufRequestType::ModuleLoadEx => RequestType::ModuleLoadEx,
+            ProtobufRequestType::ModuleUnload => RequestType::ModuleUnload,
+            ProtobufRequestType::MemoryDoctor => RequestType::MemoryDoctor,
+            ProtobufRequestType::MemoryMallocStats => RequestType::MemoryMallocStats,
+            ProtobufRequestType::MemoryPurge => RequestType::MemoryPurge,
+            ProtobufRequestType::MemoryStats => RequestType::MemoryStats,
+            ProtobufRequestType::MemoryUsage => RequestType::MemoryUsage,
+            ProtobufRequestType::BgRewriteAof => RequestType::BgRewriteAof,
+            ProtobufRequestType::BgSave => RequestType::BgSave,
+            ProtobufRequestType::FailOver => RequestType::FailOver,
+            ProtobufRequestType::ReplicaOf => RequestType::ReplicaOf,
+            ProtobufRequestType::Role => RequestType::Role,
+            ProtobufRequestType::Save => RequestType::Save,
+            ProtobufRequestType::ShutDown => RequestType::ShutDown,
+            ProtobufRequestType::SwapDb => RequestType::SwapDb,
+            ProtobufRequestType::WaitAof => RequestType::WaitAof,
             ProtobufRequestType::GeoPos => RequestType::GeoPos,
             ProtobufRequestType::BZPopMax => RequestType::BZPopMax,
             ProtobufRequestType::RenameNX => RequestType::RenameNX,
@@ -592,6 +646,17 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::BitPos => RequestType::BitPos,
             ProtobufRequestType::BitOp => RequestType::BitOp,
             ProtobufRequestType::HStrlen => RequestType::HStrlen,
+            ProtobufRequestType::HSetEx => RequestType::HSetEx,
+            ProtobufRequestType::HGetEx => RequestType::HGetEx,
+            ProtobufRequestType::HExpire => RequestType::HExpire,
+            ProtobufRequestType::HExpireAt => RequestType::HExpireAt,
+            ProtobufRequestType::HPExpire => RequestType::HPExpire,
+            ProtobufRequestType::HPExpireAt => RequestType::HPExpireAt,
+            ProtobufRequestType::HPersist => RequestType::HPersist,
+            ProtobufRequestType::HTtl => RequestType::HTtl,
+            ProtobufRequestType::HPTtl => RequestType::HPTtl,
+            ProtobufRequestType::HExpireTime => RequestType::HExpireTime,
+            ProtobufRequestType::HPExpireTime => RequestType::HPExpireTime,
             ProtobufRequestType::ExpireTime => RequestType::ExpireTime,
             ProtobufRequestType::PExpireTime => RequestType::PExpireTime,
             ProtobufRequestType::XLen => RequestType::XLen,
@@ -611,6 +676,9 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::Move => RequestType::Move,
             ProtobufRequestType::SInterCard => RequestType::SInterCard,
             ProtobufRequestType::Copy => RequestType::Copy,
+            ProtobufRequestType::SlowLogGet => RequestType::SlowLogGet,
+            ProtobufRequestType::SlowLogLen => RequestType::SlowLogLen,
+            ProtobufRequestType::SlowLogReset => RequestType::SlowLogReset,
             ProtobufRequestType::Sort => RequestType::Sort,
             ProtobufRequestType::XRevRange => RequestType::XRevRange,
             ProtobufRequestType::MSetNX => RequestType::MSetNX,
@@ -650,6 +718,11 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::PubSubShardChannels => RequestType::PubSubShardChannels,
             ProtobufRequestType::PubSubShardNumSub => RequestType::PubSubShardNumSub,
             ProtobufRequestType::ScriptExists => RequestType::ScriptExists,
+            ProtobufRequestType::ScriptLoad => RequestType::ScriptLoad,
+            ProtobufRequestType::Eval => RequestType::Eval,
+            ProtobufRequestType::EvalReadOnly => RequestType::EvalReadOnly,
+            ProtobufRequestType::EvalSha => RequestType::EvalSha,
+            ProtobufRequestType::EvalShaReadOnly => RequestType::EvalShaReadOnly,
             ProtobufRequestType::ScriptFlush => RequestType::ScriptFlush,
             ProtobufRequestType::ScriptKill => RequestType::ScriptKill,
             ProtobufRequestType::ScriptShow => RequestType::ScriptShow,
@@ -688,6 +761,14 @@ impl From<::protobuf::EnumOrUnknown<ProtobufRequestType>> for RequestType {
             ProtobufRequestType::FtInfo => RequestType::FtInfo,
             ProtobufRequestType::FtProfile => RequestType::FtProfile,
             ProtobufRequestType::FtSearch => RequestType::FtSearch,
+            ProtobufRequestType::BfAdd => RequestType::BfAdd,
+            ProtobufRequestType::BfCard => RequestType::BfCard,
+            ProtobufRequestType::BfExists => RequestType::BfExists,
+            ProtobufRequestType::BfInfo => RequestType::BfInfo,
+            ProtobufRequestType::BfInsert => RequestType::BfInsert,
+            ProtobufRequestType::BfMAdd => RequestType::BfMAdd,
+            ProtobufRequestType::BfMExists => RequestType::BfMExists,
+            ProtobufRequestType::BfReserve => RequestType::BfReserve,
             _ => todo!(),
         }
     }
@@ -709,6 +790,7 @@ impl RequestType {
             RequestType::ConfigSet => Some(get_two_word_command("CONFIG", "SET")),
             RequestType::ConfigResetStat => Some(get_two_word_command("CONFIG", "RESETSTAT")),
             RequestType::ConfigRewrite => Some(get_two_word_command("CONFIG", "REWRITE")),
+            RequestType::ClientCaching => Some(get_two_word_command("CLIENT", "CACHING")),
             RequestType::ClientGetName => Some(get_two_word_command("CLIENT", "GETNAME")),
             RequestType::ClientGetRedir => Some(get_two_word_command("CLIENT", "GETREDIR")),
             RequestType::ClientId => Some(get_two_word_command("CLIENT", "ID")),
@@ -721,6 +803,8 @@ impl RequestType {
             RequestType::ClientReply => Some(get_two_word_command("CLIENT", "REPLY")),
             RequestType::ClientSetInfo => Some(get_two_word_command("CLIENT", "SETINFO")),
             RequestType::ClientSetName => Some(get_two_word_command("CLIENT", "SETNAME")),
+            RequestType::ClientTracking => Some(get_two_word_command("CLIENT", "TRACKING")),
+            RequestType::ClientTrackingInfo => Some(get_two_word_command("CLIENT", "TRACKINGINFO")),
             RequestType::ClientUnblock => Some(get_two_word_command("CLIENT", "UNBLOCK")),
             RequestType::ClientUnpause => Some(get_two_word_command("CLIENT", "UNPAUSE")),
             RequestType::Expire => Some(cmd("EXPIRE")),
@@ -817,6 +901,12 @@ impl RequestType {
             RequestType::SMIsMember => Some(cmd("SMISMEMBER")),
             RequestType::ZUnionStore => Some(cmd("ZUNIONSTORE")),
             RequestType::LastSave => Some(cmd("LASTSAVE")),
+            RequestType::LatencyDoctor => Some(get_two_word_command("LATENCY", "DOCTOR")),
+            RequestType::LatencyGraph => Some(get_two_word_command("LATENCY", "GRAPH")),
+            RequestType::LatencyHistogram => Some(get_two_word_command("LATENCY", "HISTOGRAM")),
+            RequestType::LatencyHistory => Some(get_two_word_command("LATENCY", "HISTORY")),
+            RequestType::LatencyLatest => Some(get_two_word_command("LATENCY", "LATEST")),
+            RequestType::LatencyReset => Some(get_two_word_command("LATENCY", "RESET")),
             RequestType::GeoAdd => Some(cmd("GEOADD")),
             RequestType::GeoHash => Some(cmd("GEOHASH")),
             RequestType::ObjectEncoding => Some(get_two_word_command("OBJECT", "ENCODING")),
@@ -826,6 +916,30 @@ impl RequestType {
             RequestType::SDiff => Some(cmd("SDIFF")),
             RequestType::ObjectRefCount => Some(get_two_word_command("OBJECT", "REFCOUNT")),
             RequestType::Lolwut => Some(cmd("LOLWUT")),
+            RequestType::CommandCount => Some(get_two_word_command("COMMAND", "COUNT")),
+            RequestType::CommandDocs => Some(get_two_word_command("COMMAND", "DOCS")),
+            RequestType::CommandGetKeys => Some(get_two_word_command("COMMAND", "GETKEYS")),
+            RequestType::CommandGetKeysAndFlags => Some(get_two_word_command("COMMAND", "GETKEYSANDFLAGS")),
+            RequestType::CommandInfo => Some(get_two_word_command("COMMAND", "INFO")),
+            RequestType::CommandList => Some(get_two_word_command("COMMAND", "LIST")),
+            RequestType::ModuleList => Some(get_two_word_command("MODULE", "LIST")),
+            RequestType::ModuleLoad => Some(get_two_word_command("MODULE", "LOAD")),
+            RequestType::ModuleLoadEx => Some(get_two_word_command("MODULE", "LOADEX")),
+            RequestType::ModuleUnload => Some(get_two_word_command("MODULE", "UNLOAD")),
+            RequestType::MemoryDoctor => Some(get_two_word_command("MEMORY", "DOCTOR")),
+            RequestType::MemoryMallocStats => Some(get_two_word_command("MEMORY", "MALLOC-STATS")),
+            RequestType::MemoryPurge => Some(get_two_word_command("MEMORY", "PURGE")),
+            RequestType::MemoryStats => Some(get_two_word_command("MEMORY", "STATS")),
+            RequestType::MemoryUsage => Some(get_two_word_command("MEMORY", "USAGE")),
+            RequestType::BgRewriteAof => Some(cmd("BGREWRITEAOF")),
+            RequestType::BgSave => Some(cmd("BGSAVE")),
+            RequestType::FailOver => Some(cmd("FAILOVER")),
+            RequestType::ReplicaOf => Some(cmd("REPLICAOF")),
+            RequestType::Role => Some(cmd("ROLE")),
+            RequestType::Save => Some(cmd("SAVE")),
+            RequestType::ShutDown => Some(cmd("SHUTDOWN")),
+            RequestType::SwapDb => Some(cmd("SWAPDB")),
+            RequestType::WaitAof => Some(cmd("WAITAOF")),
             RequestType::GeoPos => Some(cmd("GEOPOS")),
             RequestType::BZPopMax => Some(cmd("BZPOPMAX")),
             RequestType::RenameNX => Some(cmd("RENAMENX")),
@@ -854,6 +968,17 @@ impl RequestType {
             RequestType::BitPos => Some(cmd("BITPOS")),
             RequestType::BitOp => Some(cmd("BITOP")),
             RequestType::HStrlen => Some(cmd("HSTRLEN")),
+            RequestType::HSetEx => Some(cmd("HSETEX")),
+            RequestType::HGetEx => Some(cmd("HGETEX")),
+            RequestType::HExpire => Some(cmd("HEXPIRE")),
+            RequestType::HExpireAt => Some(cmd("HEXPIREAT")),
+            RequestType::HPExpire => Some(cmd("HPEXPIRE")),
+            RequestType::HPExpireAt => Some(cmd("HPEXPIREAT")),
+            RequestType::HPersist => Some(cmd("HPERSIST")),
+            RequestType::HTtl => Some(cmd("HTTL")),
+            RequestType::HPTtl => Some(cmd("HPTTL")),
+            RequestType::HExpireTime => Some(cmd("HEXPIRETIME")),
+            RequestType::HPExpireTime => Some(cmd("HPEXPIRETIME")),
             RequestType::ExpireTime => Some(cmd("EXPIRETIME")),
             RequestType::PExpireTime => Some(cmd("PEXPIRETIME")),
             RequestType::XLen => Some(cmd("XLEN")),
@@ -873,6 +998,9 @@ impl RequestType {
             RequestType::Move => Some(cmd("MOVE")),
             RequestType::SInterCard => Some(cmd("SINTERCARD")),
             RequestType::Copy => Some(cmd("COPY")),
+            RequestType::SlowLogGet => Some(get_two_word_command("SLOWLOG", "GET")),
+            RequestType::SlowLogLen => Some(get_two_word_command("SLOWLOG", "LEN")),
+            RequestType::SlowLogReset => Some(get_two_word_command("SLOWLOG", "RESET")),
             RequestType::Sort => Some(cmd("SORT")),
             RequestType::XRevRange => Some(cmd("XREVRANGE")),
             RequestType::MSetNX => Some(cmd("MSETNX")),
@@ -917,6 +1045,11 @@ impl RequestType {
             RequestType::PubSubShardNumSub => Some(get_two_word_command("PUBSUB", "SHARDNUMSUB")),
             RequestType::ScriptShow => Some(get_two_word_command("SCRIPT", "SHOW")),
             RequestType::ScriptExists => Some(get_two_word_command("SCRIPT", "EXISTS")),
+            RequestType::ScriptLoad => Some(get_two_word_command("SCRIPT", "LOAD")),
+            RequestType::Eval => Some(cmd("EVAL")),
+            RequestType::EvalReadOnly => Some(cmd("EVAL_RO")),
+            RequestType::EvalSha => Some(cmd("EVALSHA")),
+            RequestType::EvalShaReadOnly => Some(cmd("EVALSHA_RO")),
             RequestType::ScriptFlush => Some(get_two_word_command("SCRIPT", "FLUSH")),
             RequestType::ScriptKill => Some(get_two_word_command("SCRIPT", "KILL")),
             RequestType::JsonArrAppend => Some(cmd("JSON.ARRAPPEND")),
@@ -954,6 +1087,14 @@ impl RequestType {
             RequestType::FtInfo => Some(cmd("FT.INFO")),
             RequestType::FtProfile => Some(cmd("FT.PROFILE")),
             RequestType::FtSearch => Some(cmd("FT.SEARCH")),
+            RequestType::BfAdd => Some(cmd("BF.ADD")),
+            RequestType::BfCard => Some(cmd("BF.CARD")),
+            RequestType::BfExists => Some(cmd("BF.EXISTS")),
+            RequestType::BfInfo => Some(cmd("BF.INFO")),
+            RequestType::BfInsert => Some(cmd("BF.INSERT")),
+            RequestType::BfMAdd => Some(cmd("BF.MADD")),
+            RequestType::BfMExists => Some(cmd("BF.MEXISTS")),
+            RequestType::BfReserve => Some(cmd("BF.RESERVE")),
             _ => todo!(),
         }
     }
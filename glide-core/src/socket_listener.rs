@@ -246,6 +246,10 @@ async fn write_result(
                     RequestErrorType::ExecAbort => response::RequestErrorType::ExecAbort,
                     RequestErrorType::Timeout => response::RequestErrorType::Timeout,
                     RequestErrorType::Disconnect => response::RequestErrorType::Disconnect,
+                    // The socket listener reports the errors specific to the FFI as unspecified errors
+                    RequestErrorType::InflightRequestsLimit | RequestErrorType::NoScript => {
+                        response::RequestErrorType::Unspecified
+                    }
                 }
                 .into(),
                 message: error_message.into(),
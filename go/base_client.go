@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"strconv"
 	"sync"
@@ -49,30 +50,37 @@ type payload struct {
 
 type clientConfiguration interface {
 	ToProtobuf() (*protobuf.ConnectionRequest, error)
+	GetWaitForInflightRequests() bool
+	GetCredentialsProvider() *config.CredentialsProviderConfig
 }
 
 type baseClient struct {
-	pending        map[unsafe.Pointer]struct{}
-	coreClient     unsafe.Pointer
-	mu             *sync.Mutex
-	messageHandler *MessageHandler
+	pending     map[unsafe.Pointer]struct{}
+	coreClient  unsafe.Pointer
+	mu          *sync.Mutex
+	pubsub      *pubSubState
+	cache       *clientSideCache
+	connection  *connectionState
+	inflight    inflightRequests
+	credentials *credentialsRefresh
+	scripts     *loadedScripts
 }
 
 // setMessageHandler assigns a message handler to the client for processing pub/sub messages
 func (client *baseClient) setMessageHandler(handler *MessageHandler) {
-	client.messageHandler = handler
+	client.pubsub.setHandler(handler)
 }
 
 // getMessageHandler returns the currently assigned message handler
 func (client *baseClient) getMessageHandler() *MessageHandler {
-	return client.messageHandler
+	return client.pubsub.getHandler()
 }
 
 // GetQueue returns the pub/sub queue for the client.
 // This method is only available for clients that have a subscription,
 // and returns an error if the client does not have a subscription.
 func (client *baseClient) GetQueue() (*PubSubMessageQueue, error) {
-	// MessageHandler is only configured when a subscription is defined
+	// MessageHandler is only configured when a subscription is defined, either in the configuration or at runtime
 	if client.getMessageHandler() == nil {
 		return nil, errors.New("no subscriptions configured for this client")
 	}
@@ -132,14 +140,34 @@ func buildAsyncClientType(successCb C.SuccessCallback, failureCb C.FailureCallba
 // Passes the pointers to callback functions which will be invoked when the command succeeds or fails.
 // Once the connection is established, this function invokes `free_connection_response` exposed by rust library to free the
 // connection_response to avoid any memory leaks.
-func createClient(config clientConfiguration) (*baseClient, error) {
+// The given client is the one embedded in the returned `Client` or `ClusterClient`, which is registered for the push
+// messages, so that the goroutines handling them see the client once it's closed.
+func createClient(config clientConfiguration, client *baseClient) (err error) {
 	request, err := config.ToProtobuf()
 	if err != nil {
-		return nil, err
+		return err
+	}
+	var credentials *credentialsRefresh
+	if providerConfig := config.GetCredentialsProvider(); providerConfig != nil {
+		connectionTimeout := time.Duration(request.ConnectionTimeout) * time.Millisecond
+		credentials, err = newCredentialsRefresh(providerConfig, connectionTimeout)
+		if err != nil {
+			return NewConnectionError("failed to get the credentials: " + err.Error())
+		}
+		// The refresh is only started once the client is created
+		defer func() {
+			if err != nil {
+				credentials.stop()
+			}
+		}()
+		request.AuthenticationInfo = &protobuf.AuthenticationInfo{
+			Username: credentials.username,
+			Password: credentials.password,
+		}
 	}
 	msg, err := proto.Marshal(request)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	byteCount := len(msg)
@@ -151,31 +179,58 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 		(C.FailureCallback)(unsafe.Pointer(C.failureCallback)),
 	)
 	if err != nil {
-		return nil, NewClosingError(err.Error())
+		return NewClosingError(err.Error())
+	}
+	*client = baseClient{
+		pending: make(map[unsafe.Pointer]struct{}),
+		mu:      &sync.Mutex{},
+		pubsub:  newPubSubState(),
+		scripts: newLoadedScripts(),
 	}
-	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}}
 
+	// The core only forwards the push notifications to the clients given a callback
+	var pushCallback C.PubSubCallback
+	if receivesPushNotifications(config) {
+		pushCallback = (C.PubSubCallback)(unsafe.Pointer(C.pubSubCallback))
+	}
 	cResponse := (*C.struct_ConnectionResponse)(
 		C.create_client(
 			(*C.uchar)(requestBytes),
 			C.uintptr_t(byteCount),
 			&clientType,
-			(C.PubSubCallback)(unsafe.Pointer(C.pubSubCallback)),
+			pushCallback,
 		),
 	)
 	defer C.free_connection_response(cResponse)
 	cErr := cResponse.connection_error_message
 	if cErr != nil {
 		message := C.GoString(cErr)
-		return nil, NewConnectionError(message)
+		return NewConnectionError(message)
 	}
 
 	client.coreClient = cResponse.conn_ptr
+	client.connection = newConnectionState(!request.LazyConnect)
+	if config.GetWaitForInflightRequests() {
+		client.inflight = newInflightRequests(request.InflightRequestsLimit)
+	}
+	client.credentials = credentials
 
 	// Register the client in our registry using the pointer value from C
 	registerClient(client, uintptr(cResponse.conn_ptr))
 
-	return client, nil
+	return nil
+}
+
+// receivesPushNotifications returns whether the client is configured to subscribe, at connection or at runtime, or to
+// cache, which both rely on the push notifications.
+func receivesPushNotifications(clientConfig clientConfiguration) bool {
+	switch clientConfig := clientConfig.(type) {
+	case *config.ClientConfiguration:
+		return clientConfig.GetSubscription() != nil || clientConfig.GetClientSideCache() != nil
+	case *config.ClusterClientConfiguration:
+		return clientConfig.GetSubscription() != nil
+	}
+	return false
 }
 
 // Close terminates the client by closing all associated resources.
@@ -187,6 +242,7 @@ func (client *baseClient) Close() {
 		return
 	}
 
+	client.stopCredentialsRefresh()
 	unregisterClient(uintptr(client.coreClient))
 
 	C.close_client(client.coreClient)
@@ -280,11 +336,39 @@ func (client *baseClient) executeCommandWithRoute(
 	requestType C.RequestType,
 	args []string,
 	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if err := client.connect(ctx); err != nil {
+		return nil, err
+	}
+	return client.sendCommand(ctx, requestType, args, route)
+}
+
+// requestTimeout returns the time left until the deadline of the context in milliseconds, which the core uses as the
+// timeout of the request, rounded up so that a request doesn't time out before the deadline. It returns 0, i.e. the
+// request timeout of the client, if the context has no deadline.
+func requestTimeout(ctx context.Context) uint32 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	timeout := (time.Until(deadline) + time.Millisecond - 1) / time.Millisecond
+	if timeout > math.MaxUint32 {
+		return 0
+	}
+	return uint32(max(timeout, 1))
+}
+
+// sendCommand sends a command to the core, without establishing the connection of a lazy client first.
+func (client *baseClient) sendCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
 ) (*C.struct_CommandResponse, error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return nil, contextError(ctx)
 	default:
 		// Continue with execution
 	}
@@ -329,21 +413,28 @@ func (client *baseClient) executeCommandWithRoute(
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
 	defer pinner.Unpin()
 
+	if err := client.inflight.acquire(ctx); err != nil {
+		return nil, err
+	}
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
+		client.inflight.release()
 		return nil, NewClosingError("executeCommand failed: the client is closed")
 	}
 	client.pending[resultChannelPtr] = struct{}{}
 	C.command(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
+		// Only the requests whose context can be done are registered for cancellation in the core
+		C._Bool(ctx.Done() != nil),
 		uint32(requestType),
 		C.size_t(len(args)),
 		cArgsPtr,
 		argLengthsPtr,
 		routeBytesPtr,
 		routeBytesCount,
+		C.uint32_t(requestTimeout(ctx)),
 		C.uint64_t(spanPtr),
 	)
 	client.mu.Unlock()
@@ -355,16 +446,23 @@ func (client *baseClient) executeCommandWithRoute(
 		if client.pending != nil {
 			delete(client.pending, resultChannelPtr)
 		}
+		coreClient := client.coreClient
 		client.mu.Unlock()
+		// Abort the request in the core, which then replies with an error
+		if coreClient != nil {
+			C.cancel_request(coreClient, C.uintptr_t(pinnedChannelPtr))
+		}
 		// Start cleanup goroutine
 		go func() {
+			defer client.inflight.release()
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
 				C.free_command_response(payload.value)
 			}
 		}()
-		return nil, ctx.Err()
+		return nil, contextError(ctx)
 	case payload = <-resultChannel:
+		client.inflight.release()
 		// Continue with normal processing
 	}
 
@@ -375,7 +473,7 @@ func (client *baseClient) executeCommandWithRoute(
 	client.mu.Unlock()
 
 	if payload.error != nil {
-		return nil, payload.error
+		return nil, requestError(ctx, payload.error)
 	}
 	return payload.value, nil
 }
@@ -401,14 +499,167 @@ func (client *baseClient) executeBatch(
 	batch internal.Batch,
 	raiseOnError bool,
 	options *internal.BatchOptions,
+) ([]any, error) {
+	scriptHashes := batch.ScriptHashes()
+	if len(scriptHashes) == 0 || len(batch.Errors) > 0 {
+		return client.sendBatch(ctx, batch, raiseOnError, options)
+	}
+	var route config.Route
+	if options != nil {
+		route = options.Route
+	}
+
+	// The scripts which the client didn't load yet are loaded before sending the batch, so that each script runs at its
+	// position in the batch
+	if missing := client.scripts.missing(scriptHashes); len(missing) > 0 {
+		if err := client.loadScripts(ctx, missing, route); err != nil {
+			return nil, err
+		}
+		client.scripts.add(missing)
+	}
+
+	// A transaction can't be retried partially, so its scripts are only loaded again before the next batch
+	if batch.IsAtomic {
+		response, err := client.sendBatch(ctx, batch, raiseOnError, options)
+		noScript := isNoScriptError(err)
+		for _, value := range response {
+			noScript = noScript || isNoScriptError(IsError(value))
+		}
+		if noScript {
+			client.scripts.remove(scriptHashes)
+		}
+		return response, err
+	}
+
+	// The scripts which were flushed since they were loaded, or which are missing on a new node, are retried once the
+	// batch completes. The errors are raised after retrying them.
+	response, err := client.sendBatch(ctx, batch, false, options)
+	if err != nil || response == nil {
+		return response, err
+	}
+	for i, cmd := range batch.Commands {
+		if cmd.ScriptHash == "" || !isNoScriptError(IsError(response[i])) {
+			continue
+		}
+		client.scripts.remove([]string{cmd.ScriptHash})
+		numKeys, _ := strconv.Atoi(cmd.Args[1])
+		keys := cmd.Args[2 : 2+numKeys]
+		args := cmd.Args[2+numKeys:]
+		// The core loads the script and retries when the script isn't loaded on the node
+		result, err := client.executeScriptWithRoute(ctx, cmd.ScriptHash, keys, args, route)
+		if err == nil {
+			response[i], err = handleAnyResponse(result)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			response[i] = err
+		}
+	}
+	if raiseOnError {
+		for _, value := range response {
+			if err := IsError(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return response, nil
+}
+
+func isNoScriptError(err error) bool {
+	var noScriptErr *NoScriptError
+	return errors.As(err, &noScriptErr)
+}
+
+// loadedScripts holds the hashes of the scripts which the client loaded for its batches, so that they aren't checked
+// before each batch. A script can still be flushed from the server, or be missing on a new node after a failover.
+type loadedScripts struct {
+	mu     sync.Mutex
+	hashes map[string]struct{}
+}
+
+func newLoadedScripts() *loadedScripts {
+	return &loadedScripts{hashes: map[string]struct{}{}}
+}
+
+// missing returns the hashes of the scripts which the client didn't load.
+func (scripts *loadedScripts) missing(hashes []string) []string {
+	scripts.mu.Lock()
+	defer scripts.mu.Unlock()
+	var missing []string
+	for _, hash := range hashes {
+		if _, ok := scripts.hashes[hash]; !ok {
+			missing = append(missing, hash)
+		}
+	}
+	return missing
+}
+
+func (scripts *loadedScripts) add(hashes []string) {
+	scripts.mu.Lock()
+	defer scripts.mu.Unlock()
+	for _, hash := range hashes {
+		scripts.hashes[hash] = struct{}{}
+	}
+}
+
+func (scripts *loadedScripts) remove(hashes []string) {
+	scripts.mu.Lock()
+	defer scripts.mu.Unlock()
+	for _, hash := range hashes {
+		delete(scripts.hashes, hash)
+	}
+}
+
+// loadScripts loads the scripts of a batch which aren't loaded yet with `SCRIPT EXISTS` and `SCRIPT LOAD`, on the route
+// of the batch. In cluster mode, the scripts are loaded on all the primaries, unless the batch is routed to a node.
+func (client *baseClient) loadScripts(ctx context.Context, hashes []string, route config.Route) error {
+	if route == nil {
+		route = config.AllPrimaries
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.ScriptExists, hashes, route)
+	if err != nil {
+		return err
+	}
+	// The core aggregates the replies of several nodes, so a script exists only if it's loaded on all of them
+	exists, err := handleBoolArrayResponse(response)
+	if err != nil {
+		return err
+	}
+	for i, hash := range hashes {
+		if exists[i] {
+			continue
+		}
+		code, ok := utils.GetScriptCode(hash)
+		if !ok {
+			return fmt.Errorf("the script %s was closed before the batch was executed", hash)
+		}
+		response, err := client.executeCommandWithRoute(ctx, C.ScriptLoad, []string{code}, route)
+		if err != nil {
+			return err
+		}
+		C.free_command_response(response)
+	}
+	return nil
+}
+
+func (client *baseClient) sendBatch(
+	ctx context.Context,
+	batch internal.Batch,
+	raiseOnError bool,
+	options *internal.BatchOptions,
 ) ([]any, error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return nil, contextError(ctx)
 	default:
 		// Continue with execution
 	}
+	if err := client.connect(ctx); err != nil {
+		return nil, err
+	}
 	if len(batch.Errors) > 0 {
 		return nil, NewBatchError(batch.Errors)
 	}
@@ -431,9 +682,13 @@ func (client *baseClient) executeBatch(
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
 	defer pinner.Unpin()
 
+	if err := client.inflight.acquire(ctx); err != nil {
+		return nil, err
+	}
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
+		client.inflight.release()
 		return nil, NewClosingError("ExecuteBatch failed. The client is closed.")
 	}
 	client.pending[resultChannelPtr] = struct{}{}
@@ -448,9 +703,12 @@ func (client *baseClient) executeBatch(
 	C.batch(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
+		// Only the requests whose context can be done are registered for cancellation in the core
+		C._Bool(ctx.Done() != nil),
 		&batchInfo,
 		C._Bool(raiseOnError),
 		optionsPtr,
+		C.uint32_t(requestTimeout(ctx)),
 		C.uint64_t(spanPtr),
 	)
 	client.mu.Unlock()
@@ -463,16 +721,23 @@ func (client *baseClient) executeBatch(
 		if client.pending != nil {
 			delete(client.pending, resultChannelPtr)
 		}
+		coreClient := client.coreClient
 		client.mu.Unlock()
+		// Abort the request in the core, which then replies with an error
+		if coreClient != nil {
+			C.cancel_request(coreClient, C.uintptr_t(pinnedChannelPtr))
+		}
 		// Start cleanup goroutine
 		go func() {
+			defer client.inflight.release()
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
 				C.free_command_response(payload.value)
 			}
 		}()
-		return nil, ctx.Err()
+		return nil, contextError(ctx)
 	case payload = <-resultChannel:
+		client.inflight.release()
 		// Continue with normal processing
 	}
 
@@ -483,7 +748,7 @@ func (client *baseClient) executeBatch(
 	client.mu.Unlock()
 
 	if payload.error != nil {
-		return nil, payload.error
+		return nil, requestError(ctx, payload.error)
 	}
 	response, err := handleAnyArrayOrNilResponse(payload.value)
 	if err != nil {
@@ -601,10 +866,13 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
-		return models.DefaultStringResponse, ctx.Err()
+		return models.DefaultStringResponse, contextError(ctx)
 	default:
 		// Continue with execution
 	}
+	if err := client.connect(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
 
 	// Create a channel to receive the result
 	resultChannel := make(chan payload, 1)
@@ -614,9 +882,13 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
 	defer pinner.Unpin()
 
+	if err := client.inflight.acquire(ctx); err != nil {
+		return models.DefaultStringResponse, err
+	}
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
+		client.inflight.release()
 		return models.DefaultStringResponse, NewClosingError("UpdatePassword failed. The client is closed.")
 	}
 	client.pending[resultChannelPtr] = struct{}{}
@@ -626,6 +898,8 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 	C.update_connection_password(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
+		// Only the requests whose context can be done are registered for cancellation in the core
+		C._Bool(ctx.Done() != nil),
 		password_cstring,
 		C._Bool(immediateAuth),
 	)
@@ -639,16 +913,23 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 		if client.pending != nil {
 			delete(client.pending, resultChannelPtr)
 		}
+		coreClient := client.coreClient
 		client.mu.Unlock()
+		// Abort the request in the core, which then replies with an error
+		if coreClient != nil {
+			C.cancel_request(coreClient, C.uintptr_t(pinnedChannelPtr))
+		}
 		// Start cleanup goroutine
 		go func() {
+			defer client.inflight.release()
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
 				C.free_command_response(payload.value)
 			}
 		}()
-		return models.DefaultStringResponse, ctx.Err()
+		return models.DefaultStringResponse, contextError(ctx)
 	case payload = <-resultChannel:
+		client.inflight.release()
 		// Continue with normal processing
 	}
 
@@ -798,6 +1079,15 @@ func (client *baseClient) SetWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/get/
 func (client *baseClient) Get(ctx context.Context, key string) (models.Result[string], error) {
+	if client.cache != nil {
+		return cachedRead(client.cache, getCacheKind, key, nil, func() (models.Result[string], error) {
+			return client.get(ctx, key)
+		})
+	}
+	return client.get(ctx, key)
+}
+
+func (client *baseClient) get(ctx context.Context, key string) (models.Result[string], error) {
 	result, err := client.executeCommand(ctx, C.Get, []string{key})
 	if err != nil {
 		return models.CreateNilStringResult(), err
@@ -951,6 +1241,9 @@ func (client *baseClient) MSetNX(ctx context.Context, keyValueMap map[string]str
 //
 // [valkey.io]: https://valkey.io/commands/mget/
 func (client *baseClient) MGet(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	if client.cache != nil {
+		return client.cachedMGet(ctx, keys)
+	}
 	result, err := client.executeCommand(ctx, C.MGet, keys)
 	if err != nil {
 		return nil, err
@@ -1365,6 +1658,15 @@ func (client *baseClient) HGet(ctx context.Context, key string, field string) (m
 //
 // [valkey.io]: https://valkey.io/commands/hgetall/
 func (client *baseClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	if client.cache != nil {
+		return cachedRead(client.cache, hGetAllCacheKind, key, maps.Clone, func() (map[string]string, error) {
+			return client.hGetAll(ctx, key)
+		})
+	}
+	return client.hGetAll(ctx, key)
+}
+
+func (client *baseClient) hGetAll(ctx context.Context, key string) (map[string]string, error) {
 	result, err := client.executeCommand(ctx, C.HGetAll, []string{key})
 	if err != nil {
 		return nil, err
@@ -1811,432 +2113,577 @@ func (client *baseClient) HRandFieldWithCountWithValues(ctx context.Context, key
 	return handle2DStringArrayResponse(result)
 }
 
-// Inserts all the specified values at the head of the list stored at key. elements are inserted one after the other to the
-// head of the list, from the leftmost element to the rightmost element. If key does not exist, it is created as an empty
-// list before performing the push operation.
+// Sets a timeout on fields of the hash stored at `key`, in seconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx      - The context for controlling the command execution.
-//	key      - The key of the list.
-//	elements - The elements to insert at the head of the list stored at key.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, a whole number of seconds.
+//	fields - The fields to expire.
 //
 // Return value:
 //
-//	The length of the list after the push operation.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpush/
-func (client *baseClient) LPush(ctx context.Context, key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.LPush, append([]string{key}, elements...))
+// [valkey.io]: https://valkey.io/commands/hexpire/
+func (client *baseClient) HExpire(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	fields []string,
+) ([]models.HashFieldExpireResult, error) {
+	seconds, err := utils.DurationToSeconds(expireTime)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-
-	return handleIntResponse(result)
+	return client.hashFieldExpire(ctx, C.HExpire, key, []string{seconds}, fields)
 }
 
-// Removes and returns the first elements of the list stored at key. The command pops a single element from the beginning
-// of the list.
+// Sets a timeout on fields of the hash stored at `key`, in seconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list.
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, a whole number of seconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
 //
 // Return value:
 //
-//	The models.Result[string] containing the value of the first element.
-//	If key does not exist, [models.CreateNilStringResult()] will be returned.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpop/
-func (client *baseClient) LPop(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.LPop, []string{key})
+// [valkey.io]: https://valkey.io/commands/hexpire/
+func (client *baseClient) HExpireWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) ([]models.HashFieldExpireResult, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return nil, err
 	}
-
-	return handleStringOrNilResponse(result)
+	seconds, err := utils.DurationToSeconds(expireTime)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{seconds, expireConditionStr}
+	return client.hashFieldExpire(ctx, C.HExpire, key, args, fields)
 }
 
-// Removes and returns up to `count` elements of the list stored at key, depending on the list's length.
+// Sets a timeout on fields of the hash stored at `key`, in milliseconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the list.
-//	count - The count of the elements to pop from the list.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, sent in milliseconds.
+//	fields - The fields to expire.
 //
 // Return value:
 //
-//	An array of the popped elements as strings will be returned depending on the list's length
-//	If key does not exist, nil will be returned.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpop/
-func (client *baseClient) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.LPop, []string{key, utils.IntToString(count)})
-	if err != nil {
-		return nil, err
-	}
-
-	return handleStringArrayOrNilResponse(result)
+// [valkey.io]: https://valkey.io/commands/hpexpire/
+func (client *baseClient) HPExpire(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	fields []string,
+) ([]models.HashFieldExpireResult, error) {
+	return client.hashFieldExpire(ctx, C.HPExpire, key, []string{utils.IntToString(expireTime.Milliseconds())}, fields)
 }
 
-// Returns the index of the first occurrence of element inside the list specified by key. If no match is found,
-// [models.CreateNilInt64Result()] is returned.
+// Sets a timeout on fields of the hash stored at `key`, in milliseconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The name of the list.
-//	element - The value to search for within the list.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, sent in milliseconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
 //
 // Return value:
 //
-//	The models.Result[int64] containing the index of the first occurrence of element, or [models.CreateNilInt64Result()] if
-//	element is not in the list.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPos(ctx context.Context, key string, element string) (models.Result[int64], error) {
-	result, err := client.executeCommand(ctx, C.LPos, []string{key, element})
+// [valkey.io]: https://valkey.io/commands/hpexpire/
+func (client *baseClient) HPExpireWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) ([]models.HashFieldExpireResult, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
-		return models.CreateNilInt64Result(), err
+		return nil, err
 	}
-
-	return handleIntOrNilResponse(result)
+	args := []string{utils.IntToString(expireTime.Milliseconds()), expireConditionStr}
+	return client.hashFieldExpire(ctx, C.HPExpire, key, args, fields)
 }
 
-// Returns the index of an occurrence of element within a list based on the given options. If no match is found,
-// [models.CreateNilInt64Result()] is returned.
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in seconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The name of the list.
-//	element - The value to search for within the list.
-//	options - The LPos options.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in seconds.
+//	fields - The fields to expire.
 //
 // Return value:
 //
-//	The models.Result[int64] containing the index of element, or [models.CreateNilInt64Result()] if element is not in the list.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPosWithOptions(
+// [valkey.io]: https://valkey.io/commands/hexpireat/
+func (client *baseClient) HExpireAt(
 	ctx context.Context,
 	key string,
-	element string,
-	options options.LPosOptions,
-) (models.Result[int64], error) {
-	optionArgs, err := options.ToArgs()
-	if err != nil {
-		return models.CreateNilInt64Result(), err
-	}
-	result, err := client.executeCommand(ctx, C.LPos, append([]string{key, element}, optionArgs...))
-	if err != nil {
-		return models.CreateNilInt64Result(), err
-	}
-
-	return handleIntOrNilResponse(result)
+	expireTime time.Time,
+	fields []string,
+) ([]models.HashFieldExpireResult, error) {
+	return client.hashFieldExpire(ctx, C.HExpireAt, key, []string{utils.IntToString(expireTime.Unix())}, fields)
 }
 
-// Returns an array of indices of matching elements within a list.
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in seconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The name of the list.
-//	element - The value to search for within the list.
-//	count   - The number of matches wanted.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in seconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
 //
 // Return value:
 //
-//	An array that holds the indices of the matching elements within the list.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPosCount(ctx context.Context, key string, element string, count int64) ([]int64, error) {
-	result, err := client.executeCommand(ctx, C.LPos, []string{key, element, constants.CountKeyword, utils.IntToString(count)})
+// [valkey.io]: https://valkey.io/commands/hexpireat/
+func (client *baseClient) HExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Time,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) ([]models.HashFieldExpireResult, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
 		return nil, err
 	}
-
-	return handleIntArrayResponse(result)
+	args := []string{utils.IntToString(expireTime.Unix()), expireConditionStr}
+	return client.hashFieldExpire(ctx, C.HExpireAt, key, args, fields)
 }
 
-// Returns an array of indices of matching elements within a list based on the given options. If no match is found, an
-// empty array is returned.
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in milliseconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The name of the list.
-//	element - The value to search for within the list.
-//	count   - The number of matches wanted.
-//	opts    - The LPos options.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in milliseconds.
+//	fields - The fields to expire.
 //
 // Return value:
 //
-//	An array that holds the indices of the matching elements within the list.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/lpos/
-func (client *baseClient) LPosCountWithOptions(
+// [valkey.io]: https://valkey.io/commands/hpexpireat/
+func (client *baseClient) HPExpireAt(
 	ctx context.Context,
 	key string,
-	element string,
-	count int64,
-	opts options.LPosOptions,
-) ([]int64, error) {
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	result, err := client.executeCommand(ctx,
-		C.LPos,
-		append([]string{key, element, constants.CountKeyword, utils.IntToString(count)}, optionArgs...),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return handleIntArrayResponse(result)
+	expireTime time.Time,
+	fields []string,
+) ([]models.HashFieldExpireResult, error) {
+	return client.hashFieldExpire(ctx, C.HPExpireAt, key, []string{utils.IntToString(expireTime.UnixMilli())}, fields)
 }
 
-// Inserts all the specified values at the tail of the list stored at key.
-// elements are inserted one after the other to the tail of the list, from the leftmost element to the rightmost element.
-// If key does not exist, it is created as an empty list before performing the push operation.
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in milliseconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx      - The context for controlling the command execution.
-//	key      - The key of the list.
-//	elements - The elements to insert at the tail of the list stored at key.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in milliseconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
 //
 // Return value:
 //
-//	The length of the list after the push operation.
+//	The status of each field, in the order of `fields`: [models.HashFieldExpireSet] if the expiration was set,
+//	[models.HashFieldExpireConditionNotMet] if the condition wasn't met, [models.HashFieldExpireDeleted] if the
+//	field was deleted because the expiration is in the past, and [models.HashFieldExpireNoSuchField] if the field or
+//	the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/rpush/
-func (client *baseClient) RPush(ctx context.Context, key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.RPush, append([]string{key}, elements...))
+// [valkey.io]: https://valkey.io/commands/hpexpireat/
+func (client *baseClient) HPExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Time,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) ([]models.HashFieldExpireResult, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
+	args := []string{utils.IntToString(expireTime.UnixMilli()), expireConditionStr}
+	return client.hashFieldExpire(ctx, C.HPExpireAt, key, args, fields)
+}
 
-	return handleIntResponse(result)
+func (client *baseClient) hashFieldExpire(
+	ctx context.Context,
+	requestType C.RequestType,
+	key string,
+	opts []string,
+	fields []string,
+) ([]models.HashFieldExpireResult, error) {
+	result, err := client.executeCommand(ctx, requestType, utils.ConvertToHashFieldsArgs(key, opts, fields))
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(result, internal.ConvertHashFieldExpireResults)
 }
 
-// SAdd adds specified members to the set stored at key.
+// Removes the expiration of fields of the hash stored at `key`, so that they are kept until they are deleted.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The key where members will be added to its set.
-//	members - A list of members to add to the set stored at key.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	fields - The fields to persist.
 //
 // Return value:
 //
-//	The number of members that were added to the set, excluding members already present.
+//	The status of each field, in the order of `fields`: [models.HashFieldPersistRemoved] if the expiration was
+//	removed, [models.HashFieldPersistNoExpiry] if the field has no expiration, and
+//	[models.HashFieldPersistNoSuchField] if the field or the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/sadd/
-func (client *baseClient) SAdd(ctx context.Context, key string, members []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SAdd, append([]string{key}, members...))
+// [valkey.io]: https://valkey.io/commands/hpersist/
+func (client *baseClient) HPersist(
+	ctx context.Context,
+	key string,
+	fields []string,
+) ([]models.HashFieldPersistResult, error) {
+	result, err := client.executeCommand(ctx, C.HPersist, utils.ConvertToHashFieldsArgs(key, []string{}, fields))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-
-	return handleIntResponse(result)
+	return handleConvertedResponse(result, internal.ConvertHashFieldPersistResults)
 }
 
-// SRem removes specified members from the set stored at key.
+// Returns the remaining time to live of fields of the hash stored at `key`, in seconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The key from which members will be removed.
-//	members - A list of members to remove from the set stored at key.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	fields - The fields to query.
 //
 // Return value:
 //
-//	The number of members that were removed from the set, excluding non-existing members.
+//	The remaining time to live of each field in seconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/srem/
-func (client *baseClient) SRem(ctx context.Context, key string, members []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SRem, append([]string{key}, members...))
+// [valkey.io]: https://valkey.io/commands/httl/
+func (client *baseClient) HTTL(ctx context.Context, key string, fields []string) ([]int64, error) {
+	result, err := client.executeCommand(ctx, C.HTtl, utils.ConvertToHashFieldsArgs(key, []string{}, fields))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-
-	return handleIntResponse(result)
+	return handleIntArrayResponse(result)
 }
 
-// SUnionStore stores the members of the union of all given sets specified by `keys` into a new set at `destination`.
+// Returns the remaining time to live of fields of the hash stored at `key`, in milliseconds.
 //
-// Note:
+// Since:
 //
-//	When in cluster mode, `destination` and all `keys` must map to the same hash slot.
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destination - The key of the destination set.
-//	keys - The keys from which to retrieve the set members.
+//	key - The key of the hash.
+//	fields - The fields to query.
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	The remaining time to live of each field in milliseconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/sunionstore/
-func (client *baseClient) SUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SUnionStore, append([]string{destination}, keys...))
+// [valkey.io]: https://valkey.io/commands/hpttl/
+func (client *baseClient) HPTTL(ctx context.Context, key string, fields []string) ([]int64, error) {
+	result, err := client.executeCommand(ctx, C.HPTtl, utils.ConvertToHashFieldsArgs(key, []string{}, fields))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-
-	return handleIntResponse(result)
+	return handleIntArrayResponse(result)
 }
 
-// SMembers retrieves all the members of the set value stored at key.
+// Returns the absolute Unix timestamp at which fields of the hash stored at `key` will expire, in seconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key from which to retrieve the set members.
+//	key - The key of the hash.
+//	fields - The fields to query.
 //
 // Return value:
 //
-//	A `map[string]struct{}` containing all members of the set.
-//	Returns an empty collection if key does not exist.
+//	The expiration Unix timestamp of each field in seconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/smembers/
-func (client *baseClient) SMembers(ctx context.Context, key string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(ctx, C.SMembers, []string{key})
+// [valkey.io]: https://valkey.io/commands/hexpiretime/
+func (client *baseClient) HExpireTime(ctx context.Context, key string, fields []string) ([]int64, error) {
+	result, err := client.executeCommand(ctx, C.HExpireTime, utils.ConvertToHashFieldsArgs(key, []string{}, fields))
 	if err != nil {
 		return nil, err
 	}
-
-	return handleStringSetResponse(result)
+	return handleIntArrayResponse(result)
 }
 
-// SCard retrieves the set cardinality (number of elements) of the set stored at key.
+// Returns the absolute Unix timestamp at which fields of the hash stored at `key` will expire, in
+// milliseconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key from which to retrieve the number of set members.
+//	key - The key of the hash.
+//	fields - The fields to query.
 //
 // Return value:
 //
-//	The cardinality (number of elements) of the set, or `0` if the key does not exist.
+//	The expiration Unix timestamp of each field in milliseconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/scard/
-func (client *baseClient) SCard(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SCard, []string{key})
+// [valkey.io]: https://valkey.io/commands/hpexpiretime/
+func (client *baseClient) HPExpireTime(ctx context.Context, key string, fields []string) ([]int64, error) {
+	result, err := client.executeCommand(ctx, C.HPExpireTime, utils.ConvertToHashFieldsArgs(key, []string{}, fields))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-
-	return handleIntResponse(result)
+	return handleIntArrayResponse(result)
 }
 
-// SIsMember returns if member is a member of the set stored at key.
+// Returns the values of fields of the hash stored at `key`, and optionally sets or removes their expiration.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx    - The context for controlling the command execution.
-//	key    - The key of the set.
-//	member - The member to check for existence in the set.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	fields - The fields to retrieve.
+//	options - The [options.HGetExOptions].
 //
 // Return value:
 //
-//	A bool containing true if the member exists in the set, false otherwise.
-//	If key doesn't exist, it is treated as an empty set and the method returns false.
+//	The values of the fields, in the order of `fields`. For every field that does not exist in the hash, a
+//	[models.CreateNilStringResult()] is returned.
 //
-// [valkey.io]: https://valkey.io/commands/sismember/
-func (client *baseClient) SIsMember(ctx context.Context, key string, member string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.SIsMember, []string{key, member})
+// [valkey.io]: https://valkey.io/commands/hgetex/
+func (client *baseClient) HGetEx(
+	ctx context.Context,
+	key string,
+	fields []string,
+	options options.HGetExOptions,
+) ([]models.Result[string], error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
 
-	return handleBoolResponse(result)
+	result, err := client.executeCommand(ctx, C.HGetEx, utils.ConvertToHashFieldsArgs(key, optionArgs, fields))
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringOrNilArrayResponse(result)
 }
 
-// SDiff computes the difference between the first set and all the successive sets in keys.
+// Sets the specified fields to their respective values in the hash stored at `key`, and optionally sets their
+// expiration. If key doesn't exist, a new key holding a hash is created.
 //
-// Note:
+// Since:
 //
-//	When in cluster mode, all keys must map to the same hash slot.
+//	Valkey 9.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sets to diff.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	values - A map of field-value pairs to set in the hash.
+//	options - The [options.HSetExOptions].
 //
 // Return value:
 //
-//	A `map[string]struct{}` representing the difference between the sets.
-//	If a key does not exist, it is treated as an empty set.
+//	`true` if all the fields were set. `false` if no field was set, because a condition of `options` wasn't met.
 //
-// [valkey.io]: https://valkey.io/commands/sdiff/
-func (client *baseClient) SDiff(ctx context.Context, keys []string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(ctx, C.SDiff, keys)
+// [valkey.io]: https://valkey.io/commands/hsetex/
+func (client *baseClient) HSetEx(
+	ctx context.Context,
+	key string,
+	values map[string]string,
+	options options.HSetExOptions,
+) (bool, error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return nil, err
+		return models.DefaultBoolResponse, err
 	}
 
-	return handleStringSetResponse(result)
+	args := utils.Concat(
+		[]string{key},
+		optionArgs,
+		[]string{constants.FieldsKeyword, strconv.Itoa(len(values))},
+		utils.MapToString(values),
+	)
+	result, err := client.executeCommand(ctx, C.HSetEx, args)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+
+	// Unlike HSETNX, the reply of HSETEX isn't converted to a boolean by the core
+	allSet, err := handleIntResponse(result)
+	return allSet == 1, err
 }
 
-// SDiffStore stores the difference between the first set and all the successive sets in `keys`
-// into a new set at `destination`.
-//
-// Note:
-//
-//	When in cluster mode, `destination` and all keys must map to the same hash slot.
+// Inserts all the specified values at the head of the list stored at key. elements are inserted one after the other to the
+// head of the list, from the leftmost element to the rightmost element. If key does not exist, it is created as an empty
+// list before performing the push operation.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	destination - The key of the destination set.
-//	keys        - The keys of the sets to diff.
+//	ctx      - The context for controlling the command execution.
+//	key      - The key of the list.
+//	elements - The elements to insert at the head of the list stored at key.
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	The length of the list after the push operation.
 //
-// [valkey.io]: https://valkey.io/commands/sdiffstore/
-func (client *baseClient) SDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SDiffStore, append([]string{destination}, keys...))
+// [valkey.io]: https://valkey.io/commands/lpush/
+func (client *baseClient) LPush(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LPush, append([]string{key}, elements...))
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
@@ -2244,486 +2691,462 @@ func (client *baseClient) SDiffStore(ctx context.Context, destination string, ke
 	return handleIntResponse(result)
 }
 
-// SInter gets the intersection of all the given sets.
-//
-// Note:
-//
-//	When in cluster mode, all keys must map to the same hash slot.
+// Removes and returns the first elements of the list stored at key. The command pops a single element from the beginning
+// of the list.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sets to intersect.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list.
 //
 // Return value:
 //
-//	A `map[string]struct{}` containing members which are present in all given sets.
-//	If one or more sets do not exist, an empty collection will be returned.
+//	The models.Result[string] containing the value of the first element.
+//	If key does not exist, [models.CreateNilStringResult()] will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/sinter/
-func (client *baseClient) SInter(ctx context.Context, keys []string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(ctx, C.SInter, keys)
+// [valkey.io]: https://valkey.io/commands/lpop/
+func (client *baseClient) LPop(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.LPop, []string{key})
 	if err != nil {
-		return nil, err
+		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringSetResponse(result)
+	return handleStringOrNilResponse(result)
 }
 
-// Stores the members of the intersection of all given sets specified by `keys` into a new set at `destination`
-//
-// Note:
-//
-//	When in cluster mode, `destination` and all keys must map to the same hash slot.
+// Removes and returns up to `count` elements of the list stored at key, depending on the list's length.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	destination - The key of the destination set.
-//	keys - The keys from which to retrieve the set members.
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the list.
+//	count - The count of the elements to pop from the list.
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	An array of the popped elements as strings will be returned depending on the list's length
+//	If key does not exist, nil will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/sinterstore/
-func (client *baseClient) SInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SInterStore, append([]string{destination}, keys...))
+// [valkey.io]: https://valkey.io/commands/lpop/
+func (client *baseClient) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.LPop, []string{key, utils.IntToString(count)})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
 
-	return handleIntResponse(result)
+	return handleStringArrayOrNilResponse(result)
 }
 
-// SInterCard gets the cardinality of the intersection of all the given sets.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
-//
-// Note:
-//
-//	When in cluster mode, all keys must map to the same hash slot.
+// Returns the index of the first occurrence of element inside the list specified by key. If no match is found,
+// [models.CreateNilInt64Result()] is returned.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sets to intersect.
+//	ctx     - The context for controlling the command execution.
+//	key     - The name of the list.
+//	element - The value to search for within the list.
 //
 // Return value:
 //
-//	The cardinality of the intersection result. If one or more sets do not exist, `0` is returned.
+//	The models.Result[int64] containing the index of the first occurrence of element, or [models.CreateNilInt64Result()] if
+//	element is not in the list.
 //
-// [valkey.io]: https://valkey.io/commands/sintercard/
-func (client *baseClient) SInterCard(ctx context.Context, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SInterCard, append([]string{strconv.Itoa(len(keys))}, keys...))
+// [valkey.io]: https://valkey.io/commands/lpos/
+func (client *baseClient) LPos(ctx context.Context, key string, element string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.LPos, []string{key, element})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilInt64Result(), err
 	}
 
-	return handleIntResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// SInterCardLimit gets the cardinality of the intersection of all the given sets, up to the specified limit.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
-//
-// Note:
-//
-//	When in cluster mode, all keys must map to the same hash slot.
+// Returns the index of an occurrence of element within a list based on the given options. If no match is found,
+// [models.CreateNilInt64Result()] is returned.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	keys  - The keys of the sets to intersect.
-//	limit - The limit for the intersection cardinality value.
+//	ctx     - The context for controlling the command execution.
+//	key     - The name of the list.
+//	element - The value to search for within the list.
+//	options - The LPos options.
 //
 // Return value:
 //
-//	The cardinality of the intersection result, or the limit if reached.
-//	If one or more sets do not exist, `0` is returned.
-//	If the intersection cardinality reaches 'limit' partway through the computation, returns 'limit' as the cardinality.
+//	The models.Result[int64] containing the index of element, or [models.CreateNilInt64Result()] if element is not in the list.
 //
-// [valkey.io]: https://valkey.io/commands/sintercard/
-func (client *baseClient) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
-	args := utils.Concat(
-		[]string{utils.IntToString(int64(len(keys)))},
-		keys,
-		[]string{constants.LimitKeyword, utils.IntToString(limit)},
-	)
-
-	result, err := client.executeCommand(ctx, C.SInterCard, args)
+// [valkey.io]: https://valkey.io/commands/lpos/
+func (client *baseClient) LPosWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	options options.LPosOptions,
+) (models.Result[int64], error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilInt64Result(), err
+	}
+	result, err := client.executeCommand(ctx, C.LPos, append([]string{key, element}, optionArgs...))
+	if err != nil {
+		return models.CreateNilInt64Result(), err
 	}
 
-	return handleIntResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// SRandMember returns a random element from the set value stored at key.
+// Returns an array of indices of matching elements within a list.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key from which to retrieve the set member.
+//	ctx     - The context for controlling the command execution.
+//	key     - The name of the list.
+//	element - The value to search for within the list.
+//	count   - The number of matches wanted.
 //
 // Return value:
 //
-//	A models.Result[string] containing a random element from the set.
-//	Returns models.CreateNilStringResult() if key does not exist.
+//	An array that holds the indices of the matching elements within the list.
 //
-// [valkey.io]: https://valkey.io/commands/srandmember/
-func (client *baseClient) SRandMember(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.SRandMember, []string{key})
+// [valkey.io]: https://valkey.io/commands/lpos/
+func (client *baseClient) LPosCount(ctx context.Context, key string, element string, count int64) ([]int64, error) {
+	result, err := client.executeCommand(ctx, C.LPos, []string{key, element, constants.CountKeyword, utils.IntToString(count)})
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return nil, err
 	}
 
-	return handleStringOrNilResponse(result)
+	return handleIntArrayResponse(result)
 }
 
-// SRandMemberCount returns multiple random members from the set value stored at key.
+// Returns an array of indices of matching elements within a list based on the given options. If no match is found, an
+// empty array is returned.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key from which to retrieve the set members.
-//	count - The number of members to return.
-//	       If count is positive, returns unique elements (no repetition) up to count or the set size, whichever is smaller.
-//	       If count is negative, returns elements with possible repetition (the same element may be returned multiple times),
-//	       and the number of returned elements is the absolute value of count.
+//	ctx     - The context for controlling the command execution.
+//	key     - The name of the list.
+//	element - The value to search for within the list.
+//	count   - The number of matches wanted.
+//	opts    - The LPos options.
 //
 // Return value:
 //
-//	An array of random elements from the set.
-//	When count is positive, the returned elements are unique (no repetitions).
-//	When count is negative, the returned elements may contain duplicates.
-//	If the set does not exist or is empty, an empty array is returned.
+//	An array that holds the indices of the matching elements within the list.
 //
-// [valkey.io]: https://valkey.io/commands/srandmember/
-func (client *baseClient) SRandMemberCount(ctx context.Context, key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.SRandMember, []string{key, utils.IntToString(count)})
+// [valkey.io]: https://valkey.io/commands/lpos/
+func (client *baseClient) LPosCountWithOptions(
+	ctx context.Context,
+	key string,
+	element string,
+	count int64,
+	opts options.LPosOptions,
+) ([]int64, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.executeCommand(ctx,
+		C.LPos,
+		append([]string{key, element, constants.CountKeyword, utils.IntToString(count)}, optionArgs...),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return handleStringArrayResponse(result)
+	return handleIntArrayResponse(result)
 }
 
-// SPop removes and returns one random member from the set stored at key.
+// Inserts all the specified values at the tail of the list stored at key.
+// elements are inserted one after the other to the tail of the list, from the leftmost element to the rightmost element.
+// If key does not exist, it is created as an empty list before performing the push operation.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the set.
+//	ctx      - The context for controlling the command execution.
+//	key      - The key of the list.
+//	elements - The elements to insert at the tail of the list stored at key.
 //
 // Return value:
 //
-//	A models.Result[string] containing the value of the popped member.
-//	Returns a NilResult if key does not exist.
+//	The length of the list after the push operation.
 //
-// [valkey.io]: https://valkey.io/commands/spop/
-func (client *baseClient) SPop(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.SPop, []string{key})
+// [valkey.io]: https://valkey.io/commands/rpush/
+func (client *baseClient) RPush(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.RPush, append([]string{key}, elements...))
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStringOrNilResponse(result)
+	return handleIntResponse(result)
 }
 
-// SpopCount removes and returns up to count random members from the set stored at key.
+// SAdd adds specified members to the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	count - The number of members to return.
-//		If count is positive, returns unique elements.
-//		If count is larger than the set's cardinality, returns the entire set.
+//	ctx     - The context for controlling the command execution.
+//	key     - The key where members will be added to its set.
+//	members - A list of members to add to the set stored at key.
 //
 // Return value:
 //
-//	A `map[string]struct{}` of popped elements.
-//	If key does not exist, an empty collection will be returned.
+//	The number of members that were added to the set, excluding members already present.
 //
-// [valkey.io]: https://valkey.io/commands/spop/
-func (client *baseClient) SPopCount(ctx context.Context, key string, count int64) (map[string]struct{}, error) {
-	result, err := client.executeCommand(ctx, C.SPop, []string{key, utils.IntToString(count)})
+// [valkey.io]: https://valkey.io/commands/sadd/
+func (client *baseClient) SAdd(ctx context.Context, key string, members []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SAdd, append([]string{key}, members...))
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStringSetResponse(result)
+	return handleIntResponse(result)
 }
 
-// SMIsMember returns whether each member is a member of the set stored at key.
+// SRem removes specified members from the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	members - The members to check.
+//	ctx     - The context for controlling the command execution.
+//	key     - The key from which members will be removed.
+//	members - A list of members to remove from the set stored at key.
 //
 // Return value:
 //
-//	A []bool containing whether each member is a member of the set stored at key.
+//	The number of members that were removed from the set, excluding non-existing members.
 //
-// [valkey.io]: https://valkey.io/commands/smismember/
-func (client *baseClient) SMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
-	result, err := client.executeCommand(ctx, C.SMIsMember, append([]string{key}, members...))
+// [valkey.io]: https://valkey.io/commands/srem/
+func (client *baseClient) SRem(ctx context.Context, key string, members []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SRem, append([]string{key}, members...))
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleBoolArrayResponse(result)
+	return handleIntResponse(result)
 }
 
-// SUnion gets the union of all the given sets.
+// SUnionStore stores the members of the union of all given sets specified by `keys` into a new set at `destination`.
 //
 // Note:
 //
-//	When in cluster mode, all keys must map to the same hash slot.
+//	When in cluster mode, `destination` and all `keys` must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sets.
+//	ctx - The context for controlling the command execution.
+//	destination - The key of the destination set.
+//	keys - The keys from which to retrieve the set members.
 //
 // Return value:
 //
-//	A `map[string]struct{}` of members which are present in at least one of the given sets.
-//	If none of the sets exist, an empty collection will be returned.
+//	The number of elements in the resulting set.
 //
-// [valkey.io]: https://valkey.io/commands/sunion/
-func (client *baseClient) SUnion(ctx context.Context, keys []string) (map[string]struct{}, error) {
-	result, err := client.executeCommand(ctx, C.SUnion, keys)
+// [valkey.io]: https://valkey.io/commands/sunionstore/
+func (client *baseClient) SUnionStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SUnionStore, append([]string{destination}, keys...))
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStringSetResponse(result)
+	return handleIntResponse(result)
 }
 
-// Iterates incrementally over a set.
+// SMembers retrieves all the members of the set value stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	cursor - The cursor that points to the next iteration of results.
+//	key - The key from which to retrieve the set members.
 //
 // Return value:
 //
-//	An object which holds the next cursor and the subset of the hash held by `key`.
-//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
-//	The data array in the result is always an array of the subset of the set held in `key`.
+//	A `map[string]struct{}` containing all members of the set.
+//	Returns an empty collection if key does not exist.
 //
-// [valkey.io]: https://valkey.io/commands/sscan/
-func (client *baseClient) SScan(ctx context.Context, key string, cursor models.Cursor) (models.ScanResult, error) {
-	result, err := client.executeCommand(ctx, C.SScan, []string{key, cursor.String()})
+// [valkey.io]: https://valkey.io/commands/smembers/
+func (client *baseClient) SMembers(ctx context.Context, key string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SMembers, []string{key})
 	if err != nil {
-		return models.ScanResult{}, err
+		return nil, err
 	}
-	return handleScanResponse(result)
+
+	return handleStringSetResponse(result)
 }
 
-// Iterates incrementally over a set.
+// SCard retrieves the set cardinality (number of elements) of the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	cursor - The cursor that points to the next iteration of results.
-//	options - [options.BaseScanOptions]
+//	key - The key from which to retrieve the number of set members.
 //
 // Return value:
 //
-//	An object which holds the next cursor and the subset of the hash held by `key`.
-//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
-//	The data array in the result is always an array of the subset of the set held in `key`.
+//	The cardinality (number of elements) of the set, or `0` if the key does not exist.
 //
-// [valkey.io]: https://valkey.io/commands/sscan/
-func (client *baseClient) SScanWithOptions(
-	ctx context.Context,
-	key string,
-	cursor models.Cursor,
-	options options.BaseScanOptions,
-) (models.ScanResult, error) {
-	optionArgs, err := options.ToArgs()
+// [valkey.io]: https://valkey.io/commands/scard/
+func (client *baseClient) SCard(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SCard, []string{key})
 	if err != nil {
-		return models.ScanResult{}, err
+		return models.DefaultIntResponse, err
 	}
 
-	result, err := client.executeCommand(ctx, C.SScan, append([]string{key, cursor.String()}, optionArgs...))
-	if err != nil {
-		return models.ScanResult{}, err
-	}
-	return handleScanResponse(result)
+	return handleIntResponse(result)
 }
 
-// Moves `member` from the set at `source` to the set at `destination`, removing it from the source set.
-// Creates a new destination set if needed. The operation is atomic.
-//
-// Note: When in cluster mode, `source` and `destination` must map to the same hash slot.
+// SIsMember returns if member is a member of the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	source - The key of the set to remove the element from.
-//	destination - The key of the set to add the element to.
-//	member - The set element to move.
+//	ctx    - The context for controlling the command execution.
+//	key    - The key of the set.
+//	member - The member to check for existence in the set.
 //
 // Return value:
 //
-//	`true` on success, or `false` if the `source` set does not exist or the element is not a member of the source set.
+//	A bool containing true if the member exists in the set, false otherwise.
+//	If key doesn't exist, it is treated as an empty set and the method returns false.
 //
-// [valkey.io]: https://valkey.io/commands/smove/
-func (client *baseClient) SMove(ctx context.Context, source string, destination string, member string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.SMove, []string{source, destination, member})
+// [valkey.io]: https://valkey.io/commands/sismember/
+func (client *baseClient) SIsMember(ctx context.Context, key string, member string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.SIsMember, []string{key, member})
 	if err != nil {
 		return models.DefaultBoolResponse, err
 	}
+
 	return handleBoolResponse(result)
 }
 
-// Returns the specified elements of the list stored at key.
-// The offsets start and end are zero-based indexes, with 0 being the first element of the list, 1 being the next element
-// and so on. These offsets can also be negative numbers indicating offsets starting at the end of the list, with -1 being
-// the last element of the list, -2 being the penultimate, and so on.
+// SDiff computes the difference between the first set and all the successive sets in keys.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the list.
-//	start - The starting point of the range.
-//	end   - The end of the range.
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sets to diff.
 //
 // Return value:
 //
-//	Array of strings in the specified range.
-//	If start exceeds the end of the list, or if start is greater than end, an empty array will be returned.
-//	If end exceeds the actual end of the list, the range will stop at the actual end of the list.
-//	If key does not exist an empty array will be returned.
+//	A `map[string]struct{}` representing the difference between the sets.
+//	If a key does not exist, it is treated as an empty set.
 //
-// [valkey.io]: https://valkey.io/commands/lrange/
-func (client *baseClient) LRange(ctx context.Context, key string, start int64, end int64) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.LRange, []string{key, utils.IntToString(start), utils.IntToString(end)})
+// [valkey.io]: https://valkey.io/commands/sdiff/
+func (client *baseClient) SDiff(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SDiff, keys)
 	if err != nil {
 		return nil, err
 	}
 
-	return handleStringArrayResponse(result)
+	return handleStringSetResponse(result)
 }
 
-// Returns the element at index from the list stored at key.
-// The index is zero-based, so 0 means the first element, 1 the second element and so on. Negative indices can be used to
-// designate elements starting at the tail of the list. Here, -1 means the last element, -2 means the penultimate and so
-// forth.
+// SDiffStore stores the difference between the first set and all the successive sets in `keys`
+// into a new set at `destination`.
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the list.
-//	index - The index of the element in the list to retrieve.
+//	ctx         - The context for controlling the command execution.
+//	destination - The key of the destination set.
+//	keys        - The keys of the sets to diff.
 //
 // Return value:
 //
-//	The models.Result[string] containing element at index in the list stored at key.
-//	If index is out of range or if key does not exist, [models.CreateNilStringResult()] is returned.
+//	The number of elements in the resulting set.
 //
-// [valkey.io]: https://valkey.io/commands/lindex/
-func (client *baseClient) LIndex(ctx context.Context, key string, index int64) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.LIndex, []string{key, utils.IntToString(index)})
+// [valkey.io]: https://valkey.io/commands/sdiffstore/
+func (client *baseClient) SDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SDiffStore, append([]string{destination}, keys...))
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStringOrNilResponse(result)
+	return handleIntResponse(result)
 }
 
-// Trims an existing list so that it will contain only the specified range of elements specified.
-// The offsets start and end are zero-based indexes, with 0 being the first element of the list, 1 being the next element
-// and so on. These offsets can also be negative numbers indicating offsets starting at the end of the list, with -1 being
-// the last element of the list, -2 being the penultimate, and so on.
+// SInter gets the intersection of all the given sets.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the list.
-//	start - The starting point of the range.
-//	end   - The end of the range.
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sets to intersect.
 //
 // Return value:
 //
-//	Always "OK".
-//	If `start` exceeds the end of the list, or if `start` is greater than `end`, the list is emptied
-//	and the key is removed.
-//	If `end` exceeds the actual end of the list, it will be treated like the last element of the list.
-//	If key does not exist, `"OK"` will be returned without changes to the database.
+//	A `map[string]struct{}` containing members which are present in all given sets.
+//	If one or more sets do not exist, an empty collection will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/ltrim/
-func (client *baseClient) LTrim(ctx context.Context, key string, start int64, end int64) (string, error) {
-	result, err := client.executeCommand(ctx, C.LTrim, []string{key, utils.IntToString(start), utils.IntToString(end)})
+// [valkey.io]: https://valkey.io/commands/sinter/
+func (client *baseClient) SInter(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SInter, keys)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
 
-	return handleOkResponse(result)
+	return handleStringSetResponse(result)
 }
 
-// Returns the length of the list stored at key.
+// Stores the members of the intersection of all given sets specified by `keys` into a new set at `destination`
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list.
+//	destination - The key of the destination set.
+//	keys - The keys from which to retrieve the set members.
 //
 // Return value:
 //
-//	The length of the list at `key`.
-//	If `key` does not exist, it is interpreted as an empty list and `0` is returned.
+//	The number of elements in the resulting set.
 //
-// [valkey.io]: https://valkey.io/commands/llen/
-func (client *baseClient) LLen(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.LLen, []string{key})
+// [valkey.io]: https://valkey.io/commands/sinterstore/
+func (client *baseClient) SInterStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SInterStore, append([]string{destination}, keys...))
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
@@ -2731,29 +3154,30 @@ func (client *baseClient) LLen(ctx context.Context, key string) (int64, error) {
 	return handleIntResponse(result)
 }
 
-// Removes the first count occurrences of elements equal to element from the list stored at key.
+// SInterCard gets the cardinality of the intersection of all the given sets.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The key of the list.
-//	count   - The count of the occurrences of elements equal to element to remove.
-//			  If count is positive: Removes elements equal to element moving from head to tail.
-//			  If count is negative: Removes elements equal to element moving from tail to head.
-//			  If count is 0 or count is greater than the occurrences of elements equal to element,
-//			  it removes all elements equal to element.
-//	element - The element to remove from the list.
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sets to intersect.
 //
 // Return value:
 //
-//	The number of the removed elements.
-//	If `key` does not exist, `0` is returned.
+//	The cardinality of the intersection result. If one or more sets do not exist, `0` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/lrem/
-func (client *baseClient) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.LRem, []string{key, utils.IntToString(count), element})
+// [valkey.io]: https://valkey.io/commands/sintercard/
+func (client *baseClient) SInterCard(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SInterCard, append([]string{strconv.Itoa(len(keys))}, keys...))
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
@@ -2761,440 +3185,407 @@ func (client *baseClient) LRem(ctx context.Context, key string, count int64, ele
 	return handleIntResponse(result)
 }
 
-// Removes and returns the last elements of the list stored at key.
-// The command pops a single element from the end of the list.
+// SInterCardLimit gets the cardinality of the intersection of all the given sets, up to the specified limit.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the list.
+//	ctx   - The context for controlling the command execution.
+//	keys  - The keys of the sets to intersect.
+//	limit - The limit for the intersection cardinality value.
 //
 // Return value:
 //
-//	The models.Result[string] containing the value of the last element.
-//	If key does not exist, [models.CreateNilStringResult()] will be returned.
+//	The cardinality of the intersection result, or the limit if reached.
+//	If one or more sets do not exist, `0` is returned.
+//	If the intersection cardinality reaches 'limit' partway through the computation, returns 'limit' as the cardinality.
 //
-// [valkey.io]: https://valkey.io/commands/rpop/
-func (client *baseClient) RPop(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.RPop, []string{key})
+// [valkey.io]: https://valkey.io/commands/sintercard/
+func (client *baseClient) SInterCardLimit(ctx context.Context, keys []string, limit int64) (int64, error) {
+	args := utils.Concat(
+		[]string{utils.IntToString(int64(len(keys)))},
+		keys,
+		[]string{constants.LimitKeyword, utils.IntToString(limit)},
+	)
+
+	result, err := client.executeCommand(ctx, C.SInterCard, args)
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStringOrNilResponse(result)
+	return handleIntResponse(result)
 }
 
-// Removes and returns up to count elements from the list stored at key, depending on the list's length.
+// SRandMember returns a random element from the set value stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the list.
-//	count - The count of the elements to pop from the list.
+//	ctx - The context for controlling the command execution.
+//	key - The key from which to retrieve the set member.
 //
 // Return value:
 //
-//	An array of popped elements as strings will be returned depending on the list's length.
-//	If key does not exist, nil will be returned.
+//	A models.Result[string] containing a random element from the set.
+//	Returns models.CreateNilStringResult() if key does not exist.
 //
-// [valkey.io]: https://valkey.io/commands/rpop/
-func (client *baseClient) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.RPop, []string{key, utils.IntToString(count)})
+// [valkey.io]: https://valkey.io/commands/srandmember/
+func (client *baseClient) SRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.SRandMember, []string{key})
 	if err != nil {
-		return nil, err
+		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringArrayOrNilResponse(result)
+	return handleStringOrNilResponse(result)
 }
 
-// Inserts element in the list at key either before or after the pivot.
+// SRandMemberCount returns multiple random members from the set value stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx            - The context for controlling the command execution.
-//	key            - The key of the list.
-//	insertPosition - The relative position to insert into - either constants.Before or constants.After the pivot.
-//	pivot          - An element of the list.
-//	element        - The new element to insert.
-//
-// Return value:
-//
-//	The list length after a successful insert operation.
-//	If the `key` doesn't exist returns `-1`.
-//	If the `pivot` wasn't found, returns `0`.
+//	ctx   - The context for controlling the command execution.
+//	key   - The key from which to retrieve the set members.
+//	count - The number of members to return.
+//	       If count is positive, returns unique elements (no repetition) up to count or the set size, whichever is smaller.
+//	       If count is negative, returns elements with possible repetition (the same element may be returned multiple times),
+//	       and the number of returned elements is the absolute value of count.
 //
-// [valkey.io]: https://valkey.io/commands/linsert/
-func (client *baseClient) LInsert(
-	ctx context.Context,
-	key string,
-	insertPosition constants.InsertPosition,
-	pivot string,
-	element string,
-) (int64, error) {
-	insertPositionStr, err := insertPosition.ToString()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-
-	result, err := client.executeCommand(ctx,
-		C.LInsert,
-		[]string{key, insertPositionStr, pivot, element},
-	)
+// Return value:
+//
+//	An array of random elements from the set.
+//	When count is positive, the returned elements are unique (no repetitions).
+//	When count is negative, the returned elements may contain duplicates.
+//	If the set does not exist or is empty, an empty array is returned.
+//
+// [valkey.io]: https://valkey.io/commands/srandmember/
+func (client *baseClient) SRandMemberCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.SRandMember, []string{key, utils.IntToString(count)})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
 
-	return handleIntResponse(result)
+	return handleStringArrayResponse(result)
 }
 
-// Pops an element from the head of the first list that is non-empty, with the given keys being checked in the order that
-// they are given.
-// Blocks the connection when there are no elements to pop from any of the given lists.
-//
-// Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - BLPop is a client blocking command, see [Blocking Commands] for more details and best practices.
+// SPop removes and returns one random member from the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	keys        - The keys of the lists to pop from.
-//	timeout     - The duration to wait for a blocking operation to complete. A value of 0 will block indefinitely.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the set.
 //
 // Return value:
 //
-//	A two-element array containing the key from which the element was popped and the value of the popped
-//	element, formatted as `[key, value]`.
-//	If no element could be popped and the timeout expired, returns `nil`.
+//	A models.Result[string] containing the value of the popped member.
+//	Returns a NilResult if key does not exist.
 //
-// [valkey.io]: https://valkey.io/commands/blpop/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BLPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.BLPop, append(keys, utils.FloatToString(timeout.Seconds())))
+// [valkey.io]: https://valkey.io/commands/spop/
+func (client *baseClient) SPop(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.SPop, []string{key})
 	if err != nil {
-		return nil, err
+		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringArrayOrNilResponse(result)
+	return handleStringOrNilResponse(result)
 }
 
-// Pops an element from the tail of the first list that is non-empty, with the given keys being checked in the order that
-// they are given.
-// Blocks the connection when there are no elements to pop from any of the given lists.
-//
-// Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - BRPop is a client blocking command, see [Blocking Commands] for more details and best practices.
+// SpopCount removes and returns up to count random members from the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	keys        - The keys of the lists to pop from.
-//	timeout     - The duration to wait for a blocking operation to complete. A value of 0 will block indefinitely.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the set.
+//	count - The number of members to return.
+//		If count is positive, returns unique elements.
+//		If count is larger than the set's cardinality, returns the entire set.
 //
 // Return value:
 //
-//	A two-element array containing the key from which the element was popped and the value of the popped
-//	element, formatted as [key, value].
-//	If no element could be popped and the timeout expired, returns `nil`.
+//	A `map[string]struct{}` of popped elements.
+//	If key does not exist, an empty collection will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/brpop/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BRPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.BRPop, append(keys, utils.FloatToString(timeout.Seconds())))
+// [valkey.io]: https://valkey.io/commands/spop/
+func (client *baseClient) SPopCount(ctx context.Context, key string, count int64) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SPop, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
 	}
 
-	return handleStringArrayOrNilResponse(result)
+	return handleStringSetResponse(result)
 }
 
-// Inserts all the specified values at the tail of the list stored at key, only if key exists and holds a list. If key is
-// not a list, this performs no operation.
+// SMIsMember returns whether each member is a member of the set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx      - The context for controlling the command execution.
-//	key      - The key of the list.
-//	elements - The elements to insert at the tail of the list stored at key.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the set.
+//	members - The members to check.
 //
 // Return value:
 //
-//	The length of the list after the push operation.
+//	A []bool containing whether each member is a member of the set stored at key.
 //
-// [valkey.io]: https://valkey.io/commands/rpushx/
-func (client *baseClient) RPushX(ctx context.Context, key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.RPushX, append([]string{key}, elements...))
+// [valkey.io]: https://valkey.io/commands/smismember/
+func (client *baseClient) SMIsMember(ctx context.Context, key string, members []string) ([]bool, error) {
+	result, err := client.executeCommand(ctx, C.SMIsMember, append([]string{key}, members...))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
 
-	return handleIntResponse(result)
+	return handleBoolArrayResponse(result)
 }
 
-// Inserts all the specified values at the head of the list stored at key, only if key exists and holds a list. If key is
-// not a list, this performs no operation.
+// SUnion gets the union of all the given sets.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx      - The context for controlling the command execution.
-//	key      - The key of the list.
-//	elements - The elements to insert at the head of the list stored at key.
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sets.
 //
 // Return value:
 //
-//	The length of the list after the push operation.
+//	A `map[string]struct{}` of members which are present in at least one of the given sets.
+//	If none of the sets exist, an empty collection will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/rpushx/
-func (client *baseClient) LPushX(ctx context.Context, key string, elements []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.LPushX, append([]string{key}, elements...))
+// [valkey.io]: https://valkey.io/commands/sunion/
+func (client *baseClient) SUnion(ctx context.Context, keys []string) (map[string]struct{}, error) {
+	result, err := client.executeCommand(ctx, C.SUnion, keys)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
 
-	return handleIntResponse(result)
+	return handleStringSetResponse(result)
 }
 
-// Pops one element from the first non-empty list from the provided keys.
+// Iterates incrementally over a set.
 //
-// Note:
+// See [valkey.io] for details.
 //
-//	When in cluster mode, `keys` must map to the same hash slot.
+// Parameters:
 //
-// Since:
+//	ctx - The context for controlling the command execution.
+//	key - The key of the set.
+//	cursor - The cursor that points to the next iteration of results.
 //
-//	Valkey 7.0 and above.
+// Return value:
+//
+//	An object which holds the next cursor and the subset of the hash held by `key`.
+//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
+//	The data array in the result is always an array of the subset of the set held in `key`.
+//
+// [valkey.io]: https://valkey.io/commands/sscan/
+func (client *baseClient) SScan(ctx context.Context, key string, cursor models.Cursor) (models.ScanResult, error) {
+	result, err := client.executeCommand(ctx, C.SScan, []string{key, cursor.String()})
+	if err != nil {
+		return models.ScanResult{}, err
+	}
+	return handleScanResponse(result)
+}
+
+// Iterates incrementally over a set.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx           - The context for controlling the command execution.
-//	keys          - An array of keys to lists.
-//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
+//	ctx - The context for controlling the command execution.
+//	key - The key of the set.
+//	cursor - The cursor that points to the next iteration of results.
+//	options - [options.BaseScanOptions]
 //
 // Return value:
 //
-//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
-//	If no elements could be popped, returns 'nil'.
+//	An object which holds the next cursor and the subset of the hash held by `key`.
+//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
+//	The data array in the result is always an array of the subset of the set held in `key`.
 //
-// [valkey.io]: https://valkey.io/commands/lmpop/
-func (client *baseClient) LMPop(
+// [valkey.io]: https://valkey.io/commands/sscan/
+func (client *baseClient) SScanWithOptions(
 	ctx context.Context,
-	keys []string,
-	listDirection constants.ListDirection,
-) ([]models.KeyValues, error) {
-	listDirectionStr, err := listDirection.ToString()
+	key string,
+	cursor models.Cursor,
+	options options.BaseScanOptions,
+) (models.ScanResult, error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return nil, err
-	}
-
-	// Check for potential length overflow.
-	if len(keys) > math.MaxInt-2 {
-		return nil, errors.New("length overflow for the provided keys")
+		return models.ScanResult{}, err
 	}
 
-	// args slice will have 2 more arguments with the keys provided.
-	args := make([]string, 0, len(keys)+2)
-	args = append(args, strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, listDirectionStr)
-	result, err := client.executeCommand(ctx, C.LMPop, args)
+	result, err := client.executeCommand(ctx, C.SScan, append([]string{key, cursor.String()}, optionArgs...))
 	if err != nil {
-		return nil, err
+		return models.ScanResult{}, err
 	}
-
-	return handleKeyValuesArrayOrNilResponse(result)
+	return handleScanResponse(result)
 }
 
-// Pops one or more elements from the first non-empty list from the provided keys.
-//
-// Note:
-//
-//	When in cluster mode, `keys` must map to the same hash slot.
-//
-// Since:
+// Moves `member` from the set at `source` to the set at `destination`, removing it from the source set.
+// Creates a new destination set if needed. The operation is atomic.
 //
-//	Valkey 7.0 and above.
+// Note: When in cluster mode, `source` and `destination` must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx           - The context for controlling the command execution.
-//	keys          - An array of keys to lists.
-//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
-//	count         - The maximum number of popped elements.
+//	ctx - The context for controlling the command execution.
+//	source - The key of the set to remove the element from.
+//	destination - The key of the set to add the element to.
+//	member - The set element to move.
 //
 // Return value:
 //
-//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
-//	If no elements could be popped, returns 'nil'.
+//	`true` on success, or `false` if the `source` set does not exist or the element is not a member of the source set.
 //
-// [valkey.io]: https://valkey.io/commands/lmpop/
-func (client *baseClient) LMPopCount(
-	ctx context.Context,
-	keys []string,
-	listDirection constants.ListDirection,
-	count int64,
-) ([]models.KeyValues, error) {
-	listDirectionStr, err := listDirection.ToString()
+// [valkey.io]: https://valkey.io/commands/smove/
+func (client *baseClient) SMove(ctx context.Context, source string, destination string, member string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.SMove, []string{source, destination, member})
 	if err != nil {
-		return nil, err
+		return models.DefaultBoolResponse, err
 	}
+	return handleBoolResponse(result)
+}
 
-	// Check for potential length overflow.
-	if len(keys) > math.MaxInt-4 {
-		return nil, errors.New("length overflow for the provided keys")
-	}
-
-	// args slice will have 4 more arguments with the keys provided.
-	args := make([]string, 0, len(keys)+4)
-	args = append(args, strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, listDirectionStr, constants.CountKeyword, utils.IntToString(count))
-	result, err := client.executeCommand(ctx, C.LMPop, args)
+// Returns the specified elements of the list stored at key.
+// The offsets start and end are zero-based indexes, with 0 being the first element of the list, 1 being the next element
+// and so on. These offsets can also be negative numbers indicating offsets starting at the end of the list, with -1 being
+// the last element of the list, -2 being the penultimate, and so on.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the list.
+//	start - The starting point of the range.
+//	end   - The end of the range.
+//
+// Return value:
+//
+//	Array of strings in the specified range.
+//	If start exceeds the end of the list, or if start is greater than end, an empty array will be returned.
+//	If end exceeds the actual end of the list, the range will stop at the actual end of the list.
+//	If key does not exist an empty array will be returned.
+//
+// [valkey.io]: https://valkey.io/commands/lrange/
+func (client *baseClient) LRange(ctx context.Context, key string, start int64, end int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.LRange, []string{key, utils.IntToString(start), utils.IntToString(end)})
 	if err != nil {
 		return nil, err
 	}
 
-	return handleKeyValuesArrayOrNilResponse(result)
+	return handleStringArrayResponse(result)
 }
 
-// Blocks the connection until it pops one element from the first non-empty list from the provided keys.
-// BLMPop is the blocking variant of [Client.LMPop] and [ClusterClient.LMPop].
-//
-// Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - BLMPop is a client blocking command, see [Blocking Commands] for more details and best practices.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the element at index from the list stored at key.
+// The index is zero-based, so 0 means the first element, 1 the second element and so on. Negative indices can be used to
+// designate elements starting at the tail of the list. Here, -1 means the last element, -2 means the penultimate and so
+// forth.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx           - The context for controlling the command execution.
-//	keys          - An array of keys to lists.
-//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
-//	timeout       - The duration to wait for a blocking operation to complete. A value of 0 will block indefinitely.
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the list.
+//	index - The index of the element in the list to retrieve.
 //
 // Return value:
 //
-//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
-//	If no member could be popped and the timeout expired, returns `nil`.
+//	The models.Result[string] containing element at index in the list stored at key.
+//	If index is out of range or if key does not exist, [models.CreateNilStringResult()] is returned.
 //
-// [valkey.io]: https://valkey.io/commands/blmpop/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BLMPop(
-	ctx context.Context,
-	keys []string,
-	listDirection constants.ListDirection,
-	timeout time.Duration,
-) ([]models.KeyValues, error) {
-	listDirectionStr, err := listDirection.ToString()
-	if err != nil {
-		return nil, err
-	}
-
-	// Check for potential length overflow.
-	if len(keys) > math.MaxInt-3 {
-		return nil, errors.New("length overflow for the provided keys")
-	}
-
-	// args slice will have 3 more arguments with the keys provided.
-	args := make([]string, 0, len(keys)+3)
-	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, listDirectionStr)
-	result, err := client.executeCommand(ctx, C.BLMPop, args)
+// [valkey.io]: https://valkey.io/commands/lindex/
+func (client *baseClient) LIndex(ctx context.Context, key string, index int64) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.LIndex, []string{key, utils.IntToString(index)})
 	if err != nil {
-		return nil, err
+		return models.CreateNilStringResult(), err
 	}
 
-	return handleKeyValuesArrayOrNilResponse(result)
+	return handleStringOrNilResponse(result)
 }
 
-// Blocks the connection until it pops one or more elements from the first non-empty list from the provided keys.
-// BLMPopCount is the blocking variant of [Client.LMPopCount] [ClusterClient.LMPopCount].
-//
-// Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - BLMPopCount is a client blocking command, see [Blocking Commands] for more details and best practices.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Trims an existing list so that it will contain only the specified range of elements specified.
+// The offsets start and end are zero-based indexes, with 0 being the first element of the list, 1 being the next element
+// and so on. These offsets can also be negative numbers indicating offsets starting at the end of the list, with -1 being
+// the last element of the list, -2 being the penultimate, and so on.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx           - The context for controlling the command execution.
-//	keys          - An array of keys to lists.
-//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
-//	count         - The maximum number of popped elements.
-//	timeout       - The duration to wait for a blocking operation to complete. A value of `0` will block
-//
-// indefinitely.
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the list.
+//	start - The starting point of the range.
+//	end   - The end of the range.
 //
 // Return value:
 //
-//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
-//	If no member could be popped and the timeout expired, returns `nil`.
+//	Always "OK".
+//	If `start` exceeds the end of the list, or if `start` is greater than `end`, the list is emptied
+//	and the key is removed.
+//	If `end` exceeds the actual end of the list, it will be treated like the last element of the list.
+//	If key does not exist, `"OK"` will be returned without changes to the database.
 //
-// [valkey.io]: https://valkey.io/commands/blmpop/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BLMPopCount(
-	ctx context.Context,
-	keys []string,
-	listDirection constants.ListDirection,
-	count int64,
-	timeout time.Duration,
-) ([]models.KeyValues, error) {
-	listDirectionStr, err := listDirection.ToString()
+// [valkey.io]: https://valkey.io/commands/ltrim/
+func (client *baseClient) LTrim(ctx context.Context, key string, start int64, end int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.LTrim, []string{key, utils.IntToString(start), utils.IntToString(end)})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
 
-	// Check for potential length overflow.
-	if len(keys) > math.MaxInt-5 {
-		return nil, errors.New("length overflow for the provided keys")
-	}
+	return handleOkResponse(result)
+}
 
-	// args slice will have 5 more arguments with the keys provided.
-	args := make([]string, 0, len(keys)+5)
-	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, listDirectionStr, constants.CountKeyword, utils.IntToString(count))
-	result, err := client.executeCommand(ctx, C.BLMPop, args)
+// Returns the length of the list stored at key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list.
+//
+// Return value:
+//
+//	The length of the list at `key`.
+//	If `key` does not exist, it is interpreted as an empty list and `0` is returned.
+//
+// [valkey.io]: https://valkey.io/commands/llen/
+func (client *baseClient) LLen(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LLen, []string{key})
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleKeyValuesArrayOrNilResponse(result)
+	return handleIntResponse(result)
 }
 
-// Sets the list element at index to element.
-// The index is zero-based, so `0` means the first element, `1` the second element and so on. Negative indices can be used to
-// designate elements starting at the tail of the list. Here, `-1` means the last element, `-2` means the penultimate and so
-// forth.
+// Removes the first count occurrences of elements equal to element from the list stored at key.
 //
 // See [valkey.io] for details.
 //
@@ -3202,58 +3593,46 @@ func (client *baseClient) BLMPopCount(
 //
 //	ctx     - The context for controlling the command execution.
 //	key     - The key of the list.
-//	index   - The index of the element in the list to be set.
-//	element - The element to be set.
+//	count   - The count of the occurrences of elements equal to element to remove.
+//			  If count is positive: Removes elements equal to element moving from head to tail.
+//			  If count is negative: Removes elements equal to element moving from tail to head.
+//			  If count is 0 or count is greater than the occurrences of elements equal to element,
+//			  it removes all elements equal to element.
+//	element - The element to remove from the list.
 //
 // Return value:
 //
-//	`"OK"`.
+//	The number of the removed elements.
+//	If `key` does not exist, `0` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/lset/
-func (client *baseClient) LSet(ctx context.Context, key string, index int64, element string) (string, error) {
-	result, err := client.executeCommand(ctx, C.LSet, []string{key, utils.IntToString(index), element})
+// [valkey.io]: https://valkey.io/commands/lrem/
+func (client *baseClient) LRem(ctx context.Context, key string, count int64, element string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LRem, []string{key, utils.IntToString(count), element})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleOkResponse(result)
+	return handleIntResponse(result)
 }
 
-// Atomically pops and removes the left/right-most element to the list stored at source depending on `whereFrom`, and pushes
-// the element at the first/last element of the list stored at destination depending on `whereTo`.
+// Removes and returns the last elements of the list stored at key.
+// The command pops a single element from the end of the list.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	source      - The key to the source list.
-//	destination - The key to the destination list.
-//	wherefrom   - The ListDirection the element should be removed from.
-//	whereto     - The ListDirection the element should be added to.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list.
 //
 // Return value:
 //
-//	A models.Result[string] containing the popped element or models.CreateNilStringResult() if source does not exist.
+//	The models.Result[string] containing the value of the last element.
+//	If key does not exist, [models.CreateNilStringResult()] will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/lmove/
-func (client *baseClient) LMove(
-	ctx context.Context,
-	source string,
-	destination string,
-	whereFrom constants.ListDirection,
-	whereTo constants.ListDirection,
-) (models.Result[string], error) {
-	whereFromStr, err := whereFrom.ToString()
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	whereToStr, err := whereTo.ToString()
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-
-	result, err := client.executeCommand(ctx, C.LMove, []string{source, destination, whereFromStr, whereToStr})
+// [valkey.io]: https://valkey.io/commands/rpop/
+func (client *baseClient) RPop(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.RPop, []string{key})
 	if err != nil {
 		return models.CreateNilStringResult(), err
 	}
@@ -3261,90 +3640,66 @@ func (client *baseClient) LMove(
 	return handleStringOrNilResponse(result)
 }
 
-// Blocks the connection until it pops atomically and removes the left/right-most element to the list stored at `source`
-// depending on `whereFrom`, and pushes the element at the first/last element of the list stored at `destination` depending on
-// `whereFrom`.
-// `BLMove` is the blocking variant of [Client.LMove] and [ClusterClient.LMove].
-//
-// Note:
-//   - When in cluster mode, `source` and `destination` must map to the same hash slot.
-//   - `BLMove` is a client blocking command, see [Blocking Commands] for more details and best practices.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Removes and returns up to count elements from the list stored at key, depending on the list's length.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	source      - The key to the source list.
-//	destination - The key to the destination list.
-//	whereFrom   - The ListDirection the element should be removed from.
-//	whereTo     - The ListDirection the element should be added to.
-//	timeout     - The duration to wait for a blocking operation to complete. A value of `0` will block indefinitely.
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the list.
+//	count - The count of the elements to pop from the list.
 //
 // Return value:
 //
-//	A models.Result[string] containing the popped element or models.CreateNilStringResult() if `source` does not exist or if
-//	the operation timed-out.
+//	An array of popped elements as strings will be returned depending on the list's length.
+//	If key does not exist, nil will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/blmove/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BLMove(
-	ctx context.Context,
-	source string,
-	destination string,
-	whereFrom constants.ListDirection,
-	whereTo constants.ListDirection,
-	timeout time.Duration,
-) (models.Result[string], error) {
-	whereFromStr, err := whereFrom.ToString()
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	whereToStr, err := whereTo.ToString()
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-
-	result, err := client.executeCommand(ctx,
-		C.BLMove,
-		[]string{source, destination, whereFromStr, whereToStr, utils.FloatToString(timeout.Seconds())},
-	)
+// [valkey.io]: https://valkey.io/commands/rpop/
+func (client *baseClient) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.RPop, []string{key, utils.IntToString(count)})
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return nil, err
 	}
 
-	return handleStringOrNilResponse(result)
+	return handleStringArrayOrNilResponse(result)
 }
 
-// Del removes the specified keys from the database. A key is ignored if it does not exist.
-//
-// Note:
-//
-//	In cluster mode, if keys in `keyValueMap` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+// Inserts element in the list at key either before or after the pivot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	keys - One or more keys to delete.
+//	ctx            - The context for controlling the command execution.
+//	key            - The key of the list.
+//	insertPosition - The relative position to insert into - either constants.Before or constants.After the pivot.
+//	pivot          - An element of the list.
+//	element        - The new element to insert.
 //
 // Return value:
 //
-//	Returns the number of keys that were removed.
+//	The list length after a successful insert operation.
+//	If the `key` doesn't exist returns `-1`.
+//	If the `pivot` wasn't found, returns `0`.
 //
-// [valkey.io]: https://valkey.io/commands/del/
-func (client *baseClient) Del(ctx context.Context, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.Del, keys)
+// [valkey.io]: https://valkey.io/commands/linsert/
+func (client *baseClient) LInsert(
+	ctx context.Context,
+	key string,
+	insertPosition constants.InsertPosition,
+	pivot string,
+	element string,
+) (int64, error) {
+	insertPositionStr, err := insertPosition.ToString()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+
+	result, err := client.executeCommand(ctx,
+		C.LInsert,
+		[]string{key, insertPositionStr, pivot, element},
+	)
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
@@ -3352,442 +3707,506 @@ func (client *baseClient) Del(ctx context.Context, keys []string) (int64, error)
 	return handleIntResponse(result)
 }
 
-// Exists returns the number of keys that exist in the database.
+// Pops an element from the head of the first list that is non-empty, with the given keys being checked in the order that
+// they are given.
+// Blocks the connection when there are no elements to pop from any of the given lists.
 //
 // Note:
-//
-//	In cluster mode, if keys in `keyValueMap` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - BLPop is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	keys - One or more keys to check if they exist.
+//	ctx         - The context for controlling the command execution.
+//	keys        - The keys of the lists to pop from.
+//	timeout     - The duration to wait for a blocking operation to complete. A value of 0 will block indefinitely.
 //
 // Return value:
 //
-//	Returns the number of existing keys.
+//	A two-element array containing the key from which the element was popped and the value of the popped
+//	element, formatted as `[key, value]`.
+//	If no element could be popped and the timeout expired, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/exists/
-func (client *baseClient) Exists(ctx context.Context, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.Exists, keys)
+// [valkey.io]: https://valkey.io/commands/blpop/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BLPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.BLPop, append(keys, utils.FloatToString(timeout.Seconds())))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
 
-	return handleIntResponse(result)
+	return handleStringArrayOrNilResponse(result)
 }
 
-// Expire sets a timeout on key. After the timeout has expired, the key will automatically be deleted.
+// Pops an element from the tail of the first list that is non-empty, with the given keys being checked in the order that
+// they are given.
+// Blocks the connection when there are no elements to pop from any of the given lists.
 //
-// If key already has an existing expire set, the time to live is updated to the new value.
-// If expireTime is a non-positive number, the key will be deleted rather than expired.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+// Note:
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - BRPop is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to expire.
-//	expireTime - Duration for the key to expire
+//	ctx         - The context for controlling the command execution.
+//	keys        - The keys of the lists to pop from.
+//	timeout     - The duration to wait for a blocking operation to complete. A value of 0 will block indefinitely.
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	A two-element array containing the key from which the element was popped and the value of the popped
+//	element, formatted as [key, value].
+//	If no element could be popped and the timeout expired, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/expire/
-func (client *baseClient) Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
-	result, err := client.executeCommand(ctx, C.Expire, []string{key, utils.FloatToString(expireTime.Seconds())})
+// [valkey.io]: https://valkey.io/commands/brpop/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BRPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.BRPop, append(keys, utils.FloatToString(timeout.Seconds())))
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
 
-	return handleBoolResponse(result)
+	return handleStringArrayOrNilResponse(result)
 }
 
-// Expire sets a timeout on key. After the timeout has expired, the key will automatically be deleted.
-//
-// If key already has an existing expire set, the time to live is updated to the new value.
-// If expireTime is a non-positive number, the key will be deleted rather than expired.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+// Inserts all the specified values at the tail of the list stored at key, only if key exists and holds a list. If key is
+// not a list, this performs no operation.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to expire.
-//	expireTime - Duration for the key to expire
-//	expireCondition - The option to set expiry, see [options.ExpireCondition].
+//	ctx      - The context for controlling the command execution.
+//	key      - The key of the list.
+//	elements - The elements to insert at the tail of the list stored at key.
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the    timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	The length of the list after the push operation.
 //
-// [valkey.io]: https://valkey.io/commands/expire/
-func (client *baseClient) ExpireWithOptions(
-	ctx context.Context,
-	key string,
-	expireTime time.Duration,
-	expireCondition constants.ExpireCondition,
-) (bool, error) {
-	expireConditionStr, err := expireCondition.ToString()
-	if err != nil {
-		return models.DefaultBoolResponse, err
-	}
-	result, err := client.executeCommand(
-		ctx,
-		C.Expire,
-		[]string{key, utils.FloatToString(expireTime.Seconds()), expireConditionStr},
-	)
+// [valkey.io]: https://valkey.io/commands/rpushx/
+func (client *baseClient) RPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.RPushX, append([]string{key}, elements...))
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return models.DefaultIntResponse, err
 	}
-	return handleBoolResponse(result)
+
+	return handleIntResponse(result)
 }
 
-// ExpireAt sets a timeout on key. It takes an absolute Unix timestamp (seconds since January 1, 1970) instead of
-// specifying the number of seconds. A timestamp in the past will delete the key immediately. After the timeout has
-// expired, the key will automatically be deleted.
-// If key already has an existing expire set, the time to live is updated to the new value.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key
-// If key already has an existing expire set, the time to live is updated to the new value.
-// If expireTime is a non-positive number, the key will be deleted rather than expired.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+// Inserts all the specified values at the head of the list stored at key, only if key exists and holds a list. If key is
+// not a list, this performs no operation.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to expire.
-//	expireTime - The timestamp for expiry.
+//	ctx      - The context for controlling the command execution.
+//	key      - The key of the list.
+//	elements - The elements to insert at the head of the list stored at key.
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	The length of the list after the push operation.
 //
-// [valkey.io]: https://valkey.io/commands/expireat/
-func (client *baseClient) ExpireAt(ctx context.Context, key string, expireTime time.Time) (bool, error) {
-	result, err := client.executeCommand(ctx, C.ExpireAt, []string{key, utils.IntToString(expireTime.Unix())})
+// [valkey.io]: https://valkey.io/commands/rpushx/
+func (client *baseClient) LPushX(ctx context.Context, key string, elements []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LPushX, append([]string{key}, elements...))
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleBoolResponse(result)
+	return handleIntResponse(result)
 }
 
-// ExpireAt sets a timeout on key. It takes an absolute Unix timestamp (seconds since January 1, 1970) instead of
-// specifying the number of seconds. A timestamp in the past will delete the key immediately. After the timeout has
-// expired, the key will automatically be deleted.
-// If key already has an existing expire set, the time to live is updated to the new value.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key
-// If key already has an existing expire set, the time to live is updated to the new value.
-// If expireTime is a non-positive number, the key will be deleted rather than expired.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+// Pops one element from the first non-empty list from the provided keys.
+//
+// Note:
+//
+//	When in cluster mode, `keys` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to expire.
-//	expireTime - The timestamp for expiry.
-//	expireCondition - The option to set expiry - see [options.ExpireCondition].
+//	ctx           - The context for controlling the command execution.
+//	keys          - An array of keys to lists.
+//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
+//	If no elements could be popped, returns 'nil'.
 //
-// [valkey.io]: https://valkey.io/commands/expireat/
-func (client *baseClient) ExpireAtWithOptions(
+// [valkey.io]: https://valkey.io/commands/lmpop/
+func (client *baseClient) LMPop(
 	ctx context.Context,
-	key string,
-	expireTime time.Time,
-	expireCondition constants.ExpireCondition,
-) (bool, error) {
-	expireConditionStr, err := expireCondition.ToString()
+	keys []string,
+	listDirection constants.ListDirection,
+) ([]models.KeyValues, error) {
+	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
-	result, err := client.executeCommand(ctx,
-		C.ExpireAt,
-		[]string{key, utils.IntToString(expireTime.Unix()), expireConditionStr},
-	)
-	if err != nil {
-		return models.DefaultBoolResponse, err
+
+	// Check for potential length overflow.
+	if len(keys) > math.MaxInt-2 {
+		return nil, errors.New("length overflow for the provided keys")
 	}
-	return handleBoolResponse(result)
+
+	// args slice will have 2 more arguments with the keys provided.
+	args := make([]string, 0, len(keys)+2)
+	args = append(args, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr)
+	result, err := client.executeCommand(ctx, C.LMPop, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleKeyValuesArrayOrNilResponse(result)
 }
 
-// Sets a timeout on key in milliseconds. After the timeout has expired, the key will automatically be deleted.
-// If key already has an existing expire set, the time to live is updated to the new value.
-// If expireTime is a non-positive number, the key will be deleted rather than expired.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
-//
-// See [valkey.io] for details.
-//
-// Parameters:
+// Pops one or more elements from the first non-empty list from the provided keys.
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to set timeout on it.
-//	expireTime - Duration for the key to expire.
+// Note:
 //
-// Return value:
+//	When in cluster mode, `keys` must map to the same hash slot.
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+// Since:
 //
-// [valkey.io]: https://valkey.io/commands/pexpire/
-func (client *baseClient) PExpire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
-	result, err := client.executeCommand(ctx, C.PExpire, []string{key, utils.IntToString(expireTime.Milliseconds())})
-	if err != nil {
-		return models.DefaultBoolResponse, err
-	}
-	return handleBoolResponse(result)
-}
-
-// Sets a timeout on key in milliseconds. After the timeout has expired, the key will automatically be deleted.
-// If key already has an existing expire set, the time to live is updated to the new value.
-// If expireTime is a non-positive number, the key will be deleted rather than expired.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to set timeout on it.
-//	expireTime - Duration for the key to expire
-//	option - The option to set expiry, see [options.ExpireCondition].
+//	ctx           - The context for controlling the command execution.
+//	keys          - An array of keys to lists.
+//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
+//	count         - The maximum number of popped elements.
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
+//	If no elements could be popped, returns 'nil'.
 //
-// [valkey.io]: https://valkey.io/commands/pexpire/
-func (client *baseClient) PExpireWithOptions(
+// [valkey.io]: https://valkey.io/commands/lmpop/
+func (client *baseClient) LMPopCount(
 	ctx context.Context,
-	key string,
-	expireTime time.Duration,
-	expireCondition constants.ExpireCondition,
-) (bool, error) {
-	expireConditionStr, err := expireCondition.ToString()
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+) ([]models.KeyValues, error) {
+	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
-	result, err := client.executeCommand(
-		ctx,
-		C.PExpire,
-		[]string{key, utils.IntToString(expireTime.Milliseconds()), expireConditionStr},
-	)
+
+	// Check for potential length overflow.
+	if len(keys) > math.MaxInt-4 {
+		return nil, errors.New("length overflow for the provided keys")
+	}
+
+	// args slice will have 4 more arguments with the keys provided.
+	args := make([]string, 0, len(keys)+4)
+	args = append(args, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr, constants.CountKeyword, utils.IntToString(count))
+	result, err := client.executeCommand(ctx, C.LMPop, args)
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
-	return handleBoolResponse(result)
+
+	return handleKeyValuesArrayOrNilResponse(result)
 }
 
-// Sets a timeout on key. It takes an absolute Unix timestamp (milliseconds since January 1, 1970) instead of
-// specifying the number of milliseconds. A timestamp in the past will delete the key immediately.
-// After the timeout has expired, the key will automatically be deleted.
-// If key already has an existing expire set, the time to live is updated to the new value.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+// Blocks the connection until it pops one element from the first non-empty list from the provided keys.
+// BLMPop is the blocking variant of [Client.LMPop] and [ClusterClient.LMPop].
+//
+// Note:
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - BLMPop is a client blocking command, see [Blocking Commands] for more details and best practices.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to set timeout on it.
-//	expireTime - The timestamp for expiry.
+//	ctx           - The context for controlling the command execution.
+//	keys          - An array of keys to lists.
+//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
+//	timeout       - The duration to wait for a blocking operation to complete. A value of 0 will block indefinitely.
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
+//	If no member could be popped and the timeout expired, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/pexpireat/
-func (client *baseClient) PExpireAt(ctx context.Context, key string, expireTime time.Time) (bool, error) {
-	result, err := client.executeCommand(ctx, C.PExpireAt, []string{key, utils.IntToString(expireTime.UnixMilli())})
+// [valkey.io]: https://valkey.io/commands/blmpop/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BLMPop(
+	ctx context.Context,
+	keys []string,
+	listDirection constants.ListDirection,
+	timeout time.Duration,
+) ([]models.KeyValues, error) {
+	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
-	return handleBoolResponse(result)
+
+	// Check for potential length overflow.
+	if len(keys) > math.MaxInt-3 {
+		return nil, errors.New("length overflow for the provided keys")
+	}
+
+	// args slice will have 3 more arguments with the keys provided.
+	args := make([]string, 0, len(keys)+3)
+	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr)
+	result, err := client.executeCommand(ctx, C.BLMPop, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleKeyValuesArrayOrNilResponse(result)
 }
 
-// Sets a timeout on key. It takes an absolute Unix timestamp (milliseconds since January 1, 1970) instead of
-// specifying the number of milliseconds. A timestamp in the past will delete the key immediately.
-// After the timeout has expired, the key will automatically be deleted.
-// If key already has an existing expire set, the time to live is updated to the new value.
-// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+// Blocks the connection until it pops one or more elements from the first non-empty list from the provided keys.
+// BLMPopCount is the blocking variant of [Client.LMPopCount] [ClusterClient.LMPopCount].
+//
+// Note:
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - BLMPopCount is a client blocking command, see [Blocking Commands] for more details and best practices.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to set timeout on it.
-//	expireTime - The timestamp for expiry.
-//	expireCondition - The option to set expiry, see [options.ExpireCondition].
+//	ctx           - The context for controlling the command execution.
+//	keys          - An array of keys to lists.
+//	listDirection - The direction based on which elements are popped from - see [options.ListDirection].
+//	count         - The maximum number of popped elements.
+//	timeout       - The duration to wait for a blocking operation to complete. A value of `0` will block
+//
+// indefinitely.
 //
 // Return value:
 //
-//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
-//	or operation skipped due to the provided arguments.
+//	A slice of [models.KeyValues], each containing a key name and an array of popped elements.
+//	If no member could be popped and the timeout expired, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/pexpireat/
-func (client *baseClient) PExpireAtWithOptions(
+// [valkey.io]: https://valkey.io/commands/blmpop/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BLMPopCount(
 	ctx context.Context,
-	key string,
-	expireTime time.Time,
-	expireCondition constants.ExpireCondition,
-) (bool, error) {
-	expireConditionStr, err := expireCondition.ToString()
+	keys []string,
+	listDirection constants.ListDirection,
+	count int64,
+	timeout time.Duration,
+) ([]models.KeyValues, error) {
+	listDirectionStr, err := listDirection.ToString()
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
-	result, err := client.executeCommand(ctx,
-		C.PExpireAt,
-		[]string{key, utils.IntToString(expireTime.UnixMilli()), expireConditionStr},
-	)
+
+	// Check for potential length overflow.
+	if len(keys) > math.MaxInt-5 {
+		return nil, errors.New("length overflow for the provided keys")
+	}
+
+	// args slice will have 5 more arguments with the keys provided.
+	args := make([]string, 0, len(keys)+5)
+	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, listDirectionStr, constants.CountKeyword, utils.IntToString(count))
+	result, err := client.executeCommand(ctx, C.BLMPop, args)
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return nil, err
 	}
-	return handleBoolResponse(result)
+
+	return handleKeyValuesArrayOrNilResponse(result)
 }
 
-// Expire Time returns the absolute Unix timestamp (since January 1, 1970) at which the given key
-// will expire, in seconds.
+// Sets the list element at index to element.
+// The index is zero-based, so `0` means the first element, `1` the second element and so on. Negative indices can be used to
+// designate elements starting at the tail of the list. Here, `-1` means the last element, `-2` means the penultimate and so
+// forth.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to determine the expiration value of.
+//	ctx     - The context for controlling the command execution.
+//	key     - The key of the list.
+//	index   - The index of the element in the list to be set.
+//	element - The element to be set.
 //
 // Return value:
 //
-//	The expiration Unix timestamp in seconds.
-//	`-2` if key does not exist or `-1` is key exists but has no associated expiration.
+//	`"OK"`.
 //
-// [valkey.io]: https://valkey.io/commands/expiretime/
-func (client *baseClient) ExpireTime(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.ExpireTime, []string{key})
+// [valkey.io]: https://valkey.io/commands/lset/
+func (client *baseClient) LSet(ctx context.Context, key string, index int64, element string) (string, error) {
+	result, err := client.executeCommand(ctx, C.LSet, []string{key, utils.IntToString(index), element})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultStringResponse, err
 	}
 
-	return handleIntResponse(result)
+	return handleOkResponse(result)
 }
 
-// PExpire Time returns the absolute Unix timestamp (since January 1, 1970) at which the given key
-// will expire, in milliseconds.
+// Atomically pops and removes the left/right-most element to the list stored at source depending on `whereFrom`, and pushes
+// the element at the first/last element of the list stored at destination depending on `whereTo`.
 //
-// Parameters:
+// See [valkey.io] for details.
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to determine the expiration value of.
+// Parameters:
+//
+//	ctx         - The context for controlling the command execution.
+//	source      - The key to the source list.
+//	destination - The key to the destination list.
+//	wherefrom   - The ListDirection the element should be removed from.
+//	whereto     - The ListDirection the element should be added to.
 //
 // Return value:
 //
-//	The expiration Unix timestamp in milliseconds.
-//	`-2` if key does not exist or `-1` is key exists but has no associated expiration.
+//	A models.Result[string] containing the popped element or models.CreateNilStringResult() if source does not exist.
 //
-// [valkey.io]: https://valkey.io/commands/pexpiretime/
-func (client *baseClient) PExpireTime(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.PExpireTime, []string{key})
+// [valkey.io]: https://valkey.io/commands/lmove/
+func (client *baseClient) LMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+) (models.Result[string], error) {
+	whereFromStr, err := whereFrom.ToString()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilStringResult(), err
+	}
+	whereToStr, err := whereTo.ToString()
+	if err != nil {
+		return models.CreateNilStringResult(), err
 	}
 
-	return handleIntResponse(result)
+	result, err := client.executeCommand(ctx, C.LMove, []string{source, destination, whereFromStr, whereToStr})
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return handleStringOrNilResponse(result)
 }
 
-// TTL returns the remaining time to live of key that has a timeout, in seconds.
-//
-// Parameters:
+// Blocks the connection until it pops atomically and removes the left/right-most element to the list stored at `source`
+// depending on `whereFrom`, and pushes the element at the first/last element of the list stored at `destination` depending on
+// `whereFrom`.
+// `BLMove` is the blocking variant of [Client.LMove] and [ClusterClient.LMove].
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to return its timeout.
+// Note:
+//   - When in cluster mode, `source` and `destination` must map to the same hash slot.
+//   - `BLMove` is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
-// Return value:
+// Since:
 //
-//	Returns TTL in seconds,
-//	`-2` if key does not exist, or `-1` if key exists but has no associated expiration.
+//	Valkey 6.2.0 and above.
 //
-// [valkey.io]: https://valkey.io/commands/ttl/
-func (client *baseClient) TTL(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.TTL, []string{key})
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-
-	return handleIntResponse(result)
-}
-
-// PTTL returns the remaining time to live of key that has a timeout, in milliseconds.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to return its timeout.
+//	ctx         - The context for controlling the command execution.
+//	source      - The key to the source list.
+//	destination - The key to the destination list.
+//	whereFrom   - The ListDirection the element should be removed from.
+//	whereTo     - The ListDirection the element should be added to.
+//	timeout     - The duration to wait for a blocking operation to complete. A value of `0` will block indefinitely.
 //
 // Return value:
 //
-//	Returns TTL in milliseconds,
-//	`-2` if key does not exist, or `-1` if key exists but has no associated expiration.
+//	A models.Result[string] containing the popped element or models.CreateNilStringResult() if `source` does not exist or if
+//	the operation timed-out.
 //
-// [valkey.io]: https://valkey.io/commands/pttl/
-func (client *baseClient) PTTL(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.PTTL, []string{key})
+// [valkey.io]: https://valkey.io/commands/blmove/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BLMove(
+	ctx context.Context,
+	source string,
+	destination string,
+	whereFrom constants.ListDirection,
+	whereTo constants.ListDirection,
+	timeout time.Duration,
+) (models.Result[string], error) {
+	whereFromStr, err := whereFrom.ToString()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilStringResult(), err
+	}
+	whereToStr, err := whereTo.ToString()
+	if err != nil {
+		return models.CreateNilStringResult(), err
 	}
 
-	return handleIntResponse(result)
+	result, err := client.executeCommand(ctx,
+		C.BLMove,
+		[]string{source, destination, whereFromStr, whereToStr, utils.FloatToString(timeout.Seconds())},
+	)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return handleStringOrNilResponse(result)
 }
 
-// PfAdd adds all elements to the HyperLogLog data structure stored at the specified key.
-// Creates a new structure if the key does not exist.
-// When no elements are provided, and key exists and is a HyperLogLog, then no operation is performed.
-// If key does not exist, then the HyperLogLog structure is created.
+// Del removes the specified keys from the database. A key is ignored if it does not exist.
+//
+// Note:
+//
+//	In cluster mode, if keys in `keyValueMap` map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the HyperLogLog data structure to add elements into.
-//	elements - An array of members to add to the HyperLogLog stored at key.
+//	keys - One or more keys to delete.
 //
 // Return value:
 //
-//	If the HyperLogLog is newly created, or if the HyperLogLog approximated cardinality is
-//	altered, then returns `true`. Otherwise, returns `false`.
+//	Returns the number of keys that were removed.
 //
-// [valkey.io]: https://valkey.io/commands/pfadd/
-func (client *baseClient) PfAdd(ctx context.Context, key string, elements []string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.PfAdd, append([]string{key}, elements...))
+// [valkey.io]: https://valkey.io/commands/del/
+func (client *baseClient) Del(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Del, keys)
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleBoolResponse(result)
+	return handleIntResponse(result)
 }
 
-// Estimates the cardinality of the data stored in a HyperLogLog structure for a single key or
-// calculates the combined cardinality of multiple keys by merging their HyperLogLogs temporarily.
+// Exists returns the number of keys that exist in the database.
 //
 // Note:
 //
@@ -3798,19 +4217,20 @@ func (client *baseClient) PfAdd(ctx context.Context, key string, elements []stri
 //	while others did not. If this behavior impacts your application logic, consider splitting
 //	the request into sub-requests per slot to ensure atomicity.
 //
+// See [valkey.io] for details.
+//
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The keys of the HyperLogLog data structures to be analyzed.
+//	keys - One or more keys to check if they exist.
 //
 // Return value:
 //
-//	The approximated cardinality of given HyperLogLog data structures.
-//	The cardinality of a key that does not exist is `0`.
+//	Returns the number of existing keys.
 //
-// [valkey.io]: https://valkey.io/commands/pfcount/
-func (client *baseClient) PfCount(ctx context.Context, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.PfCount, keys)
+// [valkey.io]: https://valkey.io/commands/exists/
+func (client *baseClient) Exists(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Exists, keys)
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
@@ -3818,442 +4238,433 @@ func (client *baseClient) PfCount(ctx context.Context, keys []string) (int64, er
 	return handleIntResponse(result)
 }
 
-// PfMerge merges multiple HyperLogLog values into a unique value.
-// If the destination variable exists, it is treated as one of the source HyperLogLog data sets,
-// otherwise a new HyperLogLog is created.
+// Expire sets a timeout on key. After the timeout has expired, the key will automatically be deleted.
 //
-// Note:
+// If key already has an existing expire set, the time to live is updated to the new value.
+// If expireTime is a non-positive number, the key will be deleted rather than expired.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
-//	When in cluster mode, `sourceKeys` and `destination` must map to the same hash slot.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destination - The key of the destination HyperLogLog where the merged data sets will be stored.
-//	sourceKeys - An array of sourceKeys of the HyperLogLog structures to be merged.
+//	key - The key to expire.
+//	expireTime - Duration for the key to expire
 //
 // Return value:
 //
-//	If the HyperLogLog values is successfully merged it returns "OK".
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/pfmerge/
-func (client *baseClient) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
-	result, err := client.executeCommand(ctx, C.PfMerge, append([]string{destination}, sourceKeys...))
+// [valkey.io]: https://valkey.io/commands/expire/
+func (client *baseClient) Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Expire, []string{key, utils.FloatToString(expireTime.Seconds())})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultBoolResponse, err
 	}
 
-	return handleOkResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Unlink (delete) multiple keys from the database. A key is ignored if it does not exist.
-// This command, similar to [Client.Del] and [ClusterClient.Del], however, this command does not block the server.
+// Expire sets a timeout on key. After the timeout has expired, the key will automatically be deleted.
 //
-// Note:
+// If key already has an existing expire set, the time to live is updated to the new value.
+// If expireTime is a non-positive number, the key will be deleted rather than expired.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
-//	In cluster mode, if keys in keys map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - One or more keys to unlink.
+//	key - The key to expire.
+//	expireTime - Duration for the key to expire
+//	expireCondition - The option to set expiry, see [options.ExpireCondition].
 //
 // Return value:
 //
-//	Return the number of keys that were unlinked.
+//	`true` if the timeout was set. `false` if the    timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/unlink/
-func (client *baseClient) Unlink(ctx context.Context, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.Unlink, keys)
+// [valkey.io]: https://valkey.io/commands/expire/
+func (client *baseClient) ExpireWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultBoolResponse, err
 	}
-
-	return handleIntResponse(result)
+	result, err := client.executeCommand(
+		ctx,
+		C.Expire,
+		[]string{key, utils.FloatToString(expireTime.Seconds()), expireConditionStr},
+	)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return handleBoolResponse(result)
 }
 
-// Type returns the string representation of the type of the value stored at key.
-// The different types that can be returned are: `"string"`, `"list"`, `"set"`, `"zset"`, `"hash"` and `"stream"`.
+// ExpireAt sets a timeout on key. It takes an absolute Unix timestamp (seconds since January 1, 1970) instead of
+// specifying the number of seconds. A timestamp in the past will delete the key immediately. After the timeout has
+// expired, the key will automatically be deleted.
+// If key already has an existing expire set, the time to live is updated to the new value.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key
+// If key already has an existing expire set, the time to live is updated to the new value.
+// If expireTime is a non-positive number, the key will be deleted rather than expired.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The `key` to check its data type.
+//	key - The key to expire.
+//	expireTime - The timestamp for expiry.
 //
 // Return value:
 //
-//	If the `key` exists, the type of the stored value is returned. Otherwise, a `"none"` string is returned.
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/type/
-func (client *baseClient) Type(ctx context.Context, key string) (string, error) {
-	result, err := client.executeCommand(ctx, C.Type, []string{key})
+// [valkey.io]: https://valkey.io/commands/expireat/
+func (client *baseClient) ExpireAt(ctx context.Context, key string, expireTime time.Time) (bool, error) {
+	result, err := client.executeCommand(ctx, C.ExpireAt, []string{key, utils.IntToString(expireTime.Unix())})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultBoolResponse, err
 	}
-	return handleStringResponse(result)
+
+	return handleBoolResponse(result)
 }
 
-// Alters the last access time of a key(s). A key is ignored if it does not exist.
-//
-// Note:
+// ExpireAt sets a timeout on key. It takes an absolute Unix timestamp (seconds since January 1, 1970) instead of
+// specifying the number of seconds. A timestamp in the past will delete the key immediately. After the timeout has
+// expired, the key will automatically be deleted.
+// If key already has an existing expire set, the time to live is updated to the new value.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key
+// If key already has an existing expire set, the time to live is updated to the new value.
+// If expireTime is a non-positive number, the key will be deleted rather than expired.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
-//	In cluster mode, if keys in keys map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - The keys to update last access time.
+//	key - The key to expire.
+//	expireTime - The timestamp for expiry.
+//	expireCondition - The option to set expiry - see [options.ExpireCondition].
 //
 // Return value:
 //
-//	The number of keys that were updated.
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: Https://valkey.io/commands/touch/
-func (client *baseClient) Touch(ctx context.Context, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.Touch, keys)
+// [valkey.io]: https://valkey.io/commands/expireat/
+func (client *baseClient) ExpireAtWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Time,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultBoolResponse, err
 	}
-
-	return handleIntResponse(result)
+	result, err := client.executeCommand(ctx,
+		C.ExpireAt,
+		[]string{key, utils.IntToString(expireTime.Unix()), expireConditionStr},
+	)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return handleBoolResponse(result)
 }
 
-// Renames `key` to `newKey`.
-// If `newKey` already exists it is overwritten.
-//
-// Note:
+// Sets a timeout on key in milliseconds. After the timeout has expired, the key will automatically be deleted.
+// If key already has an existing expire set, the time to live is updated to the new value.
+// If expireTime is a non-positive number, the key will be deleted rather than expired.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
-//	When in cluster mode, both `key` and `newKey` must map to the same hash slot.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key to rename.
-//	newKey - The new name of the key.
+//	key - The key to set timeout on it.
+//	expireTime - Duration for the key to expire.
 //
 // Return value:
 //
-//	If the key was successfully renamed, return "OK". If key does not exist, an error is thrown.
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/rename/
-func (client *baseClient) Rename(ctx context.Context, key string, newKey string) (string, error) {
-	result, err := client.executeCommand(ctx, C.Rename, []string{key, newKey})
+// [valkey.io]: https://valkey.io/commands/pexpire/
+func (client *baseClient) PExpire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
+	result, err := client.executeCommand(ctx, C.PExpire, []string{key, utils.IntToString(expireTime.Milliseconds())})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultBoolResponse, err
 	}
-	return handleOkResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Renames `key` to `newkey` if `newKey` does not yet exist.
-//
-// Note:
+// Sets a timeout on key in milliseconds. After the timeout has expired, the key will automatically be deleted.
+// If key already has an existing expire set, the time to live is updated to the new value.
+// If expireTime is a non-positive number, the key will be deleted rather than expired.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
-//	When in cluster mode, both `key` and `newkey` must map to the same hash slot.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key to rename.
-//	newKey - The new name of the key.
+//	key - The key to set timeout on it.
+//	expireTime - Duration for the key to expire
+//	option - The option to set expiry, see [options.ExpireCondition].
 //
 // Return value:
 //
-//	`true` if key was renamed to `newKey`, `false` if `newKey` already exists.
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/renamenx/
-func (client *baseClient) RenameNX(ctx context.Context, key string, newKey string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.RenameNX, []string{key, newKey})
+// [valkey.io]: https://valkey.io/commands/pexpire/
+func (client *baseClient) PExpireWithOptions(
+	ctx context.Context,
+	key string,
+	expireTime time.Duration,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	result, err := client.executeCommand(
+		ctx,
+		C.PExpire,
+		[]string{key, utils.IntToString(expireTime.Milliseconds()), expireConditionStr},
+	)
 	if err != nil {
 		return models.DefaultBoolResponse, err
 	}
 	return handleBoolResponse(result)
 }
 
-// Adds an entry to the specified stream stored at `key`. If the `key` doesn't exist, the stream is created.
+// Sets a timeout on key. It takes an absolute Unix timestamp (milliseconds since January 1, 1970) instead of
+// specifying the number of milliseconds. A timestamp in the past will delete the key immediately.
+// After the timeout has expired, the key will automatically be deleted.
+// If key already has an existing expire set, the time to live is updated to the new value.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx    - The context for controlling the command execution.
-//	key    - The key of the stream.
-//	values - Field-value pairs to be added to the entry.
+//	ctx - The context for controlling the command execution.
+//	key - The key to set timeout on it.
+//	expireTime - The timestamp for expiry.
 //
 // Return value:
 //
-//	The id of the added entry.
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/xadd/
-func (client *baseClient) XAdd(ctx context.Context, key string, values []models.FieldValue) (string, error) {
-	result, err := client.XAddWithOptions(ctx, key, values, *options.NewXAddOptions())
+// [valkey.io]: https://valkey.io/commands/pexpireat/
+func (client *baseClient) PExpireAt(ctx context.Context, key string, expireTime time.Time) (bool, error) {
+	result, err := client.executeCommand(ctx, C.PExpireAt, []string{key, utils.IntToString(expireTime.UnixMilli())})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultBoolResponse, err
 	}
-	return result.Value(), nil
+	return handleBoolResponse(result)
 }
 
-// Adds an entry to the specified stream stored at `key`. If the `key` doesn't exist, the stream is created.
+// Sets a timeout on key. It takes an absolute Unix timestamp (milliseconds since January 1, 1970) instead of
+// specifying the number of milliseconds. A timestamp in the past will delete the key immediately.
+// After the timeout has expired, the key will automatically be deleted.
+// If key already has an existing expire set, the time to live is updated to the new value.
+// The timeout will only be cleared by commands that delete or overwrite the contents of key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The key of the stream.
-//	values  - Field-value pairs to be added to the entry.
-//	options - Stream add options.
+//	ctx - The context for controlling the command execution.
+//	key - The key to set timeout on it.
+//	expireTime - The timestamp for expiry.
+//	expireCondition - The option to set expiry, see [options.ExpireCondition].
 //
 // Return value:
 //
-//	The id of the added entry, or `nil` if [options.XAddOptions.MakeStream] is set to `false`
-//	and no stream with the matching `key` exists.
+//	`true` if the timeout was set. `false` if the timeout was not set. e.g. key doesn't exist,
+//	or operation skipped due to the provided arguments.
 //
-// [valkey.io]: https://valkey.io/commands/xadd/
-func (client *baseClient) XAddWithOptions(
+// [valkey.io]: https://valkey.io/commands/pexpireat/
+func (client *baseClient) PExpireAtWithOptions(
 	ctx context.Context,
 	key string,
-	values []models.FieldValue,
-	options options.XAddOptions,
-) (models.Result[string], error) {
-	args := []string{}
-	args = append(args, key)
-	optionArgs, err := options.ToArgs()
+	expireTime time.Time,
+	expireCondition constants.ExpireCondition,
+) (bool, error) {
+	expireConditionStr, err := expireCondition.ToString()
 	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	args = append(args, optionArgs...)
-	for _, pair := range values {
-		args = append(args, []string{pair.Field, pair.Value}...)
+		return models.DefaultBoolResponse, err
 	}
-
-	result, err := client.executeCommand(ctx, C.XAdd, args)
+	result, err := client.executeCommand(ctx,
+		C.PExpireAt,
+		[]string{key, utils.IntToString(expireTime.UnixMilli()), expireConditionStr},
+	)
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return models.DefaultBoolResponse, err
 	}
-	return handleStringOrNilResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Reads entries from the given streams.
-//
-// Note:
-//
-//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
+// Expire Time returns the absolute Unix timestamp (since January 1, 1970) at which the given key
+// will expire, in seconds.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keysAndIds - A map of keys and entry IDs to read from.
+//	key - The key to determine the expiration value of.
 //
 // Return value:
 //
-//	A map[string]models.StreamResponse where:
-//	- Each key is a stream name
-//	- Each value is a StreamResponse containing:
-//	  - Entries: []StreamEntry, where each StreamEntry has:
-//	    - ID: The unique identifier of the entry
-//	    - Fields: []FieldValue array of field-value pairs for the entry.
+//	The expiration Unix timestamp in seconds.
+//	`-2` if key does not exist or `-1` is key exists but has no associated expiration.
 //
-// [valkey.io]: https://valkey.io/commands/xread/
-func (client *baseClient) XRead(ctx context.Context, keysAndIds map[string]string) (map[string]models.StreamResponse, error) {
-	return client.XReadWithOptions(ctx, keysAndIds, *options.NewXReadOptions())
+// [valkey.io]: https://valkey.io/commands/expiretime/
+func (client *baseClient) ExpireTime(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ExpireTime, []string{key})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
 }
 
-// Reads entries from the given streams.
-//
-// Note:
-//
-//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
-//
-// See [valkey.io] for details.
+// PExpire Time returns the absolute Unix timestamp (since January 1, 1970) at which the given key
+// will expire, in milliseconds.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keysAndIds - A map of keys and entry IDs to read from.
-//	opts - Options detailing how to read the stream.
+//	key - The key to determine the expiration value of.
 //
 // Return value:
 //
-//	A map[string]models.StreamResponse where:
-//	- Each key is a stream name
-//	- Each value is a StreamResponse containing:
-//	  - Entries: []StreamEntry, where each StreamEntry has:
-//	    - ID: The unique identifier of the entry
-//	    - Fields: []FieldValue array of field-value pairs for the entry
+//	The expiration Unix timestamp in milliseconds.
+//	`-2` if key does not exist or `-1` is key exists but has no associated expiration.
 //
-// [valkey.io]: https://valkey.io/commands/xread/
-func (client *baseClient) XReadWithOptions(
-	ctx context.Context,
-	keysAndIds map[string]string,
-	opts options.XReadOptions,
-) (map[string]models.StreamResponse, error) {
-	args, err := internal.CreateStreamCommandArgs(make([]string, 0, 5+2*len(keysAndIds)), keysAndIds, &opts)
-	if err != nil {
-		return nil, err
-	}
-
-	result, err := client.executeCommand(ctx, C.XRead, args)
+// [valkey.io]: https://valkey.io/commands/pexpiretime/
+func (client *baseClient) PExpireTime(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PExpireTime, []string{key})
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStreamResponse(result)
+	return handleIntResponse(result)
 }
 
-// Reads entries from the given streams owned by a consumer group.
-//
-// Note:
-//
-//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
-//
-// See [valkey.io] for details.
+// TTL returns the remaining time to live of key that has a timeout, in seconds.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	group - The consumer group name.
-//	consumer - The group consumer.
-//	keysAndIds - A map of keys and entry IDs to read from.
+//	key - The key to return its timeout.
 //
 // Return value:
 //
-//	A map[string]models.StreamResponse where:
-//	- Each key is a stream name
-//	- Each value is a StreamResponse containing:
-//	  - Entries: []StreamEntry, where each StreamEntry has:
-//	    - ID: The unique identifier of the entry
-//	    - Fields: map[string]string of field-value pairs for the entry
+//	Returns TTL in seconds,
+//	`-2` if key does not exist, or `-1` if key exists but has no associated expiration.
 //
-// [valkey.io]: https://valkey.io/commands/xreadgroup/
-func (client *baseClient) XReadGroup(
-	ctx context.Context,
-	group string,
-	consumer string,
-	keysAndIds map[string]string,
-) (map[string]models.StreamResponse, error) {
-	return client.XReadGroupWithOptions(ctx, group, consumer, keysAndIds, *options.NewXReadGroupOptions())
+// [valkey.io]: https://valkey.io/commands/ttl/
+func (client *baseClient) TTL(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.TTL, []string{key})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
 }
 
-// Reads entries from the given streams owned by a consumer group.
-//
-// Note:
-//
-//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
-//
-// See [valkey.io] for details.
+// PTTL returns the remaining time to live of key that has a timeout, in milliseconds.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	group - The consumer group name.
-//	consumer - The group consumer.
-//	keysAndIds - A map of keys and entry IDs to read from.
-//	opts - Options detailing how to read the stream.
+//	key - The key to return its timeout.
 //
 // Return value:
 //
-//	A map[string]models.StreamResponse where:
-//	- Each key is a stream name
-//	- Each value is a StreamResponse containing:
-//	  - Entries: []StreamEntry, where each StreamEntry has:
-//	    - ID: The unique identifier of the entry
-//	    - Fields: map[string]string of field-value pairs for the entry
+//	Returns TTL in milliseconds,
+//	`-2` if key does not exist, or `-1` if key exists but has no associated expiration.
 //
-// [valkey.io]: https://valkey.io/commands/xreadgroup/
-func (client *baseClient) XReadGroupWithOptions(
-	ctx context.Context,
-	group string,
-	consumer string,
-	keysAndIds map[string]string,
-	opts options.XReadGroupOptions,
-) (map[string]models.StreamResponse, error) {
-	args, err := internal.CreateStreamCommandArgs([]string{constants.GroupKeyword, group, consumer}, keysAndIds, &opts)
-	if err != nil {
-		return nil, err
-	}
-
-	result, err := client.executeCommand(ctx, C.XReadGroup, args)
+// [valkey.io]: https://valkey.io/commands/pttl/
+func (client *baseClient) PTTL(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PTTL, []string{key})
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStreamResponse(result)
+	return handleIntResponse(result)
 }
 
-// Adds one or more members to a sorted set, or updates their scores. Creates the key if it doesn't exist.
-//
-// See [valkey.io] for details.
+// PfAdd adds all elements to the HyperLogLog data structure stored at the specified key.
+// Creates a new structure if the key does not exist.
+// When no elements are provided, and key exists and is a HyperLogLog, then no operation is performed.
+// If key does not exist, then the HyperLogLog structure is created.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	membersScoreMap - A map of members to their scores.
+//	key - The key of the HyperLogLog data structure to add elements into.
+//	elements - An array of members to add to the HyperLogLog stored at key.
 //
 // Return value:
 //
-//	The number of members added to the set.
+//	If the HyperLogLog is newly created, or if the HyperLogLog approximated cardinality is
+//	altered, then returns `true`. Otherwise, returns `false`.
 //
-// [valkey.io]: https://valkey.io/commands/zadd/
-func (client *baseClient) ZAdd(
-	ctx context.Context,
-	key string,
-	membersScoreMap map[string]float64,
-) (int64, error) {
-	result, err := client.executeCommand(ctx,
-		C.ZAdd,
-		append([]string{key}, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
-	)
+// [valkey.io]: https://valkey.io/commands/pfadd/
+func (client *baseClient) PfAdd(ctx context.Context, key string, elements []string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.PfAdd, append([]string{key}, elements...))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultBoolResponse, err
 	}
 
-	return handleIntResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Adds one or more members to a sorted set, or updates their scores. Creates the key if it doesn't exist.
+// Estimates the cardinality of the data stored in a HyperLogLog structure for a single key or
+// calculates the combined cardinality of multiple keys by merging their HyperLogLogs temporarily.
 //
-// See [valkey.io] for details.
+// Note:
+//
+//	In cluster mode, if keys in `keyValueMap` map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	membersScoreMap - A map of members to their scores.
-//	opts - The options for the command. See [Client.ZAddOptions] [ClusterClient.ZAddOptions] for details.
+//	key - The keys of the HyperLogLog data structures to be analyzed.
 //
 // Return value:
 //
-//	The number of members added to the set. If `CHANGED` is set, the number of members that were updated.
+//	The approximated cardinality of given HyperLogLog data structures.
+//	The cardinality of a key that does not exist is `0`.
 //
-// [valkey.io]: https://valkey.io/commands/zadd/
-func (client *baseClient) ZAddWithOptions(
-	ctx context.Context,
-	key string,
-	membersScoreMap map[string]float64,
-	opts options.ZAddOptions,
-) (int64, error) {
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	commandArgs := append([]string{key}, optionArgs...)
-	result, err := client.executeCommand(ctx,
-		C.ZAdd,
-		append(commandArgs, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
-	)
+// [valkey.io]: https://valkey.io/commands/pfcount/
+func (client *baseClient) PfCount(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PfCount, keys)
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
@@ -4261,1480 +4672,1472 @@ func (client *baseClient) ZAddWithOptions(
 	return handleIntResponse(result)
 }
 
-func (client *baseClient) zAddIncrBase(
-	ctx context.Context,
-	key string,
-	opts *options.ZAddOptions,
-) (models.Result[float64], error) {
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return models.CreateNilFloat64Result(), err
-	}
-
-	result, err := client.executeCommand(ctx, C.ZAdd, append([]string{key}, optionArgs...))
-	if err != nil {
-		return models.CreateNilFloat64Result(), err
-	}
-
-	return handleFloatOrNilResponse(result)
-}
-
-// Increments the score of member in the sorted set stored at `key` by `increment`.
+// PfMerge merges multiple HyperLogLog values into a unique value.
+// If the destination variable exists, it is treated as one of the source HyperLogLog data sets,
+// otherwise a new HyperLogLog is created.
 //
-// If `member` does not exist in the sorted set, it is added with `increment` as its
-// score (as if its previous score was `0.0`).
-// If `key` does not exist, a new sorted set with the specified member as its sole member
-// is created.
+// Note:
 //
-// See [valkey.io] for details.
+//	When in cluster mode, `sourceKeys` and `destination` must map to the same hash slot.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	member - A member in the sorted set to increment.
-//	increment - The score to increment the member.
+//	destination - The key of the destination HyperLogLog where the merged data sets will be stored.
+//	sourceKeys - An array of sourceKeys of the HyperLogLog structures to be merged.
 //
 // Return value:
 //
-//	The new score of the member.
+//	If the HyperLogLog values is successfully merged it returns "OK".
 //
-// [valkey.io]: https://valkey.io/commands/zadd/
-func (client *baseClient) ZAddIncr(
-	ctx context.Context,
-	key string,
-	member string,
-	increment float64,
-) (float64, error) {
-	options, err := options.NewZAddOptions().SetIncr(true, increment, member)
-	if err != nil {
-		return models.DefaultFloatResponse, err
-	}
-
-	res, err := client.zAddIncrBase(ctx, key, options)
+// [valkey.io]: https://valkey.io/commands/pfmerge/
+func (client *baseClient) PfMerge(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	result, err := client.executeCommand(ctx, C.PfMerge, append([]string{destination}, sourceKeys...))
 	if err != nil {
-		return models.DefaultFloatResponse, err
+		return models.DefaultStringResponse, err
 	}
 
-	return res.Value(), nil
+	return handleOkResponse(result)
 }
 
-// Increments the score of member in the sorted set stored at `key` by `increment`.
+// Unlink (delete) multiple keys from the database. A key is ignored if it does not exist.
+// This command, similar to [Client.Del] and [ClusterClient.Del], however, this command does not block the server.
 //
-// If `member` does not exist in the sorted set, it is added with `increment` as its
-// score (as if its previous score was `0.0`).
-// If `key` does not exist, a new sorted set with the specified member as its sole member
-// is created.
+// Note:
 //
-// See [valkey.io] for details.
+//	In cluster mode, if keys in keys map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	member - A member in the sorted set to increment.
-//	increment - The score to increment the member.
-//	opts - The options for the command. See [ZAddOptions] for details.
+//	keys - One or more keys to unlink.
 //
 // Return value:
 //
-//	The new score of the member.
-//	If there was a conflict with the options, the operation aborts and `nil` is returned.
+//	Return the number of keys that were unlinked.
 //
-// [valkey.io]: https://valkey.io/commands/zadd/
-func (client *baseClient) ZAddIncrWithOptions(
-	ctx context.Context,
-	key string,
-	member string,
-	increment float64,
-	opts options.ZAddOptions,
-) (models.Result[float64], error) {
-	incrOpts, err := opts.SetIncr(true, increment, member)
+// [valkey.io]: https://valkey.io/commands/unlink/
+func (client *baseClient) Unlink(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Unlink, keys)
 	if err != nil {
-		return models.CreateNilFloat64Result(), err
+		return models.DefaultIntResponse, err
 	}
 
-	return client.zAddIncrBase(ctx, key, incrOpts)
+	return handleIntResponse(result)
 }
 
-// Increments the score of member in the sorted set stored at key by increment.
-// If member does not exist in the sorted set, it is added with increment as its score.
-// If key does not exist, a new sorted set with the specified member as its sole member
-// is created.
-//
-// See [valkey.io] for details.
+// Type returns the string representation of the type of the value stored at key.
+// The different types that can be returned are: `"string"`, `"list"`, `"set"`, `"zset"`, `"hash"` and `"stream"`.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	increment - The score increment.
-//	member - A member of the sorted set.
+//	key - The `key` to check its data type.
 //
 // Return value:
 //
-//	The new score of member.
+//	If the `key` exists, the type of the stored value is returned. Otherwise, a `"none"` string is returned.
 //
-// [valkey.io]: https://valkey.io/commands/zincrby/
-func (client *baseClient) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
-	result, err := client.executeCommand(ctx, C.ZIncrBy, []string{key, utils.FloatToString(increment), member})
+// [valkey.io]: https://valkey.io/commands/type/
+func (client *baseClient) Type(ctx context.Context, key string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Type, []string{key})
 	if err != nil {
-		return models.DefaultFloatResponse, err
+		return models.DefaultStringResponse, err
 	}
-
-	return handleFloatResponse(result)
+	return handleStringResponse(result)
 }
 
-// Removes and returns the member with the lowest score from the sorted set
-// stored at the specified `key`.
+// Alters the last access time of a key(s). A key is ignored if it does not exist.
 //
-// see [valkey.io] for details.
+// Note:
+//
+//	In cluster mode, if keys in keys map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
+//	keys - The keys to update last access time.
 //
 // Return value:
 //
-//	A map containing the removed member and its corresponding score.
-//	If `key` doesn't exist, it will be treated as an empty sorted set and the
-//	command returns an empty map.
+//	The number of keys that were updated.
 //
-// [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMin(ctx context.Context, key string) (map[string]float64, error) {
-	result, err := client.executeCommand(ctx, C.ZPopMin, []string{key})
+// [valkey.io]: Https://valkey.io/commands/touch/
+func (client *baseClient) Touch(ctx context.Context, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Touch, keys)
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
-	return handleStringDoubleMapResponse(result)
+
+	return handleIntResponse(result)
 }
 
-// Removes and returns multiple members with the lowest scores from the sorted set
-// stored at the specified `key`.
+// Renames `key` to `newKey`.
+// If `newKey` already exists it is overwritten.
 //
-// see [valkey.io] for details.
+// Note:
+//
+//	When in cluster mode, both `key` and `newKey` must map to the same hash slot.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	options - Pop options, see [options.ZPopOptions].
+//	key - The key to rename.
+//	newKey - The new name of the key.
 //
 // Return value:
 //
-//	A map containing the removed members and their corresponding scores.
-//	If `key` doesn't exist, it will be treated as an empty sorted set and the
-//	command returns an empty map.
+//	If the key was successfully renamed, return "OK". If key does not exist, an error is thrown.
 //
-// [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMinWithOptions(
-	ctx context.Context,
-	key string,
-	options options.ZPopOptions,
-) (map[string]float64, error) {
-	optArgs, err := options.ToArgs(false)
-	if err != nil {
-		return nil, err
-	}
-	result, err := client.executeCommand(ctx, C.ZPopMin, append([]string{key}, optArgs...))
+// [valkey.io]: https://valkey.io/commands/rename/
+func (client *baseClient) Rename(ctx context.Context, key string, newKey string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Rename, []string{key, newKey})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleStringDoubleMapResponse(result)
+	return handleOkResponse(result)
 }
 
-// Removes and returns the member with the highest score from the sorted set stored at the
-// specified `key`.
+// Renames `key` to `newkey` if `newKey` does not yet exist.
 //
-// see [valkey.io] for details.
+// Note:
+//
+//	When in cluster mode, both `key` and `newkey` must map to the same hash slot.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
+//	key - The key to rename.
+//	newKey - The new name of the key.
 //
 // Return value:
 //
-//	A map containing the removed member and its corresponding score.
-//	If `key` doesn't exist, it will be treated as an empty sorted set and the
-//	command returns an empty map.
+//	`true` if key was renamed to `newKey`, `false` if `newKey` already exists.
 //
-// [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMax(ctx context.Context, key string) (map[string]float64, error) {
-	result, err := client.executeCommand(ctx, C.ZPopMax, []string{key})
+// [valkey.io]: https://valkey.io/commands/renamenx/
+func (client *baseClient) RenameNX(ctx context.Context, key string, newKey string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.RenameNX, []string{key, newKey})
 	if err != nil {
-		return nil, err
+		return models.DefaultBoolResponse, err
 	}
-	return handleStringDoubleMapResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Removes and returns up to `count` members with the highest scores from the sorted set
-// stored at the specified `key`.
+// Adds an entry to the specified stream stored at `key`. If the `key` doesn't exist, the stream is created.
 //
-// see [valkey.io] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	count - The number of members to remove.
+//	ctx    - The context for controlling the command execution.
+//	key    - The key of the stream.
+//	values - Field-value pairs to be added to the entry.
 //
 // Return value:
 //
-//	A map containing the removed members and their corresponding scores.
-//	If `key` doesn't exist, it will be treated as an empty sorted set and the
-//	command returns an empty map.
+//	The id of the added entry.
 //
-// [valkey.io]: https://valkey.io/commands/zpopmin/
-func (client *baseClient) ZPopMaxWithOptions(
-	ctx context.Context,
-	key string,
-	options options.ZPopOptions,
-) (map[string]float64, error) {
-	optArgs, err := options.ToArgs(false)
-	if err != nil {
-		return nil, err
-	}
-	result, err := client.executeCommand(ctx, C.ZPopMax, append([]string{key}, optArgs...))
+// [valkey.io]: https://valkey.io/commands/xadd/
+func (client *baseClient) XAdd(ctx context.Context, key string, values []models.FieldValue) (string, error) {
+	result, err := client.XAddWithOptions(ctx, key, values, *options.NewXAddOptions())
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleStringDoubleMapResponse(result)
+	return result.Value(), nil
 }
 
-// Removes the specified members from the sorted set stored at `key`.
-// Specified members that are not a member of this set are ignored.
+// Adds an entry to the specified stream stored at `key`. If the `key` doesn't exist, the stream is created.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	members - The members to remove.
+//	ctx     - The context for controlling the command execution.
+//	key     - The key of the stream.
+//	values  - Field-value pairs to be added to the entry.
+//	options - Stream add options.
 //
 // Return value:
 //
-//	The number of members that were removed from the sorted set, not including non-existing members.
-//	If `key` does not exist, it is treated as an empty sorted set, and this command returns `0`.
+//	The id of the added entry, or `nil` if [options.XAddOptions.MakeStream] is set to `false`
+//	and no stream with the matching `key` exists.
 //
-// [valkey.io]: https://valkey.io/commands/zrem/
-func (client *baseClient) ZRem(ctx context.Context, key string, members []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.ZRem, append([]string{key}, members...))
+// [valkey.io]: https://valkey.io/commands/xadd/
+func (client *baseClient) XAddWithOptions(
+	ctx context.Context,
+	key string,
+	values []models.FieldValue,
+	options options.XAddOptions,
+) (models.Result[string], error) {
+	args := []string{}
+	args = append(args, key)
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilStringResult(), err
 	}
-	return handleIntResponse(result)
+	args = append(args, optionArgs...)
+	for _, pair := range values {
+		args = append(args, []string{pair.Field, pair.Value}...)
+	}
+
+	result, err := client.executeCommand(ctx, C.XAdd, args)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return handleStringOrNilResponse(result)
 }
 
-// Returns the cardinality (number of elements) of the sorted set stored at `key`.
+// Reads entries from the given streams.
+//
+// Note:
+//
+//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the set.
+//	keysAndIds - A map of keys and entry IDs to read from.
 //
 // Return value:
 //
-//	The number of elements in the sorted set.
-//	If `key` does not exist, it is treated as an empty sorted set, and this command returns `0`.
-//	If `key` holds a value that is not a sorted set, an error is returned.
+//	A map[string]models.StreamResponse where:
+//	- Each key is a stream name
+//	- Each value is a StreamResponse containing:
+//	  - Entries: []StreamEntry, where each StreamEntry has:
+//	    - ID: The unique identifier of the entry
+//	    - Fields: []FieldValue array of field-value pairs for the entry.
 //
-// [valkey.io]: https://valkey.io/commands/zcard/
-func (client *baseClient) ZCard(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.ZCard, []string{key})
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-
-	return handleIntResponse(result)
+// [valkey.io]: https://valkey.io/commands/xread/
+func (client *baseClient) XRead(ctx context.Context, keysAndIds map[string]string) (map[string]models.StreamResponse, error) {
+	return client.XReadWithOptions(ctx, keysAndIds, *options.NewXReadOptions())
 }
 
-// Blocks the connection until it removes and returns a member-score pair
-// with the lowest score from the first non-empty sorted set.
-// The given `keys` being checked in the order they are provided.
-// `BZPopMin` is the blocking variant of [Client.BZPopMin] and [ClusterClient.BZPopMin].
+// Reads entries from the given streams.
 //
 // Note:
-//   - When in cluster mode, all `keys` must map to the same hash slot.
-//   - `BZPopMin` is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
-// See [valkey.io] for more details.
+//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - The keys of the sorted sets.
-//	timeout - The duration to wait for a blocking operation to complete. A value of
-//	  `0` will block indefinitely.
+//	keysAndIds - A map of keys and entry IDs to read from.
+//	opts - Options detailing how to read the stream.
 //
 // Return value:
 //
-//	A `models.KeyWithMemberAndScore` struct containing the key where the member was popped out, the member
-//	itself, and the member score. If no member could be popped and the `timeout` expired, returns `nil`.
-//
-// [valkey.io]: https://valkey.io/commands/bzpopmin/
+//	A map[string]models.StreamResponse where:
+//	- Each key is a stream name
+//	- Each value is a StreamResponse containing:
+//	  - Entries: []StreamEntry, where each StreamEntry has:
+//	    - ID: The unique identifier of the entry
+//	    - Fields: []FieldValue array of field-value pairs for the entry
 //
-// [Blocking commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BZPopMin(
+// [valkey.io]: https://valkey.io/commands/xread/
+func (client *baseClient) XReadWithOptions(
 	ctx context.Context,
-	keys []string,
-	timeout time.Duration,
-) (models.Result[models.KeyWithMemberAndScore], error) {
-	result, err := client.executeCommand(ctx, C.BZPopMin, append(keys, utils.FloatToString(timeout.Seconds())))
+	keysAndIds map[string]string,
+	opts options.XReadOptions,
+) (map[string]models.StreamResponse, error) {
+	args, err := internal.CreateStreamCommandArgs(make([]string, 0, 5+2*len(keysAndIds)), keysAndIds, &opts)
 	if err != nil {
-		return models.CreateNilKeyWithMemberAndScoreResult(), err
+		return nil, err
 	}
 
-	return handleKeyWithMemberAndScoreResponse(result)
+	result, err := client.executeCommand(ctx, C.XRead, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStreamResponse(result)
 }
 
-// Blocks the connection until it pops and returns a member-score pair from the first non-empty sorted set, with the
-// given keys being checked in the order they are provided.
-// BZMPop is the blocking variant of [Client.ZMPop] and [ClusterClient.ZMPop].
+// Reads entries from the given streams owned by a consumer group.
 //
 // Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - BZMPop is a client blocking command, see [Blocking Commands] for more details and best practices.
-//
-// Since:
 //
-//	Valkey 7.0 and above.
+//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx           - The context for controlling the command execution.
-//	keys          - An array of keys to lists.
-//	scoreFilter   - The element pop criteria - either [options.MIN] or [options.MAX] to pop members with the lowest/highest
-//					scores accordingly.
-//	timeout       - The duration to wait for a blocking operation to complete. A value of `0` will block
-//					indefinitely.
+//	ctx - The context for controlling the command execution.
+//	group - The consumer group name.
+//	consumer - The group consumer.
+//	keysAndIds - A map of keys and entry IDs to read from.
 //
 // Return value:
 //
-//	An object containing the following elements:
-//	- The key name of the set from which the element was popped.
-//	- An array of member scores of the popped elements.
-//	Returns `nil` if no member could be popped and the timeout expired.
+//	A map[string]models.StreamResponse where:
+//	- Each key is a stream name
+//	- Each value is a StreamResponse containing:
+//	  - Entries: []StreamEntry, where each StreamEntry has:
+//	    - ID: The unique identifier of the entry
+//	    - Fields: map[string]string of field-value pairs for the entry
 //
-// [valkey.io]: https://valkey.io/commands/bzmpop/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BZMPop(
+// [valkey.io]: https://valkey.io/commands/xreadgroup/
+func (client *baseClient) XReadGroup(
 	ctx context.Context,
-	keys []string,
-	scoreFilter constants.ScoreFilter,
-	timeout time.Duration,
-) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
-	scoreFilterStr, err := scoreFilter.ToString()
-	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
-	}
-
-	// Check for potential length overflow.
-	if len(keys) > math.MaxInt-3 {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), errors.New(
-			"length overflow for the provided keys",
-		)
-	}
-
-	// args slice will have 3 more arguments with the keys provided.
-	args := make([]string, 0, len(keys)+3)
-	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, scoreFilterStr)
-	result, err := client.executeCommand(ctx, C.BZMPop, args)
-	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
-	}
-	return handleKeyWithArrayOfMembersAndScoresResponse(result)
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+) (map[string]models.StreamResponse, error) {
+	return client.XReadGroupWithOptions(ctx, group, consumer, keysAndIds, *options.NewXReadGroupOptions())
 }
 
-// Blocks the connection until it pops and returns a member-score pair from the first non-empty sorted set, with the
-// given keys being checked in the order they are provided.
-// BZMPop is the blocking variant of [Client.ZMPop] and [ClusterClient.ZMPop].
+// Reads entries from the given streams owned by a consumer group.
 //
 // Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - BZMPop is a client blocking command, see [Blocking Commands] for more details and best practices.
-//
-// Since:
 //
-//	Valkey 7.0 and above.
+//	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys          - An array of keys to lists.
-//	scoreFilter   - The element pop criteria - either [options.MIN] or [options.MAX] to pop members with the lowest/highest
-//					scores accordingly.
-//	count         - The maximum number of popped elements.
-//	timeout       - The duration to wait for a blocking operation to complete. A value of `0` will block indefinitely.
-//	opts          - Pop options, see [options.ZMPopOptions].
+//	group - The consumer group name.
+//	consumer - The group consumer.
+//	keysAndIds - A map of keys and entry IDs to read from.
+//	opts - Options detailing how to read the stream.
 //
 // Return value:
 //
-//	An object containing the following elements:
-//	- The key name of the set from which the element was popped.
-//	- An array of member scores of the popped elements.
-//	Returns `nil` if no member could be popped and the timeout expired.
+//	A map[string]models.StreamResponse where:
+//	- Each key is a stream name
+//	- Each value is a StreamResponse containing:
+//	  - Entries: []StreamEntry, where each StreamEntry has:
+//	    - ID: The unique identifier of the entry
+//	    - Fields: map[string]string of field-value pairs for the entry
 //
-// [valkey.io]: https://valkey.io/commands/bzmpop/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BZMPopWithOptions(
+// [valkey.io]: https://valkey.io/commands/xreadgroup/
+func (client *baseClient) XReadGroupWithOptions(
 	ctx context.Context,
-	keys []string,
-	scoreFilter constants.ScoreFilter,
-	timeout time.Duration,
-	opts options.ZMPopOptions,
-) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
-	scoreFilterStr, err := scoreFilter.ToString()
+	group string,
+	consumer string,
+	keysAndIds map[string]string,
+	opts options.XReadGroupOptions,
+) (map[string]models.StreamResponse, error) {
+	args, err := internal.CreateStreamCommandArgs([]string{constants.GroupKeyword, group, consumer}, keysAndIds, &opts)
 	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
-	}
-
-	// Check for potential length overflow.
-	if len(keys) > math.MaxInt-5 {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), errors.New(
-			"length overflow for the provided keys",
-		)
+		return nil, err
 	}
 
-	// args slice will have 5 more arguments with the keys provided.
-	args := make([]string, 0, len(keys)+5)
-	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, scoreFilterStr)
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
-	}
-	args = append(args, optionArgs...)
-	result, err := client.executeCommand(ctx, C.BZMPop, args)
+	result, err := client.executeCommand(ctx, C.XReadGroup, args)
 	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+		return nil, err
 	}
 
-	return handleKeyWithArrayOfMembersAndScoresResponse(result)
+	return handleStreamResponse(result)
 }
 
-// Returns the specified range of elements in the sorted set stored at `key`.
-// `ZRANGE` can perform different types of range queries: by index (rank), by the score, or by lexicographical order.
-//
-// To get the elements with their scores, see [Client.ZRangeWithScores] and [ClusterClient.ZRangeWithScores].
+// Adds one or more members to a sorted set, or updates their scores. Creates the key if it doesn't exist.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	rangeQuery - The range query object representing the type of range query to perform.
-//	  - For range queries by index (rank), use [RangeByIndex].
-//	  - For range queries by lexicographical order, use [RangeByLex].
-//	  - For range queries by score, use [RangeByScore].
+//	key - The key of the set.
+//	membersScoreMap - A map of members to their scores.
 //
 // Return value:
 //
-//	An array of elements within the specified range.
-//	If `key` does not exist, it is treated as an empty sorted set, and the command returns an empty array.
+//	The number of members added to the set.
 //
-// [valkey.io]: https://valkey.io/commands/zrange/
-func (client *baseClient) ZRange(ctx context.Context, key string, rangeQuery options.ZRangeQuery) ([]string, error) {
-	args := make([]string, 0, 10)
-	args = append(args, key)
-	queryArgs, err := rangeQuery.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, queryArgs...)
-	result, err := client.executeCommand(ctx, C.ZRange, args)
+// [valkey.io]: https://valkey.io/commands/zadd/
+func (client *baseClient) ZAdd(
+	ctx context.Context,
+	key string,
+	membersScoreMap map[string]float64,
+) (int64, error) {
+	result, err := client.executeCommand(ctx,
+		C.ZAdd,
+		append([]string{key}, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
+	)
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	return handleStringArrayResponse(result)
+	return handleIntResponse(result)
 }
 
-// Returns the specified range of elements with their scores in the sorted set stored at `key`.
-// `ZRANGE` can perform different types of range queries: by index (rank), by the score, or by lexicographical order.
+// Adds one or more members to a sorted set, or updates their scores. Creates the key if it doesn't exist.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	rangeQuery - The range query object representing the type of range query to perform.
-//	  - For range queries by index (rank), use [RangeByIndex].
-//	  - For range queries by score, use [RangeByScore].
+//	key - The key of the set.
+//	membersScoreMap - A map of members to their scores.
+//	opts - The options for the command. See [Client.ZAddOptions] [ClusterClient.ZAddOptions] for details.
 //
 // Return value:
 //
-//	An array of elements and their scores within the specified range.
-//	If `key` does not exist, it is treated as an empty sorted set, and the command returns an empty array.
+//	The number of members added to the set. If `CHANGED` is set, the number of members that were updated.
 //
-// [valkey.io]: https://valkey.io/commands/zrange/
-func (client *baseClient) ZRangeWithScores(
+// [valkey.io]: https://valkey.io/commands/zadd/
+func (client *baseClient) ZAddWithOptions(
 	ctx context.Context,
 	key string,
-	rangeQuery options.ZRangeQueryWithScores,
-) ([]models.MemberAndScore, error) {
-	args := make([]string, 0, 10)
-	args = append(args, key)
-	queryArgs, err := rangeQuery.ToArgs()
+	membersScoreMap map[string]float64,
+	opts options.ZAddOptions,
+) (int64, error) {
+	optionArgs, err := opts.ToArgs()
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
-	args = append(args, queryArgs...)
-	args = append(args, constants.WithScoresKeyword)
-	result, err := client.executeCommand(ctx, C.ZRange, args)
+	commandArgs := append([]string{key}, optionArgs...)
+	result, err := client.executeCommand(ctx,
+		C.ZAdd,
+		append(commandArgs, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...),
+	)
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
 
-	needsReverse := false
-	for _, arg := range args {
-		if arg == "REV" {
-			needsReverse = true
-			break
-		}
+	return handleIntResponse(result)
+}
+
+func (client *baseClient) zAddIncrBase(
+	ctx context.Context,
+	key string,
+	opts *options.ZAddOptions,
+) (models.Result[float64], error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
 	}
 
-	return handleSortedSetWithScoresResponse(result, needsReverse)
+	result, err := client.executeCommand(ctx, C.ZAdd, append([]string{key}, optionArgs...))
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+
+	return handleFloatOrNilResponse(result)
 }
 
-// Stores a specified range of elements from the sorted set at `key`, into a new
-// sorted set at `destination`. If `destination` doesn't exist, a new sorted
-// set is created; if it exists, it's overwritten.
-//
-// Note:
+// Increments the score of member in the sorted set stored at `key` by `increment`.
 //
-//	When in cluster mode, all keys must map to the same hash slot.
+// If `member` does not exist in the sorted set, it is added with `increment` as its
+// score (as if its previous score was `0.0`).
+// If `key` does not exist, a new sorted set with the specified member as its sole member
+// is created.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destination - The key for the destination sorted set.
-//	key - The key of the source sorted set.
-//	rangeQuery - The range query object representing the type of range query to perform.
-//	 - For range queries by index (rank), use [RangeByIndex].
-//	 - For range queries by lexicographical order, use [RangeByLex].
-//	 - For range queries by score, use [RangeByScore].
+//	key - The key of the sorted set.
+//	member - A member in the sorted set to increment.
+//	increment - The score to increment the member.
 //
 // Return value:
 //
-//	The number of elements in the resulting sorted set.
+//	The new score of the member.
 //
-// [valkey.io]: https://valkey.io/commands/zrangestore/
-func (client *baseClient) ZRangeStore(
+// [valkey.io]: https://valkey.io/commands/zadd/
+func (client *baseClient) ZAddIncr(
 	ctx context.Context,
-	destination string,
 	key string,
-	rangeQuery options.ZRangeQuery,
-) (int64, error) {
-	args := make([]string, 0, 10)
-	args = append(args, destination)
-	args = append(args, key)
-	rqArgs, err := rangeQuery.ToArgs()
+	member string,
+	increment float64,
+) (float64, error) {
+	options, err := options.NewZAddOptions().SetIncr(true, increment, member)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultFloatResponse, err
 	}
-	args = append(args, rqArgs...)
-	result, err := client.executeCommand(ctx, C.ZRangeStore, args)
+
+	res, err := client.zAddIncrBase(ctx, key, options)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultFloatResponse, err
 	}
 
-	return handleIntResponse(result)
+	return res.Value(), nil
 }
 
-// Removes the existing timeout on key, turning the key from volatile
-// (a key with an expire set) to persistent (a key that will never expire as no timeout is associated).
+// Increments the score of member in the sorted set stored at `key` by `increment`.
+//
+// If `member` does not exist in the sorted set, it is added with `increment` as its
+// score (as if its previous score was `0.0`).
+// If `key` does not exist, a new sorted set with the specified member as its sole member
+// is created.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key to remove the existing timeout on.
+//	key - The key of the sorted set.
+//	member - A member in the sorted set to increment.
+//	increment - The score to increment the member.
+//	opts - The options for the command. See [ZAddOptions] for details.
 //
 // Return value:
 //
-//	`false` if key does not exist or does not have an associated timeout, `true` if the timeout has been removed.
+//	The new score of the member.
+//	If there was a conflict with the options, the operation aborts and `nil` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/persist/
-func (client *baseClient) Persist(ctx context.Context, key string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.Persist, []string{key})
+// [valkey.io]: https://valkey.io/commands/zadd/
+func (client *baseClient) ZAddIncrWithOptions(
+	ctx context.Context,
+	key string,
+	member string,
+	increment float64,
+	opts options.ZAddOptions,
+) (models.Result[float64], error) {
+	incrOpts, err := opts.SetIncr(true, increment, member)
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return models.CreateNilFloat64Result(), err
 	}
-	return handleBoolResponse(result)
+
+	return client.zAddIncrBase(ctx, key, incrOpts)
 }
 
-// Returns the number of members in the sorted set stored at `key` with scores between `min` and `max` score.
+// Increments the score of member in the sorted set stored at key by increment.
+// If member does not exist in the sorted set, it is added with increment as its score.
+// If key does not exist, a new sorted set with the specified member as its sole member
+// is created.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the set.
-//	rangeOptions - Contains `min` and `max` score. `min` contains the minimum score to count from.
-//		`max` contains the maximum score to count up to. Can be positive/negative infinity, or
-//		specific score and inclusivity.
+//	key - The key of the sorted set.
+//	increment - The score increment.
+//	member - A member of the sorted set.
 //
 // Return value:
 //
-//	The number of members in the specified score range.
+//	The new score of member.
 //
-// [valkey.io]: https://valkey.io/commands/zcount/
-func (client *baseClient) ZCount(ctx context.Context, key string, rangeOptions options.ZCountRange) (int64, error) {
-	zCountRangeArgs, err := rangeOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	result, err := client.executeCommand(ctx, C.ZCount, append([]string{key}, zCountRangeArgs...))
+// [valkey.io]: https://valkey.io/commands/zincrby/
+func (client *baseClient) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+	result, err := client.executeCommand(ctx, C.ZIncrBy, []string{key, utils.FloatToString(increment), member})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultFloatResponse, err
 	}
-	return handleIntResponse(result)
+
+	return handleFloatResponse(result)
 }
 
-// Returns the rank of `member` in the sorted set stored at `key`, with
-// scores ordered from low to high, starting from `0`.
-// To get the rank of `member` with its score, see [Client.ZRankWithScore] and [ClusterClient.ZRankWithScore].
+// Removes and returns the member with the lowest score from the sorted set
+// stored at the specified `key`.
 //
-// See [valkey.io] for details.
+// see [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
-//	member - The member to get the rank of.
 //
 // Return value:
 //
-//	The rank of `member` in the sorted set.
-//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
+//	A map containing the removed member and its corresponding score.
+//	If `key` doesn't exist, it will be treated as an empty sorted set and the
+//	command returns an empty map.
 //
-// [valkey.io]: https://valkey.io/commands/zrank/
-func (client *baseClient) ZRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
-	result, err := client.executeCommand(ctx, C.ZRank, []string{key, member})
+// [valkey.io]: https://valkey.io/commands/zpopmin/
+func (client *baseClient) ZPopMin(ctx context.Context, key string) (map[string]float64, error) {
+	result, err := client.executeCommand(ctx, C.ZPopMin, []string{key})
 	if err != nil {
-		return models.CreateNilInt64Result(), err
+		return nil, err
 	}
-	return handleIntOrNilResponse(result)
+	return handleStringDoubleMapResponse(result)
 }
 
-// Returns the rank of `member` in the sorted set stored at `key` with its
-// score, where scores are ordered from the lowest to highest, starting from `0`.
-//
-// Since:
-//
-//	Valkey 7.2.0 and above.
+// Removes and returns multiple members with the lowest scores from the sorted set
+// stored at the specified `key`.
 //
-// See [valkey.io] for details.
+// see [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
-//	member - The member to get the rank of.
+//	options - Pop options, see [options.ZPopOptions].
 //
 // Return value:
 //
-//	A [models.Result[models.RankAndScore]] containing the rank of `member` and its score.
-//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
+//	A map containing the removed members and their corresponding scores.
+//	If `key` doesn't exist, it will be treated as an empty sorted set and the
+//	command returns an empty map.
 //
-// [valkey.io]: https://valkey.io/commands/zrank/
-func (client *baseClient) ZRankWithScore(
+// [valkey.io]: https://valkey.io/commands/zpopmin/
+func (client *baseClient) ZPopMinWithOptions(
 	ctx context.Context,
 	key string,
-	member string,
-) (models.Result[models.RankAndScore], error) {
-	result, err := client.executeCommand(ctx, C.ZRank, []string{key, member, constants.WithScoreKeyword})
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	optArgs, err := options.ToArgs(false)
 	if err != nil {
-		return models.CreateNilRankAndScoreResult(), err
+		return nil, err
 	}
-	return handleRankAndScoreOrNilResponse(result)
+	result, err := client.executeCommand(ctx, C.ZPopMin, append([]string{key}, optArgs...))
+	if err != nil {
+		return nil, err
+	}
+	return handleStringDoubleMapResponse(result)
 }
 
-// Returns the rank of `member` in the sorted set stored at `key`, where
-// scores are ordered from the highest to lowest, starting from `0`.
-// To get the rank of `member` with its score, see [Client.ZRevRankWithScore] and [ClusterClient.ZRevRankWithScore].
+// Removes and returns the member with the highest score from the sorted set stored at the
+// specified `key`.
 //
-// See [valkey.io] for details.
+// see [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
-//	member - The member to get the rank of.
 //
 // Return value:
 //
-//	The rank of `member` in the sorted set, where ranks are ordered from high to low based on scores.
-//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
+//	A map containing the removed member and its corresponding score.
+//	If `key` doesn't exist, it will be treated as an empty sorted set and the
+//	command returns an empty map.
 //
-// [valkey.io]: https://valkey.io/commands/zrevrank/
-func (client *baseClient) ZRevRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
-	result, err := client.executeCommand(ctx, C.ZRevRank, []string{key, member})
+// [valkey.io]: https://valkey.io/commands/zpopmin/
+func (client *baseClient) ZPopMax(ctx context.Context, key string) (map[string]float64, error) {
+	result, err := client.executeCommand(ctx, C.ZPopMax, []string{key})
 	if err != nil {
-		return models.CreateNilInt64Result(), err
+		return nil, err
 	}
-	return handleIntOrNilResponse(result)
+	return handleStringDoubleMapResponse(result)
 }
 
-// Returns the rank of `member` in the sorted set stored at `key`, where
-// scores are ordered from the highest to lowest, starting from `0`.
-//
-// Since:
-//
-//	Valkey 7.2.0 and above.
+// Removes and returns up to `count` members with the highest scores from the sorted set
+// stored at the specified `key`.
 //
-// See [valkey.io] for details.
+// see [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
-//	member - The member to get the rank of.
+//	count - The number of members to remove.
 //
 // Return value:
 //
-//	A [models.Result[models.RankAndScore]] containing the rank of `member` and its score.
-//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
+//	A map containing the removed members and their corresponding scores.
+//	If `key` doesn't exist, it will be treated as an empty sorted set and the
+//	command returns an empty map.
 //
-// [valkey.io]: https://valkey.io/commands/zrevrank/
-func (client *baseClient) ZRevRankWithScore(
+// [valkey.io]: https://valkey.io/commands/zpopmin/
+func (client *baseClient) ZPopMaxWithOptions(
 	ctx context.Context,
 	key string,
-	member string,
-) (models.Result[models.RankAndScore], error) {
-	result, err := client.executeCommand(ctx, C.ZRevRank, []string{key, member, constants.WithScoreKeyword})
+	options options.ZPopOptions,
+) (map[string]float64, error) {
+	optArgs, err := options.ToArgs(false)
 	if err != nil {
-		return models.CreateNilRankAndScoreResult(), err
+		return nil, err
 	}
-	return handleRankAndScoreOrNilResponse(result)
+	result, err := client.executeCommand(ctx, C.ZPopMax, append([]string{key}, optArgs...))
+	if err != nil {
+		return nil, err
+	}
+	return handleStringDoubleMapResponse(result)
 }
 
-// Trims the stream by evicting older entries.
+// Removes the specified members from the sorted set stored at `key`.
+// Specified members that are not a member of this set are ignored.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The key of the stream.
-//	options - Stream trim options
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	members - The members to remove.
 //
 // Return value:
 //
-//	The number of entries deleted from the stream.
+//	The number of members that were removed from the sorted set, not including non-existing members.
+//	If `key` does not exist, it is treated as an empty sorted set, and this command returns `0`.
 //
-// [valkey.io]: https://valkey.io/commands/xtrim/
-func (client *baseClient) XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error) {
-	xTrimArgs, err := options.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	result, err := client.executeCommand(ctx, C.XTrim, append([]string{key}, xTrimArgs...))
+// [valkey.io]: https://valkey.io/commands/zrem/
+func (client *baseClient) ZRem(ctx context.Context, key string, members []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ZRem, append([]string{key}, members...))
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
 	return handleIntResponse(result)
 }
 
-// Returns the number of entries in the stream stored at `key`.
+// Returns the cardinality (number of elements) of the sorted set stored at `key`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
+//	key - The key of the set.
 //
 // Return value:
 //
-//	The number of entries in the stream. If `key` does not exist, return 0.
+//	The number of elements in the sorted set.
+//	If `key` does not exist, it is treated as an empty sorted set, and this command returns `0`.
+//	If `key` holds a value that is not a sorted set, an error is returned.
 //
-// [valkey.io]: https://valkey.io/commands/xlen/
-func (client *baseClient) XLen(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.XLen, []string{key})
+// [valkey.io]: https://valkey.io/commands/zcard/
+func (client *baseClient) ZCard(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ZCard, []string{key})
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
+
 	return handleIntResponse(result)
 }
 
-// Transfers ownership of pending stream entries that match the specified criteria.
-//
-// Since:
+// Blocks the connection until it removes and returns a member-score pair
+// with the lowest score from the first non-empty sorted set.
+// The given `keys` being checked in the order they are provided.
+// `BZPopMin` is the blocking variant of [Client.BZPopMin] and [ClusterClient.BZPopMin].
 //
-//	Valkey 6.2.0 and above.
+// Note:
+//   - When in cluster mode, all `keys` must map to the same hash slot.
+//   - `BZPopMin` is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
 // See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	consumer - The group consumer.
-//	minIdleTime - The minimum idle time for the message to be claimed.
-//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
+//	keys - The keys of the sorted sets.
+//	timeout - The duration to wait for a blocking operation to complete. A value of
+//	  `0` will block indefinitely.
 //
 // Return value:
 //
-//	An object containing the following elements:
-//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
-//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
-//	    the entire stream was scanned.
-//	  - A array of the claimed entries as `[]models.StreamEntry`.
-//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
-//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
-//	    These IDs are deleted from the Pending Entries List.
+//	A `models.KeyWithMemberAndScore` struct containing the key where the member was popped out, the member
+//	itself, and the member score. If no member could be popped and the `timeout` expired, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/xautoclaim/
-func (client *baseClient) XAutoClaim(
+// [valkey.io]: https://valkey.io/commands/bzpopmin/
+//
+// [Blocking commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BZPopMin(
 	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	start string,
-) (models.XAutoClaimResponse, error) {
-	return client.XAutoClaimWithOptions(ctx, key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
+	keys []string,
+	timeout time.Duration,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	result, err := client.executeCommand(ctx, C.BZPopMin, append(keys, utils.FloatToString(timeout.Seconds())))
+	if err != nil {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
+
+	return handleKeyWithMemberAndScoreResponse(result)
 }
 
-// Transfers ownership of pending stream entries that match the specified criteria.
+// Blocks the connection until it pops and returns a member-score pair from the first non-empty sorted set, with the
+// given keys being checked in the order they are provided.
+// BZMPop is the blocking variant of [Client.ZMPop] and [ClusterClient.ZMPop].
+//
+// Note:
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - BZMPop is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
 // Since:
 //
-//	Valkey 6.2.0 and above.
+//	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	consumer - The group consumer.
-//	minIdleTime - The minimum idle time for the message to be claimed.
-//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
-//	options - Options detailing how to read the stream. Count has a default value of 100.
+//	ctx           - The context for controlling the command execution.
+//	keys          - An array of keys to lists.
+//	scoreFilter   - The element pop criteria - either [options.MIN] or [options.MAX] to pop members with the lowest/highest
+//					scores accordingly.
+//	timeout       - The duration to wait for a blocking operation to complete. A value of `0` will block
+//					indefinitely.
 //
 // Return value:
 //
 //	An object containing the following elements:
-//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
-//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
-//	    the entire stream was scanned.
-//	  - A array of the claimed entries as `[]models.StreamEntry`.
-//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
-//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
-//	    These IDs are deleted from the Pending Entries List.
+//	- The key name of the set from which the element was popped.
+//	- An array of member scores of the popped elements.
+//	Returns `nil` if no member could be popped and the timeout expired.
 //
-// [valkey.io]: https://valkey.io/commands/xautoclaim/
-func (client *baseClient) XAutoClaimWithOptions(
+// [valkey.io]: https://valkey.io/commands/bzmpop/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BZMPop(
 	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	start string,
-	options options.XAutoClaimOptions,
-) (models.XAutoClaimResponse, error) {
-	args := []string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds()), start}
-	optArgs, err := options.ToArgs()
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeout time.Duration,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	scoreFilterStr, err := scoreFilter.ToString()
 	if err != nil {
-		return models.XAutoClaimResponse{}, err
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
-	args = append(args, optArgs...)
-	result, err := client.executeCommand(ctx, C.XAutoClaim, args)
+
+	// Check for potential length overflow.
+	if len(keys) > math.MaxInt-3 {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), errors.New(
+			"length overflow for the provided keys",
+		)
+	}
+
+	// args slice will have 3 more arguments with the keys provided.
+	args := make([]string, 0, len(keys)+3)
+	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, scoreFilterStr)
+	result, err := client.executeCommand(ctx, C.BZMPop, args)
 	if err != nil {
-		return models.XAutoClaimResponse{}, err
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
-	return handleXAutoClaimResponse(result)
+	return handleKeyWithArrayOfMembersAndScoresResponse(result)
 }
 
-// Transfers ownership of pending stream entries that match the specified criteria.
+// Blocks the connection until it pops and returns a member-score pair from the first non-empty sorted set, with the
+// given keys being checked in the order they are provided.
+// BZMPop is the blocking variant of [Client.ZMPop] and [ClusterClient.ZMPop].
+//
+// Note:
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - BZMPop is a client blocking command, see [Blocking Commands] for more details and best practices.
 //
 // Since:
 //
-//	Valkey 6.2.0 and above.
+//	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	consumer - The group consumer.
-//	minIdleTime - The minimum idle time for the message to be claimed.
-//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
+//	keys          - An array of keys to lists.
+//	scoreFilter   - The element pop criteria - either [options.MIN] or [options.MAX] to pop members with the lowest/highest
+//					scores accordingly.
+//	count         - The maximum number of popped elements.
+//	timeout       - The duration to wait for a blocking operation to complete. A value of `0` will block indefinitely.
+//	opts          - Pop options, see [options.ZMPopOptions].
 //
 // Return value:
 //
 //	An object containing the following elements:
-//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
-//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
-//	    the entire stream was scanned.
-//	  - An array of IDs for the claimed entries.
-//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
-//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
-//	    These IDs are deleted from the Pending Entries List.
+//	- The key name of the set from which the element was popped.
+//	- An array of member scores of the popped elements.
+//	Returns `nil` if no member could be popped and the timeout expired.
 //
-// [valkey.io]: https://valkey.io/commands/xautoclaim/
-func (client *baseClient) XAutoClaimJustId(
+// [valkey.io]: https://valkey.io/commands/bzmpop/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BZMPopWithOptions(
 	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	start string,
-) (models.XAutoClaimJustIdResponse, error) {
-	return client.XAutoClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	timeout time.Duration,
+	opts options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	scoreFilterStr, err := scoreFilter.ToString()
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	// Check for potential length overflow.
+	if len(keys) > math.MaxInt-5 {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), errors.New(
+			"length overflow for the provided keys",
+		)
+	}
+
+	// args slice will have 5 more arguments with the keys provided.
+	args := make([]string, 0, len(keys)+5)
+	args = append(args, utils.FloatToString(timeout.Seconds()), strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, scoreFilterStr)
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+	args = append(args, optionArgs...)
+	result, err := client.executeCommand(ctx, C.BZMPop, args)
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	return handleKeyWithArrayOfMembersAndScoresResponse(result)
 }
 
-// Transfers ownership of pending stream entries that match the specified criteria.
-//
-// Since:
+// Returns the specified range of elements in the sorted set stored at `key`.
+// `ZRANGE` can perform different types of range queries: by index (rank), by the score, or by lexicographical order.
 //
-//	Valkey 6.2.0 and above.
+// To get the elements with their scores, see [Client.ZRangeWithScores] and [ClusterClient.ZRangeWithScores].
 //
 // See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	consumer - The group consumer.
-//	minIdleTime - The minimum idle time for the message to be claimed.
-//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
-//	opts - Options detailing how to read the stream. Count has a default value of 100.
+//	key - The key of the sorted set.
+//	rangeQuery - The range query object representing the type of range query to perform.
+//	  - For range queries by index (rank), use [RangeByIndex].
+//	  - For range queries by lexicographical order, use [RangeByLex].
+//	  - For range queries by score, use [RangeByScore].
 //
 // Return value:
 //
-//	An object containing the following elements:
-//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
-//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
-//	    the entire stream was scanned.
-//	  - An array of IDs for the claimed entries.
-//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
-//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
-//	    These IDs are deleted from the Pending Entries List.
+//	An array of elements within the specified range.
+//	If `key` does not exist, it is treated as an empty sorted set, and the command returns an empty array.
 //
-// [valkey.io]: https://valkey.io/commands/xautoclaim/
-func (client *baseClient) XAutoClaimJustIdWithOptions(
-	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	start string,
-	opts options.XAutoClaimOptions,
-) (models.XAutoClaimJustIdResponse, error) {
-	args := []string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds()), start}
-	optArgs, err := opts.ToArgs()
+// [valkey.io]: https://valkey.io/commands/zrange/
+func (client *baseClient) ZRange(ctx context.Context, key string, rangeQuery options.ZRangeQuery) ([]string, error) {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+	queryArgs, err := rangeQuery.ToArgs()
 	if err != nil {
-		return models.XAutoClaimJustIdResponse{}, err
+		return nil, err
 	}
-	args = append(args, optArgs...)
-	args = append(args, constants.JustIdKeyword)
-	result, err := client.executeCommand(ctx, C.XAutoClaim, args)
+	args = append(args, queryArgs...)
+	result, err := client.executeCommand(ctx, C.ZRange, args)
 	if err != nil {
-		return models.XAutoClaimJustIdResponse{}, err
+		return nil, err
 	}
-	return handleXAutoClaimJustIdResponse(result)
+
+	return handleStringArrayResponse(result)
 }
 
-// Removes the specified entries by id from a stream, and returns the number of entries deleted.
+// Returns the specified range of elements with their scores in the sorted set stored at `key`.
+// `ZRANGE` can perform different types of range queries: by index (rank), by the score, or by lexicographical order.
 //
-// See [valkey.io] for details.
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	ids - An array of entry ids.
-//
-// Return value:
-//
-//	The number of entries removed from the stream. This number may be less than the number
-//	of entries in `ids`, if the specified `ids` don't exist in the stream.
+//	key - The key of the sorted set.
+//	rangeQuery - The range query object representing the type of range query to perform.
+//	  - For range queries by index (rank), use [RangeByIndex].
+//	  - For range queries by score, use [RangeByScore].
 //
-// [valkey.io]: https://valkey.io/commands/xdel/
-func (client *baseClient) XDel(ctx context.Context, key string, ids []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.XDel, append([]string{key}, ids...))
+// Return value:
+//
+//	An array of elements and their scores within the specified range.
+//	If `key` does not exist, it is treated as an empty sorted set, and the command returns an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/zrange/
+func (client *baseClient) ZRangeWithScores(
+	ctx context.Context,
+	key string,
+	rangeQuery options.ZRangeQueryWithScores,
+) ([]models.MemberAndScore, error) {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+	queryArgs, err := rangeQuery.ToArgs()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-	return handleIntResponse(result)
+	args = append(args, queryArgs...)
+	args = append(args, constants.WithScoresKeyword)
+	result, err := client.executeCommand(ctx, C.ZRange, args)
+	if err != nil {
+		return nil, err
+	}
+
+	needsReverse := false
+	for _, arg := range args {
+		if arg == "REV" {
+			needsReverse = true
+			break
+		}
+	}
+
+	return handleSortedSetWithScoresResponse(result, needsReverse)
 }
 
-// Returns the score of `member` in the sorted set stored at `key`.
+// Stores a specified range of elements from the sorted set at `key`, into a new
+// sorted set at `destination`. If `destination` doesn't exist, a new sorted
+// set is created; if it exists, it's overwritten.
 //
-// See [valkey.io] for details.
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	member - The member whose score is to be retrieved.
+//	destination - The key for the destination sorted set.
+//	key - The key of the source sorted set.
+//	rangeQuery - The range query object representing the type of range query to perform.
+//	 - For range queries by index (rank), use [RangeByIndex].
+//	 - For range queries by lexicographical order, use [RangeByLex].
+//	 - For range queries by score, use [RangeByScore].
 //
 // Return value:
 //
-//	The score of the member. If `member` does not exist in the sorted set, `nil` is returned.
-//	If `key` does not exist, `nil` is returned.
+//	The number of elements in the resulting sorted set.
 //
-// [valkey.io]: https://valkey.io/commands/zscore/
-func (client *baseClient) ZScore(ctx context.Context, key string, member string) (models.Result[float64], error) {
-	result, err := client.executeCommand(ctx, C.ZScore, []string{key, member})
+// [valkey.io]: https://valkey.io/commands/zrangestore/
+func (client *baseClient) ZRangeStore(
+	ctx context.Context,
+	destination string,
+	key string,
+	rangeQuery options.ZRangeQuery,
+) (int64, error) {
+	args := make([]string, 0, 10)
+	args = append(args, destination)
+	args = append(args, key)
+	rqArgs, err := rangeQuery.ToArgs()
 	if err != nil {
-		return models.CreateNilFloat64Result(), err
+		return models.DefaultIntResponse, err
 	}
-	return handleFloatOrNilResponse(result)
+	args = append(args, rqArgs...)
+	result, err := client.executeCommand(ctx, C.ZRangeStore, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
 }
 
-// Iterates incrementally over a sorted set.
-//
-// See [valkey.io] for details.
+// Removes the existing timeout on key, turning the key from volatile
+// (a key with an expire set) to persistent (a key that will never expire as no timeout is associated).
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	cursor - The cursor that points to the next iteration of results.
-//	         A value of `"0"` indicates the start of the search.
-//	         For Valkey 8.0 and above, negative cursors are treated like the initial cursor("0").
+//	key - The key to remove the existing timeout on.
 //
 // Return value:
 //
-//	An object which holds the next cursor and the subset of the hash held by `key`.
-//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
-//	The data array in the result is always an array of the subset of the sorted set held in `key`.
-//	The array is a flattened series of `string` pairs, where the value is at even indices and the score is at odd indices.
+//	`false` if key does not exist or does not have an associated timeout, `true` if the timeout has been removed.
 //
-// [valkey.io]: https://valkey.io/commands/zscan/
-func (client *baseClient) ZScan(ctx context.Context, key string, cursor models.Cursor) (models.ScanResult, error) {
-	result, err := client.executeCommand(ctx, C.ZScan, []string{key, cursor.String()})
+// [valkey.io]: https://valkey.io/commands/persist/
+func (client *baseClient) Persist(ctx context.Context, key string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Persist, []string{key})
 	if err != nil {
-		return models.ScanResult{}, err
+		return models.DefaultBoolResponse, err
 	}
-	return handleScanResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Iterates incrementally over a sorted set.
+// Returns the number of members in the sorted set stored at `key` with scores between `min` and `max` score.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	cursor - The cursor that points to the next iteration of results.
-//	options - The options for the command. See [options.ZScanOptions] for details.
+//	key - The key of the set.
+//	rangeOptions - Contains `min` and `max` score. `min` contains the minimum score to count from.
+//		`max` contains the maximum score to count up to. Can be positive/negative infinity, or
+//		specific score and inclusivity.
 //
 // Return value:
 //
-//	An object which holds the next cursor and the subset of the hash held by `key`.
-//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
-//	The data array in the result is always an array of the subset of the sorted set held in `key`.
-//	The array is a flattened series of `string` pairs, where the value is at even indices and the score is at odd indices.
+//	The number of members in the specified score range.
 //
-// [valkey.io]: https://valkey.io/commands/zscan/
-func (client *baseClient) ZScanWithOptions(
-	ctx context.Context,
-	key string,
-	cursor models.Cursor,
-	options options.ZScanOptions,
-) (models.ScanResult, error) {
-	optionArgs, err := options.ToArgs()
+// [valkey.io]: https://valkey.io/commands/zcount/
+func (client *baseClient) ZCount(ctx context.Context, key string, rangeOptions options.ZCountRange) (int64, error) {
+	zCountRangeArgs, err := rangeOptions.ToArgs()
 	if err != nil {
-		return models.ScanResult{}, err
+		return models.DefaultIntResponse, err
 	}
-
-	result, err := client.executeCommand(ctx, C.ZScan, append([]string{key, cursor.String()}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.ZCount, append([]string{key}, zCountRangeArgs...))
 	if err != nil {
-		return models.ScanResult{}, err
+		return models.DefaultIntResponse, err
 	}
-	return handleScanResponse(result)
+	return handleIntResponse(result)
 }
 
-// Returns stream message summary information for pending messages matching a stream and group.
+// Returns the rank of `member` in the sorted set stored at `key`, with
+// scores ordered from low to high, starting from `0`.
+// To get the rank of `member` with its score, see [Client.ZRankWithScore] and [ClusterClient.ZRankWithScore].
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
+//	key - The key of the sorted set.
+//	member - The member to get the rank of.
 //
 // Return value:
 //
-// An models.XPendingSummary struct that includes a summary with the following fields:
-//
-//	NumOfMessages - The total number of pending messages for this consumer group.
-//	StartId - The smallest ID among the pending messages or nil if no pending messages exist.
-//	EndId - The greatest ID among the pending messages or nil if no pending messages exists.
-//	GroupConsumers - An array of ConsumerPendingMessages with the following fields:
-//	ConsumerName - The name of the consumer.
-//	MessageCount - The number of pending messages for this consumer.
+//	The rank of `member` in the sorted set.
+//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/xpending/
-func (client *baseClient) XPending(ctx context.Context, key string, group string) (models.XPendingSummary, error) {
-	result, err := client.executeCommand(ctx, C.XPending, []string{key, group})
+// [valkey.io]: https://valkey.io/commands/zrank/
+func (client *baseClient) ZRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ZRank, []string{key, member})
 	if err != nil {
-		return models.XPendingSummary{}, err
+		return models.CreateNilInt64Result(), err
 	}
-
-	return handleXPendingSummaryResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// Returns stream message summary information for pending messages matching a given range of IDs.
+// Returns the rank of `member` in the sorted set stored at `key` with its
+// score, where scores are ordered from the lowest to highest, starting from `0`.
+//
+// Since:
+//
+//	Valkey 7.2.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	opts - The options for the command. See [options.XPendingOptions] for details.
+//	key - The key of the sorted set.
+//	member - The member to get the rank of.
 //
 // Return value:
 //
-// A slice of models.XPendingDetail structs, where each detail struct includes the following fields:
-//
-//	Id - The ID of the pending message.
-//	ConsumerName - The name of the consumer that fetched the message and has still to acknowledge it.
-//	IdleTime - The time in milliseconds since the last time the message was delivered to the consumer.
-//	DeliveryCount - The number of times this message was delivered.
+//	A [models.Result[models.RankAndScore]] containing the rank of `member` and its score.
+//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/xpending/
-func (client *baseClient) XPendingWithOptions(
+// [valkey.io]: https://valkey.io/commands/zrank/
+func (client *baseClient) ZRankWithScore(
 	ctx context.Context,
 	key string,
-	group string,
-	opts options.XPendingOptions,
-) ([]models.XPendingDetail, error) {
-	optionArgs, _ := opts.ToArgs()
-	args := append([]string{key, group}, optionArgs...)
-
-	result, err := client.executeCommand(ctx, C.XPending, args)
+	member string,
+) (models.Result[models.RankAndScore], error) {
+	result, err := client.executeCommand(ctx, C.ZRank, []string{key, member, constants.WithScoreKeyword})
 	if err != nil {
-		return nil, err
+		return models.CreateNilRankAndScoreResult(), err
 	}
-	return handleXPendingDetailResponse(result)
+	return handleRankAndScoreOrNilResponse(result)
 }
 
-// Creates a new consumer group uniquely identified by `group` for the stream stored at `key`.
+// Returns the rank of `member` in the sorted set stored at `key`, where
+// scores are ordered from the highest to lowest, starting from `0`.
+// To get the rank of `member` with its score, see [Client.ZRevRankWithScore] and [ClusterClient.ZRevRankWithScore].
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The newly created consumer group name.
-//	id - Stream entry ID that specifies the last delivered entry in the stream from the new
-//	    group’s perspective. The special ID `"$"` can be used to specify the last entry in the stream.
+//	key - The key of the sorted set.
+//	member - The member to get the rank of.
 //
 // Return value:
 //
-//	`"OK"`.
+//	The rank of `member` in the sorted set, where ranks are ordered from high to low based on scores.
+//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-create/
-func (client *baseClient) XGroupCreate(ctx context.Context, key string, group string, id string) (string, error) {
-	return client.XGroupCreateWithOptions(ctx, key, group, id, *options.NewXGroupCreateOptions())
+// [valkey.io]: https://valkey.io/commands/zrevrank/
+func (client *baseClient) ZRevRank(ctx context.Context, key string, member string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ZRevRank, []string{key, member})
+	if err != nil {
+		return models.CreateNilInt64Result(), err
+	}
+	return handleIntOrNilResponse(result)
 }
 
-// Creates a new consumer group uniquely identified by `group` for the stream stored at `key`.
+// Returns the rank of `member` in the sorted set stored at `key`, where
+// scores are ordered from the highest to lowest, starting from `0`.
+//
+// Since:
+//
+//	Valkey 7.2.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The newly created consumer group name.
-//	id - Stream entry ID that specifies the last delivered entry in the stream from the new
-//	    group's perspective. The special ID `"$"` can be used to specify the last entry in the stream.
-//	opts - The options for the command. See [options.XGroupCreateOptions] for details.
+//	key - The key of the sorted set.
+//	member - The member to get the rank of.
 //
 // Return value:
 //
-//	`"OK"`.
+//	A [models.Result[models.RankAndScore]] containing the rank of `member` and its score.
+//	If `key` doesn't exist, or if `member` is not present in the set, an empty [models.Result] will be returned.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-create/
-func (client *baseClient) XGroupCreateWithOptions(
-	ctx context.Context,
+// [valkey.io]: https://valkey.io/commands/zrevrank/
+func (client *baseClient) ZRevRankWithScore(
+	ctx context.Context,
 	key string,
-	group string,
-	id string,
-	opts options.XGroupCreateOptions,
-) (string, error) {
-	optionArgs, _ := opts.ToArgs()
-	args := append([]string{key, group, id}, optionArgs...)
-	result, err := client.executeCommand(ctx, C.XGroupCreate, args)
+	member string,
+) (models.Result[models.RankAndScore], error) {
+	result, err := client.executeCommand(ctx, C.ZRevRank, []string{key, member, constants.WithScoreKeyword})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.CreateNilRankAndScoreResult(), err
 	}
-	return handleOkResponse(result)
+	return handleRankAndScoreOrNilResponse(result)
 }
 
-// Creates a key associated with a value that is obtained by
-// deserializing the provided serialized value (obtained via [Client.Dump] or [ClusterClient.Dump]).
-//
-// Parameters:
-//
-//	ctx - The context for controlling the command execution.
-//	key - The key to create.
-//	ttl - The expiry time. If 0, the key will persist.
-//	value - The serialized value to deserialize and assign to key.
-//
-// Return value:
-//
-//	Return OK if successfully create a key with a value.
+// Trims the stream by evicting older entries.
 //
-// [valkey.io]: https://valkey.io/commands/restore/
-func (client *baseClient) Restore(ctx context.Context, key string, ttl time.Duration, value string) (string, error) {
-	return client.RestoreWithOptions(ctx, key, ttl, value, *options.NewRestoreOptions())
-}
-
-// Creates a key associated with a value that is obtained by
-// deserializing the provided serialized value (obtained via [Client.Dump] or [ClusterClient.Dump]).
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key to create.
-//	ttl - The expiry time. If 0, the key will persist.
-//	value - The serialized value to deserialize and assign to key.
-//	restoreOptions - Set restore options with replace and absolute TTL modifiers, object idletime and frequency.
+//	ctx     - The context for controlling the command execution.
+//	key     - The key of the stream.
+//	options - Stream trim options
 //
 // Return value:
 //
-//	Return OK if successfully create a key with a value.
+//	The number of entries deleted from the stream.
 //
-// [valkey.io]: https://valkey.io/commands/restore/
-func (client *baseClient) RestoreWithOptions(ctx context.Context, key string, ttl time.Duration,
-	value string, options options.RestoreOptions,
-) (string, error) {
-	optionArgs, err := options.ToArgs()
+// [valkey.io]: https://valkey.io/commands/xtrim/
+func (client *baseClient) XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error) {
+	xTrimArgs, err := options.ToArgs()
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultIntResponse, err
 	}
-	result, err := client.executeCommand(ctx, C.Restore, append([]string{
-		key,
-		utils.IntToString(ttl.Milliseconds()), value,
-	}, optionArgs...))
+	result, err := client.executeCommand(ctx, C.XTrim, append([]string{key}, xTrimArgs...))
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultIntResponse, err
 	}
-	return handleOkResponse(result)
+	return handleIntResponse(result)
 }
 
-// Serializes the value stored at key in a Valkey-specific format.
+// Returns the number of entries in the stream stored at `key`.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key to serialize.
+//	key - The key of the stream.
 //
 // Return value:
 //
-//	The serialized value of the data stored at key.
-//	If key does not exist, `nil` will be returned.
+//	The number of entries in the stream. If `key` does not exist, return 0.
 //
-// [valkey.io]: https://valkey.io/commands/dump/
-func (client *baseClient) Dump(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.Dump, []string{key})
+// [valkey.io]: https://valkey.io/commands/xlen/
+func (client *baseClient) XLen(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XLen, []string{key})
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return models.DefaultIntResponse, err
 	}
-	return handleStringOrNilResponse(result)
+	return handleIntResponse(result)
 }
 
-// Returns the internal encoding for the Valkey object stored at key.
-//
-// Parameters:
-//
-//	ctx - The context for controlling the command execution.
-//	key - The key of the object to get the internal encoding of.
-//
-// Return value:
+// Transfers ownership of pending stream entries that match the specified criteria.
 //
-//	If key exists, returns the internal encoding of the object stored at
-//	key as a String. Otherwise, returns `null`.
+// Since:
 //
-// [valkey.io]: https://valkey.io/commands/object-encoding/
-func (client *baseClient) ObjectEncoding(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.ObjectEncoding, []string{key})
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	return handleStringOrNilResponse(result)
-}
-
-func (client *baseClient) echo(ctx context.Context, message string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.Echo, []string{message})
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	return handleStringOrNilResponse(result)
-}
-
-// Destroys the consumer group `group` for the stream stored at `key`.
+//	Valkey 6.2.0 and above.
 //
-// See [valkey.io] for details.
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the stream.
-//	group - The consumer group name to delete.
+//	group - The consumer group name.
+//	consumer - The group consumer.
+//	minIdleTime - The minimum idle time for the message to be claimed.
+//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
 //
 // Return value:
 //
-//	`true` if the consumer group is destroyed. Otherwise, `false`.
+//	An object containing the following elements:
+//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
+//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
+//	    the entire stream was scanned.
+//	  - A array of the claimed entries as `[]models.StreamEntry`.
+//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
+//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
+//	    These IDs are deleted from the Pending Entries List.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-destroy/
-func (client *baseClient) XGroupDestroy(ctx context.Context, key string, group string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.XGroupDestroy, []string{key, group})
-	if err != nil {
-		return models.DefaultBoolResponse, err
-	}
-	return handleBoolResponse(result)
+// [valkey.io]: https://valkey.io/commands/xautoclaim/
+func (client *baseClient) XAutoClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	start string,
+) (models.XAutoClaimResponse, error) {
+	return client.XAutoClaimWithOptions(ctx, key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
 }
 
-// Sets the last delivered ID for a consumer group.
+// Transfers ownership of pending stream entries that match the specified criteria.
 //
-// See [valkey.io] for details.
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the stream.
 //	group - The consumer group name.
-//	id - The stream entry ID that should be set as the last delivered ID for the consumer group.
+//	consumer - The group consumer.
+//	minIdleTime - The minimum idle time for the message to be claimed.
+//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
+//	options - Options detailing how to read the stream. Count has a default value of 100.
 //
 // Return value:
 //
-//	`"OK"`.
+//	An object containing the following elements:
+//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
+//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
+//	    the entire stream was scanned.
+//	  - A array of the claimed entries as `[]models.StreamEntry`.
+//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
+//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
+//	    These IDs are deleted from the Pending Entries List.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-setid/
-func (client *baseClient) XGroupSetId(ctx context.Context, key string, group string, id string) (string, error) {
-	return client.XGroupSetIdWithOptions(ctx, key, group, id, *options.NewXGroupSetIdOptionsOptions())
+// [valkey.io]: https://valkey.io/commands/xautoclaim/
+func (client *baseClient) XAutoClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	start string,
+	options options.XAutoClaimOptions,
+) (models.XAutoClaimResponse, error) {
+	args := []string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds()), start}
+	optArgs, err := options.ToArgs()
+	if err != nil {
+		return models.XAutoClaimResponse{}, err
+	}
+	args = append(args, optArgs...)
+	result, err := client.executeCommand(ctx, C.XAutoClaim, args)
+	if err != nil {
+		return models.XAutoClaimResponse{}, err
+	}
+	return handleXAutoClaimResponse(result)
 }
 
-// Sets the last delivered ID for a consumer group.
+// Transfers ownership of pending stream entries that match the specified criteria.
 //
-// See [valkey.io] for details.
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the stream.
 //	group - The consumer group name.
-//	id - The stream entry ID that should be set as the last delivered ID for the consumer group.
-//	opts - The options for the command. See [options.XGroupSetIdOptions] for details.
+//	consumer - The group consumer.
+//	minIdleTime - The minimum idle time for the message to be claimed.
+//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
 //
 // Return value:
 //
-//	`"OK"`.
+//	An object containing the following elements:
+//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
+//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
+//	    the entire stream was scanned.
+//	  - An array of IDs for the claimed entries.
+//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
+//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
+//	    These IDs are deleted from the Pending Entries List.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-setid/
-func (client *baseClient) XGroupSetIdWithOptions(
+// [valkey.io]: https://valkey.io/commands/xautoclaim/
+func (client *baseClient) XAutoClaimJustId(
 	ctx context.Context,
 	key string,
 	group string,
-	id string,
-	opts options.XGroupSetIdOptions,
-) (string, error) {
-	optionArgs, _ := opts.ToArgs()
-	args := append([]string{key, group, id}, optionArgs...)
-	result, err := client.executeCommand(ctx, C.XGroupSetId, args)
-	if err != nil {
-		return models.DefaultStringResponse, err
-	}
-	return handleOkResponse(result)
+	consumer string,
+	minIdleTime time.Duration,
+	start string,
+) (models.XAutoClaimJustIdResponse, error) {
+	return client.XAutoClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, start, *options.NewXAutoClaimOptions())
 }
 
-// Removes all elements in the sorted set stored at `key` with a lexicographical order
-// between `rangeQuery.Start` and `rangeQuery.End`.
+// Transfers ownership of pending stream entries that match the specified criteria.
 //
-// See [valkey.io] for details.
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	rangeQuery - The range query object representing the minimum and maximum bound of the lexicographical range.
+//	key - The key of the stream.
+//	group - The consumer group name.
+//	consumer - The group consumer.
+//	minIdleTime - The minimum idle time for the message to be claimed.
+//	start - Filters the claimed entries to those that have an ID equal or greater than the specified value.
+//	opts - Options detailing how to read the stream. Count has a default value of 100.
 //
 // Return value:
 //
-//	The number of members removed from the sorted set.
-//	If `key` does not exist, it is treated as an empty sorted set, and the command returns `0`.
-//	If `rangeQuery.Start` is greater than `rangeQuery.End`, `0` is returned.
+//	An object containing the following elements:
+//	  - A stream ID to be used as the start argument for the next call to `XAUTOCLAIM`. This ID is
+//	    equivalent to the next ID in the stream after the entries that were scanned, or "0-0" if
+//	    the entire stream was scanned.
+//	  - An array of IDs for the claimed entries.
+//	  - If you are using Valkey 7.0.0 or above, the response will also include an array containing
+//	    the message IDs that were in the Pending Entries List but no longer exist in the stream.
+//	    These IDs are deleted from the Pending Entries List.
 //
-// [valkey.io]: https://valkey.io/commands/zremrangebylex/
-func (client *baseClient) ZRemRangeByLex(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
-	queryArgs, err := rangeQuery.ToArgsRemRange()
+// [valkey.io]: https://valkey.io/commands/xautoclaim/
+func (client *baseClient) XAutoClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	start string,
+	opts options.XAutoClaimOptions,
+) (models.XAutoClaimJustIdResponse, error) {
+	args := []string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds()), start}
+	optArgs, err := opts.ToArgs()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.XAutoClaimJustIdResponse{}, err
 	}
-	result, err := client.executeCommand(ctx,
-		C.ZRemRangeByLex, append([]string{key}, queryArgs...))
+	args = append(args, optArgs...)
+	args = append(args, constants.JustIdKeyword)
+	result, err := client.executeCommand(ctx, C.XAutoClaim, args)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.XAutoClaimJustIdResponse{}, err
 	}
-	return handleIntResponse(result)
+	return handleXAutoClaimJustIdResponse(result)
 }
 
-// Removes all elements in the sorted set stored at `key` with a rank between `start` and `stop`.
+// Removes the specified entries by id from a stream, and returns the number of entries deleted.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	start - The start rank.
-//	stop - The stop rank.
+//	key - The key of the stream.
+//	ids - An array of entry ids.
 //
 // Return value:
 //
-//	The number of members removed from the sorted set.
-//	If `key` does not exist, it is treated as an empty sorted set, and the command returns `0`.
-//	If `start` is greater than `stop`, `0` is returned.
+//	The number of entries removed from the stream. This number may be less than the number
+//	of entries in `ids`, if the specified `ids` don't exist in the stream.
 //
-// [valkey.io]: https://valkey.io/commands/zremrangebyrank/
-func (client *baseClient) ZRemRangeByRank(ctx context.Context, key string, start int64, stop int64) (int64, error) {
-	result, err := client.executeCommand(
-		ctx,
-		C.ZRemRangeByRank,
-		[]string{key, utils.IntToString(start), utils.IntToString(stop)},
-	)
+// [valkey.io]: https://valkey.io/commands/xdel/
+func (client *baseClient) XDel(ctx context.Context, key string, ids []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XDel, append([]string{key}, ids...))
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
 	return handleIntResponse(result)
 }
 
-// Removes all elements in the sorted set stored at `key` with a score between `rangeQuery.Start` and `rangeQuery.End`.
+// Returns the score of `member` in the sorted set stored at `key`.
 //
 // See [valkey.io] for details.
 //
@@ -5742,29 +6145,23 @@ func (client *baseClient) ZRemRangeByRank(ctx context.Context, key string, start
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
-//	rangeQuery - The range query object representing the minimum and maximum bound of the score range.
-//	  can be an implementation of [options.RangeByScore].
+//	member - The member whose score is to be retrieved.
 //
 // Return value:
 //
-//	The number of members removed from the sorted set.
-//	If `key` does not exist, it is treated as an empty sorted set, and the command returns `0`.
-//	If `rangeQuery.Start` is greater than `rangeQuery.End`, `0` is returned.
+//	The score of the member. If `member` does not exist in the sorted set, `nil` is returned.
+//	If `key` does not exist, `nil` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/zremrangebyscore/
-func (client *baseClient) ZRemRangeByScore(ctx context.Context, key string, rangeQuery options.RangeByScore) (int64, error) {
-	queryArgs, err := rangeQuery.ToArgsRemRange()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	result, err := client.executeCommand(ctx, C.ZRemRangeByScore, append([]string{key}, queryArgs...))
+// [valkey.io]: https://valkey.io/commands/zscore/
+func (client *baseClient) ZScore(ctx context.Context, key string, member string) (models.Result[float64], error) {
+	result, err := client.executeCommand(ctx, C.ZScore, []string{key, member})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilFloat64Result(), err
 	}
-	return handleIntResponse(result)
+	return handleFloatOrNilResponse(result)
 }
 
-// Returns a random member from the sorted set stored at `key`.
+// Iterates incrementally over a sorted set.
 //
 // See [valkey.io] for details.
 //
@@ -5772,22 +6169,27 @@ func (client *baseClient) ZRemRangeByScore(ctx context.Context, key string, rang
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
+//	cursor - The cursor that points to the next iteration of results.
+//	         A value of `"0"` indicates the start of the search.
+//	         For Valkey 8.0 and above, negative cursors are treated like the initial cursor("0").
 //
 // Return value:
 //
-//	A string representing a random member from the sorted set.
-//	If the sorted set does not exist or is empty, the response will be `nil`.
+//	An object which holds the next cursor and the subset of the hash held by `key`.
+//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
+//	The data array in the result is always an array of the subset of the sorted set held in `key`.
+//	The array is a flattened series of `string` pairs, where the value is at even indices and the score is at odd indices.
 //
-// [valkey.io]: https://valkey.io/commands/zrandmember/
-func (client *baseClient) ZRandMember(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.ZRandMember, []string{key})
+// [valkey.io]: https://valkey.io/commands/zscan/
+func (client *baseClient) ZScan(ctx context.Context, key string, cursor models.Cursor) (models.ScanResult, error) {
+	result, err := client.executeCommand(ctx, C.ZScan, []string{key, cursor.String()})
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return models.ScanResult{}, err
 	}
-	return handleStringOrNilResponse(result)
+	return handleScanResponse(result)
 }
 
-// Returns multiple random members from the sorted set stored at `key`.
+// Iterates incrementally over a sorted set.
 //
 // See [valkey.io] for details.
 //
@@ -5795,379 +6197,390 @@ func (client *baseClient) ZRandMember(ctx context.Context, key string) (models.R
 //
 //	ctx - The context for controlling the command execution.
 //	key - The key of the sorted set.
-//	count - The number of field names to return.
-//	  If `count` is positive, returns unique elements. If negative, allows for duplicates.
+//	cursor - The cursor that points to the next iteration of results.
+//	options - The options for the command. See [options.ZScanOptions] for details.
 //
 // Return value:
 //
-//	An array of members from the sorted set.
-//	If the sorted set does not exist or is empty, the response will be an empty array.
+//	An object which holds the next cursor and the subset of the hash held by `key`.
+//	The cursor will return `false` from `IsFinished()` method on the last iteration of the subset.
+//	The data array in the result is always an array of the subset of the sorted set held in `key`.
+//	The array is a flattened series of `string` pairs, where the value is at even indices and the score is at odd indices.
 //
-// [valkey.io]: https://valkey.io/commands/zrandmember/
-func (client *baseClient) ZRandMemberWithCount(ctx context.Context, key string, count int64) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.ZRandMember, []string{key, utils.IntToString(count)})
+// [valkey.io]: https://valkey.io/commands/zscan/
+func (client *baseClient) ZScanWithOptions(
+	ctx context.Context,
+	key string,
+	cursor models.Cursor,
+	options options.ZScanOptions,
+) (models.ScanResult, error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return nil, err
+		return models.ScanResult{}, err
 	}
-	return handleStringArrayResponse(result)
+
+	result, err := client.executeCommand(ctx, C.ZScan, append([]string{key, cursor.String()}, optionArgs...))
+	if err != nil {
+		return models.ScanResult{}, err
+	}
+	return handleScanResponse(result)
 }
 
-// Returns random members with scores from the sorted set stored at key.
+// Returns stream message summary information for pending messages matching a stream and group.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	count - The number of field names to return.
-//	  If `count` is positive, returns unique elements. If negative, allows for duplicates.
+//	key - The key of the stream.
+//	group - The consumer group name.
 //
 // Return value:
 //
-//	An array of `models.MemberAndScore` objects, which store member names and their respective scores.
-//	If the sorted set does not exist or is empty, the response will be an empty array.
+// An models.XPendingSummary struct that includes a summary with the following fields:
 //
-// [valkey.io]: https://valkey.io/commands/zrandmember/
-func (client *baseClient) ZRandMemberWithCountWithScores(
-	ctx context.Context,
-	key string,
-	count int64,
-) ([]models.MemberAndScore, error) {
-	result, err := client.executeCommand(
-		ctx,
-		C.ZRandMember,
-		[]string{key, utils.IntToString(count), constants.WithScoresKeyword},
-	)
+//	NumOfMessages - The total number of pending messages for this consumer group.
+//	StartId - The smallest ID among the pending messages or nil if no pending messages exist.
+//	EndId - The greatest ID among the pending messages or nil if no pending messages exists.
+//	GroupConsumers - An array of ConsumerPendingMessages with the following fields:
+//	ConsumerName - The name of the consumer.
+//	MessageCount - The number of pending messages for this consumer.
+//
+// [valkey.io]: https://valkey.io/commands/xpending/
+func (client *baseClient) XPending(ctx context.Context, key string, group string) (models.XPendingSummary, error) {
+	result, err := client.executeCommand(ctx, C.XPending, []string{key, group})
 	if err != nil {
-		return nil, err
+		return models.XPendingSummary{}, err
 	}
-	return handleMemberAndScoreArrayResponse(result)
+
+	return handleXPendingSummaryResponse(result)
 }
 
-// Returns the scores associated with the specified `members` in the sorted set stored at `key`.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Returns stream message summary information for pending messages matching a given range of IDs.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx     - The context for controlling the command execution.
-//	key     - The key of the sorted set.
-//	members - A list of members in the sorted set.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	group - The consumer group name.
+//	opts - The options for the command. See [options.XPendingOptions] for details.
 //
 // Return value:
 //
-//	An array of scores corresponding to `members`.
-//	If a member does not exist in the sorted set, the corresponding value in the list will be `nil`.
+// A slice of models.XPendingDetail structs, where each detail struct includes the following fields:
 //
-// [valkey.io]: https://valkey.io/commands/zmscore/
-func (client *baseClient) ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error) {
-	response, err := client.executeCommand(ctx, C.ZMScore, append([]string{key}, members...))
+//	Id - The ID of the pending message.
+//	ConsumerName - The name of the consumer that fetched the message and has still to acknowledge it.
+//	IdleTime - The time in milliseconds since the last time the message was delivered to the consumer.
+//	DeliveryCount - The number of times this message was delivered.
+//
+// [valkey.io]: https://valkey.io/commands/xpending/
+func (client *baseClient) XPendingWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	opts options.XPendingOptions,
+) ([]models.XPendingDetail, error) {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, group}, optionArgs...)
+
+	result, err := client.executeCommand(ctx, C.XPending, args)
 	if err != nil {
 		return nil, err
 	}
-	return handleFloatOrNilArrayResponse(response)
+	return handleXPendingDetailResponse(result)
 }
 
-// Returns the logarithmic access frequency counter of a Valkey object stored at key.
+// Creates a new consumer group uniquely identified by `group` for the stream stored at `key`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the object to get the logarithmic access frequency counter of.
+//	key - The key of the stream.
+//	group - The newly created consumer group name.
+//	id - Stream entry ID that specifies the last delivered entry in the stream from the new
+//	    group’s perspective. The special ID `"$"` can be used to specify the last entry in the stream.
 //
 // Return value:
 //
-//	If key exists, returns the logarithmic access frequency counter of the
-//	object stored at key as a long. Otherwise, returns `nil`.
+//	`"OK"`.
 //
-// [valkey.io]: https://valkey.io/commands/object-freq/
-func (client *baseClient) ObjectFreq(ctx context.Context, key string) (models.Result[int64], error) {
-	result, err := client.executeCommand(ctx, C.ObjectFreq, []string{key})
-	if err != nil {
-		return models.CreateNilInt64Result(), err
-	}
-	return handleIntOrNilResponse(result)
+// [valkey.io]: https://valkey.io/commands/xgroup-create/
+func (client *baseClient) XGroupCreate(ctx context.Context, key string, group string, id string) (string, error) {
+	return client.XGroupCreateWithOptions(ctx, key, group, id, *options.NewXGroupCreateOptions())
 }
 
-// Returns the logarithmic access frequency counter of a Valkey object stored at key.
+// Creates a new consumer group uniquely identified by `group` for the stream stored at `key`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the object to get the logarithmic access frequency counter of.
+//	key - The key of the stream.
+//	group - The newly created consumer group name.
+//	id - Stream entry ID that specifies the last delivered entry in the stream from the new
+//	    group's perspective. The special ID `"$"` can be used to specify the last entry in the stream.
+//	opts - The options for the command. See [options.XGroupCreateOptions] for details.
 //
 // Return value:
 //
-//	If key exists, returns the idle time in seconds. Otherwise, returns `nil`.
+//	`"OK"`.
 //
-// [valkey.io]: https://valkey.io/commands/object-idletime/
-func (client *baseClient) ObjectIdleTime(ctx context.Context, key string) (models.Result[int64], error) {
-	result, err := client.executeCommand(ctx, C.ObjectIdleTime, []string{key})
+// [valkey.io]: https://valkey.io/commands/xgroup-create/
+func (client *baseClient) XGroupCreateWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	id string,
+	opts options.XGroupCreateOptions,
+) (string, error) {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, group, id}, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XGroupCreate, args)
 	if err != nil {
-		return models.CreateNilInt64Result(), err
+		return models.DefaultStringResponse, err
 	}
-	return handleIntOrNilResponse(result)
+	return handleOkResponse(result)
 }
 
-// Returns the reference count of the object stored at key.
-//
-// See [valkey.io] for details.
+// Creates a key associated with a value that is obtained by
+// deserializing the provided serialized value (obtained via [Client.Dump] or [ClusterClient.Dump]).
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the object to get the reference count of.
+//	key - The key to create.
+//	ttl - The expiry time. If 0, the key will persist.
+//	value - The serialized value to deserialize and assign to key.
 //
 // Return value:
 //
-//	If key exists, returns the reference count of the object stored at key.
-//	Otherwise, returns `nil`.
+//	Return OK if successfully create a key with a value.
 //
-// [valkey.io]: https://valkey.io/commands/object-refcount/
-func (client *baseClient) ObjectRefCount(ctx context.Context, key string) (models.Result[int64], error) {
-	result, err := client.executeCommand(ctx, C.ObjectRefCount, []string{key})
-	if err != nil {
-		return models.CreateNilInt64Result(), err
-	}
-	return handleIntOrNilResponse(result)
+// [valkey.io]: https://valkey.io/commands/restore/
+func (client *baseClient) Restore(ctx context.Context, key string, ttl time.Duration, value string) (string, error) {
+	return client.RestoreWithOptions(ctx, key, ttl, value, *options.NewRestoreOptions())
 }
 
-// Sorts the elements in the list, set, or sorted set at key and returns the result.
-// The sort command can be used to sort elements based on different criteria and apply
-// transformations on sorted elements.
-// To store the result into a new key, see the [Client.SortStore] or [ClusterClient.SortStore] function.
-//
-// See [valkey.io] for details.
+// Creates a key associated with a value that is obtained by
+// deserializing the provided serialized value (obtained via [Client.Dump] or [ClusterClient.Dump]).
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list, set, or sorted set to be sorted.
+//	key - The key to create.
+//	ttl - The expiry time. If 0, the key will persist.
+//	value - The serialized value to deserialize and assign to key.
+//	restoreOptions - Set restore options with replace and absolute TTL modifiers, object idletime and frequency.
 //
 // Return value:
 //
-//	An Array of sorted elements.
+//	Return OK if successfully create a key with a value.
 //
-// [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) Sort(ctx context.Context, key string) ([]models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.Sort, []string{key})
+// [valkey.io]: https://valkey.io/commands/restore/
+func (client *baseClient) RestoreWithOptions(ctx context.Context, key string, ttl time.Duration,
+	value string, options options.RestoreOptions,
+) (string, error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleStringOrNilArrayResponse(result)
+	result, err := client.executeCommand(ctx, C.Restore, append([]string{
+		key,
+		utils.IntToString(ttl.Milliseconds()), value,
+	}, optionArgs...))
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
 }
 
-// Sorts the elements in the list, set, or sorted set at key and returns the result.
-// The sort command can be used to sort elements based on different criteria and apply
-// transformations on sorted elements.
-// To store the result into a new key, see the [Client.SortStoreWithOptions] or
-// [ClusterClient.SortStoreWithOptions] function.
+// Serializes the value stored at key in a Valkey-specific format.
 //
-// Note:
+// Parameters:
 //
-//	In cluster mode, if `key` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
-//	The use of SortOptions.byPattern and SortOptions.getPatterns in cluster mode is
-//	supported since Valkey version 8.0.
+//	ctx - The context for controlling the command execution.
+//	key - The key to serialize.
 //
-// See [valkey.io] for details.
+// Return value:
+//
+//	The serialized value of the data stored at key.
+//	If key does not exist, `nil` will be returned.
+//
+// [valkey.io]: https://valkey.io/commands/dump/
+func (client *baseClient) Dump(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Dump, []string{key})
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return handleStringOrNilResponse(result)
+}
+
+// Returns the internal encoding for the Valkey object stored at key.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list, set, or sorted set to be sorted.
-//	sortOptions - The SortOptions type.
+//	key - The key of the object to get the internal encoding of.
 //
 // Return value:
 //
-//	An Array of sorted elements.
+//	If key exists, returns the internal encoding of the object stored at
+//	key as a String. Otherwise, returns `null`.
 //
-// [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) SortWithOptions(
-	ctx context.Context,
-	key string,
-	options options.SortOptions,
-) ([]models.Result[string], error) {
-	optionArgs, err := options.ToArgs()
+// [valkey.io]: https://valkey.io/commands/object-encoding/
+func (client *baseClient) ObjectEncoding(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.ObjectEncoding, []string{key})
 	if err != nil {
-		return nil, err
+		return models.CreateNilStringResult(), err
 	}
-	result, err := client.executeCommand(ctx, C.Sort, append([]string{key}, optionArgs...))
+	return handleStringOrNilResponse(result)
+}
+
+func (client *baseClient) echo(ctx context.Context, message string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Echo, []string{message})
 	if err != nil {
-		return nil, err
+		return models.CreateNilStringResult(), err
 	}
-	return handleStringOrNilArrayResponse(result)
+	return handleStringOrNilResponse(result)
 }
 
-// Sorts the elements in the list, set, or sorted set at key and returns the result.
-// The SortReadOnly command can be used to sort elements based on different criteria and apply
-// transformations on sorted elements.
-// This command is routed depending on the client's ReadFrom strategy.
+// Destroys the consumer group `group` for the stream stored at `key`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list, set, or sorted set to be sorted.
+//	key - The key of the stream.
+//	group - The consumer group name to delete.
 //
 // Return value:
 //
-//	An Array of sorted elements.
+//	`true` if the consumer group is destroyed. Otherwise, `false`.
 //
-// [valkey.io]: https://valkey.io/commands/sort_ro/
-func (client *baseClient) SortReadOnly(ctx context.Context, key string) ([]models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.SortReadOnly, []string{key})
+// [valkey.io]: https://valkey.io/commands/xgroup-destroy/
+func (client *baseClient) XGroupDestroy(ctx context.Context, key string, group string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.XGroupDestroy, []string{key, group})
 	if err != nil {
-		return nil, err
+		return models.DefaultBoolResponse, err
 	}
-	return handleStringOrNilArrayResponse(result)
+	return handleBoolResponse(result)
 }
 
-// Sorts the elements in the list, set, or sorted set at key and returns the result.
-// The SortReadOnly command can be used to sort elements based on different criteria and apply
-// transformations on sorted elements.
-// This command is routed depending on the client's ReadFrom strategy.
+// Sets the last delivered ID for a consumer group.
 //
-// Note:
+// See [valkey.io] for details.
 //
-//	In cluster mode, if `key` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
-//	The use of SortOptions.byPattern and SortOptions.getPatterns in cluster mode is
-//	supported since Valkey version 8.0.
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	group - The consumer group name.
+//	id - The stream entry ID that should be set as the last delivered ID for the consumer group.
+//
+// Return value:
+//
+//	`"OK"`.
+//
+// [valkey.io]: https://valkey.io/commands/xgroup-setid/
+func (client *baseClient) XGroupSetId(ctx context.Context, key string, group string, id string) (string, error) {
+	return client.XGroupSetIdWithOptions(ctx, key, group, id, *options.NewXGroupSetIdOptionsOptions())
+}
+
+// Sets the last delivered ID for a consumer group.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list, set, or sorted set to be sorted.
-//	sortOptions - The SortOptions type.
+//	key - The key of the stream.
+//	group - The consumer group name.
+//	id - The stream entry ID that should be set as the last delivered ID for the consumer group.
+//	opts - The options for the command. See [options.XGroupSetIdOptions] for details.
 //
 // Return value:
 //
-//	An Array of sorted elements.
+//	`"OK"`.
 //
-// [valkey.io]: https://valkey.io/commands/sort_ro/
-func (client *baseClient) SortReadOnlyWithOptions(
+// [valkey.io]: https://valkey.io/commands/xgroup-setid/
+func (client *baseClient) XGroupSetIdWithOptions(
 	ctx context.Context,
 	key string,
-	options options.SortOptions,
-) ([]models.Result[string], error) {
-	optionArgs, err := options.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	result, err := client.executeCommand(ctx, C.SortReadOnly, append([]string{key}, optionArgs...))
+	group string,
+	id string,
+	opts options.XGroupSetIdOptions,
+) (string, error) {
+	optionArgs, _ := opts.ToArgs()
+	args := append([]string{key, group, id}, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XGroupSetId, args)
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleStringOrNilArrayResponse(result)
+	return handleOkResponse(result)
 }
 
-// Sorts the elements in the list, set, or sorted set at key and stores the result in
-// destination. The sort command can be used to sort elements based on
-// different criteria, apply transformations on sorted elements, and store the result in a new key.
-// The SortStore command can be used to sort elements based on different criteria and apply
-// transformations on sorted elements.
-// To get the sort result without storing it into a key, see the [Client.Sort] and [ClusterClient.Sort]
-// or [Client.SortReadOnly] and [ClusterClient.SortReadOnly] function.
-//
-// Note:
-//
-//	In cluster mode, if `key` and `destination` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+// Removes all elements in the sorted set stored at `key` with a lexicographical order
+// between `rangeQuery.Start` and `rangeQuery.End`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list, set, or sorted set to be sorted.
-//	destination - The key where the sorted result will be stored.
+//	key - The key of the sorted set.
+//	rangeQuery - The range query object representing the minimum and maximum bound of the lexicographical range.
 //
 // Return value:
 //
-//	The number of elements in the sorted key stored at destination.
+//	The number of members removed from the sorted set.
+//	If `key` does not exist, it is treated as an empty sorted set, and the command returns `0`.
+//	If `rangeQuery.Start` is greater than `rangeQuery.End`, `0` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) SortStore(ctx context.Context, key string, destination string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.Sort, []string{key, constants.StoreKeyword, destination})
+// [valkey.io]: https://valkey.io/commands/zremrangebylex/
+func (client *baseClient) ZRemRangeByLex(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
+	queryArgs, err := rangeQuery.ToArgsRemRange()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	result, err := client.executeCommand(ctx,
+		C.ZRemRangeByLex, append([]string{key}, queryArgs...))
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
 	return handleIntResponse(result)
 }
 
-// Sorts the elements in the list, set, or sorted set at key and stores the result in
-// destination. The sort command can be used to sort elements based on
-// different criteria, apply transformations on sorted elements, and store the result in a new key.
-// The SortStore command can be used to sort elements based on different criteria and apply
-// transformations on sorted elements.
-// To get the sort result without storing it into a key, see the [Client.SortWithOptions] and [ClusterClient.SortWithOptions]
-// or [Client.SortReadOnlyWithOptions] and [ClusterClient.SortReadOnlyWithOptions] function.
-//
-// Note:
-//
-//	In cluster mode, if `key` and `destination` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
-//	The use of SortOptions.byPattern and SortOptions.getPatterns
-//	in cluster mode is supported since Valkey version 8.0.
+// Removes all elements in the sorted set stored at `key` with a rank between `start` and `stop`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the list, set, or sorted set to be sorted.
-//	destination - The key where the sorted result will be stored.
-//
-// opts - The [options.SortOptions] type.
+//	key - The key of the sorted set.
+//	start - The start rank.
+//	stop - The stop rank.
 //
 // Return value:
 //
-//	The number of elements in the sorted key stored at destination.
+//	The number of members removed from the sorted set.
+//	If `key` does not exist, it is treated as an empty sorted set, and the command returns `0`.
+//	If `start` is greater than `stop`, `0` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/sort/
-func (client *baseClient) SortStoreWithOptions(
-	ctx context.Context,
-	key string,
-	destination string,
-	opts options.SortOptions,
-) (int64, error) {
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
+// [valkey.io]: https://valkey.io/commands/zremrangebyrank/
+func (client *baseClient) ZRemRangeByRank(ctx context.Context, key string, start int64, stop int64) (int64, error) {
 	result, err := client.executeCommand(
 		ctx,
-		C.Sort,
-		append([]string{key, constants.StoreKeyword, destination}, optionArgs...),
+		C.ZRemRangeByRank,
+		[]string{key, utils.IntToString(start), utils.IntToString(stop)},
 	)
 	if err != nil {
 		return models.DefaultIntResponse, err
@@ -6175,1367 +6588,3559 @@ func (client *baseClient) SortStoreWithOptions(
 	return handleIntResponse(result)
 }
 
-// XGroupCreateConsumer creates a consumer named `consumer` in the consumer group `group` for the
-// stream stored at `key`.
+// Removes all elements in the sorted set stored at `key` with a score between `rangeQuery.Start` and `rangeQuery.End`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	consumer - The newly created consumer.
+//	key - The key of the sorted set.
+//	rangeQuery - The range query object representing the minimum and maximum bound of the score range.
+//	  can be an implementation of [options.RangeByScore].
 //
 // Return value:
 //
-//	Returns `true` if the consumer is created. Otherwise, returns `false`.
+//	The number of members removed from the sorted set.
+//	If `key` does not exist, it is treated as an empty sorted set, and the command returns `0`.
+//	If `rangeQuery.Start` is greater than `rangeQuery.End`, `0` is returned.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-createconsumer/
-func (client *baseClient) XGroupCreateConsumer(
-	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-) (bool, error) {
-	result, err := client.executeCommand(ctx, C.XGroupCreateConsumer, []string{key, group, consumer})
+// [valkey.io]: https://valkey.io/commands/zremrangebyscore/
+func (client *baseClient) ZRemRangeByScore(ctx context.Context, key string, rangeQuery options.RangeByScore) (int64, error) {
+	queryArgs, err := rangeQuery.ToArgsRemRange()
 	if err != nil {
-		return false, err
+		return models.DefaultIntResponse, err
 	}
-	return handleBoolResponse(result)
+	result, err := client.executeCommand(ctx, C.ZRemRangeByScore, append([]string{key}, queryArgs...))
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
 }
 
-// XGroupDelConsumer deletes a consumer named `consumer` in the consumer group `group`.
+// Returns a random member from the sorted set stored at `key`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
-//	group - The consumer group name.
-//	consumer - The consumer to delete.
+//	key - The key of the sorted set.
 //
 // Return value:
 //
-//	The number of pending messages the `consumer` had before it was deleted.
+//	A string representing a random member from the sorted set.
+//	If the sorted set does not exist or is empty, the response will be `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/xgroup-delconsumer/
-func (client *baseClient) XGroupDelConsumer(
-	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-) (int64, error) {
-	result, err := client.executeCommand(ctx, C.XGroupDelConsumer, []string{key, group, consumer})
+// [valkey.io]: https://valkey.io/commands/zrandmember/
+func (client *baseClient) ZRandMember(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.ZRandMember, []string{key})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilStringResult(), err
 	}
-	return handleIntResponse(result)
+	return handleStringOrNilResponse(result)
 }
 
-// Returns the number of messages that were successfully acknowledged by the consumer group member
-// of a stream. This command should be called on a pending message so that such message does not
-// get processed again.
+// Returns multiple random members from the sorted set stored at `key`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the stream.
-//	group - he consumer group name.
-//	ids   - Stream entry IDs to acknowledge and purge messages.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	count - The number of field names to return.
+//	  If `count` is positive, returns unique elements. If negative, allows for duplicates.
 //
 // Return value:
 //
-//	The number of messages that were successfully acknowledged.
+//	An array of members from the sorted set.
+//	If the sorted set does not exist or is empty, the response will be an empty array.
 //
-// [valkey.io]: https://valkey.io/commands/xack/
-func (client *baseClient) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.XAck, append([]string{key, group}, ids...))
+// [valkey.io]: https://valkey.io/commands/zrandmember/
+func (client *baseClient) ZRandMemberWithCount(ctx context.Context, key string, count int64) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.ZRandMember, []string{key, utils.IntToString(count)})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-	return handleIntResponse(result)
+	return handleStringArrayResponse(result)
 }
 
-// Sets or clears the bit at offset in the string value stored at key.
-// The offset is a zero-based index, with `0` being the first element of
-// the list, `1` being the next element, and so on. The offset must be
-// less than `2^32` and greater than or equal to `0` If a key is
-// non-existent then the bit at offset is set to value and the preceding
-// bits are set to `0`.
+// Returns random members with scores from the sorted set stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the string.
-//	offset - The index of the bit to be set.
-//	value - The bit value to set at offset The value must be `0` or `1`.
+//	key - The key of the sorted set.
+//	count - The number of field names to return.
+//	  If `count` is positive, returns unique elements. If negative, allows for duplicates.
 //
 // Return value:
 //
-//	The bit value that was previously stored at offset.
+//	An array of `models.MemberAndScore` objects, which store member names and their respective scores.
+//	If the sorted set does not exist or is empty, the response will be an empty array.
 //
-// [valkey.io]: https://valkey.io/commands/setbit/
-func (client *baseClient) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
-	result, err := client.executeCommand(ctx, C.SetBit, []string{key, utils.IntToString(offset), utils.IntToString(value)})
+// [valkey.io]: https://valkey.io/commands/zrandmember/
+func (client *baseClient) ZRandMemberWithCountWithScores(
+	ctx context.Context,
+	key string,
+	count int64,
+) ([]models.MemberAndScore, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.ZRandMember,
+		[]string{key, utils.IntToString(count), constants.WithScoresKeyword},
+	)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-	return handleIntResponse(result)
+	return handleMemberAndScoreArrayResponse(result)
 }
 
-// Returns the bit value at offset in the string value stored at key.
+// Returns the scores associated with the specified `members` in the sorted set stored at `key`.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx - The context for controlling the command execution.
-//	key - The key of the string.
-//	offset - The index of the bit to return. Should be greater than or equal to zero.
+//	ctx     - The context for controlling the command execution.
+//	key     - The key of the sorted set.
+//	members - A list of members in the sorted set.
 //
 // Return value:
 //
-//	The bit at offset of the string. Returns zero if the key is empty or if the positive
-//	offset exceeds the length of the string.
+//	An array of scores corresponding to `members`.
+//	If a member does not exist in the sorted set, the corresponding value in the list will be `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/getbit/
-func (client *baseClient) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
-	result, err := client.executeCommand(ctx, C.GetBit, []string{key, utils.IntToString(offset)})
+// [valkey.io]: https://valkey.io/commands/zmscore/
+func (client *baseClient) ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error) {
+	response, err := client.executeCommand(ctx, C.ZMScore, append([]string{key}, members...))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-	return handleIntResponse(result)
+	return handleFloatOrNilArrayResponse(response)
 }
 
-// Wait blocks the current client until all the previous write commands are successfully
-// transferred and acknowledged by at least the specified number of replicas or if the timeout is reached,
-// whichever is earlier.
+// Returns the logarithmic access frequency counter of a Valkey object stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	numberOfReplicas - The number of replicas to reach.
-//	timeout - The timeout value. A value of `0` will block indefinitely.
+//	key - The key of the object to get the logarithmic access frequency counter of.
 //
 // Return value:
 //
-//	The number of replicas reached by all the writes performed in the context of the current connection.
+//	If key exists, returns the logarithmic access frequency counter of the
+//	object stored at key as a long. Otherwise, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/wait/
-func (client *baseClient) Wait(ctx context.Context, numberOfReplicas int64, timeout time.Duration) (int64, error) {
-	result, err := client.executeCommand(
-		ctx,
-		C.Wait,
-		[]string{utils.IntToString(numberOfReplicas), utils.IntToString(timeout.Milliseconds())},
-	)
+// [valkey.io]: https://valkey.io/commands/object-freq/
+func (client *baseClient) ObjectFreq(ctx context.Context, key string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ObjectFreq, []string{key})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilInt64Result(), err
 	}
-	return handleIntResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// Counts the number of set bits (population counting) in a string stored at key.
+// Returns the logarithmic access frequency counter of a Valkey object stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key for the string to count the set bits of.
+//	key - The key of the object to get the logarithmic access frequency counter of.
 //
 // Return value:
 //
-//	The number of set bits in the string. Returns `0` if the key is missing as it is
-//	treated as an empty string.
+//	If key exists, returns the idle time in seconds. Otherwise, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/bitcount/
-func (client *baseClient) BitCount(ctx context.Context, key string) (int64, error) {
-	result, err := client.executeCommand(ctx, C.BitCount, []string{key})
+// [valkey.io]: https://valkey.io/commands/object-idletime/
+func (client *baseClient) ObjectIdleTime(ctx context.Context, key string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ObjectIdleTime, []string{key})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilInt64Result(), err
 	}
-	return handleIntResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// Perform a bitwise operation between multiple keys (containing string values) and store the result in the destination.
-//
-// Note:
-//
-// When in cluster mode, `destination` and all `keys` must map to the same hash slot.
+// Returns the reference count of the object stored at key.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx              - The context for controlling the command execution.
-//	bitwiseOperation - The bitwise operation to perform.
-//	destination      - The key that will store the resulting string.
-//	keys             - The list of keys to perform the bitwise operation on.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the object to get the reference count of.
 //
 // Return value:
 //
-//	The size of the string stored in destination.
+//	If key exists, returns the reference count of the object stored at key.
+//	Otherwise, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/bitop/
-func (client *baseClient) BitOp(
-	ctx context.Context,
-	bitwiseOperation options.BitOpType,
-	destination string,
-	keys []string,
-) (int64, error) {
-	bitOp, err := options.NewBitOp(bitwiseOperation, destination, keys)
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args, err := bitOp.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	result, err := client.executeCommand(ctx, C.BitOp, args)
+// [valkey.io]: https://valkey.io/commands/object-refcount/
+func (client *baseClient) ObjectRefCount(ctx context.Context, key string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.ObjectRefCount, []string{key})
 	if err != nil {
-		return models.DefaultIntResponse, errors.New("bitop command execution failed")
+		return models.CreateNilInt64Result(), err
 	}
-	return handleIntResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// Counts the number of set bits (population counting) in a string stored at key. The
-// offsets start and end are zero-based indexes, with `0` being the first element of the
-// list, `1` being the next element and so on. These offsets can also be negative numbers
-// indicating offsets starting at the end of the list, with `-1` being the last element
-// of the list, `-2` being the penultimate, and so on.
+// Returns the number of bytes used by the key and its value in memory, including the administrative overheads. The
+// memory usage of the aggregate values is estimated by sampling 5 of their nested values.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key for the string to count the set bits of.
-//	options - The offset options - see [options.BitCountOptions].
+//	key - The key to get the memory usage of.
 //
 // Return value:
 //
-//	The number of set bits in the string interval specified by start, end, and options.
-//	Returns zero if the key is missing as it is treated as an empty string.
+//	If key exists, returns the memory usage of the key in bytes.
+//	Otherwise, returns `nil`.
 //
-// [valkey.io]: https://valkey.io/commands/bitcount/
-func (client *baseClient) BitCountWithOptions(ctx context.Context, key string, opts options.BitCountOptions) (int64, error) {
+// [valkey.io]: https://valkey.io/commands/memory-usage/
+func (client *baseClient) MemoryUsage(ctx context.Context, key string) (models.Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.MemoryUsage, []string{key})
+	if err != nil {
+		return models.CreateNilInt64Result(), err
+	}
+	return handleIntOrNilResponse(result)
+}
+
+// Returns the number of bytes used by the key and its value in memory, including the administrative overheads.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to get the memory usage of.
+//	opts - The options of the command, see [options.MemoryUsageOptions].
+//
+// Return value:
+//
+//	If key exists, returns the memory usage of the key in bytes.
+//	Otherwise, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/memory-usage/
+func (client *baseClient) MemoryUsageWithOptions(
+	ctx context.Context,
+	key string,
+	opts options.MemoryUsageOptions,
+) (models.Result[int64], error) {
 	optionArgs, err := opts.ToArgs()
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilInt64Result(), err
 	}
-	commandArgs := append([]string{key}, optionArgs...)
-	result, err := client.executeCommand(ctx, C.BitCount, commandArgs)
+	result, err := client.executeCommand(ctx, C.MemoryUsage, append([]string{key}, optionArgs...))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.CreateNilInt64Result(), err
 	}
-	return handleIntResponse(result)
+	return handleIntOrNilResponse(result)
 }
 
-// Changes the ownership of a pending message.
+// Sorts the elements in the list, set, or sorted set at key and returns the result.
+// The sort command can be used to sort elements based on different criteria and apply
+// transformations on sorted elements.
+// To store the result into a new key, see the [Client.SortStore] or [ClusterClient.SortStore] function.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	key         - The key of the stream.
-//	group       - The name of the consumer group.
-//	consumer    - The name of the consumer.
-//	minIdleTime - The minimum idle time.
-//	ids         - The ids of the entries to claim.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list, set, or sorted set to be sorted.
 //
 // Return value:
 //
-//	A map[string]models.XClaimResponse where:
-//	- Each key is a message/entry ID
-//	- Each value is an XClaimResponse containing:
-//	  - Fields: []FieldValue array of field-value pairs for the claimed entry
+//	An Array of sorted elements.
 //
-// [valkey.io]: https://valkey.io/commands/xclaim/
-func (client *baseClient) XClaim(
-	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	ids []string,
-) (map[string]models.XClaimResponse, error) {
-	return client.XClaimWithOptions(ctx, key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+// [valkey.io]: https://valkey.io/commands/sort/
+func (client *baseClient) Sort(ctx context.Context, key string) ([]models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.Sort, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringOrNilArrayResponse(result)
 }
 
-// Changes the ownership of a pending message.
+// Sorts the elements in the list, set, or sorted set at key and returns the result.
+// The sort command can be used to sort elements based on different criteria and apply
+// transformations on sorted elements.
+// To store the result into a new key, see the [Client.SortStoreWithOptions] or
+// [ClusterClient.SortStoreWithOptions] function.
+//
+// Note:
+//
+//	In cluster mode, if `key` map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
+//	The use of SortOptions.byPattern and SortOptions.getPatterns in cluster mode is
+//	supported since Valkey version 8.0.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	key         - The key of the stream.
-//	group       - The name of the consumer group.
-//	consumer    - The name of the consumer.
-//	minIdleTime - The minimum idle time.
-//	ids         - The ids of the entries to claim.
-//	options     - Stream claim options.
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list, set, or sorted set to be sorted.
+//	sortOptions - The SortOptions type.
 //
 // Return value:
 //
-//	A map[string]models.XClaimResponse where:
-//	- Each key is a message/entry ID
-//	- Each value is an XClaimResponse containing:
-//	  - Fields: []FieldValue array of field-value pairs for the claimed entry
+//	An Array of sorted elements.
 //
-// [valkey.io]: https://valkey.io/commands/xclaim/
-func (client *baseClient) XClaimWithOptions(
+// [valkey.io]: https://valkey.io/commands/sort/
+func (client *baseClient) SortWithOptions(
 	ctx context.Context,
 	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	ids []string,
-	opts options.XClaimOptions,
-) (map[string]models.XClaimResponse, error) {
-	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds())}, ids...)
-	optionArgs, err := opts.ToArgs()
+	options options.SortOptions,
+) ([]models.Result[string], error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	args = append(args, optionArgs...)
-	result, err := client.executeCommand(ctx, C.XClaim, args)
+	result, err := client.executeCommand(ctx, C.Sort, append([]string{key}, optionArgs...))
 	if err != nil {
 		return nil, err
 	}
-	return handleXClaimResponse(result)
+	return handleStringOrNilArrayResponse(result)
 }
 
-// Changes the ownership of a pending message. This function returns an `array` with
-// only the message/entry IDs, and is equivalent to using `JUSTID` in the Valkey API.
+// Sorts the elements in the list, set, or sorted set at key and returns the result.
+// The SortReadOnly command can be used to sort elements based on different criteria and apply
+// transformations on sorted elements.
+// This command is routed depending on the client's ReadFrom strategy.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key         - The key of the stream.
-//	group       - The name of the consumer group.
-//	consumer    - The name of the consumer.
-//	minIdleTime - The minimum idle time.
-//	ids         - The ids of the entries to claim.
-//	options     - Stream claim options.
+//	key - The key of the list, set, or sorted set to be sorted.
 //
 // Return value:
 //
-//	An array of the ids of the entries that were claimed by the consumer.
+//	An Array of sorted elements.
 //
-// [valkey.io]: https://valkey.io/commands/xclaim/
-func (client *baseClient) XClaimJustId(
-	ctx context.Context,
-	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	ids []string,
-) ([]string, error) {
-	return client.XClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+// [valkey.io]: https://valkey.io/commands/sort_ro/
+func (client *baseClient) SortReadOnly(ctx context.Context, key string) ([]models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.SortReadOnly, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringOrNilArrayResponse(result)
 }
 
-// Changes the ownership of a pending message. This function returns an `array` with
-// only the message/entry IDs, and is equivalent to using `JUSTID` in the Valkey API.
+// Sorts the elements in the list, set, or sorted set at key and returns the result.
+// The SortReadOnly command can be used to sort elements based on different criteria and apply
+// transformations on sorted elements.
+// This command is routed depending on the client's ReadFrom strategy.
+//
+// Note:
+//
+//	In cluster mode, if `key` map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
+//	The use of SortOptions.byPattern and SortOptions.getPatterns in cluster mode is
+//	supported since Valkey version 8.0.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key         - The key of the stream.
-//	group       - The name of the consumer group.
-//	consumer    - The name of the consumer.
-//	minIdleTime - The minimum idle time.
-//	ids         - The ids of the entries to claim.
-//	options     - Stream claim options.
+//	key - The key of the list, set, or sorted set to be sorted.
+//	sortOptions - The SortOptions type.
 //
 // Return value:
 //
-//	An array of the ids of the entries that were claimed by the consumer.
+//	An Array of sorted elements.
 //
-// [valkey.io]: https://valkey.io/commands/xclaim/
-func (client *baseClient) XClaimJustIdWithOptions(
+// [valkey.io]: https://valkey.io/commands/sort_ro/
+func (client *baseClient) SortReadOnlyWithOptions(
 	ctx context.Context,
 	key string,
-	group string,
-	consumer string,
-	minIdleTime time.Duration,
-	ids []string,
-	opts options.XClaimOptions,
-) ([]string, error) {
-	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds())}, ids...)
-	optionArgs, err := opts.ToArgs()
+	options options.SortOptions,
+) ([]models.Result[string], error) {
+	optionArgs, err := options.ToArgs()
 	if err != nil {
 		return nil, err
 	}
-	args = append(args, optionArgs...)
-	args = append(args, constants.JustIdKeyword)
-	result, err := client.executeCommand(ctx, C.XClaim, args)
+	result, err := client.executeCommand(ctx, C.SortReadOnly, append([]string{key}, optionArgs...))
 	if err != nil {
 		return nil, err
 	}
-	return handleStringArrayResponse(result)
+	return handleStringOrNilArrayResponse(result)
+}
+
+// Sorts the elements in the list, set, or sorted set at key and stores the result in
+// destination. The sort command can be used to sort elements based on
+// different criteria, apply transformations on sorted elements, and store the result in a new key.
+// The SortStore command can be used to sort elements based on different criteria and apply
+// transformations on sorted elements.
+// To get the sort result without storing it into a key, see the [Client.Sort] and [ClusterClient.Sort]
+// or [Client.SortReadOnly] and [ClusterClient.SortReadOnly] function.
+//
+// Note:
+//
+//	In cluster mode, if `key` and `destination` map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list, set, or sorted set to be sorted.
+//	destination - The key where the sorted result will be stored.
+//
+// Return value:
+//
+//	The number of elements in the sorted key stored at destination.
+//
+// [valkey.io]: https://valkey.io/commands/sort/
+func (client *baseClient) SortStore(ctx context.Context, key string, destination string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.Sort, []string{key, constants.StoreKeyword, destination})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Sorts the elements in the list, set, or sorted set at key and stores the result in
+// destination. The sort command can be used to sort elements based on
+// different criteria, apply transformations on sorted elements, and store the result in a new key.
+// The SortStore command can be used to sort elements based on different criteria and apply
+// transformations on sorted elements.
+// To get the sort result without storing it into a key, see the [Client.SortWithOptions] and [ClusterClient.SortWithOptions]
+// or [Client.SortReadOnlyWithOptions] and [ClusterClient.SortReadOnlyWithOptions] function.
+//
+// Note:
+//
+//	In cluster mode, if `key` and `destination` map to different hash slots, the command
+//	will be split across these slots and executed separately for each. This means the command
+//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
+//	call will return the first encountered error, even though some requests may have succeeded
+//	while others did not. If this behavior impacts your application logic, consider splitting
+//	the request into sub-requests per slot to ensure atomicity.
+//	The use of SortOptions.byPattern and SortOptions.getPatterns
+//	in cluster mode is supported since Valkey version 8.0.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list, set, or sorted set to be sorted.
+//	destination - The key where the sorted result will be stored.
+//
+// opts - The [options.SortOptions] type.
+//
+// Return value:
+//
+//	The number of elements in the sorted key stored at destination.
+//
+// [valkey.io]: https://valkey.io/commands/sort/
+func (client *baseClient) SortStoreWithOptions(
+	ctx context.Context,
+	key string,
+	destination string,
+	opts options.SortOptions,
+) (int64, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	result, err := client.executeCommand(
+		ctx,
+		C.Sort,
+		append([]string{key, constants.StoreKeyword, destination}, optionArgs...),
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// XGroupCreateConsumer creates a consumer named `consumer` in the consumer group `group` for the
+// stream stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	group - The consumer group name.
+//	consumer - The newly created consumer.
+//
+// Return value:
+//
+//	Returns `true` if the consumer is created. Otherwise, returns `false`.
+//
+// [valkey.io]: https://valkey.io/commands/xgroup-createconsumer/
+func (client *baseClient) XGroupCreateConsumer(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+) (bool, error) {
+	result, err := client.executeCommand(ctx, C.XGroupCreateConsumer, []string{key, group, consumer})
+	if err != nil {
+		return false, err
+	}
+	return handleBoolResponse(result)
+}
+
+// XGroupDelConsumer deletes a consumer named `consumer` in the consumer group `group`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//	group - The consumer group name.
+//	consumer - The consumer to delete.
+//
+// Return value:
+//
+//	The number of pending messages the `consumer` had before it was deleted.
+//
+// [valkey.io]: https://valkey.io/commands/xgroup-delconsumer/
+func (client *baseClient) XGroupDelConsumer(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XGroupDelConsumer, []string{key, group, consumer})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the number of messages that were successfully acknowledged by the consumer group member
+// of a stream. This command should be called on a pending message so that such message does not
+// get processed again.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	group - he consumer group name.
+//	ids   - Stream entry IDs to acknowledge and purge messages.
+//
+// Return value:
+//
+//	The number of messages that were successfully acknowledged.
+//
+// [valkey.io]: https://valkey.io/commands/xack/
+func (client *baseClient) XAck(ctx context.Context, key string, group string, ids []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.XAck, append([]string{key, group}, ids...))
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Sets or clears the bit at offset in the string value stored at key.
+// The offset is a zero-based index, with `0` being the first element of
+// the list, `1` being the next element, and so on. The offset must be
+// less than `2^32` and greater than or equal to `0` If a key is
+// non-existent then the bit at offset is set to value and the preceding
+// bits are set to `0`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the string.
+//	offset - The index of the bit to be set.
+//	value - The bit value to set at offset The value must be `0` or `1`.
+//
+// Return value:
+//
+//	The bit value that was previously stored at offset.
+//
+// [valkey.io]: https://valkey.io/commands/setbit/
+func (client *baseClient) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SetBit, []string{key, utils.IntToString(offset), utils.IntToString(value)})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the bit value at offset in the string value stored at key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the string.
+//	offset - The index of the bit to return. Should be greater than or equal to zero.
+//
+// Return value:
+//
+//	The bit at offset of the string. Returns zero if the key is empty or if the positive
+//	offset exceeds the length of the string.
+//
+// [valkey.io]: https://valkey.io/commands/getbit/
+func (client *baseClient) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.GetBit, []string{key, utils.IntToString(offset)})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Wait blocks the current client until all the previous write commands are successfully
+// transferred and acknowledged by at least the specified number of replicas or if the timeout is reached,
+// whichever is earlier.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	numberOfReplicas - The number of replicas to reach.
+//	timeout - The timeout value. A value of `0` will block indefinitely.
+//
+// Return value:
+//
+//	The number of replicas reached by all the writes performed in the context of the current connection.
+//
+// [valkey.io]: https://valkey.io/commands/wait/
+func (client *baseClient) Wait(ctx context.Context, numberOfReplicas int64, timeout time.Duration) (int64, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.Wait,
+		[]string{utils.IntToString(numberOfReplicas), utils.IntToString(timeout.Milliseconds())},
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Counts the number of set bits (population counting) in a string stored at key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key for the string to count the set bits of.
+//
+// Return value:
+//
+//	The number of set bits in the string. Returns `0` if the key is missing as it is
+//	treated as an empty string.
+//
+// [valkey.io]: https://valkey.io/commands/bitcount/
+func (client *baseClient) BitCount(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.BitCount, []string{key})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Perform a bitwise operation between multiple keys (containing string values) and store the result in the destination.
+//
+// Note:
+//
+// When in cluster mode, `destination` and all `keys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx              - The context for controlling the command execution.
+//	bitwiseOperation - The bitwise operation to perform.
+//	destination      - The key that will store the resulting string.
+//	keys             - The list of keys to perform the bitwise operation on.
+//
+// Return value:
+//
+//	The size of the string stored in destination.
+//
+// [valkey.io]: https://valkey.io/commands/bitop/
+func (client *baseClient) BitOp(
+	ctx context.Context,
+	bitwiseOperation options.BitOpType,
+	destination string,
+	keys []string,
+) (int64, error) {
+	bitOp, err := options.NewBitOp(bitwiseOperation, destination, keys)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args, err := bitOp.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.BitOp, args)
+	if err != nil {
+		return models.DefaultIntResponse, errors.New("bitop command execution failed")
+	}
+	return handleIntResponse(result)
+}
+
+// Counts the number of set bits (population counting) in a string stored at key. The
+// offsets start and end are zero-based indexes, with `0` being the first element of the
+// list, `1` being the next element and so on. These offsets can also be negative numbers
+// indicating offsets starting at the end of the list, with `-1` being the last element
+// of the list, `-2` being the penultimate, and so on.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key for the string to count the set bits of.
+//	options - The offset options - see [options.BitCountOptions].
+//
+// Return value:
+//
+//	The number of set bits in the string interval specified by start, end, and options.
+//	Returns zero if the key is missing as it is treated as an empty string.
+//
+// [valkey.io]: https://valkey.io/commands/bitcount/
+func (client *baseClient) BitCountWithOptions(ctx context.Context, key string, opts options.BitCountOptions) (int64, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	commandArgs := append([]string{key}, optionArgs...)
+	result, err := client.executeCommand(ctx, C.BitCount, commandArgs)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Changes the ownership of a pending message.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx         - The context for controlling the command execution.
+//	key         - The key of the stream.
+//	group       - The name of the consumer group.
+//	consumer    - The name of the consumer.
+//	minIdleTime - The minimum idle time.
+//	ids         - The ids of the entries to claim.
+//
+// Return value:
+//
+//	A map[string]models.XClaimResponse where:
+//	- Each key is a message/entry ID
+//	- Each value is an XClaimResponse containing:
+//	  - Fields: []FieldValue array of field-value pairs for the claimed entry
+//
+// [valkey.io]: https://valkey.io/commands/xclaim/
+func (client *baseClient) XClaim(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	ids []string,
+) (map[string]models.XClaimResponse, error) {
+	return client.XClaimWithOptions(ctx, key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+}
+
+// Changes the ownership of a pending message.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx         - The context for controlling the command execution.
+//	key         - The key of the stream.
+//	group       - The name of the consumer group.
+//	consumer    - The name of the consumer.
+//	minIdleTime - The minimum idle time.
+//	ids         - The ids of the entries to claim.
+//	options     - Stream claim options.
+//
+// Return value:
+//
+//	A map[string]models.XClaimResponse where:
+//	- Each key is a message/entry ID
+//	- Each value is an XClaimResponse containing:
+//	  - Fields: []FieldValue array of field-value pairs for the claimed entry
+//
+// [valkey.io]: https://valkey.io/commands/xclaim/
+func (client *baseClient) XClaimWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	ids []string,
+	opts options.XClaimOptions,
+) (map[string]models.XClaimResponse, error) {
+	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds())}, ids...)
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XClaim, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleXClaimResponse(result)
+}
+
+// Changes the ownership of a pending message. This function returns an `array` with
+// only the message/entry IDs, and is equivalent to using `JUSTID` in the Valkey API.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key         - The key of the stream.
+//	group       - The name of the consumer group.
+//	consumer    - The name of the consumer.
+//	minIdleTime - The minimum idle time.
+//	ids         - The ids of the entries to claim.
+//	options     - Stream claim options.
+//
+// Return value:
+//
+//	An array of the ids of the entries that were claimed by the consumer.
+//
+// [valkey.io]: https://valkey.io/commands/xclaim/
+func (client *baseClient) XClaimJustId(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	ids []string,
+) ([]string, error) {
+	return client.XClaimJustIdWithOptions(ctx, key, group, consumer, minIdleTime, ids, *options.NewXClaimOptions())
+}
+
+// Changes the ownership of a pending message. This function returns an `array` with
+// only the message/entry IDs, and is equivalent to using `JUSTID` in the Valkey API.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key         - The key of the stream.
+//	group       - The name of the consumer group.
+//	consumer    - The name of the consumer.
+//	minIdleTime - The minimum idle time.
+//	ids         - The ids of the entries to claim.
+//	options     - Stream claim options.
+//
+// Return value:
+//
+//	An array of the ids of the entries that were claimed by the consumer.
+//
+// [valkey.io]: https://valkey.io/commands/xclaim/
+func (client *baseClient) XClaimJustIdWithOptions(
+	ctx context.Context,
+	key string,
+	group string,
+	consumer string,
+	minIdleTime time.Duration,
+	ids []string,
+	opts options.XClaimOptions,
+) ([]string, error) {
+	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime.Milliseconds())}, ids...)
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionArgs...)
+	args = append(args, constants.JustIdKeyword)
+	result, err := client.executeCommand(ctx, C.XClaim, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the position of the first bit matching the given bit value.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the string.
+//	bit - The bit value to match. The value must be 0 or 1.
+//
+// Return value:
+//
+//	The position of the first occurrence matching bit in the binary value of
+//	the string held at key. If bit is not found, a -1 is returned.
+//
+// [valkey.io]: https://valkey.io/commands/bitpos/
+func (client *baseClient) BitPos(ctx context.Context, key string, bit int64) (int64, error) {
+	result, err := client.executeCommand(ctx, C.BitPos, []string{key, utils.IntToString(bit)})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the position of the first bit matching the given bit value.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the string.
+//	bit - The bit value to match. The value must be 0 or 1.
+//	bitposOptions - The [BitPosOptions] type.
+//
+// Return value:
+//
+//	The position of the first occurrence matching bit in the binary value of
+//	the string held at key. If bit is not found, a -1 is returned.
+//
+// [valkey.io]: https://valkey.io/commands/bitpos/
+func (client *baseClient) BitPosWithOptions(
+	ctx context.Context,
+	key string,
+	bit int64,
+	bitposOptions options.BitPosOptions,
+) (int64, error) {
+	optionArgs, err := bitposOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	commandArgs := append([]string{key, utils.IntToString(bit)}, optionArgs...)
+	result, err := client.executeCommand(ctx, C.BitPos, commandArgs)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Copies the value stored at the source to the destination key if the
+// destination key does not yet exist.
+//
+// Note:
+//
+//	When in cluster mode, both `source` and `destination` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	source - The key to the source value.
+//	destination - The key where the value should be copied to.
+//
+// Return value:
+//
+//	`true` if source was copied, `false` if source was not copied.
+//
+// [valkey.io]: https://valkey.io/commands/copy/
+func (client *baseClient) Copy(ctx context.Context, source string, destination string) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Copy, []string{source, destination})
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return handleBoolResponse(result)
+}
+
+// Copies the value stored at the source to the destination key. When
+// `replace` in `options` is `true`, removes the destination key first if it already
+// exists, otherwise performs no action.
+//
+// Note:
+//
+//	When in cluster mode, both `source` and `destination` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	source - The key to the source value.
+//	destination - The key where the value should be copied to.
+//	copyOptions - Set copy options with replace and DB destination-db
+//
+// Return value:
+//
+//	`true` if source was copied, `false` if source was not copied.
+//
+// [valkey.io]: https://valkey.io/commands/copy/
+func (client *baseClient) CopyWithOptions(
+	ctx context.Context,
+	source string,
+	destination string,
+	options options.CopyOptions,
+) (bool, error) {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.Copy, append([]string{
+		source, destination,
+	}, optionArgs...))
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return handleBoolResponse(result)
+}
+
+// Returns stream entries matching a given range of IDs.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	end   - The end position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//
+// Return value:
+//
+//	An `array` of [models.StreamEntry], where entry data stores an array of
+//	pairings with format `[[field, entry], [field, entry], ...]`.
+//
+// [valkey.io]: https://valkey.io/commands/xrange/
+func (client *baseClient) XRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.StreamEntry, error) {
+	return client.XRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
+}
+
+// Returns stream entries matching a given range of IDs.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	end   - The end position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	opts  - Stream range options.
+//
+// Return value:
+//
+//	An `array` of [models.StreamEntry], where entry data stores an array of
+//	pairings with format `[[field, entry], [field, entry], ...]`.
+//	Returns `nil` if `count` is non-positive.
+//
+// [valkey.io]: https://valkey.io/commands/xrange/
+func (client *baseClient) XRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) ([]models.StreamEntry, error) {
+	args := []string{key, string(start), string(end)}
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XRange, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleXRangeResponse(result, false)
+}
+
+// Returns stream entries matching a given range of IDs in reverse order.
+// Equivalent to `XRange` but returns entries in reverse order.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	end   - The end position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//
+// Return value:
+//
+//	An `array` of [models.StreamEntry], where entry data stores an array of
+//	pairings with format `[[field, entry], [field, entry], ...]`.
+//
+// [valkey.io]: https://valkey.io/commands/xrevrange/
+func (client *baseClient) XRevRange(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+) ([]models.StreamEntry, error) {
+	return client.XRevRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
+}
+
+// Returns stream entries matching a given range of IDs in reverse order.
+// Equivalent to `XRange` but returns entries in reverse order.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	start - The start position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	end   - The end position.
+//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
+//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	opts  - Stream range options.
+//
+// Return value:
+//
+//	An `array` of [models.StreamEntry], where entry data stores an array of
+//	pairings with format `[[field, entry], [field, entry], ...]`.
+//	Returns `nil` if `count` is non-positive.
+//
+// [valkey.io]: https://valkey.io/commands/xrevrange/
+func (client *baseClient) XRevRangeWithOptions(
+	ctx context.Context,
+	key string,
+	start options.StreamBoundary,
+	end options.StreamBoundary,
+	opts options.XRangeOptions,
+) ([]models.StreamEntry, error) {
+	args := []string{key, string(start), string(end)}
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XRevRange, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleXRangeResponse(result, true)
+}
+
+// Returns information about the stream stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//
+// Return value:
+//
+//	A [models.XInfoStreamResponse] containing information about the stream stored at key:
+//	- Length: the number of entries in the stream
+//	- RadixTreeKeys: the number of keys in the underlying radix data structure
+//	- RadixTreeNodes: the number of nodes in the underlying radix data structure
+//	- Groups: the number of consumer groups defined for the stream
+//	- LastGeneratedID: the ID of the least-recently entry that was added to the stream
+//	- MaxDeletedEntryID: the maximal entry ID that was deleted from the stream
+//	- EntriesAdded: the count of all entries added to the stream during its lifetime
+//	- FirstEntry: the ID and field-value tuples of the first entry in the stream
+//	- LastEntry: the ID and field-value tuples of the last entry in the stream
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+func (client *baseClient) XInfoStream(ctx context.Context, key string) (models.XInfoStreamResponse, error) {
+	result, err := client.executeCommand(ctx, C.XInfoStream, []string{key})
+	if err != nil {
+		return models.XInfoStreamResponse{}, err
+	}
+	return handleXInfoStreamResponse(result)
+}
+
+// Returns detailed information about the stream stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	key  - The key of the stream.
+//	opts - Stream info options.
+//
+// Return value:
+//
+//	A detailed stream information for the given `key`. See the example for a sample response.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+func (client *baseClient) XInfoStreamFullWithOptions(
+	ctx context.Context,
+	key string,
+	opts options.XInfoStreamOptions,
+) (models.XInfoStreamFullOptionsResponse, error) {
+	args := []string{key, constants.FullKeyword}
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.XInfoStreamFullOptionsResponse{}, err
+	}
+	args = append(args, optionArgs...)
+	result, err := client.executeCommand(ctx, C.XInfoStream, args)
+	if err != nil {
+		return models.XInfoStreamFullOptionsResponse{}, err
+	}
+	return handleXInfoStreamFullOptionsResponse(result)
+}
+
+// Returns the list of all consumers and their attributes for the given consumer group of the
+// stream stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	group - The consumer group name.
+//
+// Return value:
+//
+//	An array of [models.XInfoConsumerInfo], where each element contains the attributes
+//	of a consumer for the given consumer group of the stream at `key`.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-consumers/
+func (client *baseClient) XInfoConsumers(ctx context.Context, key string, group string) ([]models.XInfoConsumerInfo, error) {
+	response, err := client.executeCommand(ctx, C.XInfoConsumers, []string{key, group})
+	if err != nil {
+		return nil, err
+	}
+	return handleXInfoConsumersResponse(response)
+}
+
+// Returns the list of all consumer groups and their attributes for the stream stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the stream.
+//
+// Return value:
+//
+//	An array of [models.XInfoGroupInfo], where each element represents the
+//	attributes of a consumer group for the stream at `key`.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-groups/
+func (client *baseClient) XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error) {
+	response, err := client.executeCommand(ctx, C.XInfoGroups, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	return handleXInfoGroupsResponse(response)
+}
+
+// Reads or modifies the array of bits representing the string that is held at key
+// based on the specified sub commands.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx          - The context for controlling the command execution.
+//	key          - The key of the string.
+//	subCommands  - The subCommands to be performed on the binary value of the string at
+//	                key, which could be any of the following:
+//	                  - [BitFieldGet].
+//	                  - [BitFieldSet].
+//	                  - [BitFieldIncrby].
+//	                  - [BitFieldOverflow].
+//		            Use `options.NewBitFieldGet()` to specify a  BitField GET command.
+//		            Use `options.NewBitFieldSet()` to specify a BitField SET command.
+//		            Use `options.NewBitFieldIncrby()` to specify a BitField INCRYBY command.
+//		            Use `options.BitFieldOverflow()` to specify a BitField OVERFLOW command.
+//
+// Return value:
+//
+//	Result from the executed subcommands.
+//	  - BitFieldGet returns the value in the binary representation of the string.
+//	  - BitFieldSet returns the previous value before setting the new value in the binary representation.
+//	  - BitFieldIncrBy returns the updated value after increasing or decreasing the bits.
+//	  - BitFieldOverflow controls the behavior of subsequent operations and returns
+//	    a result based on the specified overflow type (WRAP, SAT, FAIL).
+//
+// [valkey.io]: https://valkey.io/commands/bitfield/
+func (client *baseClient) BitField(
+	ctx context.Context,
+	key string,
+	subCommands []options.BitFieldSubCommands,
+) ([]models.Result[int64], error) {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+
+	for _, cmd := range subCommands {
+		cmdArgs, err := cmd.ToArgs()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, cmdArgs...)
+	}
+
+	result, err := client.executeCommand(ctx, C.BitField, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleIntOrNilArrayResponse(result)
+}
+
+// Reads the array of bits representing the string that is held at key
+// based on the specified  sub commands.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx          - The context for controlling the command execution.
+//	key          - The key of the string.
+//	subCommands  - The read-only subCommands to be performed on the binary value
+//	               of the string at key, which could be:
+//	                 - [BitFieldGet].
+//		           Use `options.NewBitFieldGet()` to specify a BitField GET command.
+//
+// Return value:
+//
+//	Result from the executed GET subcommands.
+//	  - BitFieldGet returns the value in the binary representation of the string.
+//
+// [valkey.io]: https://valkey.io/commands/bitfield_ro/
+func (client *baseClient) BitFieldRO(
+	ctx context.Context,
+	key string,
+	commands []options.BitFieldROCommands,
+) ([]models.Result[int64], error) {
+	args := make([]string, 0, 10)
+	args = append(args, key)
+
+	for _, cmd := range commands {
+		cmdArgs, err := cmd.ToArgs()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, cmdArgs...)
+	}
+
+	result, err := client.executeCommand(ctx, C.BitFieldReadOnly, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleIntOrNilArrayResponse(result)
+}
+
+// Returns the server time.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The current server time as a String array with two elements:
+//	A UNIX TIME and the amount of microseconds already elapsed in the current second.
+//	The returned array is in a [UNIX TIME, Microseconds already elapsed] format.
+//
+// [valkey.io]: https://valkey.io/commands/time/
+func (client *baseClient) Time(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.Time, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the intersection of members from sorted sets specified by the given `keys`.
+// To get the elements with their scores, see [Client.ZInterWithScores] or [ClusterClient.ZInterWithScores].
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys of the sorted sets, see - [options.KeyArray].
+//
+// Return value:
+//
+//	The resulting sorted set from the intersection.
+//
+// [valkey.io]: https://valkey.io/commands/zinter/
+func (client *baseClient) ZInter(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	args, err := keys.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.executeCommand(ctx, C.ZInter, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the intersection of members and their scores from sorted sets specified by the given
+// `keysOrWeightedKeys`.
+//
+// Note:
+//
+//	When in cluster mode, all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
+//	                     - Use `options.NewKeyArray()` for keys only.
+//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//	options - The options for the ZInter command, see - [options.ZInterOptions].
+//	           Optional `aggregate` option specifies the aggregation strategy to apply when combining the scores of
+//	           elements.
+//
+// Return value:
+//
+//	An array of `models.MemberAndScore` objects, which store member names and their respective scores.
+//	If the sorted set does not exist or is empty, the response will be an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/zinter/
+func (client *baseClient) ZInterWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zInterOptions options.ZInterOptions,
+) ([]models.MemberAndScore, error) {
+	args, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	optionsArgs, err := zInterOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, constants.WithScoresKeyword)
+	result, err := client.executeCommand(ctx, C.ZInter, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleSortedSetWithScoresResponse(result, false)
+}
+
+// Computes the intersection of sorted sets given by the specified `keysOrWeightedKeys`
+// and stores the result in `destination`. If `destination` already exists, it is overwritten.
+// Otherwise, a new sorted set will be created.
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The destination key for the result.
+//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
+//	                     - Use `options.NewKeyArray()` for keys only.
+//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//
+// Return value:
+//
+//	The number of elements in the resulting sorted set stored at `destination`.
+//
+// [valkey.io]: https://valkey.io/commands/zinterstore/
+func (client *baseClient) ZInterStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return client.ZInterStoreWithOptions(ctx, destination, keysOrWeightedKeys, *options.NewZInterOptions())
+}
+
+// Computes the intersection of sorted sets given by the specified `keysOrWeightedKeys`
+// and stores the result in `destination`. If `destination` already exists, it is overwritten.
+// Otherwise, a new sorted set will be created.
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The destination key for the result.
+//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
+//	                     - Use `options.NewKeyArray()` for keys only.
+//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//	options - The options for the ZInterStore command, see - [options.ZInterOptions].
+//	          Optional `aggregate` option specifies the aggregation strategy to apply when combining the scores of
+//	          elements.
+//
+// Return value:
+//
+//	The number of elements in the resulting sorted set stored at `destination`.
+//
+// [valkey.io]: https://valkey.io/commands/zinterstore/
+func (client *baseClient) ZInterStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zInterOptions options.ZInterOptions,
+) (int64, error) {
+	args, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append([]string{destination}, args...)
+	optionsArgs, err := zInterOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, optionsArgs...)
+	result, err := client.executeCommand(ctx, C.ZInterStore, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the difference between the first sorted set and all the successive sorted sets.
+// To get the elements with their scores, see [Client.ZDiffWithScores] or [ClusterClient.ZDiffWithScores].
+//
+// Note:
+//
+//	When in cluster mode, all `keys` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sorted sets.
+//
+// Return value:
+//
+//	An array of elements representing the difference between the sorted sets.
+//	If the first `key` does not exist, it is treated as an empty sorted set, and the
+//	command returns an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/zdiff/
+func (client *baseClient) ZDiff(ctx context.Context, keys []string) ([]string, error) {
+	args := append([]string{}, strconv.Itoa(len(keys)))
+	result, err := client.executeCommand(ctx, C.ZDiff, append(args, keys...))
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the difference between the first sorted set and all the successive sorted sets.
+//
+// Note:
+//
+//	When in cluster mode, all `keys` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sorted sets.
+//
+// Return value:
+//
+//	An array of elements and their scores representing the difference between the sorted sets.
+//	If the first `key` does not exist, it is treated as an empty sorted set, and the
+//	command returns an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/zdiff/
+func (client *baseClient) ZDiffWithScores(ctx context.Context, keys []string) ([]models.MemberAndScore, error) {
+	args := append([]string{}, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	result, err := client.executeCommand(ctx, C.ZDiff, append(args, constants.WithScoresKeyword))
+	if err != nil {
+		return nil, err
+	}
+	return handleSortedSetWithScoresResponse(result, false)
+}
+
+// Calculates the difference between the first sorted set and all the successive sorted sets at
+// `keys` and stores the difference as a sorted set to `destination`,
+// overwriting it if it already exists. Non-existent keys are treated as empty sets.
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all `keys` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx         - The context for controlling the command execution.
+//	destination - The key for the resulting sorted set.
+//	keys        - The keys of the sorted sets to compare.
+//
+// Return value:
+//
+//	The number of members in the resulting sorted set stored at `destination`.
+//
+// [valkey.io]: https://valkey.io/commands/zdiffstore/
+func (client *baseClient) ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
+	result, err := client.executeCommand(ctx,
+		C.ZDiffStore,
+		append([]string{destination, strconv.Itoa(len(keys))}, keys...),
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the union of members from sorted sets specified by the given `keys`.
+// To get the elements with their scores, see [Client.ZUnionWithScores] or [ClusterClient.ZUnionWithScores].
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys of the sorted sets.
+//
+// Return Value:
+//
+//	The resulting sorted set from the union.
+//
+// [valkey.io]: https://valkey.io/commands/zunion/
+func (client *baseClient) ZUnion(ctx context.Context, keys options.KeyArray) ([]string, error) {
+	args, err := keys.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.executeCommand(ctx, C.ZUnion, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the union of members and their scores from sorted sets specified by the given
+// `keysOrWeightedKeys`.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Note:
+//
+//	When in cluster mode, all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keysOrWeightedKeys - The keys of the sorted sets with possible formats:
+//	                     - Use `KeyArray` for keys only.
+//	                     - Use `WeightedKeys` for weighted keys with score multipliers.
+//	zUnionOptions - The options for the ZUnionStore command, see - [options.ZUnionOptions].
+//	                Optional `aggregate` option specifies the aggregation strategy to apply when
+//	                combining the scores of elements.
+//
+// Return Value:
+//
+//	The resulting sorted set from the union.
+//
+// [valkey.io]: https://valkey.io/commands/zunion/
+func (client *baseClient) ZUnionWithScores(
+	ctx context.Context,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zUnionOptions options.ZUnionOptions,
+) ([]models.MemberAndScore, error) {
+	args, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	optionsArgs, err := zUnionOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, constants.WithScoresKeyword)
+	result, err := client.executeCommand(ctx, C.ZUnion, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleSortedSetWithScoresResponse(result, false)
+}
+
+// Computes the union of sorted sets given by the specified `KeysOrWeightedKeys`, and
+// stores the result in `destination`. If `destination` already exists, it
+// is overwritten. Otherwise, a new sorted set will be created.
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The key of the destination sorted set.
+//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
+//	                   - Use `options.NewKeyArray()` for keys only.
+//	                   - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//
+// Return Value:
+//
+//	The number of elements in the resulting sorted set stored at `destination`.
+//
+// [valkey.io]: https://valkey.io/commands/zunionstore/
+func (client *baseClient) ZUnionStore(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+) (int64, error) {
+	return client.ZUnionStoreWithOptions(ctx, destination, keysOrWeightedKeys, *options.NewZUnionOptions())
+}
+
+// Computes the union of sorted sets given by the specified `KeysOrWeightedKeys`, and
+// stores the result in `destination`. If `destination` already exists, it
+// is overwritten. Otherwise, a new sorted set will be created.
+//
+// Note:
+//
+//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The key of the destination sorted set.
+//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
+//	                     - Use `options.NewKeyArray()` for keys only.
+//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//	zUnionOptions - The options for the ZUnionStore command, see - [options.ZUnionOptions].
+//	                Optional `aggregate` option specifies the aggregation strategy to apply when
+//	                combining the scores of elements.
+//
+// Return Value:
+//
+//	The number of elements in the resulting sorted set stored at `destination`.
+//
+// [valkey.io]: https://valkey.io/commands/zunionstore/
+func (client *baseClient) ZUnionStoreWithOptions(
+	ctx context.Context,
+	destination string,
+	keysOrWeightedKeys options.KeysOrWeightedKeys,
+	zUnionOptions options.ZUnionOptions,
+) (int64, error) {
+	keysArgs, err := keysOrWeightedKeys.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args := append([]string{destination}, keysArgs...)
+	optionsArgs, err := zUnionOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, optionsArgs...)
+	result, err := client.executeCommand(ctx, C.ZUnionStore, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the cardinality of the intersection of the sorted sets specified by `keys`.
+//
+// Note:
+//
+// When in cluster mode, all keys must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys of the sorted sets.
+//
+// Return value:
+//
+//	The cardinality of the intersection of the sorted sets.
+//
+// [valkey.io]: https://valkey.io/commands/zintercard/
+func (client *baseClient) ZInterCard(ctx context.Context, keys []string) (int64, error) {
+	return client.ZInterCardWithOptions(ctx, keys, *options.NewZInterCardOptions())
+}
+
+// Returns the cardinality of the intersection of the sorted sets specified by `keys`.
+// If the intersection cardinality reaches `options.limit` partway through the computation, the
+// algorithm will exit early and yield `options.limit` as the cardinality.
+//
+// Note:
+//
+// When in cluster mode, all keys must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys of the sorted sets.
+//	options - The options for the ZInterCard command, see - [options.ZInterCardOptions].
+//
+// Return value:
+//
+//	The cardinality of the intersection of the sorted sets.
+//
+// [valkey.io]: https://valkey.io/commands/zintercard/
+func (client *baseClient) ZInterCardWithOptions(
+	ctx context.Context,
+	keys []string,
+	options options.ZInterCardOptions,
+) (int64, error) {
+	args := append([]string{strconv.Itoa(len(keys))}, keys...)
+	optionsArgs, err := options.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, optionsArgs...)
+	result, err := client.executeCommand(ctx, C.ZInterCard, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the number of elements in the sorted set at key with a value between min and max.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	rangeQuery - The range query to apply to the sorted set.
+//
+// Return value:
+//
+//	The number of elements in the sorted set at key with a value between min and max.
+//
+// [valkey.io]: https://valkey.io/commands/zlexcount/
+func (client *baseClient) ZLexCount(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
+	args := []string{key}
+	args = append(args, rangeQuery.ToArgsLexCount()...)
+	result, err := client.executeCommand(ctx, C.ZLexCount, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Blocks the connection until it pops and returns a member-score pair
+// with the highest score from the first non-empty sorted set.
+// The given `keys` being checked in the order they are provided.
+// `BZPopMax` is the blocking variant of [Client.ZPopMax] and [ClusterClient.ZPopMax].
+//
+// Note:
+//   - When in cluster mode, all keys must map to the same hash slot.
+//   - `BZPopMax` is a client blocking command, see [Blocking Commands] for more details and best practices.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - An array of keys to check for elements.
+//	timeout - The maximum number of seconds to block (0 blocks indefinitely).
+//
+// Return value:
+//
+//	A `models.KeyWithMemberAndScore` struct containing the key from which the member was popped,
+//	the popped member, and its score. If no element could be popped and the timeout expired,
+//	returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/bzpopmax/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BZPopMax(
+	ctx context.Context,
+	keys []string,
+	timeout time.Duration,
+) (models.Result[models.KeyWithMemberAndScore], error) {
+	args := append(keys, utils.FloatToString(timeout.Seconds()))
+
+	result, err := client.executeCommand(ctx, C.BZPopMax, args)
+	if err != nil {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
+
+	return handleKeyWithMemberAndScoreResponse(result)
+}
+
+// Removes and returns up to `count` members from the first non-empty sorted set
+// among the provided `keys`, based on the specified `scoreFilter` criteria.
+//
+// Note:
+//
+// When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - A list of keys representing sorted sets to check for elements.
+//	scoreFilter - Pop criteria - either [constants.MIN] or [constants.MAX] to pop members with the lowest/highest scores.
+//	opts - Additional options, such as specifying the maximum number of elements to pop.
+//
+// Return value:
+//
+//	A `Result` containing a `models.KeyWithArrayOfMembersAndScores` object.
+//	If no elements could be popped from the provided keys, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/zmpop/
+func (client *baseClient) ZMPopWithOptions(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+	opts options.ZMPopOptions,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	scoreFilterStr, err := scoreFilter.ToString()
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	optArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	args := append([]string{strconv.Itoa(len(keys))}, keys...)
+	args = append(args, scoreFilterStr)
+	args = append(args, optArgs...)
+
+	result, err := client.executeCommand(ctx, C.ZMPop, args)
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	return handleKeyWithArrayOfMembersAndScoresResponse(result)
+}
+
+// Pops one or more member-score pairs from the first non-empty sorted set,
+// with the given keys being checked in the order provided.
+//
+// Note:
+//
+// When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - An array of keys to check for elements.
+//	scoreFilter - Pop criteria - either [constants.MIN] or [constants.MAX] to pop members with the lowest/highest scores.
+//
+// Return value:
+//
+//	A `models.KeyWithArrayOfMembersAndScores` struct containing:
+//	- The key from which the elements were popped.
+//	- An array of member-score pairs of the popped elements.
+//	  Returns `nil` if no member could be popped.
+//
+// [valkey.io]: https://valkey.io/commands/zmpop/
+func (client *baseClient) ZMPop(
+	ctx context.Context,
+	keys []string,
+	scoreFilter constants.ScoreFilter,
+) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
+	scoreFilterStr, err := scoreFilter.ToString()
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	args := make([]string, 0, len(keys)+3)
+	args = append(args, strconv.Itoa(len(keys)))
+	args = append(args, keys...)
+	args = append(args, scoreFilterStr)
+
+	result, err := client.executeCommand(ctx, C.ZMPop, args)
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+
+	return handleKeyWithArrayOfMembersAndScoresResponse(result)
+}
+
+// Adds geospatial members with their positions to the specified sorted set stored at `key`.
+// If a member is already a part of the sorted set, its position is updated.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	membersToGeospatialData - A map of member names to their corresponding positions. See [options.GeospatialData].
+//	  The command will report an error when index coordinates are out of the specified range.
+//
+// Return value:
+//
+//	The number of elements added to the sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/geoadd/
+func (client *baseClient) GeoAdd(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+) (int64, error) {
+	result, err := client.executeCommand(ctx,
+		C.GeoAdd,
+		append([]string{key}, options.MapGeoDataToArray(membersToGeospatialData)...),
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Adds geospatial members with their positions to the specified sorted set stored at `key`.
+// If a member is already a part of the sorted set, its position is updated.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	membersToGeospatialData - A map of member names to their corresponding positions. See [options.GeospatialData].
+//	  The command will report an error when index coordinates are out of the specified range.
+//	geoAddOptions - The options for the GeoAdd command, see - [options.GeoAddOptions].
+//
+// Return value:
+//
+//	The number of elements added to the sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/geoadd/
+func (client *baseClient) GeoAddWithOptions(
+	ctx context.Context,
+	key string,
+	membersToGeospatialData map[string]options.GeospatialData,
+	geoAddOptions options.GeoAddOptions,
+) (int64, error) {
+	args := []string{key}
+	optionsArgs, err := geoAddOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, options.MapGeoDataToArray(membersToGeospatialData)...)
+	result, err := client.executeCommand(ctx, C.GeoAdd, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns the GeoHash strings representing the positions of all the specified
+// `members` in the sorted set stored at the `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key -  The key of the sorted set.
+//	members - The array of members whose GeoHash strings are to be retrieved.
+//
+// Returns value:
+//
+//	An array of GeoHash strings (of type models.Result[string]) representing the positions of the specified
+//	members stored at key. If a member does not exist in the sorted set, a `nil` value is returned
+//	for that member.
+//
+// [valkey.io]: https://valkey.io/commands/geohash/
+func (client *baseClient) GeoHash(ctx context.Context, key string, members []string) ([]models.Result[string], error) {
+	result, err := client.executeCommand(ctx,
+		C.GeoHash,
+		append([]string{key}, members...),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringOrNilArrayResponse(result)
+}
+
+// Returns the positions (longitude,latitude) of all the specified members of the
+// geospatial index represented by the sorted set at key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	members - The members of the sorted set.
+//
+// Return value:
+//
+//	A 2D `array` which represent positions (longitude and latitude) corresponding to the given members.
+//	If a member does not exist, its position will be `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/geopos/
+func (client *baseClient) GeoPos(ctx context.Context, key string, members []string) ([][]float64, error) {
+	args := []string{key}
+	args = append(args, members...)
+	result, err := client.executeCommand(ctx, C.GeoPos, args)
+	if err != nil {
+		return nil, err
+	}
+	return handle2DFloat64OrNullArrayResponse(result)
+}
+
+// Returns the distance between `member1` and `member2` saved in the
+// geospatial index stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	member1 - The name of the first member.
+//	member2 - The name of the second member.
+//
+// Return value:
+//
+//	The distance between `member1` and `member2`. If one or both members do not exist,
+//	or if the key does not exist, returns `nil`. The default unit is meters, see - [options.Meters]
+//
+// [valkey.io]: https://valkey.io/commands/geodist/
+func (client *baseClient) GeoDist(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+) (models.Result[float64], error) {
+	result, err := client.executeCommand(ctx,
+		C.GeoDist,
+		[]string{key, member1, member2},
+	)
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	return handleFloatOrNilResponse(result)
+}
+
+// Returns the distance between `member1` and `member2` saved in the
+// geospatial index stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	member1 - The name of the first member.
+//	member2 - The name of the second member.
+//	unit - The unit of distance measurement - see [options.GeoUnit].
+//
+// Return value:
+//
+//	The distance between `member1` and `member2`. If one or both members
+//	do not exist, or if the key does not exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/geodist/
+func (client *baseClient) GeoDistWithUnit(
+	ctx context.Context,
+	key string,
+	member1 string,
+	member2 string,
+	unit constants.GeoUnit,
+) (models.Result[float64], error) {
+	result, err := client.executeCommand(ctx,
+		C.GeoDist,
+		[]string{key, member1, member2, string(unit)},
+	)
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	return handleFloatOrNilResponse(result)
+}
+
+// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
+// which are within the borders of the area specified by a given shape.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	searchFrom - The query's center point options, could be one of:
+//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
+//		- `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		- `BYRADIUS` to search inside circular area according to given radius.
+//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//	resultOptions - Optional inputs for sorting/limiting the results.
+//	infoOptions - The optional inputs to request additional information.
+//
+// Return value:
+//
+//	An array of [options.Location] containing the following information:
+//	 - The coordinates as a [options.GeospatialData] object.
+//	 - The member (location) name.
+//	 - The distance from the center as a `float64`, in the same unit specified for `searchByShape`.
+//	 - The geohash of the location as a `int64`.
+//
+// [valkey.io]: https://valkey.io/commands/geosearch/
+func (client *baseClient) GeoSearchWithFullOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	args := []string{key}
+	searchFromArgs, err := searchFrom.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, searchFromArgs...)
+	searchByShapeArgs, err := searchByShape.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, searchByShapeArgs...)
+	infoOptionsArgs, err := infoOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, infoOptionsArgs...)
+	resultOptionsArgs, err := resultOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, resultOptionsArgs...)
+	result, err := client.executeCommand(ctx, C.GeoSearch, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleLocationArrayResponse(result)
+}
+
+// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
+// which are within the borders of the area specified by a given shape.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	searchFrom - The query's center point options, could be one of:
+//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
+//		- `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		- `BYRADIUS` to search inside circular area according to given radius.
+//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//	resultOptions - Optional inputs for sorting/limiting the results.
+//
+// Return value:
+//
+//	An array of matched member names.
+//
+// [valkey.io]: https://valkey.io/commands/geosearch/
+func (client *baseClient) GeoSearchWithResultOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) ([]string, error) {
+	args := []string{key}
+	searchFromArgs, err := searchFrom.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, searchFromArgs...)
+	searchByShapeArgs, err := searchByShape.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, searchByShapeArgs...)
+	resultOptionsArgs, err := resultOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, resultOptionsArgs...)
+
+	result, err := client.executeCommand(ctx, C.GeoSearch, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
+// which are within the borders of the area specified by a given shape.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	searchFrom - The query's center point options, could be one of:
+//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
+//		- `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		- `BYRADIUS` to search inside circular area according to given radius.
+//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//	infoOptions - The optional inputs to request additional information.
+//
+// Return value:
+//
+//	An array of [options.Location] containing the following information:
+//	 - The coordinates as a [options.GeospatialData] object.
+//	 - The member (location) name.
+//	 - The distance from the center as a `float64`, in the same unit specified for
+//	   `searchByShape`.
+//	 - The geohash of the location as a `int64`.
+//
+// [valkey.io]: https://valkey.io/commands/geosearch/
+func (client *baseClient) GeoSearchWithInfoOptions(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchInfoOptions,
+) ([]options.Location, error) {
+	return client.GeoSearchWithFullOptions(
+		ctx,
+		key,
+		searchFrom,
+		searchByShape,
+		*options.NewGeoSearchResultOptions(),
+		infoOptions,
+	)
+}
+
+// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
+// which are within the borders of the area specified by a given shape.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	searchFrom - The query's center point options, could be one of:
+//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
+//		- `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		- `BYRADIUS` to search inside circular area according to given radius.
+//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//
+// Return value:
+//
+//	An array of matched member names.
+//
+// [valkey.io]: https://valkey.io/commands/geosearch/
+func (client *baseClient) GeoSearch(
+	ctx context.Context,
+	key string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) ([]string, error) {
+	return client.GeoSearchWithResultOptions(
+		ctx,
+		key,
+		searchFrom,
+		searchByShape,
+		*options.NewGeoSearchResultOptions(),
+	)
+}
+
+// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
+// within a circular or rectangular area and stores the result in `destinationKey`. If
+// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
+// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Note:
+//
+// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destinationKey - The key of the sorted set to store the result.
+//	sourceKey - The key of the sorted set to search.
+//	searchFrom - The query's center point options, could be one of:
+//		 - `MemberOrigin` to use the position of the given existing member in the sorted set.
+//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		 - `BYRADIUS` to search inside circular area according to given radius.
+//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//	resultOptions - Optional inputs for sorting/limiting the results.
+//	infoOptions - The optional inputs to request additional information.
+//
+// Return value:
+//
+//	The number of elements in the resulting set.
+//
+// [valkey.io]: https://valkey.io/commands/geosearchstore/
+func (client *baseClient) GeoSearchStoreWithFullOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+	infoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	args := []string{destinationKey, sourceKey}
+	searchFromArgs, err := searchFrom.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, searchFromArgs...)
+	searchByShapeArgs, err := searchByShape.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, searchByShapeArgs...)
+	resultOptionsArgs, err := resultOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, resultOptionsArgs...)
+	infoOptionsArgs, err := infoOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, infoOptionsArgs...)
+
+	result, err := client.executeCommand(ctx, C.GeoSearchStore, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
+// within a circular or rectangular area and stores the result in `destinationKey`. If
+// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
+// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Note:
+//
+// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destinationKey - The key of the sorted set to store the result.
+//	sourceKey - The key of the sorted set to search.
+//	searchFrom - The query's center point options, could be one of:
+//		 - `MemberOrigin` to use the position of the given existing member in the sorted
+//	          set.
+//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		 - `BYRADIUS` to search inside circular area according to given radius.
+//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//
+// Return value:
+//
+//	The number of elements in the resulting set.
+//
+// [valkey.io]: https://valkey.io/commands/geosearchstore/
+func (client *baseClient) GeoSearchStore(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+) (int64, error) {
+	return client.GeoSearchStoreWithFullOptions(
+		ctx,
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		*options.NewGeoSearchResultOptions(),
+		*options.NewGeoSearchStoreInfoOptions(),
+	)
+}
+
+// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
+// within a circular or rectangular area and stores the result in `destinationKey`. If
+// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
+// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Note:
+//
+// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destinationKey - The key of the sorted set to store the result.
+//	sourceKey - The key of the sorted set to search.
+//	searchFrom - The query's center point options, could be one of:
+//		 - `MemberOrigin` to use the position of the given existing member in the sorted
+//	          set.
+//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		 - `BYRADIUS` to search inside circular area according to given radius.
+//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//	resultOptions - Optional inputs for sorting/limiting the results.
+//
+// Return value:
+//
+//	The number of elements in the resulting set.
+//
+// [valkey.io]: https://valkey.io/commands/geosearchstore/
+func (client *baseClient) GeoSearchStoreWithResultOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	resultOptions options.GeoSearchResultOptions,
+) (int64, error) {
+	return client.GeoSearchStoreWithFullOptions(
+		ctx,
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		resultOptions,
+		*options.NewGeoSearchStoreInfoOptions(),
+	)
+}
+
+// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
+// within a circular or rectangular area and stores the result in `destinationKey`. If
+// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
+// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// Note:
+//
+// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destinationKey - The key of the sorted set to store the result.
+//	sourceKey - The key of the sorted set to search.
+//	searchFrom - The query's center point options, could be one of:
+//		 - `MemberOrigin` to use the position of the given existing member in the sorted
+//	          set.
+//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
+//	searchByShape - The query's shape options:
+//		 - `BYRADIUS` to search inside circular area according to given radius.
+//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
+//	infoOptions - The optional inputs to request additional information.
+//
+// Return value:
+//
+//	The number of elements in the resulting set.
+//
+// [valkey.io]: https://valkey.io/commands/geosearchstore/
+func (client *baseClient) GeoSearchStoreWithInfoOptions(
+	ctx context.Context,
+	destinationKey string,
+	sourceKey string,
+	searchFrom options.GeoSearchOrigin,
+	searchByShape options.GeoSearchShape,
+	infoOptions options.GeoSearchStoreInfoOptions,
+) (int64, error) {
+	return client.GeoSearchStoreWithFullOptions(
+		ctx,
+		destinationKey,
+		sourceKey,
+		searchFrom,
+		searchByShape,
+		*options.NewGeoSearchResultOptions(),
+		infoOptions,
+	)
 }
 
-// Returns the position of the first bit matching the given bit value.
+// Loads a library to Valkey.
 //
-// See [valkey.io] for details.
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the string.
-//	bit - The bit value to match. The value must be 0 or 1.
+//	libraryCode - The source code that implements the library.
+//	replace - Whether the given library should overwrite a library with the same name if it already exists.
 //
 // Return value:
 //
-//	The position of the first occurrence matching bit in the binary value of
-//	the string held at key. If bit is not found, a -1 is returned.
+//	The library name that was loaded.
 //
-// [valkey.io]: https://valkey.io/commands/bitpos/
-func (client *baseClient) BitPos(ctx context.Context, key string, bit int64) (int64, error) {
-	result, err := client.executeCommand(ctx, C.BitPos, []string{key, utils.IntToString(bit)})
+// [valkey.io]: https://valkey.io/commands/function-load/
+func (client *baseClient) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
+	args := []string{}
+	if replace {
+		args = append(args, constants.ReplaceKeyword)
+	}
+	args = append(args, libraryCode)
+	result, err := client.executeCommand(ctx, C.FunctionLoad, args)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultStringResponse, err
 	}
-	return handleIntResponse(result)
+	return handleStringResponse(result)
 }
 
-// Returns the position of the first bit matching the given bit value.
+// Deletes all function libraries.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the string.
-//	bit - The bit value to match. The value must be 0 or 1.
-//	bitposOptions - The [BitPosOptions] type.
 //
 // Return value:
 //
-//	The position of the first occurrence matching bit in the binary value of
-//	the string held at key. If bit is not found, a -1 is returned.
+//	`OK`
 //
-// [valkey.io]: https://valkey.io/commands/bitpos/
-func (client *baseClient) BitPosWithOptions(
-	ctx context.Context,
-	key string,
-	bit int64,
-	bitposOptions options.BitPosOptions,
-) (int64, error) {
-	optionArgs, err := bitposOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	commandArgs := append([]string{key, utils.IntToString(bit)}, optionArgs...)
-	result, err := client.executeCommand(ctx, C.BitPos, commandArgs)
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *baseClient) FunctionFlush(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultStringResponse, err
 	}
-	return handleIntResponse(result)
+	return handleOkResponse(result)
 }
 
-// Copies the value stored at the source to the destination key if the
-// destination key does not yet exist.
-//
-// Note:
-//
-//	When in cluster mode, both `source` and `destination` must map to the same hash slot.
+// Deletes all function libraries in synchronous mode.
 //
 // Since:
 //
-//	Valkey 6.2.0 and above.
+//	Valkey 7.0 and above.
 //
-// See [valkey.io] for details.
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	source - The key to the source value.
-//	destination - The key where the value should be copied to.
 //
 // Return value:
 //
-//	`true` if source was copied, `false` if source was not copied.
+//	`OK`
 //
-// [valkey.io]: https://valkey.io/commands/copy/
-func (client *baseClient) Copy(ctx context.Context, source string, destination string) (bool, error) {
-	result, err := client.executeCommand(ctx, C.Copy, []string{source, destination})
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *baseClient) FunctionFlushSync(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{string(options.SYNC)})
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return models.DefaultStringResponse, err
 	}
-	return handleBoolResponse(result)
+	return handleOkResponse(result)
 }
 
-// Copies the value stored at the source to the destination key. When
-// `replace` in `options` is `true`, removes the destination key first if it already
-// exists, otherwise performs no action.
-//
-// Note:
-//
-//	When in cluster mode, both `source` and `destination` must map to the same hash slot.
+// Deletes all function libraries in asynchronous mode.
 //
 // Since:
 //
-//	Valkey 6.2.0 and above.
+//	Valkey 7.0 and above.
 //
-// See [valkey.io] for details.
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	source - The key to the source value.
-//	destination - The key where the value should be copied to.
-//	copyOptions - Set copy options with replace and DB destination-db
 //
 // Return value:
 //
-//	`true` if source was copied, `false` if source was not copied.
+//	`OK`
 //
-// [valkey.io]: https://valkey.io/commands/copy/
-func (client *baseClient) CopyWithOptions(
-	ctx context.Context,
-	source string,
-	destination string,
-	options options.CopyOptions,
-) (bool, error) {
-	optionArgs, err := options.ToArgs()
-	if err != nil {
-		return models.DefaultBoolResponse, err
-	}
-	result, err := client.executeCommand(ctx, C.Copy, append([]string{
-		source, destination,
-	}, optionArgs...))
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *baseClient) FunctionFlushAsync(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{string(options.ASYNC)})
 	if err != nil {
-		return models.DefaultBoolResponse, err
+		return models.DefaultStringResponse, err
 	}
-	return handleBoolResponse(result)
+	return handleOkResponse(result)
 }
 
-// Returns stream entries matching a given range of IDs.
-//
-// See [valkey.io] for details.
-//
-// Parameters:
-//
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the stream.
-//	start - The start position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//	end   - The end position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//
-// Return value:
+// Invokes a previously loaded function.
+// The command will be routed to a primary random node.
+// To route to a replica please refer to [Client.FCallReadOnly] or [ClusterClient.FCallReadOnly].
 //
-//	An `array` of [models.StreamEntry], where entry data stores an array of
-//	pairings with format `[[field, entry], [field, entry], ...]`.
+// Since:
 //
-// [valkey.io]: https://valkey.io/commands/xrange/
-func (client *baseClient) XRange(
-	ctx context.Context,
-	key string,
-	start options.StreamBoundary,
-	end options.StreamBoundary,
-) ([]models.StreamEntry, error) {
-	return client.XRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
-}
-
-// Returns stream entries matching a given range of IDs.
+//	Valkey 7.0 and above.
 //
-// See [valkey.io] for details.
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the stream.
-//	start - The start position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//	end   - The end position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//	opts  - Stream range options.
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
 //
 // Return value:
 //
-//	An `array` of [models.StreamEntry], where entry data stores an array of
-//	pairings with format `[[field, entry], [field, entry], ...]`.
-//	Returns `nil` if `count` is non-positive.
+//	The invoked function's return value.
 //
-// [valkey.io]: https://valkey.io/commands/xrange/
-func (client *baseClient) XRangeWithOptions(
-	ctx context.Context,
-	key string,
-	start options.StreamBoundary,
-	end options.StreamBoundary,
-	opts options.XRangeOptions,
-) ([]models.StreamEntry, error) {
-	args := []string{key, string(start), string(end)}
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, optionArgs...)
-	result, err := client.executeCommand(ctx, C.XRange, args)
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *baseClient) FCall(ctx context.Context, function string) (any, error) {
+	result, err := client.executeCommand(ctx, C.FCall, []string{function, utils.IntToString(0)})
 	if err != nil {
 		return nil, err
 	}
-	return handleXRangeResponse(result, false)
+	return handleAnyResponse(result)
 }
 
-// Returns stream entries matching a given range of IDs in reverse order.
-// Equivalent to `XRange` but returns entries in reverse order.
+// Invokes a previously loaded read-only function.
+// This command is routed depending on the client's {@link ReadFrom} strategy.
 //
-// See [valkey.io] for details.
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the stream.
-//	start - The start position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//	end   - The end position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
 //
 // Return value:
 //
-//	An `array` of [models.StreamEntry], where entry data stores an array of
-//	pairings with format `[[field, entry], [field, entry], ...]`.
+//	The invoked function's return value.
 //
-// [valkey.io]: https://valkey.io/commands/xrevrange/
-func (client *baseClient) XRevRange(
-	ctx context.Context,
-	key string,
-	start options.StreamBoundary,
-	end options.StreamBoundary,
-) ([]models.StreamEntry, error) {
-	return client.XRevRangeWithOptions(ctx, key, start, end, *options.NewXRangeOptions())
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *baseClient) FCallReadOnly(ctx context.Context, function string) (any, error) {
+	result, err := client.executeCommand(ctx, C.FCallReadOnly, []string{function, utils.IntToString(0)})
+	if err != nil {
+		return nil, err
+	}
+	return handleAnyResponse(result)
 }
 
-// Returns stream entries matching a given range of IDs in reverse order.
-// Equivalent to `XRange` but returns entries in reverse order.
+// Invokes a previously loaded function.
+// This command is routed to primary nodes only.
+// To route to a replica please refer to [Client.FCallReadOnly] or [ClusterClient.FCallReadOnly].
 //
-// See [valkey.io] for details.
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key   - The key of the stream.
-//	start - The start position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//	end   - The end position.
-//	        Use `options.NewStreamBoundary()` to specify a stream entry ID and its inclusive/exclusive status.
-//	        Use `options.NewInfiniteStreamBoundary()` to specify an infinite stream boundary.
-//	opts  - Stream range options.
+//	function - The function name.
+//	keys - An `array` of keys accessed by the function. To ensure the correct
+//	   execution of functions, both in standalone and clustered deployments, all names of keys
+//	   that a function accesses must be explicitly provided as `keys`.
+//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
 //
 // Return value:
 //
-//	An `array` of [models.StreamEntry], where entry data stores an array of
-//	pairings with format `[[field, entry], [field, entry], ...]`.
-//	Returns `nil` if `count` is non-positive.
+//	The invoked function's return value.
 //
-// [valkey.io]: https://valkey.io/commands/xrevrange/
-func (client *baseClient) XRevRangeWithOptions(
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *baseClient) FCallWithKeysAndArgs(
 	ctx context.Context,
-	key string,
-	start options.StreamBoundary,
-	end options.StreamBoundary,
-	opts options.XRangeOptions,
-) ([]models.StreamEntry, error) {
-	args := []string{key, string(start), string(end)}
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, optionArgs...)
-	result, err := client.executeCommand(ctx, C.XRevRange, args)
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
+	cmdArgs = append(cmdArgs, keys...)
+	cmdArgs = append(cmdArgs, args...)
+	result, err := client.executeCommand(ctx, C.FCall, cmdArgs)
 	if err != nil {
 		return nil, err
 	}
-	return handleXRangeResponse(result, true)
+	return handleAnyResponse(result)
 }
 
-// Returns information about the stream stored at `key`.
+// Invokes a previously loaded read-only function.
+// This command is routed depending on the client's {@link ReadFrom} strategy.
 //
-// See [valkey.io] for details.
+// Note: When in cluster mode, all `keys` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
+//	function - The function name.
+//	keys - An `array` of keys accessed by the function. To ensure the correct
+//	   execution of functions, both in standalone and clustered deployments, all names of keys
+//	   that a function accesses must be explicitly provided as `keys`.
+//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
 //
 // Return value:
 //
-//	A [models.XInfoStreamResponse] containing information about the stream stored at key:
-//	- Length: the number of entries in the stream
-//	- RadixTreeKeys: the number of keys in the underlying radix data structure
-//	- RadixTreeNodes: the number of nodes in the underlying radix data structure
-//	- Groups: the number of consumer groups defined for the stream
-//	- LastGeneratedID: the ID of the least-recently entry that was added to the stream
-//	- MaxDeletedEntryID: the maximal entry ID that was deleted from the stream
-//	- EntriesAdded: the count of all entries added to the stream during its lifetime
-//	- FirstEntry: the ID and field-value tuples of the first entry in the stream
-//	- LastEntry: the ID and field-value tuples of the last entry in the stream
+//	The invoked function's return value.
 //
-// [valkey.io]: https://valkey.io/commands/xinfo-stream/
-func (client *baseClient) XInfoStream(ctx context.Context, key string) (models.XInfoStreamResponse, error) {
-	result, err := client.executeCommand(ctx, C.XInfoStream, []string{key})
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *baseClient) FCallReadOnlyWithKeysAndArgs(
+	ctx context.Context,
+	function string,
+	keys []string,
+	args []string,
+) (any, error) {
+	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
+	cmdArgs = append(cmdArgs, keys...)
+	cmdArgs = append(cmdArgs, args...)
+	result, err := client.executeCommand(ctx, C.FCallReadOnly, cmdArgs)
 	if err != nil {
-		return models.XInfoStreamResponse{}, err
+		return nil, err
 	}
-	return handleXInfoStreamResponse(result)
+	return handleAnyResponse(result)
 }
 
-// Returns detailed information about the stream stored at `key`.
+// Lists the currently active channels.
+//
+// When used in cluster mode, the command is routed to all nodes and aggregates
+// the responses into a single array.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	key  - The key of the stream.
-//	opts - Stream info options.
+//	ctx - The context for controlling the command execution.
 //
 // Return value:
 //
-//	A detailed stream information for the given `key`. See the example for a sample response.
+//	An array of active channel names.
 //
-// [valkey.io]: https://valkey.io/commands/xinfo-stream/
-func (client *baseClient) XInfoStreamFullWithOptions(
-	ctx context.Context,
-	key string,
-	opts options.XInfoStreamOptions,
-) (models.XInfoStreamFullOptionsResponse, error) {
-	args := []string{key, constants.FullKeyword}
-	optionArgs, err := opts.ToArgs()
-	if err != nil {
-		return models.XInfoStreamFullOptionsResponse{}, err
-	}
-	args = append(args, optionArgs...)
-	result, err := client.executeCommand(ctx, C.XInfoStream, args)
+// [valkey.io]: https://valkey.io/commands/pubsub-channels
+func (client *baseClient) PubSubChannels(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubChannels, []string{})
 	if err != nil {
-		return models.XInfoStreamFullOptionsResponse{}, err
+		return nil, err
 	}
-	return handleXInfoStreamFullOptionsResponse(result)
+
+	return handleStringArrayResponse(result)
 }
 
-// Returns the list of all consumers and their attributes for the given consumer group of the
-// stream stored at `key`.
+// Lists the currently active channels matching the specified pattern.
+//
+// Pattern can be any glob-style pattern:
+// - h?llo matches hello, hallo and hxllo
+// - h*llo matches hllo and heeeello
+// - h[ae]llo matches hello and hallo, but not hillo
+//
+// When used in cluster mode, the command is routed to all nodes and aggregates
+// the responses into a single array.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	key   - The key of the stream.
-//	group - The consumer group name.
+//	ctx - The context for controlling the command execution.
+//	pattern - The pattern to match channel names against.
 //
 // Return value:
 //
-//	An array of [models.XInfoConsumerInfo], where each element contains the attributes
-//	of a consumer for the given consumer group of the stream at `key`.
+//	An array of active channel names matching the pattern.
 //
-// [valkey.io]: https://valkey.io/commands/xinfo-consumers/
-func (client *baseClient) XInfoConsumers(ctx context.Context, key string, group string) ([]models.XInfoConsumerInfo, error) {
-	response, err := client.executeCommand(ctx, C.XInfoConsumers, []string{key, group})
+// [valkey.io]: https://valkey.io/commands/pubsub-channels
+func (client *baseClient) PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	args := []string{pattern}
+	result, err := client.executeCommand(ctx, C.PubSubChannels, args)
 	if err != nil {
 		return nil, err
 	}
-	return handleXInfoConsumersResponse(response)
+
+	return handleStringArrayResponse(result)
 }
 
-// Returns the list of all consumer groups and their attributes for the stream stored at `key`.
+// Returns the number of patterns that are subscribed to by clients.
+//
+// This returns the total number of unique patterns that all clients are subscribed to,
+// not the count of clients subscribed to patterns.
+//
+// When used in cluster mode, the command is routed to all nodes and aggregates
+// the responses.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the stream.
 //
 // Return value:
 //
-//	An array of [models.XInfoGroupInfo], where each element represents the
-//	attributes of a consumer group for the stream at `key`.
+//	The number of patterns that are subscribed to by clients.
 //
-// [valkey.io]: https://valkey.io/commands/xinfo-groups/
-func (client *baseClient) XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error) {
-	response, err := client.executeCommand(ctx, C.XInfoGroups, []string{key})
+// [valkey.io]: https://valkey.io/commands/pubsub-numpat
+func (client *baseClient) PubSubNumPat(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.PubSubNumPat, []string{})
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return handleXInfoGroupsResponse(response)
+
+	return handleIntResponse(result)
 }
 
-// Reads or modifies the array of bits representing the string that is held at key
-// based on the specified sub commands.
+// Returns the number of subscribers for the specified channels.
+//
+// The count only includes clients subscribed to exact channels, not pattern subscriptions.
+// If no channels are specified, an empty map is returned.
+//
+// When used in cluster mode, the command is routed to all nodes and aggregates
+// the responses into a single map.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx          - The context for controlling the command execution.
-//	key          - The key of the string.
-//	subCommands  - The subCommands to be performed on the binary value of the string at
-//	                key, which could be any of the following:
-//	                  - [BitFieldGet].
-//	                  - [BitFieldSet].
-//	                  - [BitFieldIncrby].
-//	                  - [BitFieldOverflow].
-//		            Use `options.NewBitFieldGet()` to specify a  BitField GET command.
-//		            Use `options.NewBitFieldSet()` to specify a BitField SET command.
-//		            Use `options.NewBitFieldIncrby()` to specify a BitField INCRYBY command.
-//		            Use `options.BitFieldOverflow()` to specify a BitField OVERFLOW command.
+//	ctx - The context for controlling the command execution.
+//	channels - The channel names to get subscriber counts for.
 //
 // Return value:
 //
-//	Result from the executed subcommands.
-//	  - BitFieldGet returns the value in the binary representation of the string.
-//	  - BitFieldSet returns the previous value before setting the new value in the binary representation.
-//	  - BitFieldIncrBy returns the updated value after increasing or decreasing the bits.
-//	  - BitFieldOverflow controls the behavior of subsequent operations and returns
-//	    a result based on the specified overflow type (WRAP, SAT, FAIL).
+//	A map of channel names to their subscriber counts.
 //
-// [valkey.io]: https://valkey.io/commands/bitfield/
-func (client *baseClient) BitField(
-	ctx context.Context,
-	key string,
-	subCommands []options.BitFieldSubCommands,
-) ([]models.Result[int64], error) {
-	args := make([]string, 0, 10)
-	args = append(args, key)
-
-	for _, cmd := range subCommands {
-		cmdArgs, err := cmd.ToArgs()
-		if err != nil {
-			return nil, err
-		}
-		args = append(args, cmdArgs...)
-	}
-
-	result, err := client.executeCommand(ctx, C.BitField, args)
+// [valkey.io]: https://valkey.io/commands/pubsub-numsub
+func (client *baseClient) PubSubNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	result, err := client.executeCommand(ctx, C.PubSubNumSub, channels)
 	if err != nil {
 		return nil, err
 	}
-	return handleIntOrNilArrayResponse(result)
+
+	return handleStringIntMapResponse(result)
 }
 
-// Reads the array of bits representing the string that is held at key
-// based on the specified  sub commands.
+// Subscribes the client to the specified channels. The messages are delivered to the callback given in the subscription
+// configuration of the client, or to the queue returned by [Client.GetQueue] otherwise. The client must
+// be created with a subscription configuration, which may have no subscriptions.
+//
+// Unlike the subscriptions given in the configuration, the subscriptions made at runtime are restored by the client once
+// it reconnects after a disconnection.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx          - The context for controlling the command execution.
-//	key          - The key of the string.
-//	subCommands  - The read-only subCommands to be performed on the binary value
-//	               of the string at key, which could be:
-//	                 - [BitFieldGet].
-//		           Use `options.NewBitFieldGet()` to specify a BitField GET command.
+//	ctx - The context for controlling the command execution.
+//	channels - The channels to subscribe to.
 //
 // Return value:
 //
-//	Result from the executed GET subcommands.
-//	  - BitFieldGet returns the value in the binary representation of the string.
+//	An error if the client failed to subscribe to any of the channels.
 //
-// [valkey.io]: https://valkey.io/commands/bitfield_ro/
-func (client *baseClient) BitFieldRO(
-	ctx context.Context,
-	key string,
-	commands []options.BitFieldROCommands,
-) ([]models.Result[int64], error) {
-	args := make([]string, 0, 10)
-	args = append(args, key)
-
-	for _, cmd := range commands {
-		cmdArgs, err := cmd.ToArgs()
-		if err != nil {
-			return nil, err
-		}
-		args = append(args, cmdArgs...)
-	}
+// [valkey.io]: https://valkey.io/commands/subscribe/
+func (client *baseClient) Subscribe(ctx context.Context, channels ...string) error {
+	return client.subscribe(ctx, config.ExactClusterChannelMode, channels)
+}
 
-	result, err := client.executeCommand(ctx, C.BitFieldReadOnly, args)
-	if err != nil {
-		return nil, err
-	}
-	return handleIntOrNilArrayResponse(result)
+// Subscribes the client to the specified glob-style patterns. The messages are delivered to the callback given in the
+// subscription configuration of the client, or to the queue returned by [Client.GetQueue] otherwise. The client must
+// be created with a subscription configuration, which may have no subscriptions.
+//
+// Unlike the subscriptions given in the configuration, the subscriptions made at runtime are restored by the client once
+// it reconnects after a disconnection.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	patterns - The patterns to subscribe to.
+//
+// Return value:
+//
+//	An error if the client failed to subscribe to any of the patterns.
+//
+// [valkey.io]: https://valkey.io/commands/psubscribe/
+func (client *baseClient) PSubscribe(ctx context.Context, patterns ...string) error {
+	return client.subscribe(ctx, config.PatternClusterChannelMode, patterns)
 }
 
-// Returns the server time.
+// Unsubscribes the client from the specified channels, or from all the channels it is subscribed to if none are given.
+// The messages already delivered to the queue of the client remain in the queue.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	channels - The channels to unsubscribe from.
 //
 // Return value:
 //
-//	The current server time as a String array with two elements:
-//	A UNIX TIME and the amount of microseconds already elapsed in the current second.
-//	The returned array is in a [UNIX TIME, Microseconds already elapsed] format.
+//	An error if the client failed to unsubscribe from any of the channels.
 //
-// [valkey.io]: https://valkey.io/commands/time/
-func (client *baseClient) Time(ctx context.Context) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.Time, []string{})
-	if err != nil {
-		return nil, err
-	}
-	return handleStringArrayResponse(result)
+// [valkey.io]: https://valkey.io/commands/unsubscribe/
+func (client *baseClient) Unsubscribe(ctx context.Context, channels ...string) error {
+	return client.unsubscribe(ctx, config.ExactClusterChannelMode, channels)
 }
 
-// Returns the intersection of members from sorted sets specified by the given `keys`.
-// To get the elements with their scores, see [Client.ZInterWithScores] or [ClusterClient.ZInterWithScores].
-//
-// Note:
-//
-//	When in cluster mode, all keys must map to the same hash slot.
+// Unsubscribes the client from the specified patterns, or from all the patterns it is subscribed to if none are given.
+// The messages already delivered to the queue of the client remain in the queue.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - The keys of the sorted sets, see - [options.KeyArray].
+//	patterns - The patterns to unsubscribe from.
 //
 // Return value:
 //
-//	The resulting sorted set from the intersection.
+//	An error if the client failed to unsubscribe from any of the patterns.
 //
-// [valkey.io]: https://valkey.io/commands/zinter/
-func (client *baseClient) ZInter(ctx context.Context, keys options.KeyArray) ([]string, error) {
-	args, err := keys.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	result, err := client.executeCommand(ctx, C.ZInter, args)
-	if err != nil {
-		return nil, err
-	}
-	return handleStringArrayResponse(result)
+// [valkey.io]: https://valkey.io/commands/punsubscribe/
+func (client *baseClient) PUnsubscribe(ctx context.Context, patterns ...string) error {
+	return client.unsubscribe(ctx, config.PatternClusterChannelMode, patterns)
 }
 
-// Returns the intersection of members and their scores from sorted sets specified by the given
-// `keysOrWeightedKeys`.
-//
-// Note:
+// Executes a Lua script on the server.
 //
-//	When in cluster mode, all keys in `keysOrWeightedKeys` must map to the same hash slot.
+// This function simplifies the process of invoking scripts on the server by using an object that
+// represents a Lua script. The script loading and execution will all be handled internally. If
+// the script has not already been loaded, it will be loaded automatically using the
+// `SCRIPT LOAD` command. After that, it will be invoked using the `EVALSHA`
+// command.
 //
-// See [valkey.io] for details.
+// See [LOAD] and [EVALSHA] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
-//	                     - Use `options.NewKeyArray()` for keys only.
-//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
-//	options - The options for the ZInter command, see - [options.ZInterOptions].
-//	           Optional `aggregate` option specifies the aggregation strategy to apply when combining the scores of
-//	           elements.
+//	script - The Lua script to execute.
 //
 // Return value:
 //
-//	An array of `models.MemberAndScore` objects, which store member names and their respective scores.
-//	If the sorted set does not exist or is empty, the response will be an empty array.
+//	The result of the script execution.
 //
-// [valkey.io]: https://valkey.io/commands/zinter/
-func (client *baseClient) ZInterWithScores(
-	ctx context.Context,
-	keysOrWeightedKeys options.KeysOrWeightedKeys,
-	zInterOptions options.ZInterOptions,
-) ([]models.MemberAndScore, error) {
-	args, err := keysOrWeightedKeys.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	optionsArgs, err := zInterOptions.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, optionsArgs...)
-	args = append(args, constants.WithScoresKeyword)
-	result, err := client.executeCommand(ctx, C.ZInter, args)
+// [LOAD]: https://valkey.io/commands/script-load/
+// [EVALSHA]: https://valkey.io/commands/evalsha/
+func (client *baseClient) InvokeScript(ctx context.Context, script options.Script) (any, error) {
+	response, err := client.executeScriptWithRoute(ctx, script.GetHash(), []string{}, []string{}, nil)
 	if err != nil {
 		return nil, err
 	}
-	return handleSortedSetWithScoresResponse(result, false)
+
+	return handleAnyResponse(response)
 }
 
-// Computes the intersection of sorted sets given by the specified `keysOrWeightedKeys`
-// and stores the result in `destination`. If `destination` already exists, it is overwritten.
-// Otherwise, a new sorted set will be created.
+// Executes a Lua script on the server with additional options.
+//
+// This function simplifies the process of invoking scripts on the server by using an object that
+// represents a Lua script. The script loading, argument preparation, and execution will all be
+// handled internally. If the script has not already been loaded, it will be loaded automatically
+// using the `SCRIPT LOAD` command. After that, it will be invoked using the
+// `EVALSHA` command.
 //
 // Note:
 //
-//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//	When in cluster mode:
+//	- all `keys` in `scriptOptions` must map to the same hash slot.
+//	- if no `keys` are given, command will be routed to a random primary node.
 //
-// See [valkey.io] for details.
+// See [LOAD] and [EVALSHA] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destination - The destination key for the result.
-//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
-//	                     - Use `options.NewKeyArray()` for keys only.
-//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//	script - The Lua script to execute.
+//	scriptOptions - Options for script execution including keys and arguments.
 //
 // Return value:
 //
-//	The number of elements in the resulting sorted set stored at `destination`.
+//	The result of the script execution.
 //
-// [valkey.io]: https://valkey.io/commands/zinterstore/
-func (client *baseClient) ZInterStore(
+// [LOAD]: https://valkey.io/commands/script-load/
+// [EVALSHA]: https://valkey.io/commands/evalsha/
+func (client *baseClient) InvokeScriptWithOptions(
 	ctx context.Context,
-	destination string,
-	keysOrWeightedKeys options.KeysOrWeightedKeys,
-) (int64, error) {
-	return client.ZInterStoreWithOptions(ctx, destination, keysOrWeightedKeys, *options.NewZInterOptions())
+	script options.Script,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	keys := scriptOptions.Keys
+	args := scriptOptions.Args
+
+	response, err := client.executeScriptWithRoute(ctx, script.GetHash(), keys, args, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleAnyResponse(response)
 }
 
-// Computes the intersection of sorted sets given by the specified `keysOrWeightedKeys`
-// and stores the result in `destination`. If `destination` already exists, it is overwritten.
-// Otherwise, a new sorted set will be created.
-//
-// Note:
-//
-//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
-//
-// See [valkey.io] for details.
+// executeScriptWithRoute executes a Lua script with the given hash, keys, args, and routing information.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destination - The destination key for the result.
-//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
-//	                     - Use `options.NewKeyArray()` for keys only.
-//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
-//	options - The options for the ZInterStore command, see - [options.ZInterOptions].
-//	          Optional `aggregate` option specifies the aggregation strategy to apply when combining the scores of
-//	          elements.
+//	hash - The SHA1 hash of the script to execute.
+//	keys - The keys that the script will access.
+//	args - The arguments to pass to the script.
+//	route - Optional routing information for the script execution.
 //
 // Return value:
 //
-//	The number of elements in the resulting sorted set stored at `destination`.
-//
-// [valkey.io]: https://valkey.io/commands/zinterstore/
-func (client *baseClient) ZInterStoreWithOptions(
+//	A CommandResponse containing the result of the script execution.
+func (client *baseClient) executeScriptWithRoute(
 	ctx context.Context,
-	destination string,
-	keysOrWeightedKeys options.KeysOrWeightedKeys,
-	zInterOptions options.ZInterOptions,
-) (int64, error) {
-	args, err := keysOrWeightedKeys.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
+	hash string,
+	keys []string,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	// Check if context is already done
+	select {
+	case <-ctx.Done():
+		return nil, contextError(ctx)
+	default:
+		// Continue with execution
+	}
+	if err := client.connect(ctx); err != nil {
+		return nil, err
+	}
+	var cKeysPtr *C.uintptr_t = nil
+	var keysLengthsPtr *C.ulong = nil
+	if len(keys) > 0 {
+		cKeys, keysLengths := toCStrings(keys)
+		cKeysPtr = &cKeys[0]
+		keysLengthsPtr = &keysLengths[0]
+	}
+
+	var cArgsPtr *C.uintptr_t = nil
+	var argsLengthsPtr *C.ulong = nil
+	if len(args) > 0 {
+		cArgs, argsLengths := toCStrings(args)
+		cArgsPtr = &cArgs[0]
+		argsLengthsPtr = &argsLengths[0]
+	}
+
+	var routeBytesPtr *C.uchar = nil
+	var routeBytesCount C.uintptr_t = 0
+	if route != nil {
+		routeProto, err := routeToProtobuf(route)
+		if err != nil {
+			return nil, errors.New("ExecuteScript failed due to invalid route")
+		}
+		msg, err := proto.Marshal(routeProto)
+		if err != nil {
+			return nil, err
+		}
+
+		routeBytesCount = C.uintptr_t(len(msg))
+		routeCBytes := C.CBytes(msg)
+		defer C.free(routeCBytes)
+		routeBytesPtr = (*C.uchar)(routeCBytes)
+	}
+
+	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
+	resultChannel := make(chan payload, 1)
+	resultChannelPtr := unsafe.Pointer(&resultChannel)
+
+	pinner := pinner{}
+	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
+	defer pinner.Unpin()
+
+	if err := client.inflight.acquire(ctx); err != nil {
+		return nil, err
+	}
+	client.mu.Lock()
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		client.inflight.release()
+		return nil, NewClosingError("ExecuteScript failed. The client is closed.")
+	}
+	client.pending[resultChannelPtr] = struct{}{}
+	hash_cstring := C.CString(hash)
+	defer C.free(unsafe.Pointer(hash_cstring))
+	C.invoke_script(
+		client.coreClient,
+		C.uintptr_t(pinnedChannelPtr),
+		// Only the requests whose context can be done are registered for cancellation in the core
+		C._Bool(ctx.Done() != nil),
+		hash_cstring,
+		C.size_t(len(keys)),
+		cKeysPtr,
+		keysLengthsPtr,
+		C.size_t(len(args)),
+		cArgsPtr,
+		argsLengthsPtr,
+		routeBytesPtr,
+		routeBytesCount,
+		C.uint32_t(requestTimeout(ctx)),
+	)
+	client.mu.Unlock()
+
+	// Wait for result or context cancellation
+	var payload payload
+	select {
+	case <-ctx.Done():
+		client.mu.Lock()
+		if client.pending != nil {
+			delete(client.pending, resultChannelPtr)
+		}
+		coreClient := client.coreClient
+		client.mu.Unlock()
+		// Abort the request in the core, which then replies with an error
+		if coreClient != nil {
+			C.cancel_request(coreClient, C.uintptr_t(pinnedChannelPtr))
+		}
+		// Start cleanup goroutine
+		go func() {
+			defer client.inflight.release()
+			// Wait for payload on separate channel
+			if payload := <-resultChannel; payload.value != nil {
+				C.free_command_response(payload.value)
+			}
+		}()
+		return nil, contextError(ctx)
+	case payload = <-resultChannel:
+		client.inflight.release()
+		// Continue with normal processing
 	}
-	args = append([]string{destination}, args...)
-	optionsArgs, err := zInterOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
+
+	client.mu.Lock()
+	if client.pending != nil {
+		delete(client.pending, resultChannelPtr)
 	}
-	args = append(args, optionsArgs...)
-	result, err := client.executeCommand(ctx, C.ZInterStore, args)
-	if err != nil {
-		return models.DefaultIntResponse, err
+	client.mu.Unlock()
+
+	if payload.error != nil {
+		return nil, requestError(ctx, payload.error)
 	}
-	return handleIntResponse(result)
+	return payload.value, nil
 }
 
-// Returns the difference between the first sorted set and all the successive sorted sets.
-// To get the elements with their scores, see [Client.ZDiffWithScores] or [ClusterClient.ZDiffWithScores].
-//
-// Note:
-//
-//	When in cluster mode, all `keys` must map to the same hash slot.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Checks existence of scripts in the script cache by their SHA1 digest.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sorted sets.
+//	ctx - The context for controlling the command execution.
+//	sha1s - SHA1 digests of Lua scripts to be checked.
 //
 // Return value:
 //
-//	An array of elements representing the difference between the sorted sets.
-//	If the first `key` does not exist, it is treated as an empty sorted set, and the
-//	command returns an empty array.
+//	An array of boolean values indicating the existence of each script.
 //
-// [valkey.io]: https://valkey.io/commands/zdiff/
-func (client *baseClient) ZDiff(ctx context.Context, keys []string) ([]string, error) {
-	args := append([]string{}, strconv.Itoa(len(keys)))
-	result, err := client.executeCommand(ctx, C.ZDiff, append(args, keys...))
+// [valkey.io]: https://valkey.io/commands/script-exists
+func (client *baseClient) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
+	response, err := client.executeCommand(ctx, C.ScriptExists, sha1s)
 	if err != nil {
 		return nil, err
 	}
-	return handleStringArrayResponse(result)
+
+	return handleBoolArrayResponse(response)
 }
 
-// Returns the difference between the first sorted set and all the successive sorted sets.
-//
-// Note:
-//
-//	When in cluster mode, all `keys` must map to the same hash slot.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Removes all the scripts from the script cache.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sorted sets.
+//	ctx - The context for controlling the command execution.
 //
 // Return value:
 //
-//	An array of elements and their scores representing the difference between the sorted sets.
-//	If the first `key` does not exist, it is treated as an empty sorted set, and the
-//	command returns an empty array.
+//	OK on success.
 //
-// [valkey.io]: https://valkey.io/commands/zdiff/
-func (client *baseClient) ZDiffWithScores(ctx context.Context, keys []string) ([]models.MemberAndScore, error) {
-	args := append([]string{}, strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	result, err := client.executeCommand(ctx, C.ZDiff, append(args, constants.WithScoresKeyword))
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *baseClient) ScriptFlush(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptFlush, []string{})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleSortedSetWithScoresResponse(result, false)
+	return handleOkResponse(result)
 }
 
-// Calculates the difference between the first sorted set and all the successive sorted sets at
-// `keys` and stores the difference as a sorted set to `destination`,
-// overwriting it if it already exists. Non-existent keys are treated as empty sets.
-//
-// Note:
-//
-//	When in cluster mode, `destination` and all `keys` must map to the same hash slot.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Removes all the scripts from the script cache with the specified flush mode.
+// The mode can be either SYNC or ASYNC.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx         - The context for controlling the command execution.
-//	destination - The key for the resulting sorted set.
-//	keys        - The keys of the sorted sets to compare.
+//	ctx  - The context for controlling the command execution.
+//	mode - The flush mode (SYNC or ASYNC).
 //
 // Return value:
 //
-//	The number of members in the resulting sorted set stored at `destination`.
+//	OK on success.
 //
-// [valkey.io]: https://valkey.io/commands/zdiffstore/
-func (client *baseClient) ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error) {
-	result, err := client.executeCommand(ctx,
-		C.ZDiffStore,
-		append([]string{destination, strconv.Itoa(len(keys))}, keys...),
-	)
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *baseClient) ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptFlush, []string{string(mode)})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultStringResponse, err
 	}
-	return handleIntResponse(result)
+	return handleOkResponse(result)
 }
 
-// Returns the union of members from sorted sets specified by the given `keys`.
-// To get the elements with their scores, see [Client.ZUnionWithScores] or [ClusterClient.ZUnionWithScores].
+// ScriptShow returns the original source code of a script in the script cache.
 //
 // Since:
 //
-//	Valkey 6.2.0 and above.
-//
-// Note:
-//
-//	When in cluster mode, all keys must map to the same hash slot.
+//	Valkey 8.0.0
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx  - The context for controlling the command execution.
-//	keys - The keys of the sorted sets.
+//	sha1 - The SHA1 digest of the script.
 //
-// Return Value:
+// Return value:
 //
-//	The resulting sorted set from the union.
+//	The original source code of the script, if present in the cache.
+//	If the script is not found in the cache, an error is thrown.
 //
-// [valkey.io]: https://valkey.io/commands/zunion/
-func (client *baseClient) ZUnion(ctx context.Context, keys options.KeyArray) ([]string, error) {
-	args, err := keys.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	result, err := client.executeCommand(ctx, C.ZUnion, args)
+// [valkey.io]: https://valkey.io/commands/script-show
+func (client *baseClient) ScriptShow(ctx context.Context, sha1 string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptShow, []string{sha1})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleStringArrayResponse(result)
+	return handleStringResponse(result)
 }
 
-// Returns the union of members and their scores from sorted sets specified by the given
-// `keysOrWeightedKeys`.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Kills the currently executing Lua script, assuming no write operation was yet performed by the
+// script.
 //
 // Note:
 //
-//	When in cluster mode, all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//	When in cluster mode, this command will be routed to all nodes.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keysOrWeightedKeys - The keys of the sorted sets with possible formats:
-//	                     - Use `KeyArray` for keys only.
-//	                     - Use `WeightedKeys` for weighted keys with score multipliers.
-//	zUnionOptions - The options for the ZUnionStore command, see - [options.ZUnionOptions].
-//	                Optional `aggregate` option specifies the aggregation strategy to apply when
-//	                combining the scores of elements.
 //
-// Return Value:
+// Return value:
 //
-//	The resulting sorted set from the union.
+//	`OK` if script is terminated. Otherwise, throws an error.
 //
-// [valkey.io]: https://valkey.io/commands/zunion/
-func (client *baseClient) ZUnionWithScores(
-	ctx context.Context,
-	keysOrWeightedKeys options.KeysOrWeightedKeys,
-	zUnionOptions options.ZUnionOptions,
-) ([]models.MemberAndScore, error) {
-	args, err := keysOrWeightedKeys.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	optionsArgs, err := zUnionOptions.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, optionsArgs...)
-	args = append(args, constants.WithScoresKeyword)
-	result, err := client.executeCommand(ctx, C.ZUnion, args)
+// [valkey.io]: https://valkey.io/commands/script-kill
+func (client *baseClient) ScriptKill(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptKill, []string{})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleSortedSetWithScoresResponse(result, false)
+	return handleOkResponse(result)
 }
 
-// Computes the union of sorted sets given by the specified `KeysOrWeightedKeys`, and
-// stores the result in `destination`. If `destination` already exists, it
-// is overwritten. Otherwise, a new sorted set will be created.
+// Executes a Lua script on the server with `EVAL`.
+//
+// Unlike [Client.InvokeScript], the script is sent to the server with each call. Prefer [Client.EvalSha] with a
+// script loaded by [Client.ScriptLoad] for the scripts invoked frequently.
 //
 // Note:
 //
-//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
+//	When in cluster mode, the command will be routed to a random primary node.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destination - The key of the destination sorted set.
-//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
-//	                   - Use `options.NewKeyArray()` for keys only.
-//	                   - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
+//	script - The source code of the Lua script.
 //
-// Return Value:
+// Return value:
 //
-//	The number of elements in the resulting sorted set stored at `destination`.
+//	The result of the script execution.
 //
-// [valkey.io]: https://valkey.io/commands/zunionstore/
-func (client *baseClient) ZUnionStore(
-	ctx context.Context,
-	destination string,
-	keysOrWeightedKeys options.KeysOrWeightedKeys,
-) (int64, error) {
-	return client.ZUnionStoreWithOptions(ctx, destination, keysOrWeightedKeys, *options.NewZUnionOptions())
+// [valkey.io]: https://valkey.io/commands/eval/
+func (client *baseClient) Eval(ctx context.Context, script string) (any, error) {
+	return client.EvalWithOptions(ctx, script, *options.NewScriptOptions())
 }
 
-// Computes the union of sorted sets given by the specified `KeysOrWeightedKeys`, and
-// stores the result in `destination`. If `destination` already exists, it
-// is overwritten. Otherwise, a new sorted set will be created.
+// Executes a Lua script on the server with `EVAL`, with keys and arguments.
 //
 // Note:
 //
-//	When in cluster mode, `destination` and all keys in `keysOrWeightedKeys` must map to the same hash slot.
-//
-// See [valkey.io] for details.
-//
-// Parameters:
-//
-//	ctx - The context for controlling the command execution.
-//	destination - The key of the destination sorted set.
-//	keysOrWeightedKeys - The keys or weighted keys of the sorted sets, see - [options.KeysOrWeightedKeys].
-//	                     - Use `options.NewKeyArray()` for keys only.
-//	                     - Use `options.NewWeightedKeys()` for weighted keys with score multipliers.
-//	zUnionOptions - The options for the ZUnionStore command, see - [options.ZUnionOptions].
-//	                Optional `aggregate` option specifies the aggregation strategy to apply when
-//	                combining the scores of elements.
-//
-// Return Value:
-//
-//	The number of elements in the resulting sorted set stored at `destination`.
-//
-// [valkey.io]: https://valkey.io/commands/zunionstore/
-func (client *baseClient) ZUnionStoreWithOptions(
-	ctx context.Context,
-	destination string,
-	keysOrWeightedKeys options.KeysOrWeightedKeys,
-	zUnionOptions options.ZUnionOptions,
-) (int64, error) {
-	keysArgs, err := keysOrWeightedKeys.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args := append([]string{destination}, keysArgs...)
-	optionsArgs, err := zUnionOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args = append(args, optionsArgs...)
-	result, err := client.executeCommand(ctx, C.ZUnionStore, args)
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	return handleIntResponse(result)
-}
-
-// Returns the cardinality of the intersection of the sorted sets specified by `keys`.
+//	When in cluster mode:
+//	- all `keys` in `scriptOptions` must map to the same hash slot.
+//	- if no `keys` are given, command will be routed to a random primary node.
 //
-// Note:
+// See [valkey.io] for details.
 //
-// When in cluster mode, all keys must map to the same hash slot.
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The source code of the Lua script.
+//	scriptOptions - Options for script execution including keys and arguments.
+//
+// Return value:
+//
+//	The result of the script execution.
+//
+// [valkey.io]: https://valkey.io/commands/eval/
+func (client *baseClient) EvalWithOptions(
+	ctx context.Context,
+	script string,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return client.eval(ctx, C.Eval, script, scriptOptions)
+}
+
+// Executes a read-only Lua script on the server with `EVAL_RO`. The command can be routed to the replicas, and the
+// script fails if it modifies the data.
 //
 // Since:
 //
@@ -7546,24 +10151,23 @@ func (client *baseClient) ZUnionStoreWithOptions(
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - The keys of the sorted sets.
+//	script - The source code of the Lua script.
 //
 // Return value:
 //
-//	The cardinality of the intersection of the sorted sets.
+//	The result of the script execution.
 //
-// [valkey.io]: https://valkey.io/commands/zintercard/
-func (client *baseClient) ZInterCard(ctx context.Context, keys []string) (int64, error) {
-	return client.ZInterCardWithOptions(ctx, keys, *options.NewZInterCardOptions())
+// [valkey.io]: https://valkey.io/commands/eval_ro/
+func (client *baseClient) EvalReadOnly(ctx context.Context, script string) (any, error) {
+	return client.EvalReadOnlyWithOptions(ctx, script, *options.NewScriptOptions())
 }
 
-// Returns the cardinality of the intersection of the sorted sets specified by `keys`.
-// If the intersection cardinality reaches `options.limit` partway through the computation, the
-// algorithm will exit early and yield `options.limit` as the cardinality.
+// Executes a read-only Lua script on the server with `EVAL_RO`, with keys and arguments. The command can be routed to
+// the replicas, and the script fails if it modifies the data.
 //
 // Note:
 //
-// When in cluster mode, all keys must map to the same hash slot.
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
 //
 // Since:
 //
@@ -7574,867 +10178,639 @@ func (client *baseClient) ZInterCard(ctx context.Context, keys []string) (int64,
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - The keys of the sorted sets.
-//	options - The options for the ZInterCard command, see - [options.ZInterCardOptions].
+//	script - The source code of the Lua script.
+//	scriptOptions - Options for script execution including keys and arguments.
 //
 // Return value:
 //
-//	The cardinality of the intersection of the sorted sets.
+//	The result of the script execution.
 //
-// [valkey.io]: https://valkey.io/commands/zintercard/
-func (client *baseClient) ZInterCardWithOptions(
+// [valkey.io]: https://valkey.io/commands/eval_ro/
+func (client *baseClient) EvalReadOnlyWithOptions(
 	ctx context.Context,
-	keys []string,
-	options options.ZInterCardOptions,
-) (int64, error) {
-	args := append([]string{strconv.Itoa(len(keys))}, keys...)
-	optionsArgs, err := options.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args = append(args, optionsArgs...)
-	result, err := client.executeCommand(ctx, C.ZInterCard, args)
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	return handleIntResponse(result)
+	script string,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return client.eval(ctx, C.EvalReadOnly, script, scriptOptions)
 }
 
-// Returns the number of elements in the sorted set at key with a value between min and max.
+// Executes a Lua script loaded on the server, by its SHA1 digest, with `EVALSHA`.
+//
+// Unlike [Client.InvokeScript], the client doesn't load the script when it's missing, and returns a
+// [NoScriptError] instead.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	rangeQuery - The range query to apply to the sorted set.
+//	sha1 - The SHA1 digest of the script, as returned by [Client.ScriptLoad].
 //
 // Return value:
 //
-//	The number of elements in the sorted set at key with a value between min and max.
+//	The result of the script execution, or a [NoScriptError] if the script isn't loaded.
 //
-// [valkey.io]: https://valkey.io/commands/zlexcount/
-func (client *baseClient) ZLexCount(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
-	args := []string{key}
-	args = append(args, rangeQuery.ToArgsLexCount()...)
-	result, err := client.executeCommand(ctx, C.ZLexCount, args)
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	return handleIntResponse(result)
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *baseClient) EvalSha(ctx context.Context, sha1 string) (any, error) {
+	return client.EvalShaWithOptions(ctx, sha1, *options.NewScriptOptions())
 }
 
-// Blocks the connection until it pops and returns a member-score pair
-// with the highest score from the first non-empty sorted set.
-// The given `keys` being checked in the order they are provided.
-// `BZPopMax` is the blocking variant of [Client.ZPopMax] and [ClusterClient.ZPopMax].
+// Executes a Lua script loaded on the server, by its SHA1 digest, with `EVALSHA`, with keys and arguments.
 //
 // Note:
-//   - When in cluster mode, all keys must map to the same hash slot.
-//   - `BZPopMax` is a client blocking command, see [Blocking Commands] for more details and best practices.
+//
+//	When in cluster mode:
+//	- all `keys` in `scriptOptions` must map to the same hash slot.
+//	- if no `keys` are given, command will be routed to a random primary node.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - An array of keys to check for elements.
-//	timeout - The maximum number of seconds to block (0 blocks indefinitely).
+//	sha1 - The SHA1 digest of the script, as returned by [Client.ScriptLoad].
+//	scriptOptions - Options for script execution including keys and arguments.
 //
 // Return value:
 //
-//	A `models.KeyWithMemberAndScore` struct containing the key from which the member was popped,
-//	the popped member, and its score. If no element could be popped and the timeout expired,
-//	returns `nil`.
+//	The result of the script execution, or a [NoScriptError] if the script isn't loaded.
 //
-// [valkey.io]: https://valkey.io/commands/bzpopmax/
-// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
-func (client *baseClient) BZPopMax(
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *baseClient) EvalShaWithOptions(
 	ctx context.Context,
-	keys []string,
-	timeout time.Duration,
-) (models.Result[models.KeyWithMemberAndScore], error) {
-	args := append(keys, utils.FloatToString(timeout.Seconds()))
-
-	result, err := client.executeCommand(ctx, C.BZPopMax, args)
-	if err != nil {
-		return models.CreateNilKeyWithMemberAndScoreResult(), err
-	}
+	sha1 string,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return client.eval(ctx, C.EvalSha, sha1, scriptOptions)
+}
 
-	return handleKeyWithMemberAndScoreResponse(result)
+// Executes a read-only Lua script loaded on the server, by its SHA1 digest, with `EVALSHA_RO`. The command can be
+// routed to the replicas, and the script fails if it modifies the data.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1 - The SHA1 digest of the script, as returned by [Client.ScriptLoad].
+//
+// Return value:
+//
+//	The result of the script execution, or a [NoScriptError] if the script isn't loaded.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha_ro/
+func (client *baseClient) EvalShaReadOnly(ctx context.Context, sha1 string) (any, error) {
+	return client.EvalShaReadOnlyWithOptions(ctx, sha1, *options.NewScriptOptions())
 }
 
-// Removes and returns up to `count` members from the first non-empty sorted set
-// among the provided `keys`, based on the specified `scoreFilter` criteria.
+// Executes a read-only Lua script loaded on the server, by its SHA1 digest, with `EVALSHA_RO`, with keys and
+// arguments. The command can be routed to the replicas, and the script fails if it modifies the data.
 //
 // Note:
 //
-// When in cluster mode, all keys must map to the same hash slot.
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - A list of keys representing sorted sets to check for elements.
-//	scoreFilter - Pop criteria - either [constants.MIN] or [constants.MAX] to pop members with the lowest/highest scores.
-//	opts - Additional options, such as specifying the maximum number of elements to pop.
+//	sha1 - The SHA1 digest of the script, as returned by [Client.ScriptLoad].
+//	scriptOptions - Options for script execution including keys and arguments.
 //
 // Return value:
 //
-//	A `Result` containing a `models.KeyWithArrayOfMembersAndScores` object.
-//	If no elements could be popped from the provided keys, returns `nil`.
+//	The result of the script execution, or a [NoScriptError] if the script isn't loaded.
 //
-// [valkey.io]: https://valkey.io/commands/zmpop/
-func (client *baseClient) ZMPopWithOptions(
+// [valkey.io]: https://valkey.io/commands/evalsha_ro/
+func (client *baseClient) EvalShaReadOnlyWithOptions(
 	ctx context.Context,
-	keys []string,
-	scoreFilter constants.ScoreFilter,
-	opts options.ZMPopOptions,
-) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
-	scoreFilterStr, err := scoreFilter.ToString()
-	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
-	}
-
-	optArgs, err := opts.ToArgs()
-	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
-	}
-
-	args := append([]string{strconv.Itoa(len(keys))}, keys...)
-	args = append(args, scoreFilterStr)
-	args = append(args, optArgs...)
+	sha1 string,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	return client.eval(ctx, C.EvalShaReadOnly, sha1, scriptOptions)
+}
 
-	result, err := client.executeCommand(ctx, C.ZMPop, args)
+func (client *baseClient) eval(
+	ctx context.Context,
+	requestType C.RequestType,
+	scriptOrSha1 string,
+	scriptOptions options.ScriptOptions,
+) (any, error) {
+	result, err := client.executeCommand(ctx, requestType, scriptArgs(scriptOrSha1, scriptOptions.Keys, scriptOptions.Args))
 	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+		return nil, err
 	}
+	return handleAnyResponse(result)
+}
 
-	return handleKeyWithArrayOfMembersAndScoresResponse(result)
+// The arguments of `EVAL` and of its variants: the script or its SHA1 digest, the number of keys, the keys and the
+// arguments.
+func scriptArgs(scriptOrSha1 string, keys []string, args []string) []string {
+	commandArgs := make([]string, 0, 2+len(keys)+len(args))
+	commandArgs = append(commandArgs, scriptOrSha1, strconv.Itoa(len(keys)))
+	commandArgs = append(commandArgs, keys...)
+	return append(commandArgs, args...)
 }
 
-// Pops one or more member-score pairs from the first non-empty sorted set,
-// with the given keys being checked in the order provided.
+// Loads a Lua script into the script cache of the server, without executing it. The script can then be executed by its
+// SHA1 digest with [Client.EvalSha].
 //
 // Note:
 //
-// When in cluster mode, all keys must map to the same hash slot.
+//	When in cluster mode, the command will be routed to all nodes.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	keys - An array of keys to check for elements.
-//	scoreFilter - Pop criteria - either [constants.MIN] or [constants.MAX] to pop members with the lowest/highest scores.
+//	script - The source code of the Lua script.
 //
 // Return value:
 //
-//	A `models.KeyWithArrayOfMembersAndScores` struct containing:
-//	- The key from which the elements were popped.
-//	- An array of member-score pairs of the popped elements.
-//	  Returns `nil` if no member could be popped.
+//	The SHA1 digest of the script.
 //
-// [valkey.io]: https://valkey.io/commands/zmpop/
-func (client *baseClient) ZMPop(
-	ctx context.Context,
-	keys []string,
-	scoreFilter constants.ScoreFilter,
-) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
-	scoreFilterStr, err := scoreFilter.ToString()
+// [valkey.io]: https://valkey.io/commands/script-load/
+func (client *baseClient) ScriptLoad(ctx context.Context, script string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ScriptLoad, []string{script})
 	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+		return models.DefaultStringResponse, err
 	}
+	return handleStringResponse(result)
+}
 
-	args := make([]string, 0, len(keys)+3)
-	args = append(args, strconv.Itoa(len(keys)))
-	args = append(args, keys...)
-	args = append(args, scoreFilterStr)
-
-	result, err := client.executeCommand(ctx, C.ZMPop, args)
+// Marks the given keys to be watched for conditional execution of an atomic batch (Transaction).
+// Transactions will only execute commands if the watched keys are not modified before execution of the
+// transaction.
+//
+// See [valkey.io] and [Valkey Glide Wiki] for details.
+//
+// Note:
+//
+//	In cluster mode, if keys in `keys` map to different hash slots,
+//	the command will be split across these slots and executed separately for each.
+//	This means the command is atomic only at the slot level. If one or more slot-specific
+//	requests fail, the entire call will return the first encountered error, even
+//	though some requests may have succeeded while others did not.
+//	If this behavior impacts your application logic, consider splitting the
+//	request into sub-requests per slot to ensure atomicity.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys to watch.
+//
+// Return value:
+//
+//	A simple "OK" response.
+//
+// [valkey.io]: https://valkey.io/commands/watch
+// [Valkey Glide Wiki]: https://valkey.io/topics/transactions/#cas
+func (client *baseClient) Watch(ctx context.Context, keys []string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Watch, keys)
 	if err != nil {
-		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+		return models.DefaultStringResponse, err
 	}
-
-	return handleKeyWithArrayOfMembersAndScoresResponse(result)
+	return handleOkResponse(result)
 }
 
-// Adds geospatial members with their positions to the specified sorted set stored at `key`.
-// If a member is already a part of the sorted set, its position is updated.
+// Returns the ACL categories.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	membersToGeospatialData - A map of member names to their corresponding positions. See [options.GeospatialData].
-//	  The command will report an error when index coordinates are out of the specified range.
 //
 // Return value:
 //
-//	The number of elements added to the sorted set.
+//	An array of the ACL categories, e.g. `keyspace`, `read` or `pubsub`.
 //
-// [valkey.io]: https://valkey.io/commands/geoadd/
-func (client *baseClient) GeoAdd(
-	ctx context.Context,
-	key string,
-	membersToGeospatialData map[string]options.GeospatialData,
-) (int64, error) {
-	result, err := client.executeCommand(ctx,
-		C.GeoAdd,
-		append([]string{key}, options.MapGeoDataToArray(membersToGeospatialData)...),
-	)
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *baseClient) AclCat(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclCat, []string{})
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return nil, err
 	}
-	return handleIntResponse(result)
+	return handleStringArrayResponse(result)
 }
 
-// Adds geospatial members with their positions to the specified sorted set stored at `key`.
-// If a member is already a part of the sorted set, its position is updated.
+// Returns the commands of the given ACL category.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	membersToGeospatialData - A map of member names to their corresponding positions. See [options.GeospatialData].
-//	  The command will report an error when index coordinates are out of the specified range.
-//	geoAddOptions - The options for the GeoAdd command, see - [options.GeoAddOptions].
+//	category - The ACL category, as returned by [Client.AclCat] or [ClusterClient.AclCat].
+//
+// Return value:
+//
+//	An array of the commands, including the subcommands, of the category.
+//
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *baseClient) AclCatWithCategory(ctx context.Context, category string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclCat, []string{category})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Deletes the ACL users and terminates their connections. Users which don't exist are ignored.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	usernames - The usernames of the users to delete.
 //
 // Return value:
 //
-//	The number of elements added to the sorted set.
+//	The number of users that were deleted.
 //
-// [valkey.io]: https://valkey.io/commands/geoadd/
-func (client *baseClient) GeoAddWithOptions(
-	ctx context.Context,
-	key string,
-	membersToGeospatialData map[string]options.GeospatialData,
-	geoAddOptions options.GeoAddOptions,
-) (int64, error) {
-	args := []string{key}
-	optionsArgs, err := geoAddOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args = append(args, optionsArgs...)
-	args = append(args, options.MapGeoDataToArray(membersToGeospatialData)...)
-	result, err := client.executeCommand(ctx, C.GeoAdd, args)
+// [valkey.io]: https://valkey.io/commands/acl-deluser/
+func (client *baseClient) AclDelUser(ctx context.Context, usernames []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.AclDelUser, usernames)
 	if err != nil {
 		return models.DefaultIntResponse, err
 	}
 	return handleIntResponse(result)
 }
 
-// Returns the GeoHash strings representing the positions of all the specified
-// `members` in the sorted set stored at the `key`.
+// Simulates the execution of a command by a user, without executing it. Allows to check the permissions of a user.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key -  The key of the sorted set.
-//	members - The array of members whose GeoHash strings are to be retrieved.
+//	username - The username of the user.
+//	command - The command to simulate.
+//	args - The arguments of the command.
 //
-// Returns value:
+// Return value:
 //
-//	An array of GeoHash strings (of type models.Result[string]) representing the positions of the specified
-//	members stored at key. If a member does not exist in the sorted set, a `nil` value is returned
-//	for that member.
+//	`"OK"` if the user is allowed to execute the command, otherwise a message explaining why it's denied.
 //
-// [valkey.io]: https://valkey.io/commands/geohash/
-func (client *baseClient) GeoHash(ctx context.Context, key string, members []string) ([]models.Result[string], error) {
-	result, err := client.executeCommand(ctx,
-		C.GeoHash,
-		append([]string{key}, members...),
-	)
+// [valkey.io]: https://valkey.io/commands/acl-dryrun/
+func (client *baseClient) AclDryRun(ctx context.Context, username string, command string, args []string) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclDryRun, append([]string{username, command}, args...))
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleStringOrNilArrayResponse(result)
+	res, err := handleOkOrStringOrNilResponse(result)
+	return res.Value(), err
 }
 
-// Returns the positions (longitude,latitude) of all the specified members of the
-// geospatial index represented by the sorted set at key.
+// Generates a pseudorandom secure password of 256 bits, which can be used for an ACL user.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	members - The members of the sorted set.
 //
 // Return value:
 //
-//	A 2D `array` which represent positions (longitude and latitude) corresponding to the given members.
-//	If a member does not exist, its position will be `nil`.
+//	A hexadecimal password of 64 characters.
 //
-// [valkey.io]: https://valkey.io/commands/geopos/
-func (client *baseClient) GeoPos(ctx context.Context, key string, members []string) ([][]float64, error) {
-	args := []string{key}
-	args = append(args, members...)
-	result, err := client.executeCommand(ctx, C.GeoPos, args)
+// [valkey.io]: https://valkey.io/commands/acl-genpass/
+func (client *baseClient) AclGenPass(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclGenPass, []string{})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handle2DFloat64OrNullArrayResponse(result)
+	return handleStringResponse(result)
 }
 
-// Returns the distance between `member1` and `member2` saved in the
-// geospatial index stored at `key`.
+// Generates a pseudorandom secure password of the given number of bits, which can be used for an ACL user.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	member1 - The name of the first member.
-//	member2 - The name of the second member.
+//	bits - The number of bits of the password, rounded to the next multiple of 4. Must be between 1 and 4096.
 //
 // Return value:
 //
-//	The distance between `member1` and `member2`. If one or both members do not exist,
-//	or if the key does not exist, returns `nil`. The default unit is meters, see - [options.Meters]
+//	A hexadecimal password of `bits / 4` characters.
 //
-// [valkey.io]: https://valkey.io/commands/geodist/
-func (client *baseClient) GeoDist(
-	ctx context.Context,
-	key string,
-	member1 string,
-	member2 string,
-) (models.Result[float64], error) {
-	result, err := client.executeCommand(ctx,
-		C.GeoDist,
-		[]string{key, member1, member2},
-	)
+// [valkey.io]: https://valkey.io/commands/acl-genpass/
+func (client *baseClient) AclGenPassWithBits(ctx context.Context, bits int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclGenPass, []string{utils.IntToString(bits)})
 	if err != nil {
-		return models.CreateNilFloat64Result(), err
+		return models.DefaultStringResponse, err
 	}
-	return handleFloatOrNilResponse(result)
+	return handleStringResponse(result)
 }
 
-// Returns the distance between `member1` and `member2` saved in the
-// geospatial index stored at `key`.
+// Returns the rules of an ACL user.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	member1 - The name of the first member.
-//	member2 - The name of the second member.
-//	unit - The unit of distance measurement - see [options.GeoUnit].
+//	username - The username of the user.
 //
 // Return value:
 //
-//	The distance between `member1` and `member2`. If one or both members
-//	do not exist, or if the key does not exist, returns `nil`.
+//	A [models.AclUser] describing the rules of the user, or a `nil` result if the user doesn't exist.
 //
-// [valkey.io]: https://valkey.io/commands/geodist/
-func (client *baseClient) GeoDistWithUnit(
-	ctx context.Context,
-	key string,
-	member1 string,
-	member2 string,
-	unit constants.GeoUnit,
-) (models.Result[float64], error) {
-	result, err := client.executeCommand(ctx,
-		C.GeoDist,
-		[]string{key, member1, member2, string(unit)},
-	)
+// [valkey.io]: https://valkey.io/commands/acl-getuser/
+func (client *baseClient) AclGetUser(ctx context.Context, username string) (models.Result[models.AclUser], error) {
+	result, err := client.executeCommand(ctx, C.AclGetUser, []string{username})
 	if err != nil {
-		return models.CreateNilFloat64Result(), err
+		return models.CreateNilResultOf[models.AclUser](), err
 	}
-	return handleFloatOrNilResponse(result)
+	return handleAclUserResponse(result)
 }
 
-// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
-// which are within the borders of the area specified by a given shape.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Returns the rules of all the ACL users, in the format of the ACL configuration file.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	searchFrom - The query's center point options, could be one of:
-//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
-//		- `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		- `BYRADIUS` to search inside circular area according to given radius.
-//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
-//	resultOptions - Optional inputs for sorting/limiting the results.
-//	infoOptions - The optional inputs to request additional information.
 //
 // Return value:
 //
-//	An array of [options.Location] containing the following information:
-//	 - The coordinates as a [options.GeospatialData] object.
-//	 - The member (location) name.
-//	 - The distance from the center as a `float64`, in the same unit specified for `searchByShape`.
-//	 - The geohash of the location as a `int64`.
+//	An array of the rules of each user, e.g. `user default on nopass ~* &* +@all`.
 //
-// [valkey.io]: https://valkey.io/commands/geosearch/
-func (client *baseClient) GeoSearchWithFullOptions(
-	ctx context.Context,
-	key string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-	resultOptions options.GeoSearchResultOptions,
-	infoOptions options.GeoSearchInfoOptions,
-) ([]options.Location, error) {
-	args := []string{key}
-	searchFromArgs, err := searchFrom.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, searchFromArgs...)
-	searchByShapeArgs, err := searchByShape.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, searchByShapeArgs...)
-	infoOptionsArgs, err := infoOptions.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, infoOptionsArgs...)
-	resultOptionsArgs, err := resultOptions.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, resultOptionsArgs...)
-	result, err := client.executeCommand(ctx, C.GeoSearch, args)
+// [valkey.io]: https://valkey.io/commands/acl-list/
+func (client *baseClient) AclList(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclList, []string{})
 	if err != nil {
 		return nil, err
 	}
-	return handleLocationArrayResponse(result)
+	return handleStringArrayResponse(result)
 }
 
-// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
-// which are within the borders of the area specified by a given shape.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Reloads the ACL users from the configured ACL file, replacing all the current users.
+// Fails if the server isn't configured to use an ACL file.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	searchFrom - The query's center point options, could be one of:
-//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
-//		- `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		- `BYRADIUS` to search inside circular area according to given radius.
-//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
-//	resultOptions - Optional inputs for sorting/limiting the results.
 //
 // Return value:
 //
-//	An array of matched member names.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/geosearch/
-func (client *baseClient) GeoSearchWithResultOptions(
-	ctx context.Context,
-	key string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-	resultOptions options.GeoSearchResultOptions,
-) ([]string, error) {
-	args := []string{key}
-	searchFromArgs, err := searchFrom.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, searchFromArgs...)
-	searchByShapeArgs, err := searchByShape.ToArgs()
-	if err != nil {
-		return nil, err
-	}
-	args = append(args, searchByShapeArgs...)
-	resultOptionsArgs, err := resultOptions.ToArgs()
+// [valkey.io]: https://valkey.io/commands/acl-load/
+func (client *baseClient) AclLoad(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclLoad, []string{})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	args = append(args, resultOptionsArgs...)
+	return handleOkResponse(result)
+}
 
-	result, err := client.executeCommand(ctx, C.GeoSearch, args)
+// Returns the 10 most recent ACL security events.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [models.AclLogEntry], from the most recent to the oldest.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *baseClient) AclLog(ctx context.Context) ([]models.AclLogEntry, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{})
 	if err != nil {
 		return nil, err
 	}
-	return handleStringArrayResponse(result)
+	return handleAclLogResponse(result)
 }
 
-// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
-// which are within the borders of the area specified by a given shape.
-//
-// Since:
+// Returns the most recent ACL security events.
 //
-//	Valkey 6.2.0 and above.
-//
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	searchFrom - The query's center point options, could be one of:
-//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
-//		- `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		- `BYRADIUS` to search inside circular area according to given radius.
-//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
-//	infoOptions - The optional inputs to request additional information.
+//	count - The maximum number of entries to return.
 //
 // Return value:
 //
-//	An array of [options.Location] containing the following information:
-//	 - The coordinates as a [options.GeospatialData] object.
-//	 - The member (location) name.
-//	 - The distance from the center as a `float64`, in the same unit specified for
-//	   `searchByShape`.
-//	 - The geohash of the location as a `int64`.
+//	An array of [models.AclLogEntry], from the most recent to the oldest.
 //
-// [valkey.io]: https://valkey.io/commands/geosearch/
-func (client *baseClient) GeoSearchWithInfoOptions(
-	ctx context.Context,
-	key string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-	infoOptions options.GeoSearchInfoOptions,
-) ([]options.Location, error) {
-	return client.GeoSearchWithFullOptions(
-		ctx,
-		key,
-		searchFrom,
-		searchByShape,
-		*options.NewGeoSearchResultOptions(),
-		infoOptions,
-	)
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *baseClient) AclLogWithCount(ctx context.Context, count int64) ([]models.AclLogEntry, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{utils.IntToString(count)})
+	if err != nil {
+		return nil, err
+	}
+	return handleAclLogResponse(result)
 }
 
-// Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
-// which are within the borders of the area specified by a given shape.
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Clears the ACL security events log.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	key - The key of the sorted set.
-//	searchFrom - The query's center point options, could be one of:
-//		- `MemberOrigin` to use the position of the given existing member in the sorted set.
-//		- `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		- `BYRADIUS` to search inside circular area according to given radius.
-//		- `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
 //
 // Return value:
 //
-//	An array of matched member names.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/geosearch/
-func (client *baseClient) GeoSearch(
-	ctx context.Context,
-	key string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-) ([]string, error) {
-	return client.GeoSearchWithResultOptions(
-		ctx,
-		key,
-		searchFrom,
-		searchByShape,
-		*options.NewGeoSearchResultOptions(),
-	)
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *baseClient) AclLogReset(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{options.AclLogResetKeyword})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
 }
 
-// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
-// within a circular or rectangular area and stores the result in `destinationKey`. If
-// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
-// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
+// Saves the current ACL users to the configured ACL file.
+// Fails if the server isn't configured to use an ACL file.
 //
-// Since:
+// See [valkey.io] for details.
 //
-//	Valkey 6.2.0 and above.
+// Parameters:
 //
-// Note:
+//	ctx - The context for controlling the command execution.
 //
-// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+// Return value:
 //
-// See [valkey.io] for more details.
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-save/
+func (client *baseClient) AclSave(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclSave, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Creates an ACL user, or modifies the rules of an existing user.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destinationKey - The key of the sorted set to store the result.
-//	sourceKey - The key of the sorted set to search.
-//	searchFrom - The query's center point options, could be one of:
-//		 - `MemberOrigin` to use the position of the given existing member in the sorted set.
-//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		 - `BYRADIUS` to search inside circular area according to given radius.
-//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
-//	resultOptions - Optional inputs for sorting/limiting the results.
-//	infoOptions - The optional inputs to request additional information.
+//	username - The username of the user.
+//	rules - The rules to apply to the user, see [options.AclRules].
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/geosearchstore/
-func (client *baseClient) GeoSearchStoreWithFullOptions(
-	ctx context.Context,
-	destinationKey string,
-	sourceKey string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-	resultOptions options.GeoSearchResultOptions,
-	infoOptions options.GeoSearchStoreInfoOptions,
-) (int64, error) {
-	args := []string{destinationKey, sourceKey}
-	searchFromArgs, err := searchFrom.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args = append(args, searchFromArgs...)
-	searchByShapeArgs, err := searchByShape.ToArgs()
+// [valkey.io]: https://valkey.io/commands/acl-setuser/
+func (client *baseClient) AclSetUser(ctx context.Context, username string, rules options.AclRules) (string, error) {
+	args, err := rules.ToArgs()
 	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args = append(args, searchByShapeArgs...)
-	resultOptionsArgs, err := resultOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
-	}
-	args = append(args, resultOptionsArgs...)
-	infoOptionsArgs, err := infoOptions.ToArgs()
-	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultStringResponse, err
 	}
-	args = append(args, infoOptionsArgs...)
-
-	result, err := client.executeCommand(ctx, C.GeoSearchStore, args)
+	result, err := client.executeCommand(ctx, C.AclSetSser, append([]string{username}, args...))
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultStringResponse, err
 	}
-	return handleIntResponse(result)
+	return handleOkResponse(result)
 }
 
-// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
-// within a circular or rectangular area and stores the result in `destinationKey`. If
-// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
-// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
-//
-// Note:
-//
-// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+// Returns the usernames of all the ACL users.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destinationKey - The key of the sorted set to store the result.
-//	sourceKey - The key of the sorted set to search.
-//	searchFrom - The query's center point options, could be one of:
-//		 - `MemberOrigin` to use the position of the given existing member in the sorted
-//	          set.
-//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		 - `BYRADIUS` to search inside circular area according to given radius.
-//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	An array of the usernames.
 //
-// [valkey.io]: https://valkey.io/commands/geosearchstore/
-func (client *baseClient) GeoSearchStore(
-	ctx context.Context,
-	destinationKey string,
-	sourceKey string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-) (int64, error) {
-	return client.GeoSearchStoreWithFullOptions(
-		ctx,
-		destinationKey,
-		sourceKey,
-		searchFrom,
-		searchByShape,
-		*options.NewGeoSearchResultOptions(),
-		*options.NewGeoSearchStoreInfoOptions(),
-	)
+// [valkey.io]: https://valkey.io/commands/acl-users/
+func (client *baseClient) AclUsers(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclUsers, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
 }
 
-// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
-// within a circular or rectangular area and stores the result in `destinationKey`. If
-// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
-// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
-//
-// Since:
-//
-//	Valkey 6.2.0 and above.
+// Returns the username the current connection is authenticated with.
 //
-// Note:
-//
-// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
-//
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destinationKey - The key of the sorted set to store the result.
-//	sourceKey - The key of the sorted set to search.
-//	searchFrom - The query's center point options, could be one of:
-//		 - `MemberOrigin` to use the position of the given existing member in the sorted
-//	          set.
-//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		 - `BYRADIUS` to search inside circular area according to given radius.
-//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
-//	resultOptions - Optional inputs for sorting/limiting the results.
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	The username of the current connection.
 //
-// [valkey.io]: https://valkey.io/commands/geosearchstore/
-func (client *baseClient) GeoSearchStoreWithResultOptions(
-	ctx context.Context,
-	destinationKey string,
-	sourceKey string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-	resultOptions options.GeoSearchResultOptions,
-) (int64, error) {
-	return client.GeoSearchStoreWithFullOptions(
-		ctx,
-		destinationKey,
-		sourceKey,
-		searchFrom,
-		searchByShape,
-		resultOptions,
-		*options.NewGeoSearchStoreInfoOptions(),
-	)
+// [valkey.io]: https://valkey.io/commands/acl-whoami/
+func (client *baseClient) AclWhoami(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclWhoami, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
 }
 
-// Searches for members in a sorted set stored at `sourceKey` representing geospatial data
-// within a circular or rectangular area and stores the result in `destinationKey`. If
-// `destinationKey` already exists, it is overwritten. Otherwise, a new sorted set will be
-// created. To get the result directly, see [Client.GeoSearchWithFullOptions] or [ClusterClient.GeoSearchWithFullOptions].
+// Returns information about the current connection.
 //
-// Since:
+// See [valkey.io] for details.
 //
-//	Valkey 6.2.0 and above.
+// Parameters:
 //
-// Note:
+//	ctx - The context for controlling the command execution.
 //
-// When in cluster mode, `destinationKey` and `sourceKey` must map to the same hash slot.
+// Return value:
 //
-// See [valkey.io] for more details.
+//	A [models.ClientInfo] describing the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *baseClient) ClientInfo(ctx context.Context) (models.ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientInfo, []string{})
+	if err != nil {
+		return models.ClientInfo{}, err
+	}
+	return handleConvertedResponse(result, internal.ConvertClientInfo)
+}
+
+// Closes the client connections matching the filter. The current connection is skipped, unless
+// [options.ClientFilter.SetSkipMe] is set to `false`.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	destinationKey - The key of the sorted set to store the result.
-//	sourceKey - The key of the sorted set to search.
-//	searchFrom - The query's center point options, could be one of:
-//		 - `MemberOrigin` to use the position of the given existing member in the sorted
-//	          set.
-//		 - `CoordOrigin` to use the given longitude and latitude coordinates.
-//	searchByShape - The query's shape options:
-//		 - `BYRADIUS` to search inside circular area according to given radius.
-//		 - `BYBOX` to search inside an axis-aligned rectangle, determined by height and width.
-//	infoOptions - The optional inputs to request additional information.
+//	filter - The filter selecting the connections to close, see [options.ClientFilter].
 //
 // Return value:
 //
-//	The number of elements in the resulting set.
+//	The number of connections closed.
 //
-// [valkey.io]: https://valkey.io/commands/geosearchstore/
-func (client *baseClient) GeoSearchStoreWithInfoOptions(
-	ctx context.Context,
-	destinationKey string,
-	sourceKey string,
-	searchFrom options.GeoSearchOrigin,
-	searchByShape options.GeoSearchShape,
-	infoOptions options.GeoSearchStoreInfoOptions,
-) (int64, error) {
-	return client.GeoSearchStoreWithFullOptions(
-		ctx,
-		destinationKey,
-		sourceKey,
-		searchFrom,
-		searchByShape,
-		*options.NewGeoSearchResultOptions(),
-		infoOptions,
-	)
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *baseClient) ClientKill(ctx context.Context, filter options.ClientFilter) (int64, error) {
+	args, err := filter.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.ClientKill, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
 }
 
-// Loads a library to Valkey.
+// Closes the client connection from the given address.
 //
-// Since:
-//
-//	Valkey 7.0 and above.
-//
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	libraryCode - The source code that implements the library.
-//	replace - Whether the given library should overwrite a library with the same name if it already exists.
+//	addr - The address of the client, in `ip:port` format, as returned by [Client.ClientList].
 //
 // Return value:
 //
-//	The library name that was loaded.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-load/
-func (client *baseClient) FunctionLoad(ctx context.Context, libraryCode string, replace bool) (string, error) {
-	args := []string{}
-	if replace {
-		args = append(args, constants.ReplaceKeyword)
-	}
-	args = append(args, libraryCode)
-	result, err := client.executeCommand(ctx, C.FunctionLoad, args)
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *baseClient) ClientKillSimple(ctx context.Context, addr string) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientKillSimple, []string{addr})
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleStringResponse(result)
+	return handleOkResponse(result)
 }
 
-// Deletes all function libraries.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns information about all the client connections of the server.
 //
 // See [valkey.io] for details.
 //
@@ -8444,267 +10820,231 @@ func (client *baseClient) FunctionLoad(ctx context.Context, libraryCode string,
 //
 // Return value:
 //
-//	`OK`
+//	An array of [models.ClientInfo] describing the connections.
 //
-// [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *baseClient) FunctionFlush(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{})
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *baseClient) ClientList(ctx context.Context) ([]models.ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientList, []string{})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleOkResponse(result)
+	return handleConvertedResponse(result, internal.ConvertClientList)
 }
 
-// Deletes all function libraries in synchronous mode.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns information about the client connections of the server matching the filter.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	filter - The filter selecting the connections, see [options.ClientFilter].
 //
 // Return value:
 //
-//	`OK`
+//	An array of [models.ClientInfo] describing the connections.
 //
-// [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *baseClient) FunctionFlushSync(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{string(options.SYNC)})
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *baseClient) ClientListWithFilter(ctx context.Context, filter options.ClientFilter) ([]models.ClientInfo, error) {
+	args, err := filter.ToArgs()
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleOkResponse(result)
+	result, err := client.executeCommand(ctx, C.ClientList, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(result, internal.ConvertClientList)
 }
 
-// Deletes all function libraries in asynchronous mode.
+// Sets whether the current connection is excluded from the client eviction.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	enabled - Whether the connection is excluded from the client eviction.
 //
 // Return value:
 //
-//	`OK`
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *baseClient) FunctionFlushAsync(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionFlush, []string{string(options.ASYNC)})
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *baseClient) ClientNoEvict(ctx context.Context, enabled bool) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientNoEvict, []string{onOffArg(enabled)})
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
 	return handleOkResponse(result)
 }
 
-// Invokes a previously loaded function.
-// The command will be routed to a primary random node.
-// To route to a replica please refer to [Client.FCallReadOnly] or [ClusterClient.FCallReadOnly].
+// Sets whether the commands of the current connection alter the LRU/LFU of the keys they access.
 //
 // Since:
 //
-//	Valkey 7.0 and above.
+//	Valkey 7.2 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
+//	enabled - Whether the commands don't alter the LRU/LFU of the keys.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *baseClient) FCall(ctx context.Context, function string) (any, error) {
-	result, err := client.executeCommand(ctx, C.FCall, []string{function, utils.IntToString(0)})
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *baseClient) ClientNoTouch(ctx context.Context, enabled bool) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientNoTouch, []string{onOffArg(enabled)})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleAnyResponse(result)
+	return handleOkResponse(result)
 }
 
-// Invokes a previously loaded read-only function.
-// This command is routed depending on the client's {@link ReadFrom} strategy.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Suspends all the commands of all the clients for the given duration.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
+//	timeout - The duration of the pause, in milliseconds precision.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *baseClient) FCallReadOnly(ctx context.Context, function string) (any, error) {
-	result, err := client.executeCommand(ctx, C.FCallReadOnly, []string{function, utils.IntToString(0)})
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *baseClient) ClientPause(ctx context.Context, timeout time.Duration) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientPause, []string{utils.IntToString(timeout.Milliseconds())})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleAnyResponse(result)
+	return handleOkResponse(result)
 }
 
-// Invokes a previously loaded function.
-// This command is routed to primary nodes only.
-// To route to a replica please refer to [Client.FCallReadOnly] or [ClusterClient.FCallReadOnly].
-//
-// Since:
+// Suspends the commands of all the clients for the given duration.
 //
-//	Valkey 7.0 and above.
-//
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	keys - An `array` of keys accessed by the function. To ensure the correct
-//	   execution of functions, both in standalone and clustered deployments, all names of keys
-//	   that a function accesses must be explicitly provided as `keys`.
-//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	timeout - The duration of the pause, in milliseconds precision.
+//	mode - The commands to suspend, [options.ClientPauseAll] or [options.ClientPauseWrite].
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *baseClient) FCallWithKeysAndArgs(
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *baseClient) ClientPauseWithMode(
 	ctx context.Context,
-	function string,
-	keys []string,
-	args []string,
-) (any, error) {
-	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
-	cmdArgs = append(cmdArgs, keys...)
-	cmdArgs = append(cmdArgs, args...)
-	result, err := client.executeCommand(ctx, C.FCall, cmdArgs)
+	timeout time.Duration,
+	mode options.ClientPauseMode,
+) (string, error) {
+	result, err := client.executeCommand(
+		ctx,
+		C.ClientPause,
+		[]string{utils.IntToString(timeout.Milliseconds()), string(mode)},
+	)
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleAnyResponse(result)
+	return handleOkResponse(result)
 }
 
-// Invokes a previously loaded read-only function.
-// This command is routed depending on the client's {@link ReadFrom} strategy.
-//
-// Note: When in cluster mode, all `keys` must map to the same hash slot.
+// Sets an attribute of the current connection, reported by [Client.ClientInfo] and [Client.ClientList].
 //
 // Since:
 //
-//	Valkey 7.0 and above.
+//	Valkey 7.2 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	keys - An `array` of keys accessed by the function. To ensure the correct
-//	   execution of functions, both in standalone and clustered deployments, all names of keys
-//	   that a function accesses must be explicitly provided as `keys`.
-//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	attribute - The attribute to set, [options.ClientLibName] or [options.ClientLibVersion].
+//	value - The value of the attribute.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *baseClient) FCallReadOnlyWithKeysAndArgs(
+// [valkey.io]: https://valkey.io/commands/client-setinfo/
+func (client *baseClient) ClientSetInfo(
 	ctx context.Context,
-	function string,
-	keys []string,
-	args []string,
-) (any, error) {
-	cmdArgs := []string{function, utils.IntToString(int64(len(keys)))}
-	cmdArgs = append(cmdArgs, keys...)
-	cmdArgs = append(cmdArgs, args...)
-	result, err := client.executeCommand(ctx, C.FCallReadOnly, cmdArgs)
+	attribute options.ClientSetInfoAttribute,
+	value string,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientSetInfo, []string{string(attribute), value})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-	return handleAnyResponse(result)
+	return handleOkResponse(result)
 }
 
-// Lists the currently active channels.
-//
-// When used in cluster mode, the command is routed to all nodes and aggregates
-// the responses into a single array.
+// Unblocks a client blocked by a blocking command, as if the timeout of the command was reached.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	clientId - The ID of the blocked client.
 //
 // Return value:
 //
-//	An array of active channel names.
+//	`true` if the client was unblocked, `false` if it wasn't blocked.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-channels
-func (client *baseClient) PubSubChannels(ctx context.Context) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.PubSubChannels, []string{})
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *baseClient) ClientUnblock(ctx context.Context, clientId int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnblock, []string{utils.IntToString(clientId)})
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-
-	return handleStringArrayResponse(result)
+	unblocked, err := handleIntResponse(result)
+	return unblocked == 1, err
 }
 
-// Lists the currently active channels matching the specified pattern.
-//
-// Pattern can be any glob-style pattern:
-// - h?llo matches hello, hallo and hxllo
-// - h*llo matches hllo and heeeello
-// - h[ae]llo matches hello and hallo, but not hillo
-//
-// When used in cluster mode, the command is routed to all nodes and aggregates
-// the responses into a single array.
+// Unblocks a client blocked by a blocking command.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	pattern - The pattern to match channel names against.
+//	clientId - The ID of the blocked client.
+//	mode - How the client is unblocked, [options.ClientUnblockTimeout] or [options.ClientUnblockError].
 //
 // Return value:
 //
-//	An array of active channel names matching the pattern.
+//	`true` if the client was unblocked, `false` if it wasn't blocked.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-channels
-func (client *baseClient) PubSubChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
-	args := []string{pattern}
-	result, err := client.executeCommand(ctx, C.PubSubChannels, args)
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *baseClient) ClientUnblockWithMode(
+	ctx context.Context,
+	clientId int64,
+	mode options.ClientUnblockMode,
+) (bool, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnblock, []string{utils.IntToString(clientId), string(mode)})
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-
-	return handleStringArrayResponse(result)
+	unblocked, err := handleIntResponse(result)
+	return unblocked == 1, err
 }
 
-// Returns the number of patterns that are subscribed to by clients.
-//
-// This returns the total number of unique patterns that all clients are subscribed to,
-// not the count of clients subscribed to patterns.
-//
-// When used in cluster mode, the command is routed to all nodes and aggregates
-// the responses.
+// Resumes the clients suspended by [Client.ClientPause].
 //
 // See [valkey.io] for details.
 //
@@ -8714,397 +11054,303 @@ func (client *baseClient) PubSubChannelsWithPattern(ctx context.Context, pattern
 //
 // Return value:
 //
-//	The number of patterns that are subscribed to by clients.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-numpat
-func (client *baseClient) PubSubNumPat(ctx context.Context) (int64, error) {
-	result, err := client.executeCommand(ctx, C.PubSubNumPat, []string{})
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *baseClient) ClientUnpause(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnpause, []string{})
 	if err != nil {
-		return 0, err
+		return models.DefaultStringResponse, err
 	}
-
-	return handleIntResponse(result)
+	return handleOkResponse(result)
 }
 
-// Returns the number of subscribers for the specified channels.
-//
-// The count only includes clients subscribed to exact channels, not pattern subscriptions.
-// If no channels are specified, an empty map is returned.
+// Enables or disables the tracking of the keys read by the connection, for the server assisted client side caching.
+// The invalidation messages are delivered to the connection with the RESP3 protocol.
 //
-// When used in cluster mode, the command is routed to all nodes and aggregates
-// the responses into a single map.
+// The client side cache configured with [config.ClientConfiguration.WithClientSideCache] manages the tracking itself,
+// and stops receiving the invalidation messages if the tracking is disabled with this command.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	channels - The channel names to get subscriber counts for.
+//	trackingOptions - The tracking mode and options, see [options.ClientTrackingOptions].
 //
 // Return value:
 //
-//	A map of channel names to their subscriber counts.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-numsub
-func (client *baseClient) PubSubNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
-	result, err := client.executeCommand(ctx, C.PubSubNumSub, channels)
+// [valkey.io]: https://valkey.io/commands/client-tracking/
+func (client *baseClient) ClientTracking(
+	ctx context.Context,
+	trackingOptions options.ClientTrackingOptions,
+) (string, error) {
+	args, err := trackingOptions.ToArgs()
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-
-	return handleStringIntMapResponse(result)
+	result, err := client.executeCommand(ctx, C.ClientTracking, args)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
 }
 
-// Executes a Lua script on the server.
-//
-// This function simplifies the process of invoking scripts on the server by using an object that
-// represents a Lua script. The script loading and execution will all be handled internally. If
-// the script has not already been loaded, it will be loaded automatically using the
-// `SCRIPT LOAD` command. After that, it will be invoked using the `EVALSHA`
-// command.
+// Controls whether the keys read by the next command of the connection are tracked, when the tracking is enabled in the
+// OPTIN or OPTOUT mode.
 //
-// See [LOAD] and [EVALSHA] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	script - The Lua script to execute.
+//	enabled - `true` to track the keys of the next command in OPTIN mode, `false` to skip them in OPTOUT mode.
 //
 // Return value:
 //
-//	The result of the script execution.
+//	`"OK"` response on success.
 //
-// [LOAD]: https://valkey.io/commands/script-load/
-// [EVALSHA]: https://valkey.io/commands/evalsha/
-func (client *baseClient) InvokeScript(ctx context.Context, script options.Script) (any, error) {
-	response, err := client.executeScriptWithRoute(ctx, script.GetHash(), []string{}, []string{}, nil)
+// [valkey.io]: https://valkey.io/commands/client-caching/
+func (client *baseClient) ClientCaching(ctx context.Context, enabled bool) (string, error) {
+	mode := "NO"
+	if enabled {
+		mode = "YES"
+	}
+	result, err := client.executeCommand(ctx, C.ClientCaching, []string{mode})
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-
-	return handleAnyResponse(response)
+	return handleOkResponse(result)
 }
 
-// Executes a Lua script on the server with additional options.
-//
-// This function simplifies the process of invoking scripts on the server by using an object that
-// represents a Lua script. The script loading, argument preparation, and execution will all be
-// handled internally. If the script has not already been loaded, it will be loaded automatically
-// using the `SCRIPT LOAD` command. After that, it will be invoked using the
-// `EVALSHA` command.
-//
-// Note:
-//
-//	When in cluster mode:
-//	- all `keys` in `scriptOptions` must map to the same hash slot.
-//	- if no `keys` are given, command will be routed to a random primary node.
+// Returns the ID of the client the tracking invalidation messages of the connection are redirected to.
 //
-// See [LOAD] and [EVALSHA] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	script - The Lua script to execute.
-//	scriptOptions - Options for script execution including keys and arguments.
 //
 // Return value:
 //
-//	The result of the script execution.
+//	The ID of the client, `0` if the tracking is enabled without redirection, or `-1` if the tracking is disabled.
 //
-// [LOAD]: https://valkey.io/commands/script-load/
-// [EVALSHA]: https://valkey.io/commands/evalsha/
-func (client *baseClient) InvokeScriptWithOptions(
-	ctx context.Context,
-	script options.Script,
-	scriptOptions options.ScriptOptions,
-) (any, error) {
-	keys := scriptOptions.Keys
-	args := scriptOptions.Args
-
-	response, err := client.executeScriptWithRoute(ctx, script.GetHash(), keys, args, nil)
+// [valkey.io]: https://valkey.io/commands/client-getredir/
+func (client *baseClient) ClientGetRedir(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ClientGetRedir, []string{})
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
-
-	return handleAnyResponse(response)
+	return handleIntResponse(result)
 }
 
-// executeScriptWithRoute executes a Lua script with the given hash, keys, args, and routing information.
+// Returns the tracking state of the connection, for the server assisted client side caching.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	hash - The SHA1 hash of the script to execute.
-//	keys - The keys that the script will access.
-//	args - The arguments to pass to the script.
-//	route - Optional routing information for the script execution.
 //
 // Return value:
 //
-//	A CommandResponse containing the result of the script execution.
-func (client *baseClient) executeScriptWithRoute(
-	ctx context.Context,
-	hash string,
-	keys []string,
-	args []string,
-	route config.Route,
-) (*C.struct_CommandResponse, error) {
-	// Check if context is already done
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-		// Continue with execution
-	}
-	var cKeysPtr *C.uintptr_t = nil
-	var keysLengthsPtr *C.ulong = nil
-	if len(keys) > 0 {
-		cKeys, keysLengths := toCStrings(keys)
-		cKeysPtr = &cKeys[0]
-		keysLengthsPtr = &keysLengths[0]
-	}
-
-	var cArgsPtr *C.uintptr_t = nil
-	var argsLengthsPtr *C.ulong = nil
-	if len(args) > 0 {
-		cArgs, argsLengths := toCStrings(args)
-		cArgsPtr = &cArgs[0]
-		argsLengthsPtr = &argsLengths[0]
-	}
-
-	var routeBytesPtr *C.uchar = nil
-	var routeBytesCount C.uintptr_t = 0
-	if route != nil {
-		routeProto, err := routeToProtobuf(route)
-		if err != nil {
-			return nil, errors.New("ExecuteScript failed due to invalid route")
-		}
-		msg, err := proto.Marshal(routeProto)
-		if err != nil {
-			return nil, err
-		}
-
-		routeBytesCount = C.uintptr_t(len(msg))
-		routeCBytes := C.CBytes(msg)
-		defer C.free(routeCBytes)
-		routeBytesPtr = (*C.uchar)(routeCBytes)
-	}
-
-	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
-	resultChannel := make(chan payload, 1)
-	resultChannelPtr := unsafe.Pointer(&resultChannel)
-
-	pinner := pinner{}
-	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
-
-	client.mu.Lock()
-	if client.coreClient == nil {
-		client.mu.Unlock()
-		return nil, NewClosingError("ExecuteScript failed. The client is closed.")
-	}
-	client.pending[resultChannelPtr] = struct{}{}
-	hash_cstring := C.CString(hash)
-	defer C.free(unsafe.Pointer(hash_cstring))
-	C.invoke_script(
-		client.coreClient,
-		C.uintptr_t(pinnedChannelPtr),
-		hash_cstring,
-		C.size_t(len(keys)),
-		cKeysPtr,
-		keysLengthsPtr,
-		C.size_t(len(args)),
-		cArgsPtr,
-		argsLengthsPtr,
-		routeBytesPtr,
-		routeBytesCount,
-	)
-	client.mu.Unlock()
-
-	// Wait for result or context cancellation
-	var payload payload
-	select {
-	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
-		// Start cleanup goroutine
-		go func() {
-			// Wait for payload on separate channel
-			if payload := <-resultChannel; payload.value != nil {
-				C.free_command_response(payload.value)
-			}
-		}()
-		return nil, ctx.Err()
-	case payload = <-resultChannel:
-		// Continue with normal processing
-	}
-
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
+//	The tracking flags, redirection and prefixes of the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-trackinginfo/
+func (client *baseClient) ClientTrackingInfo(ctx context.Context) (models.ClientTrackingInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientTrackingInfo, []string{})
+	if err != nil {
+		return models.ClientTrackingInfo{}, err
 	}
-	client.mu.Unlock()
+	return handleConvertedResponse(result, internal.ConvertClientTrackingInfo)
+}
 
-	if payload.error != nil {
-		return nil, payload.error
+// Returns the statistics of the client side cache, accumulated since the client was created.
+//
+// Return value:
+//
+//	The hits, misses and evictions of the cache, or an error if the client side cache isn't configured.
+func (client *baseClient) CacheStatistics() (models.CacheStatistics, error) {
+	if client.cache == nil {
+		return models.CacheStatistics{}, errors.New("client side cache isn't configured for this client")
 	}
-	return payload.value, nil
+	return client.cache.statistics(), nil
 }
 
-// Checks existence of scripts in the script cache by their SHA1 digest.
+// Returns the number of commands of the server.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	sha1s - SHA1 digests of Lua scripts to be checked.
 //
 // Return value:
 //
-//	An array of boolean values indicating the existence of each script.
+//	The number of commands.
 //
-// [valkey.io]: https://valkey.io/commands/script-exists
-func (client *baseClient) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
-	response, err := client.executeCommand(ctx, C.ScriptExists, sha1s)
+// [valkey.io]: https://valkey.io/commands/command-count/
+func (client *baseClient) CommandCount(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.CommandCount, []string{})
 	if err != nil {
-		return nil, err
+		return models.DefaultIntResponse, err
 	}
+	return handleIntResponse(result)
+}
 
-	return handleBoolArrayResponse(response)
+// Returns the names of the commands of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Return value:
+//
+//	The names of the commands in lowercase.
+//
+// [valkey.io]: https://valkey.io/commands/command-list/
+func (client *baseClient) CommandList(ctx context.Context) ([]string, error) {
+	return client.CommandListWithOptions(ctx, *options.NewCommandListOptions())
 }
 
-// Removes all the scripts from the script cache.
+// Returns the names of the commands of the server which match a filter.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	opts - The filter of the commands, see [options.CommandListOptions].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // Return value:
 //
-//	OK on success.
+//	The names of the commands which match the filter, in lowercase.
 //
-// [valkey.io]: https://valkey.io/commands/script-flush/
-func (client *baseClient) ScriptFlush(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.ScriptFlush, []string{})
+// [valkey.io]: https://valkey.io/commands/command-list/
+func (client *baseClient) CommandListWithOptions(ctx context.Context, opts options.CommandListOptions) ([]string, error) {
+	args, err := opts.ToArgs()
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleOkResponse(result)
+	result, err := client.executeCommand(ctx, C.CommandList, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
 }
 
-// Removes all the scripts from the script cache with the specified flush mode.
-// The mode can be either SYNC or ASYNC.
+// Returns the details of commands, such as their arity, flags, key positions and ACL categories.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	mode - The flush mode (SYNC or ASYNC).
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands. The details of all the commands are returned when no name is given.
 //
 // Return value:
 //
-//	OK on success.
+//	A map of the names of the commands in lowercase to their details. The unknown commands are omitted.
 //
-// [valkey.io]: https://valkey.io/commands/script-flush/
-func (client *baseClient) ScriptFlushWithMode(ctx context.Context, mode options.FlushMode) (string, error) {
-	result, err := client.executeCommand(ctx, C.ScriptFlush, []string{string(mode)})
+// [valkey.io]: https://valkey.io/commands/command-info/
+func (client *baseClient) CommandInfo(ctx context.Context, commands ...string) (map[string]models.CommandInfo, error) {
+	result, err := client.executeCommand(ctx, C.CommandInfo, commands)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleOkResponse(result)
+	return handleConvertedResponse(result, internal.ConvertCommandInfo)
 }
 
-// ScriptShow returns the original source code of a script in the script cache.
-//
-// Since:
-//
-//	Valkey 8.0.0
+// Returns the documentation of commands, such as their summary, arguments and history.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx  - The context for controlling the command execution.
-//	sha1 - The SHA1 digest of the script.
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands. The documentation of all the commands is returned when no name is given.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // Return value:
 //
-//	The original source code of the script, if present in the cache.
-//	If the script is not found in the cache, an error is thrown.
+//	A map of the names of the commands in lowercase to their documentation. The unknown commands are omitted.
 //
-// [valkey.io]: https://valkey.io/commands/script-show
-func (client *baseClient) ScriptShow(ctx context.Context, sha1 string) (string, error) {
-	result, err := client.executeCommand(ctx, C.ScriptShow, []string{sha1})
+// [valkey.io]: https://valkey.io/commands/command-docs/
+func (client *baseClient) CommandDocs(ctx context.Context, commands ...string) (map[string]models.CommandDocs, error) {
+	result, err := client.executeCommand(ctx, C.CommandDocs, commands)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleStringResponse(result)
+	return handleConvertedResponse(result, internal.ConvertCommandDocs)
 }
 
-// Kills the currently executing Lua script, assuming no write operation was yet performed by the
-// script.
-//
-// Note:
-//
-//	When in cluster mode, this command will be routed to all nodes.
+// Returns the keys of a full command line, e.g. `[]string{"MSET", "k1", "v1", "k2", "v2"}`.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	args - The name and the arguments of the command.
 //
 // Return value:
 //
-//	`OK` if script is terminated. Otherwise, throws an error.
+//	The keys of the command line. An error is returned if the command is unknown or has no keys.
 //
-// [valkey.io]: https://valkey.io/commands/script-kill
-func (client *baseClient) ScriptKill(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.ScriptKill, []string{})
+// [valkey.io]: https://valkey.io/commands/command-getkeys/
+func (client *baseClient) CommandGetKeys(ctx context.Context, args []string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.CommandGetKeys, args)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleOkResponse(result)
+	return handleStringArrayResponse(result)
 }
 
-// Marks the given keys to be watched for conditional execution of an atomic batch (Transaction).
-// Transactions will only execute commands if the watched keys are not modified before execution of the
-// transaction.
+// Returns the keys of a full command line, e.g. `[]string{"SET", "key", "value"}`, with the way the command accesses
+// them.
 //
-// See [valkey.io] and [Valkey Glide Wiki] for details.
+// See [valkey.io] for details.
 //
-// Note:
+// Parameters:
 //
-//	In cluster mode, if keys in `keys` map to different hash slots,
-//	the command will be split across these slots and executed separately for each.
-//	This means the command is atomic only at the slot level. If one or more slot-specific
-//	requests fail, the entire call will return the first encountered error, even
-//	though some requests may have succeeded while others did not.
-//	If this behavior impacts your application logic, consider splitting the
-//	request into sub-requests per slot to ensure atomicity.
+//	ctx - The context for controlling the command execution.
+//	args - The name and the arguments of the command.
 //
-// Parameters:
+// Since:
 //
-//	ctx  - The context for controlling the command execution.
-//	keys - The keys to watch.
+//	Valkey 7.0 and above.
 //
 // Return value:
 //
-//	A simple "OK" response.
+//	The keys of the command line with their flags. An error is returned if the command is unknown or has no keys.
 //
-// [valkey.io]: https://valkey.io/commands/watch
-// [Valkey Glide Wiki]: https://valkey.io/topics/transactions/#cas
-func (client *baseClient) Watch(ctx context.Context, keys []string) (string, error) {
-	result, err := client.executeCommand(ctx, C.Watch, keys)
+// [valkey.io]: https://valkey.io/commands/command-getkeysandflags/
+func (client *baseClient) CommandGetKeysAndFlags(ctx context.Context, args []string) ([]models.KeyWithFlags, error) {
+	result, err := client.executeCommand(ctx, C.CommandGetKeysAndFlags, args)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return nil, err
 	}
-	return handleOkResponse(result)
+	return handleConvertedResponse(result, internal.ConvertKeysWithFlags)
+}
+
+func onOffArg(enabled bool) string {
+	if enabled {
+		return "ON"
+	}
+	return "OFF"
 }
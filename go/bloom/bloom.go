@@ -0,0 +1,297 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package bloom
+
+import (
+	"context"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Client is a constraint satisfied by the standalone [glide.Client] and the cluster [glide.ClusterClient].
+type Client interface {
+	*glide.Client | *glide.ClusterClient
+}
+
+// Executes a Bloom module command with its request type. Commands routed to a single node (all Bloom commands are keyed)
+// return the node value.
+func executeCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) (any, error) {
+	return internal.ExecuteModuleCommand(ctx, client, requestType, args)
+}
+
+func executeBoolCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) (bool, error) {
+	result, err := executeCommand(ctx, client, requestType, args)
+	if err != nil {
+		return models.DefaultBoolResponse, err
+	}
+	return internal.ConvertBloomBool(result)
+}
+
+func executeBoolArrayCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) ([]bool, error) {
+	result, err := executeCommand(ctx, client, requestType, args)
+	if err != nil {
+		return nil, err
+	}
+	return internal.ConvertBloomBools(result)
+}
+
+// Adds an item to the bloom filter stored at `key`. The filter is created with the default capacity and error rate of
+// the server if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	item - The item to add.
+//
+// Return value:
+//
+//	`true` if the item was added, `false` if it possibly already exists in the filter.
+//
+// [valkey.io]: https://valkey.io/commands/bf.add/
+func Add[C Client](ctx context.Context, client C, key string, item string) (bool, error) {
+	return executeBoolCommand(ctx, client, internal.BfAdd, []string{key, item})
+}
+
+// Adds items to the bloom filter stored at `key`. The filter is created with the default capacity and error rate of
+// the server if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//
+// Return value:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item was added, `false` if it possibly
+//	already exists in the filter.
+//	Returns an error if an item can't be added, e.g. when a non-scaling filter is full.
+//
+// [valkey.io]: https://valkey.io/commands/bf.madd/
+func MAdd[C Client](ctx context.Context, client C, key string, items []string) ([]bool, error) {
+	return executeBoolArrayCommand(ctx, client, internal.BfMAdd, utils.Concat([]string{key}, items))
+}
+
+// Checks whether an item was added to the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	item - The item to check.
+//
+// Return value:
+//
+//	`true` if the item possibly exists in the filter, `false` if it certainly doesn't or if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.exists/
+func Exists[C Client](ctx context.Context, client C, key string, item string) (bool, error) {
+	return executeBoolCommand(ctx, client, internal.BfExists, []string{key, item})
+}
+
+// Checks whether items were added to the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	items - The items to check.
+//
+// Return value:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item possibly exists in the filter,
+//	`false` if it certainly doesn't or if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.mexists/
+func MExists[C Client](ctx context.Context, client C, key string, items []string) ([]bool, error) {
+	return executeBoolArrayCommand(ctx, client, internal.BfMExists, utils.Concat([]string{key}, items))
+}
+
+// Creates an empty bloom filter at `key`, with the given false positive rate and capacity.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	errorRate - The false positive rate of the filter, between 0 and 1.
+//	capacity - The number of items the filter can hold before scaling out.
+//
+// Return value:
+//
+//	`"OK"` if the filter is created. Returns an error if `key` already exists.
+//
+// [valkey.io]: https://valkey.io/commands/bf.reserve/
+func Reserve[C Client](ctx context.Context, client C, key string, errorRate float64, capacity int64) (string, error) {
+	return ReserveWithOptions(ctx, client, key, errorRate, capacity, *options.NewBloomReserveOptions())
+}
+
+// Creates an empty bloom filter at `key`, with the given false positive rate and capacity.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	errorRate - The false positive rate of the filter, between 0 and 1.
+//	capacity - The number of items the filter can hold before scaling out.
+//	opts - The [options.BloomReserveOptions], i.e. how the filter scales out.
+//
+// Return value:
+//
+//	`"OK"` if the filter is created. Returns an error if `key` already exists.
+//
+// [valkey.io]: https://valkey.io/commands/bf.reserve/
+func ReserveWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	errorRate float64,
+	capacity int64,
+	opts options.BloomReserveOptions,
+) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	result, err := executeCommand(
+		ctx,
+		client,
+		internal.BfReserve,
+		append([]string{key, utils.FloatToString(errorRate), utils.IntToString(capacity)}, optionArgs...),
+	)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return internal.ConvertToString(result)
+}
+
+// Adds items to the bloom filter stored at `key`. The filter is created with the default capacity and error rate of
+// the server if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//
+// Return value:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item was added, `false` if it possibly
+//	already exists in the filter.
+//	Returns an error if an item can't be added, e.g. when a non-scaling filter is full.
+//
+// [valkey.io]: https://valkey.io/commands/bf.insert/
+func Insert[C Client](ctx context.Context, client C, key string, items []string) ([]bool, error) {
+	return InsertWithOptions(ctx, client, key, items, *options.NewBloomInsertOptions())
+}
+
+// Adds items to the bloom filter stored at `key`. Unless [options.BloomInsertOptions.NoCreate] is set, the filter is
+// created with the given options if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//	opts - The [options.BloomInsertOptions], i.e. the capacity, the error rate and how the filter scales out.
+//
+// Return value:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item was added, `false` if it possibly
+//	already exists in the filter.
+//	Returns an error if an item can't be added, e.g. when a non-scaling filter is full, or if `key` doesn't exist and
+//	[options.BloomInsertOptions.NoCreate] is set.
+//
+// [valkey.io]: https://valkey.io/commands/bf.insert/
+func InsertWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	items []string,
+	opts options.BloomInsertOptions,
+) ([]bool, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	return executeBoolArrayCommand(
+		ctx,
+		client,
+		internal.BfInsert,
+		utils.Concat([]string{key}, optionArgs, []string{options.BloomItemsKeyword}, items),
+	)
+}
+
+// Returns the properties of the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//
+// Return value:
+//
+//	The properties of the filter. Returns an error if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.info/
+func Info[C Client](ctx context.Context, client C, key string) (models.BloomInfo, error) {
+	result, err := executeCommand(ctx, client, internal.BfInfo, []string{key})
+	if err != nil {
+		return models.BloomInfo{}, err
+	}
+	return internal.ConvertBloomInfo(result)
+}
+
+// Returns the number of items added to the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the bloom filter.
+//
+// Return value:
+//
+//	The number of items added to the filter, or `0` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.card/
+func Card[C Client](ctx context.Context, client C, key string) (int64, error) {
+	result, err := executeCommand(ctx, client, internal.BfCard, []string{key})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return internal.ConvertToInt64(result)
+}
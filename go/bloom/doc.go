@@ -0,0 +1,22 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package bloom provides the commands of the Valkey Bloom module for Valkey GLIDE clients.
+//
+// The commands are executed with a standalone [glide.Client] or a cluster [glide.ClusterClient]:
+//
+//	client, _ := glide.NewClusterClient(cfg)
+//	_, err := bloom.Reserve(ctx, client, "filter", 0.001, 10000)
+//	added, err := bloom.MAdd(ctx, client, "filter", []string{"a", "b"})
+//	exists, err := bloom.Exists(ctx, client, "filter", "a")
+//
+// A bloom filter tells whether an item was possibly added to it, with a configurable false positive rate, or was
+// certainly not added to it. A filter is created with the default capacity and error rate of the server when an item
+// is first added to it, unless it was created beforehand with [Reserve] or [InsertWithOptions]. Once its capacity is
+// reached, a filter scales out by adding a sub-filter, unless it is non-scaling.
+//
+// The same commands can be added to a batch with the `Bf` methods of `pipeline.BaseBatch`, e.g. `BfAdd`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/topics/bloomfilters/
+package bloom
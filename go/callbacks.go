@@ -40,6 +40,15 @@ func getClientByPtr(ptrValue uintptr) *baseClient {
 	return clientRegistry[ptrValue]
 }
 
+// isRegistered returns whether the client is still registered, i.e. it isn't closed. The goroutines handling the push
+// messages stop once it isn't, as the core client is freed.
+func (client *baseClient) isRegistered() bool {
+	client.mu.Lock()
+	coreClient := client.coreClient
+	client.mu.Unlock()
+	return coreClient != nil && getClientByPtr(uintptr(coreClient)) == client
+}
+
 //export successCallback
 func successCallback(channelPtr unsafe.Pointer, cResponse *C.struct_CommandResponse) {
 	response := cResponse
@@ -70,6 +79,29 @@ func pubSubCallback(
 		return
 	}
 
+	switch pushKind {
+	case C.PushDisconnection:
+		// The subscriptions changed at runtime and the tracking aren't restored by the core on reconnection
+		if client := getClientByPtr(uintptr(clientPtr)); client != nil {
+			if client.cache != nil {
+				go client.restoreTracking()
+			}
+			go client.restoreSubscriptions()
+		}
+		return
+	case C.PushInvalidate:
+		// Handled synchronously, so that the invalidations are applied in order. The key is nil when all the keys
+		// are invalidated.
+		if client := getClientByPtr(uintptr(clientPtr)); client != nil {
+			var key []byte
+			if message != nil {
+				key = C.GoBytes(message, message_len)
+			}
+			client.handleInvalidation(key)
+		}
+		return
+	}
+
 	msg := string(C.GoBytes(message, message_len))
 	cha := string(C.GoBytes(channel, channel_len))
 	pat := models.CreateNilStringResult()
@@ -0,0 +1,25 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRegistry(t *testing.T) {
+	var coreClient byte
+	client := &Client{baseClient{mu: &sync.Mutex{}, coreClient: unsafe.Pointer(&coreClient)}}
+	registerClient(&client.baseClient, uintptr(client.coreClient))
+	assert.True(t, client.isRegistered())
+
+	// only the client embedded in the returned client is registered, since it's the one which is closed
+	detached := client.baseClient
+	assert.False(t, detached.isRegistered())
+
+	unregisterClient(uintptr(client.coreClient))
+	assert.False(t, client.isRegistered())
+}
@@ -0,0 +1,370 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// The timeout of the command enabling the tracking when the client is created or reconnected.
+const trackingTimeout = 5 * time.Second
+
+// cacheKind is the read command whose reply is cached, since the replies of different commands for the same key differ.
+type cacheKind int
+
+const (
+	getCacheKind cacheKind = iota
+	hGetAllCacheKind
+)
+
+var cacheKinds = [...]cacheKind{getCacheKind, hGetAllCacheKind}
+
+type cacheKey struct {
+	kind cacheKind
+	key  string
+}
+
+type cacheEntry struct {
+	key       cacheKey
+	value     any
+	expiresAt time.Time
+}
+
+// clientSideCache is a bounded LRU cache of the replies of the read commands, invalidated by the tracking messages of
+// the server.
+//
+// A reply is only cached if no invalidation of its key arrived while it was fetched, otherwise a stale value could be
+// cached after the invalidation.
+type clientSideCache struct {
+	mu      sync.Mutex
+	config  *config.ClientSideCacheConfig
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+	// The number of fetches in progress by key, and the sequence number of the last invalidation of their key.
+	fetching    map[string]int
+	invalidated map[string]uint64
+	sequence    uint64
+	flushedAt   uint64
+	// Set while the tracking isn't active, e.g. after a disconnection, in which case the cache is bypassed.
+	suspended bool
+	// Set while the tracking is being enabled again, so that concurrent disconnections don't enable it twice.
+	restoring bool
+	stats     models.CacheStatistics
+	now       func() time.Time
+}
+
+func newClientSideCache(cacheConfig *config.ClientSideCacheConfig) *clientSideCache {
+	return &clientSideCache{
+		config:      cacheConfig,
+		entries:     map[cacheKey]*list.Element{},
+		lru:         list.New(),
+		fetching:    map[string]int{},
+		invalidated: map[string]uint64{},
+		now:         time.Now,
+	}
+}
+
+// isCacheable returns whether the server sends the invalidation messages of the key.
+func (cache *clientSideCache) isCacheable(key string) bool {
+	if cache.config.GetTrackingMode() != config.BroadcastTrackingMode || len(cache.config.GetPrefixes()) == 0 {
+		return true
+	}
+	for _, prefix := range cache.config.GetPrefixes() {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cache *clientSideCache) get(kind cacheKind, key string) (any, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if element, ok := cache.entries[cacheKey{kind, key}]; ok && !cache.suspended {
+		entry := element.Value.(*cacheEntry)
+		if entry.expiresAt.IsZero() || cache.now().Before(entry.expiresAt) {
+			cache.lru.MoveToFront(element)
+			cache.stats.Hits++
+			return entry.value, true
+		}
+		cache.removeElement(element)
+		cache.stats.Expirations++
+	}
+	cache.stats.Misses++
+	return nil, false
+}
+
+// beginFetch registers a fetch of the key from the server, and returns the sequence number to pass to endFetch.
+func (cache *clientSideCache) beginFetch(key string) uint64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.fetching[key]++
+	return cache.sequence
+}
+
+// endFetch stores the fetched value, unless the key was invalidated since the fetch began.
+func (cache *clientSideCache) endFetch(kind cacheKind, key string, startedAt uint64, value any, store bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	stale := cache.invalidated[key] > startedAt || cache.flushedAt > startedAt
+	if cache.fetching[key]--; cache.fetching[key] <= 0 {
+		delete(cache.fetching, key)
+		delete(cache.invalidated, key)
+	}
+	if !store || stale || cache.suspended {
+		return
+	}
+
+	entry := &cacheEntry{key: cacheKey{kind, key}, value: value}
+	if ttl := cache.config.GetTTL(); ttl > 0 {
+		entry.expiresAt = cache.now().Add(ttl)
+	}
+	if element, ok := cache.entries[entry.key]; ok {
+		element.Value = entry
+		cache.lru.MoveToFront(element)
+		return
+	}
+	cache.entries[entry.key] = cache.lru.PushFront(entry)
+	for cache.lru.Len() > cache.config.GetMaxEntries() {
+		cache.removeElement(cache.lru.Back())
+		cache.stats.Evictions++
+	}
+}
+
+// invalidate removes the entries of the key, once the server notified that it was modified.
+func (cache *clientSideCache) invalidate(key string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.sequence++
+	if cache.fetching[key] > 0 {
+		cache.invalidated[key] = cache.sequence
+	}
+	for _, kind := range cacheKinds {
+		if element, ok := cache.entries[cacheKey{kind, key}]; ok {
+			cache.removeElement(element)
+			cache.stats.Invalidations++
+		}
+	}
+}
+
+// flush removes all the entries, once the server notified that all the keys were modified, or the tracking was lost.
+func (cache *clientSideCache) flush() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.flushLocked()
+}
+
+func (cache *clientSideCache) flushLocked() {
+	cache.sequence++
+	cache.flushedAt = cache.sequence
+	cache.stats.Invalidations += int64(cache.lru.Len())
+	cache.entries = map[cacheKey]*list.Element{}
+	cache.lru.Init()
+}
+
+// suspendForRestore suspends the cache, and returns whether the caller should enable the tracking again.
+func (cache *clientSideCache) suspendForRestore() bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.suspended = true
+	cache.flushLocked()
+	if cache.restoring {
+		return false
+	}
+	cache.restoring = true
+	return true
+}
+
+// endRestore ends the restore of the tracking, and resumes the cache if the tracking was enabled.
+func (cache *clientSideCache) endRestore(enabled bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.restoring = false
+	if enabled {
+		cache.suspended = false
+		// the values being fetched may have been read before the tracking was enabled
+		cache.sequence++
+		cache.flushedAt = cache.sequence
+	}
+}
+
+func (cache *clientSideCache) statistics() models.CacheStatistics {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	stats := cache.stats
+	stats.Size = int64(cache.lru.Len())
+	return stats
+}
+
+func (cache *clientSideCache) removeElement(element *list.Element) {
+	delete(cache.entries, element.Value.(*cacheEntry).key)
+	cache.lru.Remove(element)
+}
+
+// cachedRead serves the read from the cache, or fetches it from the server and caches it. Mutable values are cloned so
+// that the caller can't modify the cached value.
+func cachedRead[T any](
+	cache *clientSideCache,
+	kind cacheKind,
+	key string,
+	clone func(T) T,
+	fetch func() (T, error),
+) (T, error) {
+	if !cache.isCacheable(key) {
+		return fetch()
+	}
+	if value, ok := cache.get(kind, key); ok {
+		if clone != nil {
+			return clone(value.(T)), nil
+		}
+		return value.(T), nil
+	}
+	startedAt := cache.beginFetch(key)
+	value, err := fetch()
+	cached := value
+	if err == nil && clone != nil {
+		cached = clone(value)
+	}
+	cache.endFetch(kind, key, startedAt, cached, err == nil)
+	return value, err
+}
+
+// cachedMGet serves the cached keys from the cache, and fetches the other keys with a single MGET.
+func (client *baseClient) cachedMGet(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	cache := client.cache
+	values := make([]models.Result[string], len(keys))
+	var missingKeys []string
+	var missingIndexes []int
+	for i, key := range keys {
+		if cache.isCacheable(key) {
+			if value, ok := cache.get(getCacheKind, key); ok {
+				values[i] = value.(models.Result[string])
+				continue
+			}
+		}
+		missingKeys = append(missingKeys, key)
+		missingIndexes = append(missingIndexes, i)
+	}
+	if len(missingKeys) == 0 {
+		return values, nil
+	}
+
+	sequences := make([]uint64, len(missingKeys))
+	for i, key := range missingKeys {
+		sequences[i] = cache.beginFetch(key)
+	}
+	result, err := client.executeCommand(ctx, C.MGet, missingKeys)
+	var fetched []models.Result[string]
+	if err == nil {
+		fetched, err = handleStringOrNilArrayResponse(result)
+	}
+	for i, key := range missingKeys {
+		if err != nil || !cache.isCacheable(key) {
+			cache.endFetch(getCacheKind, key, sequences[i], nil, false)
+			continue
+		}
+		cache.endFetch(getCacheKind, key, sequences[i], fetched[i], true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, index := range missingIndexes {
+		values[index] = fetched[i]
+	}
+	return values, nil
+}
+
+// initClientSideCache creates the cache of a new client, and enables the tracking.
+func (client *baseClient) initClientSideCache(cacheConfig *config.ClientSideCacheConfig) error {
+	client.cache = newClientSideCache(cacheConfig)
+	// The tracking of a lazy client is enabled once it is connected
+	if !client.connection.connected.Load() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), trackingTimeout)
+	defer cancel()
+	if err := client.enableTracking(ctx); err != nil {
+		return NewConfigurationError("failed to enable the tracking of the client side cache: " + err.Error())
+	}
+	return nil
+}
+
+// enableTracking enables the tracking of the server.
+func (client *baseClient) enableTracking(ctx context.Context) error {
+	cacheConfig := client.cache.config
+	trackingOptions := options.NewClientTrackingOptions(true)
+	if cacheConfig.GetTrackingMode() == config.BroadcastTrackingMode {
+		trackingOptions.SetBroadcast(cacheConfig.GetPrefixes()...)
+	}
+	args, err := trackingOptions.ToArgs()
+	if err != nil {
+		return err
+	}
+	result, err := client.sendCommand(ctx, C.ClientTracking, args, nil)
+	if err != nil {
+		return err
+	}
+	_, err = handleOkResponse(result)
+	return err
+}
+
+// restoreTracking enables the tracking again after a disconnection, since the tracking is a state of the connection.
+// The cache is bypassed until the tracking is enabled, as the invalidation messages could be missed meanwhile.
+func (client *baseClient) restoreTracking() {
+	if !client.cache.suspendForRestore() {
+		return
+	}
+	delay := resubscribeInitialDelay
+	for attempt := 0; attempt < resubscribeAttempts; attempt++ {
+		time.Sleep(delay)
+		if !client.isRegistered() {
+			client.cache.endRestore(false)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), trackingTimeout)
+		err := client.enableTracking(ctx)
+		cancel()
+		if err == nil {
+			client.cache.endRestore(true)
+			return
+		}
+		var closingErr *ClosingError
+		if errors.As(err, &closingErr) {
+			client.cache.endRestore(false)
+			return
+		}
+		delay *= 2
+	}
+	client.cache.endRestore(false)
+	logError("failed to enable the tracking after a disconnection, the client side cache remains disabled")
+}
+
+// flushClientSideCache flushes the cache, if any, once the client switched to another database or the database changed,
+// since the entries are keyed by the key names only.
+func (client *baseClient) flushClientSideCache() {
+	client.handleInvalidation(nil)
+}
+
+// handleInvalidation handles an invalidation message of the server, a nil key invalidating all the keys.
+func (client *baseClient) handleInvalidation(key []byte) {
+	if client.cache == nil {
+		return
+	}
+	if key == nil {
+		client.cache.flush()
+	} else {
+		client.cache.invalidate(string(key))
+	}
+}
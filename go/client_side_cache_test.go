@@ -0,0 +1,150 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func fetchValue(value string) func() (models.Result[string], error) {
+	return func() (models.Result[string], error) {
+		return models.CreateStringResult(value), nil
+	}
+}
+
+func TestClientSideCache_HitAndMiss(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10))
+
+	value, err := cachedRead(cache, getCacheKind, "key", nil, fetchValue("value"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+
+	value, err = cachedRead(cache, getCacheKind, "key", nil, fetchValue("other"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value", value.Value())
+
+	stats := cache.statistics()
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, int64(1), stats.Size)
+}
+
+func TestClientSideCache_LruEviction(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(2))
+
+	cachedRead(cache, getCacheKind, "a", nil, fetchValue("a"))
+	cachedRead(cache, getCacheKind, "b", nil, fetchValue("b"))
+	// "a" becomes the most recently used entry, so "b" is evicted
+	cachedRead(cache, getCacheKind, "a", nil, fetchValue("a"))
+	cachedRead(cache, getCacheKind, "c", nil, fetchValue("c"))
+
+	_, ok := cache.get(getCacheKind, "a")
+	assert.True(t, ok)
+	_, ok = cache.get(getCacheKind, "b")
+	assert.False(t, ok)
+	assert.Equal(t, int64(1), cache.statistics().Evictions)
+	assert.Equal(t, int64(2), cache.statistics().Size)
+}
+
+func TestClientSideCache_TTL(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10).WithTTL(time.Second))
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	cachedRead(cache, getCacheKind, "key", nil, fetchValue("value"))
+	_, ok := cache.get(getCacheKind, "key")
+	assert.True(t, ok)
+
+	now = now.Add(2 * time.Second)
+	_, ok = cache.get(getCacheKind, "key")
+	assert.False(t, ok)
+	assert.Equal(t, int64(1), cache.statistics().Expirations)
+	assert.Equal(t, int64(0), cache.statistics().Size)
+}
+
+func TestClientSideCache_Invalidation(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10))
+
+	cachedRead(cache, getCacheKind, "key", nil, fetchValue("value"))
+	cachedRead(cache, hGetAllCacheKind, "key", nil, func() (map[string]string, error) {
+		return map[string]string{"field": "value"}, nil
+	})
+	cachedRead(cache, getCacheKind, "other", nil, fetchValue("value"))
+
+	cache.invalidate("key")
+	_, ok := cache.get(getCacheKind, "key")
+	assert.False(t, ok)
+	_, ok = cache.get(hGetAllCacheKind, "key")
+	assert.False(t, ok)
+	assert.Equal(t, int64(2), cache.statistics().Invalidations)
+
+	cache.flush()
+	_, ok = cache.get(getCacheKind, "other")
+	assert.False(t, ok)
+	assert.Equal(t, int64(3), cache.statistics().Invalidations)
+}
+
+func TestClientSideCache_InvalidationDuringFetch(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10))
+
+	_, err := cachedRead(cache, getCacheKind, "key", nil, func() (models.Result[string], error) {
+		cache.invalidate("key")
+		return models.CreateStringResult("stale"), nil
+	})
+	assert.NoError(t, err)
+	_, ok := cache.get(getCacheKind, "key")
+	assert.False(t, ok)
+
+	_, err = cachedRead(cache, getCacheKind, "key", nil, func() (models.Result[string], error) {
+		cache.flush()
+		return models.CreateStringResult("stale"), nil
+	})
+	assert.NoError(t, err)
+	_, ok = cache.get(getCacheKind, "key")
+	assert.False(t, ok)
+}
+
+func TestClientSideCache_Restore(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10))
+	cachedRead(cache, getCacheKind, "key", nil, fetchValue("value"))
+
+	assert.True(t, cache.suspendForRestore())
+	assert.False(t, cache.suspendForRestore())
+	_, ok := cache.get(getCacheKind, "key")
+	assert.False(t, ok)
+	cachedRead(cache, getCacheKind, "key", nil, fetchValue("value"))
+	assert.Equal(t, int64(0), cache.statistics().Size)
+
+	cache.endRestore(true)
+	cachedRead(cache, getCacheKind, "key", nil, fetchValue("value"))
+	_, ok = cache.get(getCacheKind, "key")
+	assert.True(t, ok)
+}
+
+func TestClientSideCache_BroadcastPrefixes(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10).WithBroadcastMode("user:"))
+	assert.True(t, cache.isCacheable("user:1"))
+	assert.False(t, cache.isCacheable("order:1"))
+
+	cachedRead(cache, getCacheKind, "order:1", nil, fetchValue("value"))
+	assert.Equal(t, int64(0), cache.statistics().Size)
+
+	cache = newClientSideCache(config.NewClientSideCacheConfig(10).WithBroadcastMode())
+	assert.True(t, cache.isCacheable("order:1"))
+}
+
+func TestClientSideCache_ClonesMutableValues(t *testing.T) {
+	cache := newClientSideCache(config.NewClientSideCacheConfig(10))
+	fetch := func() (map[string]string, error) { return map[string]string{"field": "value"}, nil }
+
+	value, _ := cachedRead(cache, hGetAllCacheKind, "key", maps.Clone, fetch)
+	value["field"] = "modified"
+	value, _ = cachedRead(cache, hGetAllCacheKind, "key", maps.Clone, fetch)
+	assert.Equal(t, "value", value["field"])
+}
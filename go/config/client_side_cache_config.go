@@ -0,0 +1,92 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// TrackingMode is the mode of the server assisted client side caching, which decides the keys the server sends
+// invalidation messages for.
+type TrackingMode int
+
+const (
+	// The server remembers the keys read by the client, and notifies the client when they are modified.
+	DefaultTrackingMode TrackingMode = iota
+	// The server notifies the client of the modification of all the keys matching the prefixes, whether the client read
+	// them or not. Only the keys matching the prefixes are cached.
+	BroadcastTrackingMode
+)
+
+func (mode TrackingMode) String() string {
+	return [...]string{"DEFAULT", "BCAST"}[mode]
+}
+
+// ClientSideCacheConfig configures the client side cache of a standalone client, which serves the reads of GET, MGET
+// and HGETALL from memory.
+//
+// The cache relies on the tracking of the server, which notifies the client of the modified keys with the RESP3
+// protocol. The entries are evicted in least recently used order once the maximum number of entries is reached, and
+// optionally expire after a time to live, which bounds the staleness of the cache if an invalidation is missed.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/topics/client-side-caching/
+type ClientSideCacheConfig struct {
+	maxEntries   int
+	ttl          time.Duration
+	trackingMode TrackingMode
+	prefixes     []string
+}
+
+// NewClientSideCacheConfig returns a [ClientSideCacheConfig] holding up to the given number of entries, with the default
+// tracking mode and no time to live.
+func NewClientSideCacheConfig(maxEntries int) *ClientSideCacheConfig {
+	return &ClientSideCacheConfig{maxEntries: maxEntries}
+}
+
+// WithTTL sets the duration after which the entries expire. A zero duration keeps the entries until they are evicted or
+// invalidated.
+func (config *ClientSideCacheConfig) WithTTL(ttl time.Duration) *ClientSideCacheConfig {
+	config.ttl = ttl
+	return config
+}
+
+// WithBroadcastMode enables the broadcasting tracking mode, for the keys matching any of the given prefixes, or for all
+// the keys if none are given.
+func (config *ClientSideCacheConfig) WithBroadcastMode(prefixes ...string) *ClientSideCacheConfig {
+	config.trackingMode = BroadcastTrackingMode
+	config.prefixes = prefixes
+	return config
+}
+
+// GetMaxEntries returns the maximum number of entries of the cache.
+func (config *ClientSideCacheConfig) GetMaxEntries() int {
+	return config.maxEntries
+}
+
+// GetTTL returns the duration after which the entries expire, zero if they don't.
+func (config *ClientSideCacheConfig) GetTTL() time.Duration {
+	return config.ttl
+}
+
+// GetTrackingMode returns the tracking mode of the cache.
+func (config *ClientSideCacheConfig) GetTrackingMode() TrackingMode {
+	return config.trackingMode
+}
+
+// GetPrefixes returns the key prefixes of the broadcasting mode.
+func (config *ClientSideCacheConfig) GetPrefixes() []string {
+	return config.prefixes
+}
+
+func (config *ClientSideCacheConfig) validate() error {
+	if config.maxEntries <= 0 {
+		return errors.New("the maximum number of entries of the client side cache must be positive")
+	}
+	if config.ttl < 0 {
+		return errors.New("the time to live of the client side cache entries must not be negative")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
@@ -54,6 +55,16 @@ func NewServerCredentialsWithDefaultUsername(password string) *ServerCredentials
 	return &ServerCredentials{password: password}
 }
 
+// GetUsername returns the username of the credentials, empty for the default username.
+func (creds *ServerCredentials) GetUsername() string {
+	return creds.username
+}
+
+// GetPassword returns the password of the credentials.
+func (creds *ServerCredentials) GetPassword() string {
+	return creds.password
+}
+
 func (creds *ServerCredentials) toProtobuf() *protobuf.AuthenticationInfo {
 	return &protobuf.AuthenticationInfo{Username: creds.username, Password: creds.password}
 }
@@ -92,15 +103,39 @@ func mapReadFrom(readFrom ReadFrom) protobuf.ReadFrom {
 	return protobuf.ReadFrom_Primary
 }
 
+// ProtocolVersion represents the serialization protocol used to communicate with the server.
+type ProtocolVersion int
+
+const (
+	// RESP3 - Use RESP3 to communicate with the server. This is the default protocol.
+	RESP3 ProtocolVersion = iota
+	// RESP2 - Use RESP2 to communicate with the server, e.g. with servers or proxies which don't support RESP3. The
+	// replies are returned as the same Go types as with RESP3, but the push notifications, and thus the subscriptions and
+	// the client side cache, aren't supported.
+	RESP2
+)
+
+func mapProtocolVersion(protocol ProtocolVersion) protobuf.ProtocolVersion {
+	if protocol == RESP2 {
+		return protobuf.ProtocolVersion_RESP2
+	}
+
+	return protobuf.ProtocolVersion_RESP3
+}
+
 type baseClientConfiguration struct {
-	addresses         []NodeAddress
-	useTLS            bool
-	credentials       *ServerCredentials
-	readFrom          ReadFrom
-	requestTimeout    time.Duration
-	clientName        string
-	clientAZ          string
-	reconnectStrategy *BackoffStrategy
+	addresses           []NodeAddress
+	useTLS              bool
+	tlsConfig           *TLSConfig
+	credentials         *ServerCredentials
+	credentialsProvider *CredentialsProviderConfig
+	readFrom            ReadFrom
+	requestTimeout      time.Duration
+	clientName          string
+	clientAZ            string
+	reconnectStrategy   *BackoffStrategy
+	protocol            ProtocolVersion
+	lazyConnect         bool
 }
 
 func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest, error) {
@@ -109,9 +144,19 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.Addresses = append(request.Addresses, address.toProtobuf())
 	}
 
-	if config.useTLS {
+	switch {
+	case config.tlsConfig != nil:
+		if err := config.tlsConfig.validate(); err != nil {
+			return nil, err
+		}
 		request.TlsMode = protobuf.TlsMode_SecureTls
-	} else {
+		if config.tlsConfig.insecure {
+			request.TlsMode = protobuf.TlsMode_InsecureTls
+		}
+		request.TlsConfiguration = config.tlsConfig.toProtobuf()
+	case config.useTLS:
+		request.TlsMode = protobuf.TlsMode_SecureTls
+	default:
 		request.TlsMode = protobuf.TlsMode_NoTls
 	}
 
@@ -119,6 +164,16 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.AuthenticationInfo = config.credentials.toProtobuf()
 	}
 
+	// The credentials of the provider are set by the client when it's created
+	if config.credentialsProvider != nil {
+		if err := config.credentialsProvider.validate(); err != nil {
+			return nil, err
+		}
+		if config.credentials != nil {
+			return nil, errors.New("credentials and a credentials provider can't be set together")
+		}
+	}
+
 	request.ReadFrom = mapReadFrom(config.readFrom)
 	if config.requestTimeout != 0 {
 		requestTimeout, err := utils.DurationToMilliseconds(config.requestTimeout)
@@ -147,6 +202,9 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.ConnectionRetryStrategy = config.reconnectStrategy.toProtobuf()
 	}
 
+	request.Protocol = mapProtocolVersion(config.protocol)
+	request.LazyConnect = config.lazyConnect
+
 	return &request, nil
 }
 
@@ -210,6 +268,7 @@ type ClientConfiguration struct {
 	baseClientConfiguration
 	databaseId         int
 	subscriptionConfig *StandaloneSubscriptionConfig
+	clientSideCache    *ClientSideCacheConfig
 	AdvancedClientConfiguration
 }
 
@@ -226,10 +285,27 @@ func (config *ClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequest, er
 	}
 	request.ClusterModeEnabled = false
 
+	if config.clientSideCache != nil {
+		if err := config.clientSideCache.validate(); err != nil {
+			return nil, err
+		}
+		// The invalidation messages are push notifications, which only exist in RESP3
+		if config.protocol == RESP2 {
+			return nil, errors.New("client side caching requires the RESP3 protocol")
+		}
+		// The tracking is only enabled on the connection to the primary
+		if config.readFrom != Primary {
+			return nil, errors.New("client side caching is only supported when reading from the primary in standalone mode")
+		}
+	}
+
 	if config.databaseId != 0 {
 		request.DatabaseId = uint32(config.databaseId)
 	}
-	if config.subscriptionConfig != nil && len(config.subscriptionConfig.subscriptions) > 0 {
+	if config.subscriptionConfig != nil && config.protocol == RESP2 {
+		return nil, errors.New("subscriptions require the RESP3 protocol")
+	}
+	if config.HasSubscription() {
 		request.PubsubSubscriptions = config.subscriptionConfig.toProtobuf()
 	}
 
@@ -240,6 +316,7 @@ func (config *ClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequest, er
 		}
 		request.ConnectionTimeout = connectionTimeout
 	}
+	request.InflightRequestsLimit = config.AdvancedClientConfiguration.inflightRequestsLimit
 
 	return request, nil
 }
@@ -269,6 +346,13 @@ func (config *ClientConfiguration) WithUseTLS(useTLS bool) *ClientConfiguration
 	return config
 }
 
+// WithTLSConfig enables TLS with the given [TLSConfig], e.g. to trust a private certificate authority or to authenticate
+// the client with a certificate. The TLS configuration takes precedence over [ClientConfiguration.WithUseTLS].
+func (config *ClientConfiguration) WithTLSConfig(tlsConfig *TLSConfig) *ClientConfiguration {
+	config.tlsConfig = tlsConfig
+	return config
+}
+
 // WithCredentials sets the credentials for the authentication process. If none are set, the client will not authenticate
 // itself with the server.
 func (config *ClientConfiguration) WithCredentials(credentials *ServerCredentials) *ClientConfiguration {
@@ -276,6 +360,21 @@ func (config *ClientConfiguration) WithCredentials(credentials *ServerCredential
 	return config
 }
 
+// WithCredentialsProvider sets the provider of credentials which rotate, e.g. short-lived auth tokens. The client
+// authenticates with the credentials of the provider when it's created, and refreshes them periodically, see
+// [CredentialsProviderConfig]. It can't be combined with [ClientConfiguration.WithCredentials].
+func (config *ClientConfiguration) WithCredentialsProvider(
+	providerConfig *CredentialsProviderConfig,
+) *ClientConfiguration {
+	config.credentialsProvider = providerConfig
+	return config
+}
+
+// GetCredentialsProvider returns the configuration of the credentials provider, nil if none is set.
+func (config *ClientConfiguration) GetCredentialsProvider() *CredentialsProviderConfig {
+	return config.credentialsProvider
+}
+
 // WithReadFrom sets the client's [ReadFrom] strategy. If not set, [Primary] will be used.
 func (config *ClientConfiguration) WithReadFrom(readFrom ReadFrom) *ClientConfiguration {
 	config.readFrom = readFrom
@@ -314,6 +413,20 @@ func (config *ClientConfiguration) WithReconnectStrategy(strategy *BackoffStrate
 	return config
 }
 
+// WithProtocol sets the serialization protocol used to communicate with the server. If not set, [RESP3] will be used.
+func (config *ClientConfiguration) WithProtocol(protocol ProtocolVersion) *ClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
+// WithLazyConnect sets whether the client connects to the server when the first command is sent, instead of when the
+// client is created. A lazy client can be created while the server is unreachable, and reports a failure to connect as
+// a connection error of the first command. Use `WaitUntilReady` of the client to connect explicitly.
+func (config *ClientConfiguration) WithLazyConnect(lazyConnect bool) *ClientConfiguration {
+	config.lazyConnect = lazyConnect
+	return config
+}
+
 // WithDatabaseId sets the index of the logical database to connect to.
 func (config *ClientConfiguration) WithDatabaseId(id int) *ClientConfiguration {
 	config.databaseId = id
@@ -328,7 +441,8 @@ func (config *ClientConfiguration) WithAdvancedConfiguration(
 	return config
 }
 
-// WithSubscriptionConfig sets the subscription configuration for the client.
+// WithSubscriptionConfig sets the subscription configuration for the client. The client can only subscribe at runtime
+// if it has a subscription configuration, which can be given without any subscription for that purpose.
 func (config *ClientConfiguration) WithSubscriptionConfig(
 	subscriptionConfig *StandaloneSubscriptionConfig,
 ) *ClientConfiguration {
@@ -336,15 +450,26 @@ func (config *ClientConfiguration) WithSubscriptionConfig(
 	return config
 }
 
+// WithClientSideCache enables the client side cache, which serves the reads of GET, MGET and HGETALL from memory until
+// the server notifies that the keys were modified.
+func (config *ClientConfiguration) WithClientSideCache(cacheConfig *ClientSideCacheConfig) *ClientConfiguration {
+	config.clientSideCache = cacheConfig
+	return config
+}
+
+// GetClientSideCache returns the configuration of the client side cache, nil if the cache is disabled.
+func (config *ClientConfiguration) GetClientSideCache() *ClientSideCacheConfig {
+	return config.clientSideCache
+}
+
+// HasSubscription returns whether the client subscribes to channels or patterns upon connection.
 func (config *ClientConfiguration) HasSubscription() bool {
 	return config.subscriptionConfig != nil && len(config.subscriptionConfig.subscriptions) > 0
 }
 
+// GetSubscription returns the subscription configuration of the client, nil if none is set.
 func (config *ClientConfiguration) GetSubscription() *StandaloneSubscriptionConfig {
-	if config.HasSubscription() {
-		return config.subscriptionConfig
-	}
-	return nil
+	return config.subscriptionConfig
 }
 
 // ClusterClientConfiguration represents the configuration settings for a Cluster Glide client.
@@ -379,7 +504,14 @@ func (config *ClusterClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequ
 		}
 		request.ConnectionTimeout = connectionTimeout
 	}
-	if config.subscriptionConfig != nil && len(config.subscriptionConfig.subscriptions) > 0 {
+	request.InflightRequestsLimit = config.AdvancedClusterClientConfiguration.inflightRequestsLimit
+	if err := config.AdvancedClusterClientConfiguration.setPeriodicChecks(request); err != nil {
+		return nil, err
+	}
+	if config.subscriptionConfig != nil && config.protocol == RESP2 {
+		return nil, errors.New("subscriptions require the RESP3 protocol")
+	}
+	if config.HasSubscription() {
 		request.PubsubSubscriptions = config.subscriptionConfig.toProtobuf()
 	}
 	return request, nil
@@ -410,6 +542,13 @@ func (config *ClusterClientConfiguration) WithUseTLS(useTLS bool) *ClusterClient
 	return config
 }
 
+// WithTLSConfig enables TLS with the given [TLSConfig], e.g. to trust a private certificate authority or to authenticate
+// the client with a certificate. The TLS configuration takes precedence over [ClusterClientConfiguration.WithUseTLS].
+func (config *ClusterClientConfiguration) WithTLSConfig(tlsConfig *TLSConfig) *ClusterClientConfiguration {
+	config.tlsConfig = tlsConfig
+	return config
+}
+
 // WithCredentials sets the credentials for the authentication process. If none are set, the client will not authenticate
 // itself with the server.
 func (config *ClusterClientConfiguration) WithCredentials(
@@ -419,6 +558,21 @@ func (config *ClusterClientConfiguration) WithCredentials(
 	return config
 }
 
+// WithCredentialsProvider sets the provider of credentials which rotate, e.g. short-lived auth tokens. The client
+// authenticates with the credentials of the provider when it's created, and refreshes them periodically, see
+// [CredentialsProviderConfig]. It can't be combined with [ClusterClientConfiguration.WithCredentials].
+func (config *ClusterClientConfiguration) WithCredentialsProvider(
+	providerConfig *CredentialsProviderConfig,
+) *ClusterClientConfiguration {
+	config.credentialsProvider = providerConfig
+	return config
+}
+
+// GetCredentialsProvider returns the configuration of the credentials provider, nil if none is set.
+func (config *ClusterClientConfiguration) GetCredentialsProvider() *CredentialsProviderConfig {
+	return config.credentialsProvider
+}
+
 // WithReadFrom sets the client's [ReadFrom] strategy. If not set, [Primary] will be used.
 func (config *ClusterClientConfiguration) WithReadFrom(readFrom ReadFrom) *ClusterClientConfiguration {
 	config.readFrom = readFrom
@@ -459,6 +613,20 @@ func (config *ClusterClientConfiguration) WithReconnectStrategy(
 	return config
 }
 
+// WithLazyConnect sets whether the client connects to the cluster when the first command is sent, instead of when the
+// client is created. A lazy client can be created while the cluster is unreachable, and reports a failure to connect as
+// a connection error of the first command. Use `WaitUntilReady` of the client to connect explicitly.
+func (config *ClusterClientConfiguration) WithLazyConnect(lazyConnect bool) *ClusterClientConfiguration {
+	config.lazyConnect = lazyConnect
+	return config
+}
+
+// WithProtocol sets the serialization protocol used to communicate with the server. If not set, [RESP3] will be used.
+func (config *ClusterClientConfiguration) WithProtocol(protocol ProtocolVersion) *ClusterClientConfiguration {
+	config.protocol = protocol
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *ClusterClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedClusterClientConfiguration,
@@ -467,7 +635,8 @@ func (config *ClusterClientConfiguration) WithAdvancedConfiguration(
 	return config
 }
 
-// WithSubscriptionConfig sets the subscription configuration for the client.
+// WithSubscriptionConfig sets the subscription configuration for the client. The client can only subscribe at runtime
+// if it has a subscription configuration, which can be given without any subscription for that purpose.
 func (config *ClusterClientConfiguration) WithSubscriptionConfig(
 	subscriptionConfig *ClusterSubscriptionConfig,
 ) *ClusterClientConfiguration {
@@ -475,20 +644,25 @@ func (config *ClusterClientConfiguration) WithSubscriptionConfig(
 	return config
 }
 
+// HasSubscription returns whether the client subscribes to channels or patterns upon connection.
 func (config *ClusterClientConfiguration) HasSubscription() bool {
 	return config.subscriptionConfig != nil && len(config.subscriptionConfig.subscriptions) > 0
 }
 
+// GetSubscription returns the subscription configuration of the client, nil if none is set.
 func (config *ClusterClientConfiguration) GetSubscription() *ClusterSubscriptionConfig {
-	if config.HasSubscription() {
-		return config.subscriptionConfig
-	}
-	return nil
+	return config.subscriptionConfig
 }
 
+// DefaultInflightRequestsLimit is the maximum number of concurrent requests of a client waiting for the inflight
+// requests, unless set with `WithInflightRequestsLimit` of the advanced configuration.
+const DefaultInflightRequestsLimit = 1000
+
 // Represents advanced configuration settings for a Standalone client used in [ClientConfiguration].
 type AdvancedClientConfiguration struct {
-	connectionTimeout time.Duration
+	connectionTimeout       time.Duration
+	inflightRequestsLimit   uint32
+	waitForInflightRequests bool
 }
 
 // NewAdvancedClientConfiguration returns a new [AdvancedClientConfiguration] with default settings.
@@ -511,10 +685,37 @@ func (config *AdvancedClientConfiguration) WithConnectionTimeout(
 	return config
 }
 
+// WithInflightRequestsLimit sets the maximum number of concurrent requests of the client, i.e. the requests sent
+// to the servers which didn't get a response yet. Once the limit is reached, new requests fail with an
+// `InflightRequestsLimitError`, unless waiting is enabled with [AdvancedClientConfiguration.WithWaitForInflightRequests].
+// If not explicitly set, or set to `0`, the requests aren't limited, except when waiting is enabled, which uses the
+// [DefaultInflightRequestsLimit].
+func (config *AdvancedClientConfiguration) WithInflightRequestsLimit(limit uint32) *AdvancedClientConfiguration {
+	config.inflightRequestsLimit = limit
+	return config
+}
+
+// WithWaitForInflightRequests sets whether a command waits until the number of concurrent requests is below the
+// inflight requests limit, instead of failing right away with an `InflightRequestsLimitError`. A waiting command
+// fails with the error of its context once the context is done.
+func (config *AdvancedClientConfiguration) WithWaitForInflightRequests(wait bool) *AdvancedClientConfiguration {
+	config.waitForInflightRequests = wait
+	return config
+}
+
+// GetWaitForInflightRequests returns whether the commands wait when the inflight requests limit is reached.
+func (config *AdvancedClientConfiguration) GetWaitForInflightRequests() bool {
+	return config.waitForInflightRequests
+}
+
 // Represents advanced configuration settings for a Cluster client used in
 // [ClusterClientConfiguration].
 type AdvancedClusterClientConfiguration struct {
-	connectionTimeout time.Duration
+	connectionTimeout       time.Duration
+	inflightRequestsLimit   uint32
+	waitForInflightRequests bool
+	periodicChecksInterval  time.Duration
+	periodicChecksDisabled  bool
 }
 
 // NewAdvancedClusterClientConfiguration returns a new [AdvancedClusterClientConfiguration] with default settings.
@@ -532,3 +733,73 @@ func (config *AdvancedClusterClientConfiguration) WithConnectionTimeout(
 	config.connectionTimeout = connectionTimeout
 	return config
 }
+
+// WithInflightRequestsLimit sets the maximum number of concurrent requests of the client, i.e. the requests sent
+// to the servers which didn't get a response yet. Once the limit is reached, new requests fail with an
+// `InflightRequestsLimitError`, unless waiting is enabled with
+// [AdvancedClusterClientConfiguration.WithWaitForInflightRequests].
+// If not explicitly set, or set to `0`, the requests aren't limited, except when waiting is enabled, which uses the
+// [DefaultInflightRequestsLimit].
+func (config *AdvancedClusterClientConfiguration) WithInflightRequestsLimit(
+	limit uint32,
+) *AdvancedClusterClientConfiguration {
+	config.inflightRequestsLimit = limit
+	return config
+}
+
+// WithWaitForInflightRequests sets whether a command waits until the number of concurrent requests is below the
+// inflight requests limit, instead of failing right away with an `InflightRequestsLimitError`. A waiting command
+// fails with the error of its context once the context is done.
+func (config *AdvancedClusterClientConfiguration) WithWaitForInflightRequests(
+	wait bool,
+) *AdvancedClusterClientConfiguration {
+	config.waitForInflightRequests = wait
+	return config
+}
+
+// GetWaitForInflightRequests returns whether the commands wait when the inflight requests limit is reached.
+func (config *AdvancedClusterClientConfiguration) GetWaitForInflightRequests() bool {
+	return config.waitForInflightRequests
+}
+
+// WithPeriodicChecksManualInterval sets the interval of the periodic checks of the cluster topology, which detect
+// changes such as added nodes or migrated slots. If not explicitly set, the checks run every 60 seconds.
+//
+// The interval must be a positive whole number of seconds, otherwise the configuration is invalid.
+func (config *AdvancedClusterClientConfiguration) WithPeriodicChecksManualInterval(
+	interval time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.periodicChecksInterval = interval
+	config.periodicChecksDisabled = false
+	return config
+}
+
+// WithPeriodicChecksDisabled disables the periodic checks of the cluster topology. Changes of the topology are then
+// only detected from the errors of the requests, e.g. MOVED errors.
+func (config *AdvancedClusterClientConfiguration) WithPeriodicChecksDisabled() *AdvancedClusterClientConfiguration {
+	config.periodicChecksInterval = 0
+	config.periodicChecksDisabled = true
+	return config
+}
+
+func (config *AdvancedClusterClientConfiguration) setPeriodicChecks(request *protobuf.ConnectionRequest) error {
+	if config.periodicChecksDisabled {
+		request.PeriodicChecks = &protobuf.ConnectionRequest_PeriodicChecksDisabled{
+			PeriodicChecksDisabled: &protobuf.PeriodicChecksDisabled{},
+		}
+		return nil
+	}
+	if config.periodicChecksInterval == 0 {
+		return nil
+	}
+	if config.periodicChecksInterval < time.Second || config.periodicChecksInterval%time.Second != 0 ||
+		config.periodicChecksInterval/time.Second > math.MaxUint32 {
+		return errors.New("periodic checks interval must be a positive whole number of seconds")
+	}
+	request.PeriodicChecks = &protobuf.ConnectionRequest_PeriodicChecksManualInterval{
+		PeriodicChecksManualInterval: &protobuf.PeriodicChecksManualInterval{
+			DurationInSec: uint32(config.periodicChecksInterval / time.Second),
+		},
+	}
+	return nil
+}
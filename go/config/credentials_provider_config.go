@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// CredentialsProvider provides the credentials of a client when they rotate, e.g. short-lived auth tokens or leased
+// passwords.
+type CredentialsProvider interface {
+	// GetCredentials returns the current credentials. The context is done once the client is closed, or once the
+	// connection timeout of the client elapsed.
+	GetCredentials(ctx context.Context) (*ServerCredentials, error)
+}
+
+// CredentialsProviderFunc is a function which implements [CredentialsProvider].
+type CredentialsProviderFunc func(ctx context.Context) (*ServerCredentials, error)
+
+// GetCredentials calls the function.
+func (provider CredentialsProviderFunc) GetCredentials(ctx context.Context) (*ServerCredentials, error) {
+	return provider(ctx)
+}
+
+// CredentialsProviderConfig configures the [CredentialsProvider] of a client, which is called when the client is created
+// and then periodically to refresh the credentials.
+//
+// The client authenticates its connections with the credentials returned when it's created. Each refreshed password is
+// set with `UpdateConnectionPassword` of the client, with immediate authentication. When the provider or the
+// authentication fails, the error is reported to the error callback, and the connections keep the previous password,
+// which is also used to reconnect. The username can't be changed once the client is created.
+type CredentialsProviderConfig struct {
+	provider        CredentialsProvider
+	refreshInterval time.Duration
+	errorCallback   func(err error)
+}
+
+// NewCredentialsProviderConfig returns a [CredentialsProviderConfig] which refreshes the credentials from the provider
+// at the given interval.
+func NewCredentialsProviderConfig(provider CredentialsProvider, refreshInterval time.Duration) *CredentialsProviderConfig {
+	return &CredentialsProviderConfig{provider: provider, refreshInterval: refreshInterval}
+}
+
+// WithErrorCallback sets the function called with the errors of the refreshes of the credentials. The errors are
+// ignored if it isn't set.
+func (config *CredentialsProviderConfig) WithErrorCallback(callback func(err error)) *CredentialsProviderConfig {
+	config.errorCallback = callback
+	return config
+}
+
+// GetProvider returns the provider of the credentials.
+func (config *CredentialsProviderConfig) GetProvider() CredentialsProvider {
+	return config.provider
+}
+
+// GetRefreshInterval returns the interval between the refreshes of the credentials.
+func (config *CredentialsProviderConfig) GetRefreshInterval() time.Duration {
+	return config.refreshInterval
+}
+
+// GetErrorCallback returns the function called with the errors of the refreshes of the credentials.
+func (config *CredentialsProviderConfig) GetErrorCallback() func(err error) {
+	return config.errorCallback
+}
+
+func (config *CredentialsProviderConfig) validate() error {
+	if config.provider == nil {
+		return errors.New("the credentials provider must be set")
+	}
+	if config.refreshInterval <= 0 {
+		return errors.New("the refresh interval of the credentials provider must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,440 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeclarativeConfiguration is a client configuration in a structured form, e.g. shared by the services of a deployment
+// in a file. It's loaded from JSON with [ParseConfigurationJSON], or from environment variables with
+// [ConfigurationFromEnv]. Its fields also have `yaml` tags, so that it can be decoded from YAML with any YAML library,
+// which the client doesn't depend on.
+//
+// The durations are strings in the format of [time.ParseDuration], e.g. `500ms`, and the addresses have the format
+// `host[:port]`. The read strategy is one of `primary`, `preferReplica`, `AZAffinity` or `AZAffinityReplicasAndPrimary`,
+// and the protocol is `RESP3` or `RESP2`. The callback of the subscriptions is set on the resulting configuration.
+type DeclarativeConfiguration struct {
+	Addresses         []string                          `json:"addresses,omitempty"         yaml:"addresses,omitempty"`
+	UseTLS            bool                              `json:"useTLS,omitempty"            yaml:"useTLS,omitempty"`
+	Username          string                            `json:"username,omitempty"          yaml:"username,omitempty"`
+	Password          string                            `json:"password,omitempty"          yaml:"password,omitempty"`
+	ReadFrom          string                            `json:"readFrom,omitempty"          yaml:"readFrom,omitempty"`
+	ClientAZ          string                            `json:"clientAZ,omitempty"          yaml:"clientAZ,omitempty"`
+	RequestTimeout    string                            `json:"requestTimeout,omitempty"    yaml:"requestTimeout,omitempty"`
+	ClientName        string                            `json:"clientName,omitempty"        yaml:"clientName,omitempty"`
+	Protocol          string                            `json:"protocol,omitempty"          yaml:"protocol,omitempty"`
+	LazyConnect       bool                              `json:"lazyConnect,omitempty"       yaml:"lazyConnect,omitempty"`
+	DatabaseId        int                               `json:"databaseId,omitempty"        yaml:"databaseId,omitempty"`
+	ReconnectStrategy *DeclarativeBackoffStrategy       `json:"reconnectStrategy,omitempty" yaml:"reconnectStrategy,omitempty"`
+	Advanced          *DeclarativeAdvancedConfiguration `json:"advanced,omitempty"          yaml:"advanced,omitempty"`
+	Subscriptions     *DeclarativeSubscriptions         `json:"subscriptions,omitempty"     yaml:"subscriptions,omitempty"`
+}
+
+// DeclarativeBackoffStrategy is the declarative form of a [BackoffStrategy].
+type DeclarativeBackoffStrategy struct {
+	NumOfRetries  int  `json:"numOfRetries"            yaml:"numOfRetries"`
+	Factor        int  `json:"factor"                  yaml:"factor"`
+	ExponentBase  int  `json:"exponentBase"            yaml:"exponentBase"`
+	JitterPercent *int `json:"jitterPercent,omitempty" yaml:"jitterPercent,omitempty"`
+}
+
+// DeclarativeAdvancedConfiguration is the declarative form of an [AdvancedClientConfiguration] or an
+// [AdvancedClusterClientConfiguration]. The periodic checks only apply to cluster clients.
+type DeclarativeAdvancedConfiguration struct {
+	ConnectionTimeout       string `json:"connectionTimeout,omitempty"       yaml:"connectionTimeout,omitempty"`
+	InflightRequestsLimit   uint32 `json:"inflightRequestsLimit,omitempty"   yaml:"inflightRequestsLimit,omitempty"`
+	WaitForInflightRequests bool   `json:"waitForInflightRequests,omitempty" yaml:"waitForInflightRequests,omitempty"`
+	PeriodicChecksInterval  string `json:"periodicChecksInterval,omitempty"  yaml:"periodicChecksInterval,omitempty"`
+	PeriodicChecksDisabled  bool   `json:"periodicChecksDisabled,omitempty"  yaml:"periodicChecksDisabled,omitempty"`
+}
+
+// DeclarativeSubscriptions is the declarative form of a [StandaloneSubscriptionConfig] or a
+// [ClusterSubscriptionConfig]. The sharded channels only apply to cluster clients.
+type DeclarativeSubscriptions struct {
+	Exact   []string `json:"exact,omitempty"   yaml:"exact,omitempty"`
+	Pattern []string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Sharded []string `json:"sharded,omitempty" yaml:"sharded,omitempty"`
+}
+
+// ParseConfigurationJSON parses a [DeclarativeConfiguration] from JSON. Unknown fields are reported as errors, so that
+// misspelled settings aren't silently ignored.
+func ParseConfigurationJSON(data []byte) (*DeclarativeConfiguration, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	config := &DeclarativeConfiguration{}
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("invalid JSON configuration: %w", err)
+	}
+	return config, nil
+}
+
+// ConfigurationFromEnv reads a [DeclarativeConfiguration] from the environment variables with the given prefix, e.g.
+// `VALKEY_` for `VALKEY_ADDRESSES`. The variables are named after the fields in upper snake case:
+//
+//	ADDRESSES, USE_TLS, USERNAME, PASSWORD, READ_FROM, CLIENT_AZ, REQUEST_TIMEOUT, CLIENT_NAME, PROTOCOL,
+//	LAZY_CONNECT, DATABASE_ID,
+//	RECONNECT_NUM_OF_RETRIES, RECONNECT_FACTOR, RECONNECT_EXPONENT_BASE, RECONNECT_JITTER_PERCENT,
+//	CONNECTION_TIMEOUT, INFLIGHT_REQUESTS_LIMIT, WAIT_FOR_INFLIGHT_REQUESTS, PERIODIC_CHECKS_INTERVAL,
+//	PERIODIC_CHECKS_DISABLED,
+//	SUBSCRIPTIONS_EXACT, SUBSCRIPTIONS_PATTERN, SUBSCRIPTIONS_SHARDED
+//
+// The lists are separated by commas, and the booleans have the format of [strconv.ParseBool]. The error reports every
+// variable with an invalid value, along with the configuration read from the other variables.
+func ConfigurationFromEnv(prefix string) (*DeclarativeConfiguration, error) {
+	env := &envReader{prefix: prefix}
+	config := &DeclarativeConfiguration{}
+	env.list("ADDRESSES", &config.Addresses)
+	env.boolean("USE_TLS", &config.UseTLS)
+	env.string("USERNAME", &config.Username)
+	env.string("PASSWORD", &config.Password)
+	env.string("READ_FROM", &config.ReadFrom)
+	env.string("CLIENT_AZ", &config.ClientAZ)
+	env.string("REQUEST_TIMEOUT", &config.RequestTimeout)
+	env.string("CLIENT_NAME", &config.ClientName)
+	env.string("PROTOCOL", &config.Protocol)
+	env.boolean("LAZY_CONNECT", &config.LazyConnect)
+	env.integer("DATABASE_ID", &config.DatabaseId)
+
+	if env.isSet("RECONNECT_NUM_OF_RETRIES", "RECONNECT_FACTOR", "RECONNECT_EXPONENT_BASE", "RECONNECT_JITTER_PERCENT") {
+		strategy := &DeclarativeBackoffStrategy{}
+		env.integer("RECONNECT_NUM_OF_RETRIES", &strategy.NumOfRetries)
+		env.integer("RECONNECT_FACTOR", &strategy.Factor)
+		env.integer("RECONNECT_EXPONENT_BASE", &strategy.ExponentBase)
+		if env.isSet("RECONNECT_JITTER_PERCENT") {
+			strategy.JitterPercent = new(int)
+			env.integer("RECONNECT_JITTER_PERCENT", strategy.JitterPercent)
+		}
+		config.ReconnectStrategy = strategy
+	}
+
+	if env.isSet("CONNECTION_TIMEOUT", "INFLIGHT_REQUESTS_LIMIT", "WAIT_FOR_INFLIGHT_REQUESTS", "PERIODIC_CHECKS_INTERVAL",
+		"PERIODIC_CHECKS_DISABLED") {
+		advanced := &DeclarativeAdvancedConfiguration{}
+		env.string("CONNECTION_TIMEOUT", &advanced.ConnectionTimeout)
+		env.uint32("INFLIGHT_REQUESTS_LIMIT", &advanced.InflightRequestsLimit)
+		env.boolean("WAIT_FOR_INFLIGHT_REQUESTS", &advanced.WaitForInflightRequests)
+		env.string("PERIODIC_CHECKS_INTERVAL", &advanced.PeriodicChecksInterval)
+		env.boolean("PERIODIC_CHECKS_DISABLED", &advanced.PeriodicChecksDisabled)
+		config.Advanced = advanced
+	}
+
+	if env.isSet("SUBSCRIPTIONS_EXACT", "SUBSCRIPTIONS_PATTERN", "SUBSCRIPTIONS_SHARDED") {
+		subscriptions := &DeclarativeSubscriptions{}
+		env.list("SUBSCRIPTIONS_EXACT", &subscriptions.Exact)
+		env.list("SUBSCRIPTIONS_PATTERN", &subscriptions.Pattern)
+		env.list("SUBSCRIPTIONS_SHARDED", &subscriptions.Sharded)
+		config.Subscriptions = subscriptions
+	}
+	return config, errors.Join(env.errs...)
+}
+
+// NewClientConfigurationFromJSON returns a [ClientConfiguration] from a [DeclarativeConfiguration] in JSON, see
+// [ParseConfigurationJSON] and [DeclarativeConfiguration.ToClientConfiguration].
+func NewClientConfigurationFromJSON(data []byte) (*ClientConfiguration, error) {
+	declarative, err := ParseConfigurationJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return declarative.ToClientConfiguration()
+}
+
+// NewClusterClientConfigurationFromJSON returns a [ClusterClientConfiguration] from a [DeclarativeConfiguration] in
+// JSON, see [ParseConfigurationJSON] and [DeclarativeConfiguration.ToClusterClientConfiguration].
+func NewClusterClientConfigurationFromJSON(data []byte) (*ClusterClientConfiguration, error) {
+	declarative, err := ParseConfigurationJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return declarative.ToClusterClientConfiguration()
+}
+
+// NewClientConfigurationFromEnv returns a [ClientConfiguration] from the environment variables with the given prefix,
+// see [ConfigurationFromEnv] and [DeclarativeConfiguration.ToClientConfiguration]. The error reports both the invalid
+// variables and the problems of the configuration.
+func NewClientConfigurationFromEnv(prefix string) (*ClientConfiguration, error) {
+	declarative, envErr := ConfigurationFromEnv(prefix)
+	config, err := declarative.ToClientConfiguration()
+	if envErr != nil {
+		return nil, errors.Join(envErr, err)
+	}
+	return config, err
+}
+
+// NewClusterClientConfigurationFromEnv returns a [ClusterClientConfiguration] from the environment variables with the
+// given prefix, see [ConfigurationFromEnv] and [DeclarativeConfiguration.ToClusterClientConfiguration]. The error
+// reports both the invalid variables and the problems of the configuration.
+func NewClusterClientConfigurationFromEnv(prefix string) (*ClusterClientConfiguration, error) {
+	declarative, envErr := ConfigurationFromEnv(prefix)
+	config, err := declarative.ToClusterClientConfiguration()
+	if envErr != nil {
+		return nil, errors.Join(envErr, err)
+	}
+	return config, err
+}
+
+// ToClientConfiguration returns the [ClientConfiguration] of a standalone client. The configuration is fully validated,
+// and the error reports every problem found, e.g. an AZ affinity read strategy without a client AZ, joined with
+// [errors.Join].
+func (declarative *DeclarativeConfiguration) ToClientConfiguration() (*ClientConfiguration, error) {
+	problems := &configurationProblems{}
+	config := NewClientConfiguration()
+	declarative.setBase(&config.baseClientConfiguration, problems)
+
+	if declarative.DatabaseId < 0 {
+		problems.add("databaseId", "must not be negative")
+	}
+	config.databaseId = declarative.DatabaseId
+
+	if advanced := declarative.Advanced; advanced != nil {
+		if advanced.PeriodicChecksInterval != "" || advanced.PeriodicChecksDisabled {
+			problems.add("advanced", "the periodic checks only apply to cluster clients")
+		}
+		config.WithAdvancedConfiguration(NewAdvancedClientConfiguration().
+			WithConnectionTimeout(problems.duration("advanced.connectionTimeout", advanced.ConnectionTimeout)).
+			WithInflightRequestsLimit(advanced.InflightRequestsLimit).
+			WithWaitForInflightRequests(advanced.WaitForInflightRequests))
+	}
+
+	if subscriptions := declarative.Subscriptions; subscriptions != nil {
+		if len(subscriptions.Sharded) > 0 {
+			problems.add("subscriptions.sharded", "sharded channels only apply to cluster clients")
+		}
+		subscriptionConfig := NewStandaloneSubscriptionConfig()
+		for _, channel := range subscriptions.Exact {
+			subscriptionConfig.WithSubscription(ExactChannelMode, channel)
+		}
+		for _, pattern := range subscriptions.Pattern {
+			subscriptionConfig.WithSubscription(PatternChannelMode, pattern)
+		}
+		config.WithSubscriptionConfig(subscriptionConfig)
+	}
+
+	if len(problems.errs) == 0 {
+		if _, err := config.ToProtobuf(); err != nil {
+			problems.errs = append(problems.errs, err)
+		}
+	}
+	if err := problems.err(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ToClusterClientConfiguration returns the [ClusterClientConfiguration] of a cluster client, where the addresses are
+// the seed nodes of the cluster. The configuration is fully validated, and the error reports every problem found, e.g.
+// an AZ affinity read strategy without a client AZ, joined with [errors.Join].
+func (declarative *DeclarativeConfiguration) ToClusterClientConfiguration() (*ClusterClientConfiguration, error) {
+	problems := &configurationProblems{}
+	config := NewClusterClientConfiguration()
+	declarative.setBase(&config.baseClientConfiguration, problems)
+
+	if declarative.DatabaseId != 0 {
+		problems.add("databaseId", "a database other than 0 can't be selected in cluster mode")
+	}
+
+	if advanced := declarative.Advanced; advanced != nil {
+		advancedConfig := NewAdvancedClusterClientConfiguration().
+			WithConnectionTimeout(problems.duration("advanced.connectionTimeout", advanced.ConnectionTimeout)).
+			WithInflightRequestsLimit(advanced.InflightRequestsLimit).
+			WithWaitForInflightRequests(advanced.WaitForInflightRequests)
+		switch {
+		case advanced.PeriodicChecksDisabled && advanced.PeriodicChecksInterval != "":
+			problems.add("advanced", "the periodic checks can't have an interval when they are disabled")
+		case advanced.PeriodicChecksDisabled:
+			advancedConfig.WithPeriodicChecksDisabled()
+		case advanced.PeriodicChecksInterval != "":
+			advancedConfig.WithPeriodicChecksManualInterval(
+				problems.duration("advanced.periodicChecksInterval", advanced.PeriodicChecksInterval),
+			)
+		}
+		config.WithAdvancedConfiguration(advancedConfig)
+	}
+
+	if subscriptions := declarative.Subscriptions; subscriptions != nil {
+		subscriptionConfig := NewClusterSubscriptionConfig()
+		for _, channel := range subscriptions.Exact {
+			subscriptionConfig.WithSubscription(ExactClusterChannelMode, channel)
+		}
+		for _, pattern := range subscriptions.Pattern {
+			subscriptionConfig.WithSubscription(PatternClusterChannelMode, pattern)
+		}
+		for _, channel := range subscriptions.Sharded {
+			subscriptionConfig.WithSubscription(ShardedClusterChannelMode, channel)
+		}
+		config.WithSubscriptionConfig(subscriptionConfig)
+	}
+
+	if len(problems.errs) == 0 {
+		if _, err := config.ToProtobuf(); err != nil {
+			problems.errs = append(problems.errs, err)
+		}
+	}
+	if err := problems.err(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// setBase sets the settings shared by standalone and cluster clients.
+func (declarative *DeclarativeConfiguration) setBase(config *baseClientConfiguration, problems *configurationProblems) {
+	if len(declarative.Addresses) == 0 {
+		problems.add("addresses", "at least one address must be set")
+	}
+	for i, address := range declarative.Addresses {
+		addresses, err := parseHosts([]string{address})
+		if err != nil {
+			problems.add(fmt.Sprintf("addresses[%d]", i), err.Error())
+			continue
+		}
+		config.addresses = append(config.addresses, addresses...)
+	}
+	config.useTLS = declarative.UseTLS
+
+	switch {
+	case declarative.Password != "":
+		config.credentials = NewServerCredentials(declarative.Username, declarative.Password)
+	case declarative.Username != "":
+		problems.add("password", "must be set with the username")
+	}
+
+	if declarative.ReadFrom != "" {
+		readFrom, err := parseReadFrom(declarative.ReadFrom)
+		if err != nil {
+			problems.add("readFrom", err.Error())
+		}
+		config.readFrom = readFrom
+	}
+	if (config.readFrom == AzAffinity || config.readFrom == AzAffinityReplicaAndPrimary) && declarative.ClientAZ == "" {
+		problems.add("clientAZ", "must be set with the AZ affinity read strategies")
+	}
+	config.clientAZ = declarative.ClientAZ
+	config.requestTimeout = problems.duration("requestTimeout", declarative.RequestTimeout)
+	config.clientName = declarative.ClientName
+
+	switch strings.ToUpper(declarative.Protocol) {
+	case "", "RESP3":
+		config.protocol = RESP3
+	case "RESP2":
+		config.protocol = RESP2
+	default:
+		problems.add("protocol", "expected RESP3 or RESP2")
+	}
+	config.lazyConnect = declarative.LazyConnect
+
+	if strategy := declarative.ReconnectStrategy; strategy != nil {
+		if strategy.NumOfRetries < 0 || strategy.Factor < 0 || strategy.ExponentBase < 0 {
+			problems.add("reconnectStrategy", "the number of retries, the factor and the exponent base must not be negative")
+		}
+		config.reconnectStrategy = NewBackoffStrategy(strategy.NumOfRetries, strategy.Factor, strategy.ExponentBase)
+		if strategy.JitterPercent != nil {
+			if *strategy.JitterPercent < 0 || *strategy.JitterPercent > 100 {
+				problems.add("reconnectStrategy.jitterPercent", "must be between 0 and 100")
+			}
+			config.reconnectStrategy.WithJitterPercent(*strategy.JitterPercent)
+		}
+	}
+}
+
+// configurationProblems collects the problems of a configuration, so that they are reported all at once.
+type configurationProblems struct {
+	errs []error
+}
+
+func (problems *configurationProblems) add(field string, problem string) {
+	problems.errs = append(problems.errs, fmt.Errorf("%s: %s", field, problem))
+}
+
+// duration parses a positive duration, or returns 0 if it isn't set.
+func (problems *configurationProblems) duration(field string, value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		problems.add(field, fmt.Sprintf("invalid duration %q, expected a positive duration such as 500ms", value))
+		return 0
+	}
+	return duration
+}
+
+func (problems *configurationProblems) err() error {
+	if len(problems.errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid configuration:\n%w", errors.Join(problems.errs...))
+}
+
+// envReader reads the environment variables with a prefix, and collects the variables with invalid values.
+type envReader struct {
+	prefix string
+	errs   []error
+}
+
+func (env *envReader) isSet(names ...string) bool {
+	for _, name := range names {
+		if _, ok := os.LookupEnv(env.prefix + name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (env *envReader) string(name string, value *string) {
+	if envValue, ok := os.LookupEnv(env.prefix + name); ok {
+		*value = envValue
+	}
+}
+
+func (env *envReader) list(name string, value *[]string) {
+	if envValue, ok := os.LookupEnv(env.prefix + name); ok && envValue != "" {
+		*value = nil
+		for _, item := range strings.Split(envValue, ",") {
+			*value = append(*value, strings.TrimSpace(item))
+		}
+	}
+}
+
+func (env *envReader) boolean(name string, value *bool) {
+	if envValue, ok := os.LookupEnv(env.prefix + name); ok {
+		parsed, err := strconv.ParseBool(envValue)
+		if err != nil {
+			env.invalid(name, envValue, "a boolean")
+			return
+		}
+		*value = parsed
+	}
+}
+
+func (env *envReader) integer(name string, value *int) {
+	if envValue, ok := os.LookupEnv(env.prefix + name); ok {
+		parsed, err := strconv.Atoi(envValue)
+		if err != nil {
+			env.invalid(name, envValue, "an integer")
+			return
+		}
+		*value = parsed
+	}
+}
+
+func (env *envReader) uint32(name string, value *uint32) {
+	if envValue, ok := os.LookupEnv(env.prefix + name); ok {
+		parsed, err := strconv.ParseUint(envValue, 10, 32)
+		if err != nil {
+			env.invalid(name, envValue, "a non-negative integer")
+			return
+		}
+		*value = uint32(parsed)
+	}
+}
+
+func (env *envReader) invalid(name string, value string, expected string) {
+	env.errs = append(env.errs, fmt.Errorf("%s%s: invalid value %q, expected %s", env.prefix, name, value, expected))
+}
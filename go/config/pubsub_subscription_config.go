@@ -94,6 +94,15 @@ func (config *StandaloneSubscriptionConfig) WithSubscription(
 	return config
 }
 
+// GetSubscriptions returns the channels and patterns to subscribe to upon connection establishment, by their mode.
+func (config *StandaloneSubscriptionConfig) GetSubscriptions() map[PubSubChannelMode][]string {
+	subscriptions := make(map[PubSubChannelMode][]string, len(config.subscriptions))
+	for mode, channels := range config.subscriptions {
+		subscriptions[PubSubChannelMode(mode)] = append([]string{}, channels...)
+	}
+	return subscriptions
+}
+
 // *** ClusterSubscriptionConfig ***
 
 type PubSubClusterChannelMode int
@@ -137,3 +146,12 @@ func (config *ClusterSubscriptionConfig) WithSubscription(
 	config.subscriptions[modeKey] = append(channels, channelOrPattern)
 	return config
 }
+
+// GetSubscriptions returns the channels and patterns to subscribe to upon connection establishment, by their mode.
+func (config *ClusterSubscriptionConfig) GetSubscriptions() map[PubSubClusterChannelMode][]string {
+	subscriptions := make(map[PubSubClusterChannelMode][]string, len(config.subscriptions))
+	for mode, channels := range config.subscriptions {
+		subscriptions[PubSubClusterChannelMode(mode)] = append([]string{}, channels...)
+	}
+	return subscriptions
+}
@@ -0,0 +1,129 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+)
+
+// TLSConfig configures the TLS connections of a client, e.g. to servers with certificates issued by a private
+// certificate authority, or which require the clients to authenticate with a certificate (mutual TLS).
+//
+// The TLS connections are established by the core of the client, so only the certificates, the server name and the
+// verification mode are taken into account, while the TLS versions and cipher suites are those of the core.
+type TLSConfig struct {
+	rootCerts  [][]byte
+	clientCert []byte
+	clientKey  []byte
+	serverName string
+	insecure   bool
+}
+
+// NewTLSConfig returns a [TLSConfig] which verifies the certificates of the servers with the root certificates of the
+// platform.
+func NewTLSConfig() *TLSConfig {
+	return &TLSConfig{}
+}
+
+// NewTLSConfigFromTLS returns a [TLSConfig] with the client certificate, the server name and the verification mode of
+// the given [tls.Config].
+//
+// The root certificate authorities of a [tls.Config] can't be read from its [x509.CertPool], so they must be set in PEM
+// format with [TLSConfig.WithRootCertificates]. An error is returned if `RootCAs` is set, or if the config relies on
+// callbacks, e.g. `GetClientCertificate` or `VerifyPeerCertificate`, which can't be used by the core.
+func NewTLSConfigFromTLS(tlsConfig *tls.Config) (*TLSConfig, error) {
+	if tlsConfig.RootCAs != nil {
+		return nil, errors.New("the RootCAs of a tls.Config can't be used, set the root certificates in PEM format instead")
+	}
+	if tlsConfig.GetClientCertificate != nil || tlsConfig.GetCertificate != nil ||
+		tlsConfig.VerifyPeerCertificate != nil || tlsConfig.VerifyConnection != nil {
+		return nil, errors.New("the callbacks of a tls.Config can't be used")
+	}
+	if len(tlsConfig.Certificates) > 1 {
+		return nil, errors.New("a tls.Config with more than one client certificate can't be used")
+	}
+
+	config := &TLSConfig{serverName: tlsConfig.ServerName, insecure: tlsConfig.InsecureSkipVerify}
+	if len(tlsConfig.Certificates) == 1 {
+		certificate := tlsConfig.Certificates[0]
+		for _, cert := range certificate.Certificate {
+			config.clientCert = append(config.clientCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})...)
+		}
+		key, err := x509.MarshalPKCS8PrivateKey(certificate.PrivateKey)
+		if err != nil {
+			return nil, errors.New("the private key of the client certificate can't be encoded: " + err.Error())
+		}
+		config.clientKey = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key})
+	}
+	return config, nil
+}
+
+// WithRootCertificates adds root certificates in PEM format, e.g. the certificate of a private certificate authority.
+// Once set, the certificates of the servers are verified with these root certificates instead of those of the platform.
+func (config *TLSConfig) WithRootCertificates(pemCerts ...[]byte) *TLSConfig {
+	config.rootCerts = append(config.rootCerts, pemCerts...)
+	return config
+}
+
+// WithClientCertificate sets the certificate chain and the private key in PEM format which authenticate the client to
+// the servers, for mutual TLS.
+func (config *TLSConfig) WithClientCertificate(certPEM []byte, keyPEM []byte) *TLSConfig {
+	config.clientCert = certPEM
+	config.clientKey = keyPEM
+	return config
+}
+
+// WithServerName sets the name verified against the certificates of the servers, instead of the hosts of their
+// addresses. This is useful when the servers are reached by IP address, e.g. in a cluster, while their certificates
+// are issued for a DNS name.
+func (config *TLSConfig) WithServerName(serverName string) *TLSConfig {
+	config.serverName = serverName
+	return config
+}
+
+// WithInsecure sets whether the certificates of the servers aren't verified. The connections are still encrypted, but
+// aren't protected against impersonation of the servers, so this is only meant for local development, e.g. with
+// self-signed certificates.
+func (config *TLSConfig) WithInsecure(insecure bool) *TLSConfig {
+	config.insecure = insecure
+	return config
+}
+
+// IsInsecure returns whether the certificates of the servers aren't verified.
+func (config *TLSConfig) IsInsecure() bool {
+	return config.insecure
+}
+
+func (config *TLSConfig) validate() error {
+	if (config.clientCert == nil) != (config.clientKey == nil) {
+		return errors.New("the client certificate and key must be set together")
+	}
+	if config.clientCert != nil {
+		if _, err := tls.X509KeyPair(config.clientCert, config.clientKey); err != nil {
+			return errors.New("invalid client certificate: " + err.Error())
+		}
+	}
+	for _, rootCert := range config.rootCerts {
+		if !x509.NewCertPool().AppendCertsFromPEM(rootCert) {
+			return errors.New("invalid root certificates: no certificate found in PEM data")
+		}
+	}
+	return nil
+}
+
+func (config *TLSConfig) toProtobuf() *protobuf.TlsConfiguration {
+	if len(config.rootCerts) == 0 && config.clientCert == nil && config.serverName == "" {
+		return nil
+	}
+	return &protobuf.TlsConfiguration{
+		RootCerts:  config.rootCerts,
+		ClientCert: config.clientCert,
+		ClientKey:  config.clientKey,
+		ServerName: config.serverName,
+	}
+}
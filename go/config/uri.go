@@ -0,0 +1,245 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConnectionURI holds the settings of a connection URI, see [ParseURI].
+type ConnectionURI struct {
+	// The addresses of the hosts, with the default port if the host has none.
+	Addresses []NodeAddress
+	// Whether the scheme is `rediss` or `valkeys`.
+	UseTLS bool
+	// The credentials of the user info, nil if there is none.
+	Credentials *ServerCredentials
+	// The index of the database of the path, 0 if there is none.
+	DatabaseId int
+	// The settings of the query parameters, left to their zero value when the parameter isn't set.
+	RequestTimeout    time.Duration
+	ClientName        string
+	ReadFrom          ReadFrom
+	ClientAZ          string
+	ReconnectStrategy *BackoffStrategy
+}
+
+var readFromNames = map[string]ReadFrom{
+	"primary":                      Primary,
+	"preferreplica":                PreferReplica,
+	"azaffinity":                   AzAffinity,
+	"azaffinityreplicasandprimary": AzAffinityReplicaAndPrimary,
+}
+
+// ParseURI parses a connection URI with the format:
+//
+//	scheme://[[username]:password@]host[:port][,host[:port]...][/database][?parameter=value[&parameter=value...]]
+//
+// The scheme is `redis` or `valkey`, or `rediss` or `valkeys` for TLS connections. The default username is used when
+// only the password is set, and the hosts without a port use [DefaultPort]. IPv6 hosts are enclosed in brackets.
+//
+// The supported query parameters are:
+//   - `request_timeout`: the request timeout, as a duration such as `500ms` or `2s`.
+//   - `client_name`: the name of the client.
+//   - `read_from`: the read strategy, one of `primary`, `preferReplica`, `AZAffinity` or `AZAffinityReplicasAndPrimary`.
+//   - `client_az`: the availability zone of the client, required by the AZ affinity read strategies.
+//   - `reconnect_retries`, `reconnect_factor`, `reconnect_exponent_base` and `reconnect_jitter_percent`: the reconnect
+//     [BackoffStrategy], where all the parameters but the jitter must be set together.
+//
+// An error is returned for an unknown scheme or parameter, an invalid value, or a parameter set more than once.
+func ParseURI(uri string) (*ConnectionURI, error) {
+	// The hosts are split from the rest of the URI, which can't be parsed with several hosts in brackets
+	scheme, rest, found := strings.Cut(uri, "://")
+	if !found {
+		return nil, errors.New("invalid URI: the scheme must be followed by ://")
+	}
+	authorityEnd := strings.IndexAny(rest, "/?#")
+	if authorityEnd < 0 {
+		authorityEnd = len(rest)
+	}
+	userInfo, hosts := "", rest[:authorityEnd]
+	if i := strings.LastIndex(hosts, "@"); i >= 0 {
+		userInfo, hosts = hosts[:i+1], hosts[i+1:]
+	}
+	parsed, err := url.Parse(scheme + "://" + userInfo + rest[authorityEnd:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid URI: %w", err)
+	}
+	result := &ConnectionURI{}
+	switch parsed.Scheme {
+	case "redis", "valkey":
+	case "rediss", "valkeys":
+		result.UseTLS = true
+	default:
+		return nil, fmt.Errorf("invalid URI: unsupported scheme %q, expected redis, rediss, valkey or valkeys", parsed.Scheme)
+	}
+	if parsed.Fragment != "" {
+		return nil, errors.New("invalid URI: fragments aren't supported")
+	}
+
+	if result.Addresses, err = parseHosts(strings.Split(hosts, ",")); err != nil {
+		return nil, fmt.Errorf("invalid URI: %w", err)
+	}
+	if parsed.User != nil {
+		password, hasPassword := parsed.User.Password()
+		if !hasPassword {
+			return nil, errors.New("invalid URI: the username must be followed by a password")
+		}
+		result.Credentials = NewServerCredentials(parsed.User.Username(), password)
+	}
+	if database := strings.TrimPrefix(parsed.Path, "/"); database != "" {
+		if result.DatabaseId, err = strconv.Atoi(database); err != nil || result.DatabaseId < 0 {
+			return nil, fmt.Errorf("invalid URI: the database must be a non-negative integer, got %q", database)
+		}
+	}
+
+	query, err := url.ParseQuery(parsed.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URI: %w", err)
+	}
+	if err := result.parseQuery(query); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// parseHosts parses hosts with the format `host[:port]`, or `localhost` if the only host is empty.
+func parseHosts(hosts []string) ([]NodeAddress, error) {
+	if len(hosts) == 1 && hosts[0] == "" {
+		return []NodeAddress{{Host: DefaultHost, Port: DefaultPort}}, nil
+	}
+	var addresses []NodeAddress
+	for _, host := range hosts {
+		address := NodeAddress{Host: host, Port: DefaultPort}
+		// The port is optional, and IPv6 hosts contain colons, so the host is split only if it ends with a port
+		if hostname, port, err := net.SplitHostPort(host); err == nil {
+			address.Host = hostname
+			if address.Port, err = strconv.Atoi(port); err != nil || address.Port <= 0 || address.Port > 65535 {
+				return nil, fmt.Errorf("invalid port in host %q", host)
+			}
+		} else if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+			address.Host = host[1 : len(host)-1]
+		}
+		if address.Host == "" || strings.ContainsAny(address.Host, "[]") {
+			return nil, fmt.Errorf("invalid host %q", host)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func (result *ConnectionURI) parseQuery(query url.Values) error {
+	var retries, factor, exponentBase, jitter *int
+	for name, values := range query {
+		if len(values) > 1 {
+			return fmt.Errorf("invalid URI: the parameter %s is set more than once", name)
+		}
+		value := values[0]
+		var err error
+		switch name {
+		case "request_timeout":
+			result.RequestTimeout, err = time.ParseDuration(value)
+			if err == nil && result.RequestTimeout <= 0 {
+				err = errors.New("the duration must be positive")
+			}
+		case "client_name":
+			result.ClientName = value
+		case "read_from":
+			result.ReadFrom, err = parseReadFrom(value)
+		case "client_az":
+			result.ClientAZ = value
+		case "reconnect_retries":
+			retries, err = parseURIUint(value)
+		case "reconnect_factor":
+			factor, err = parseURIUint(value)
+		case "reconnect_exponent_base":
+			exponentBase, err = parseURIUint(value)
+		case "reconnect_jitter_percent":
+			jitter, err = parseURIUint(value)
+		default:
+			return fmt.Errorf("invalid URI: unknown parameter %s", name)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid URI: invalid value %q of the parameter %s: %w", value, name, err)
+		}
+	}
+
+	if (result.ReadFrom == AzAffinity || result.ReadFrom == AzAffinityReplicaAndPrimary) && result.ClientAZ == "" {
+		return errors.New("invalid URI: the AZ affinity read strategies require the client_az parameter")
+	}
+	if retries != nil || factor != nil || exponentBase != nil || jitter != nil {
+		if retries == nil || factor == nil || exponentBase == nil {
+			return errors.New("invalid URI: the parameters reconnect_retries, reconnect_factor and " +
+				"reconnect_exponent_base must be set together")
+		}
+		result.ReconnectStrategy = NewBackoffStrategy(*retries, *factor, *exponentBase)
+		if jitter != nil {
+			result.ReconnectStrategy.WithJitterPercent(*jitter)
+		}
+	}
+	return nil
+}
+
+// parseReadFrom parses the name of a read strategy, regardless of its case.
+func parseReadFrom(name string) (ReadFrom, error) {
+	readFrom, ok := readFromNames[strings.ToLower(name)]
+	if !ok {
+		return Primary, errors.New("expected primary, preferReplica, AZAffinity or AZAffinityReplicasAndPrimary")
+	}
+	return readFrom, nil
+}
+
+func parseURIUint(value string) (*int, error) {
+	number, err := strconv.ParseUint(value, 10, 31)
+	if err != nil {
+		return nil, errors.New("expected a non-negative integer")
+	}
+	result := int(number)
+	return &result, nil
+}
+
+// NewClientConfigurationFromURI returns a [ClientConfiguration] with the settings of a connection URI, see [ParseURI].
+// It can be further configured with the [ClientConfiguration] With* methods.
+func NewClientConfigurationFromURI(uri string) (*ClientConfiguration, error) {
+	parsed, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	config := NewClientConfiguration()
+	config.setURI(parsed)
+	config.databaseId = parsed.DatabaseId
+	return config, nil
+}
+
+// NewClusterClientConfigurationFromURI returns a [ClusterClientConfiguration] with the settings of a connection URI, see
+// [ParseURI]. The hosts are used as the seed nodes of the cluster, and the URI can't have a database other than 0.
+// It can be further configured with the [ClusterClientConfiguration] With* methods.
+func NewClusterClientConfigurationFromURI(uri string) (*ClusterClientConfiguration, error) {
+	parsed, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.DatabaseId != 0 {
+		return nil, errors.New("invalid URI: a database other than 0 can't be selected in cluster mode")
+	}
+	config := NewClusterClientConfiguration()
+	config.setURI(parsed)
+	return config, nil
+}
+
+func (config *baseClientConfiguration) setURI(uri *ConnectionURI) {
+	config.addresses = append(config.addresses, uri.Addresses...)
+	config.useTLS = uri.UseTLS
+	config.credentials = uri.Credentials
+	config.requestTimeout = uri.RequestTimeout
+	config.clientName = uri.ClientName
+	config.readFrom = uri.ReadFrom
+	config.clientAZ = uri.ClientAZ
+	config.reconnectStrategy = uri.ReconnectStrategy
+}
@@ -138,3 +138,44 @@ func ExampleClusterClient_ClientGetNameWithOptions() {
 
 	// Output: true
 }
+
+func ExampleClusterClient_ClientInfoWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientInfoWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, info := range result.MultiValue() {
+		fmt.Println(info.Id > 0)
+		break
+	}
+
+	// Output: true
+}
+
+func ExampleClusterClient_ClientListWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	filter := options.NewClientFilter().SetType(options.ClientTypeNormal)
+	opts := options.RouteOption{Route: config.RandomRoute}
+	result, err := client.ClientListWithOptions(context.Background(), *filter, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result.SingleValue()) > 0)
+
+	// Output: true
+}
+
+func ExampleClusterClient_ClientTrackingInfoWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")}
+	client.ClientTrackingWithOptions(context.Background(), *options.NewClientTrackingOptions(true).SetOptIn(), route)
+	info, err := client.ClientTrackingInfoWithOptions(context.Background(), route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(info.SingleValue().Flags)
+
+	// Output: [on optin]
+}
@@ -5,6 +5,7 @@ package glide
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -80,3 +81,80 @@ func ExampleClient_ClientGetName() {
 
 	// Output: true
 }
+
+func ExampleClient_ClientInfo() {
+	var client *Client = getExampleClient() // example helper function
+	id, _ := client.ClientId(context.Background())
+	result, err := client.ClientInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Id == id)
+
+	// Output: true
+}
+
+func ExampleClient_ClientListWithFilter() {
+	var client *Client = getExampleClient() // example helper function
+	id, _ := client.ClientId(context.Background())
+	filter := options.NewClientFilter().SetIds(id)
+	result, err := client.ClientListWithFilter(context.Background(), *filter)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result))
+	fmt.Println(result[0].Id == id)
+
+	// Output:
+	// 1
+	// true
+}
+
+func ExampleClient_ClientKill() {
+	var client *Client = getExampleClient() // example helper function
+	filter := options.NewClientFilter().SetType(options.ClientTypePubSub).SetUser("nonexistent-" + uuid.NewString())
+	result, err := client.ClientKill(context.Background(), *filter)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 0
+}
+
+func ExampleClient_ClientPauseWithMode() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.ClientPauseWithMode(context.Background(), 10*time.Millisecond, options.ClientPauseWrite)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.ClientUnpause(context.Background())
+
+	// Output: OK
+}
+
+func ExampleClient_ClientTracking() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.ClientTracking(context.Background(), *options.NewClientTrackingOptions(true).SetNoLoop())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.ClientTracking(context.Background(), *options.NewClientTrackingOptions(false))
+
+	// Output: OK
+}
+
+func ExampleClient_ClientTrackingInfo() {
+	var client *Client = getExampleClient() // example helper function
+	client.ClientTracking(context.Background(), *options.NewClientTrackingOptions(true).SetBroadcast("user:"))
+	info, err := client.ClientTrackingInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(info.Flags, info.Prefixes)
+	client.ClientTracking(context.Background(), *options.NewClientTrackingOptions(false))
+
+	// Output: [on bcast] [user:]
+}
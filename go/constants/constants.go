@@ -16,6 +16,7 @@ const (
 	WithScoresKeyword string = "WITHSCORES" // Valkey API keyword for ZRandMember and ZDiff command to return scores along with members.
 	NoScoresKeyword   string = "NOSCORES"   // Valkey API keyword for the no scores option for zscan command.
 	WithValuesKeyword string = "WITHVALUES" // Valkey API keyword to query hash values along their names in `HRANDFIELD`.
+	FieldsKeyword     string = "FIELDS"     // Valkey API keyword preceding the fields in the hash field expiration commands.
 	AggregateKeyWord  string = "AGGREGATE"  // Valkey API keyword for the aggregate option for multiple commands.
 	WeightsKeyword    string = "WEIGHTS"    // Valkey API keyword for the weights option for multiple commands.
 	RankKeyword       string = "RANK"       // Valkey API keyword use to determine the rank of the match to return.
@@ -100,6 +101,16 @@ func (expireCondition ExpireCondition) ToString() (string, error) {
 	}
 }
 
+// A HashFieldConditionalSet defines whether the fields of a hash should be set depending on their existence.
+type HashFieldConditionalSet string
+
+const (
+	// OnlyIfAllFieldsExist only sets the fields if all of them already exist. Equivalent to "FXX" in the valkey API.
+	OnlyIfAllFieldsExist HashFieldConditionalSet = "FXX"
+	// OnlyIfNoFieldExists only sets the fields if none of them already exist. Equivalent to "FNX" in the valkey API.
+	OnlyIfNoFieldExists HashFieldConditionalSet = "FNX"
+)
+
 // An ExpiryType is used to configure the type of expiration for a value.
 type ExpiryType string
 
@@ -0,0 +1,131 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// credentialsRefresh refreshes the password of a client from its [config.CredentialsProvider] until the client is
+// closed.
+type credentialsRefresh struct {
+	config   *config.CredentialsProviderConfig
+	timeout  time.Duration
+	username string
+	password string
+	ctx      context.Context
+	stop     context.CancelFunc
+}
+
+// defaultConnectionTimeout returns the connection timeout applied by the core to a client which doesn't set it, see
+// `WithConnectionTimeout` of the advanced configuration.
+func defaultConnectionTimeout() time.Duration {
+	return time.Duration(C.default_connection_timeout_ms()) * time.Millisecond
+}
+
+// getCredentials gets the current credentials of the provider, which must return them within the timeout.
+func getCredentials(
+	ctx context.Context,
+	providerConfig *config.CredentialsProviderConfig,
+	timeout time.Duration,
+) (*config.ServerCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	credentials, err := providerConfig.GetProvider().GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if credentials == nil {
+		return nil, errors.New("the credentials provider returned no credentials")
+	}
+	return credentials, nil
+}
+
+// newCredentialsRefresh gets the credentials of the provider, which authenticate the connections of a new client. The
+// provider is given the connection timeout of the client to return them, or the default one if it's 0.
+func newCredentialsRefresh(
+	providerConfig *config.CredentialsProviderConfig,
+	connectionTimeout time.Duration,
+) (*credentialsRefresh, error) {
+	if connectionTimeout == 0 {
+		connectionTimeout = defaultConnectionTimeout()
+	}
+	ctx, stop := context.WithCancel(context.Background())
+	credentials, err := getCredentials(ctx, providerConfig, connectionTimeout)
+	if err != nil {
+		stop()
+		return nil, err
+	}
+	return &credentialsRefresh{
+		config:   providerConfig,
+		timeout:  connectionTimeout,
+		username: credentials.GetUsername(),
+		password: credentials.GetPassword(),
+		ctx:      ctx,
+		stop:     stop,
+	}, nil
+}
+
+// startCredentialsRefresh starts refreshing the password of the client, if it has a credentials provider. The refresh
+// of a client created with lazy connection establishes its connection.
+func (client *baseClient) startCredentialsRefresh() {
+	if client.credentials == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(client.credentials.config.GetRefreshInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-client.credentials.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := client.refreshCredentials(); err != nil && client.credentials.ctx.Err() == nil {
+					if callback := client.credentials.config.GetErrorCallback(); callback != nil {
+						callback(err)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// stopCredentialsRefresh stops refreshing the password of the client.
+func (client *baseClient) stopCredentialsRefresh() {
+	if client.credentials != nil {
+		client.credentials.stop()
+	}
+}
+
+// refreshCredentials updates the password of the client with the one of the provider, and authenticates the connections
+// with it. If the authentication fails, the previous password is restored, so that the client still reconnects with it.
+func (client *baseClient) refreshCredentials() error {
+	refresh := client.credentials
+	credentials, err := getCredentials(refresh.ctx, refresh.config, refresh.timeout)
+	if err != nil {
+		return errors.New("failed to refresh the credentials: " + err.Error())
+	}
+	if credentials.GetUsername() != refresh.username {
+		return errors.New("failed to refresh the credentials: the username can't be changed")
+	}
+	if credentials.GetPassword() == refresh.password {
+		return nil
+	}
+
+	if _, err := client.UpdateConnectionPassword(refresh.ctx, credentials.GetPassword(), true); err != nil {
+		if _, restoreErr := client.UpdateConnectionPassword(refresh.ctx, refresh.password, false); restoreErr != nil {
+			return errors.New("failed to update the password: " + err.Error() +
+				", and to restore the previous password: " + restoreErr.Error())
+		}
+		return errors.New("failed to update the password: " + err.Error())
+	}
+	refresh.password = credentials.GetPassword()
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestCredentialsRefreshTimeout(t *testing.T) {
+	// the provider hangs until its context is done
+	provider := config.CredentialsProviderFunc(func(ctx context.Context) (*config.ServerCredentials, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	providerConfig := config.NewCredentialsProviderConfig(provider, time.Minute)
+	refresh, err := newCredentialsRefresh(providerConfig, 10*time.Millisecond)
+	assert.Nil(t, refresh)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	provider = func(ctx context.Context) (*config.ServerCredentials, error) {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(defaultConnectionTimeout()), deadline, time.Second)
+		return config.NewServerCredentials("user", "password"), nil
+	}
+	refresh, err = newCredentialsRefresh(config.NewCredentialsProviderConfig(provider, time.Minute), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultConnectionTimeout(), refresh.timeout)
+	refresh.stop()
+}
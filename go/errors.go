@@ -6,9 +6,11 @@ package glide
 import "C"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // ConnectionError is a client error that occurs when there is an error while connecting or when a connection
@@ -34,9 +36,11 @@ func NewExecAbortError(message string) *ExecAbortError {
 
 func (e *ExecAbortError) Error() string { return e.msg }
 
-// TimeoutError is a client error that occurs when a request times out.
+// TimeoutError is a client error that occurs when a request times out, either after the request timeout of the client
+// or at the deadline of its context, in which case it wraps [context.DeadlineExceeded].
 type TimeoutError struct {
-	msg string
+	msg   string
+	cause error
 }
 
 func NewTimeoutError(message string) *TimeoutError {
@@ -45,6 +49,34 @@ func NewTimeoutError(message string) *TimeoutError {
 
 func (e *TimeoutError) Error() string { return e.msg }
 
+func (e *TimeoutError) Unwrap() error { return e.cause }
+
+// contextError returns the error of a request whose context is done, which is a [TimeoutError] once its deadline is
+// exceeded.
+func contextError(ctx context.Context) error {
+	if err := ctx.Err(); !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return newDeadlineExceededError()
+}
+
+// requestError returns the error of a request which failed in the core. The core times out at the deadline of the
+// context, possibly before the context is done, in which case the timeout is reported as in [contextError].
+func requestError(ctx context.Context, err error) error {
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.cause != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		return newDeadlineExceededError()
+	}
+	return err
+}
+
+func newDeadlineExceededError() *TimeoutError {
+	return &TimeoutError{msg: "request timed out: " + context.DeadlineExceeded.Error(), cause: context.DeadlineExceeded}
+}
+
 // DisconnectError is a client error that indicates a connection problem between Glide and server.
 type DisconnectError struct {
 	msg string
@@ -78,6 +110,31 @@ func NewConfigurationError(message string) *ConfigurationError {
 
 func (e *ConfigurationError) Error() string { return e.msg }
 
+// NoScriptError is a server error that occurs when a script invoked by its SHA1 digest, e.g. with `EvalSha`, isn't
+// loaded on the server. The script can be loaded with `ScriptLoad` before retrying the command.
+type NoScriptError struct {
+	msg string
+}
+
+func NewNoScriptError(message string) *NoScriptError {
+	return &NoScriptError{msg: message}
+}
+
+func (e *NoScriptError) Error() string { return e.msg }
+
+// InflightRequestsLimitError is a client error that occurs when a request is sent while the client already has as many
+// inflight requests as its limit, see `WithInflightRequestsLimit` of the advanced configuration. The request isn't
+// sent to the server, and can be retried once some of the inflight requests complete.
+type InflightRequestsLimitError struct {
+	msg string
+}
+
+func NewInflightRequestsLimitError(message string) *InflightRequestsLimitError {
+	return &InflightRequestsLimitError{msg: message}
+}
+
+func (e *InflightRequestsLimitError) Error() string { return e.msg }
+
 type BatchError struct {
 	errors []error
 }
@@ -104,12 +161,25 @@ func GoError(cErrorType uint32, errorMessage string) error {
 	case C.ExecAbort:
 		return &ExecAbortError{errorMessage}
 	case C.Timeout:
-		return &TimeoutError{errorMessage}
+		return &TimeoutError{msg: errorMessage}
 	case C.Disconnect:
 		return &DisconnectError{errorMessage}
+	case C.InflightRequestsLimit:
+		return &InflightRequestsLimitError{errorMessage}
+	case C.NoScript:
+		return &NoScriptError{errorMessage}
 	default:
-		return errors.New(errorMessage)
+		return serverError(errorMessage)
+	}
+}
+
+// serverError converts an error in the reply of a batch, which keeps the format of the server, to a Go error. The
+// NOSCRIPT errors of the other requests are identified by their error type instead.
+func serverError(errorMessage string) error {
+	if strings.HasPrefix(errorMessage, "NOSCRIPT ") {
+		return &NoScriptError{errorMessage}
 	}
+	return errors.New(errorMessage)
 }
 
 // ErrorsToString converts a slice of errors into a single string.
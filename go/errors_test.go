@@ -0,0 +1,19 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerError(t *testing.T) {
+	var noScriptErr *NoScriptError
+	assert.True(t, errors.As(serverError("NOSCRIPT No matching script. Please use EVAL."), &noScriptErr))
+
+	// only the error code of the server identifies a NOSCRIPT error, not the text of the error
+	assert.False(t, errors.As(serverError("ERR user_function: NoScriptError"), &noScriptErr))
+	assert.False(t, errors.As(serverError("An error was signalled by the server - NoScriptError: "), &noScriptErr))
+}
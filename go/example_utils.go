@@ -105,11 +105,17 @@ func getExampleClusterClient() *ClusterClient {
 }
 
 func getExampleClientWithSubscription(mode config.PubSubChannelMode, channelOrPattern string) *Client {
+	sConfig := config.NewStandaloneSubscriptionConfig().
+		WithSubscription(mode, channelOrPattern)
+	return getExampleClientWithSubscriptionConfig(sConfig)
+}
+
+// getExampleClientWithSubscriptionConfig returns a Client with the given subscription configuration, which can be empty
+// for the clients that subscribe at runtime.
+func getExampleClientWithSubscriptionConfig(sConfig *config.StandaloneSubscriptionConfig) *Client {
 	standaloneSubOnce.Do(func() {
 		initFlags()
 	})
-	sConfig := config.NewStandaloneSubscriptionConfig().
-		WithSubscription(mode, channelOrPattern)
 
 	config := config.NewClientConfiguration().
 		WithAddress(&standaloneAddresses[0]).
@@ -135,11 +141,17 @@ func getExampleClusterClientWithSubscription(
 	mode config.PubSubClusterChannelMode,
 	channelOrPattern string,
 ) *ClusterClient {
+	cConfig := config.NewClusterSubscriptionConfig().
+		WithSubscription(mode, channelOrPattern)
+	return getExampleClusterClientWithSubscriptionConfig(cConfig)
+}
+
+// getExampleClusterClientWithSubscriptionConfig returns a ClusterClient with the given subscription configuration, which
+// can be empty for the clients that subscribe at runtime.
+func getExampleClusterClientWithSubscriptionConfig(cConfig *config.ClusterSubscriptionConfig) *ClusterClient {
 	clusterSubOnce.Do(func() {
 		initFlags()
 	})
-	cConfig := config.NewClusterSubscriptionConfig().
-		WithSubscription(mode, channelOrPattern)
 
 	ccConfig := config.NewClusterClientConfiguration().
 		WithAddress(&clusterAddresses[0]).
@@ -0,0 +1,21 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package ft provides the commands of the Valkey Search module for Valkey GLIDE clients.
+//
+// The commands are executed with a standalone [glide.Client] or a cluster [glide.ClusterClient]:
+//
+//	schema := options.NewFtSchema().
+//		AddField(options.NewFtTagField("category")).
+//		AddField(options.NewFtVectorFieldHnsw("vec", options.FtDistanceMetricL2, 2))
+//	_, err := ft.CreateWithOptions(ctx, client, "idx", *schema,
+//		*options.NewFtCreateOptions().SetDataType(options.FtDataTypeHash).SetPrefixes("product:"))
+//	result, err := ft.SearchWithOptions(ctx, client, "idx", "*=>[KNN 2 @vec $query_vec]",
+//		*options.NewFtSearchOptions().AddParam("query_vec", vector))
+//	for _, document := range result.Documents {
+//		fmt.Println(document.Key, document.Fields)
+//	}
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/topics/search/
+package ft
@@ -0,0 +1,438 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package ft
+
+import (
+	"context"
+	"fmt"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Client is a constraint satisfied by the standalone [glide.Client] and the cluster [glide.ClusterClient].
+type Client interface {
+	*glide.Client | *glide.ClusterClient
+}
+
+// Executes a search module command with its request type. The cluster client sends the command to a single node, which
+// coordinates it with the other nodes of the cluster.
+func executeCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) (any, error) {
+	return internal.ExecuteModuleCommand(ctx, client, requestType, args)
+}
+
+func executeStringCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) (string, error) {
+	result, err := executeCommand(ctx, client, requestType, args)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	value, ok := utils.ToString(result)
+	if !ok {
+		return models.DefaultStringResponse, fmt.Errorf("unexpected type received: %T, expected: string", result)
+	}
+	return value, nil
+}
+
+// Creates an index and initiates a backfill of that index.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to create.
+//	schema - The [options.FtSchema] with the fields of the index.
+//
+// Return value:
+//
+//	`"OK"` if the index is successfully created.
+//
+// [valkey.io]: https://valkey.io/commands/ft.create/
+func Create[C Client](ctx context.Context, client C, indexName string, schema options.FtSchema) (string, error) {
+	return CreateWithOptions(ctx, client, indexName, schema, *options.NewFtCreateOptions())
+}
+
+// Creates an index and initiates a backfill of that index.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to create.
+//	schema - The [options.FtSchema] with the fields of the index.
+//	opts - The [options.FtCreateOptions], such as the type and the prefixes of the indexed keys.
+//
+// Return value:
+//
+//	`"OK"` if the index is successfully created.
+//
+// [valkey.io]: https://valkey.io/commands/ft.create/
+func CreateWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	indexName string,
+	schema options.FtSchema,
+	opts options.FtCreateOptions,
+) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	schemaArgs, err := schema.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return executeStringCommand(ctx, client, internal.FtCreate, utils.Concat([]string{indexName}, optionArgs, schemaArgs))
+}
+
+// Deletes an index and the associated metadata. The indexed keys are not deleted.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to drop.
+//
+// Return value:
+//
+//	`"OK"` if the index is successfully dropped.
+//
+// [valkey.io]: https://valkey.io/commands/ft.dropindex/
+func DropIndex[C Client](ctx context.Context, client C, indexName string) (string, error) {
+	return executeStringCommand(ctx, client, internal.FtDropIndex, []string{indexName})
+}
+
+// Lists the names of all the existing indexes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//
+// Return value:
+//
+//	The names of the indexes.
+//
+// [valkey.io]: https://valkey.io/commands/ft._list/
+func List[C Client](ctx context.Context, client C) ([]string, error) {
+	result, err := executeCommand(ctx, client, internal.FtList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	converted, err := internal.ConvertArrayOf[string](result)
+	if err != nil {
+		return nil, err
+	}
+	return converted.([]string), nil
+}
+
+// Uses the provided query expression to locate keys within an index.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to query.
+//	query - The text query to search.
+//
+// Return value:
+//
+//	A [models.FtSearchResult] with the total number of matched documents and the returned documents.
+//
+// [valkey.io]: https://valkey.io/commands/ft.search/
+func Search[C Client](ctx context.Context, client C, indexName string, query string) (models.FtSearchResult, error) {
+	return SearchWithOptions(ctx, client, indexName, query, *options.NewFtSearchOptions())
+}
+
+// Uses the provided query expression to locate keys within an index.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to query.
+//	query - The text query to search.
+//	opts - The [options.FtSearchOptions], such as the returned fields, the query parameters and the limit.
+//
+// Return value:
+//
+//	A [models.FtSearchResult] with the total number of matched documents and the returned documents.
+//
+// [valkey.io]: https://valkey.io/commands/ft.search/
+func SearchWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	indexName string,
+	query string,
+	opts options.FtSearchOptions,
+) (models.FtSearchResult, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.FtSearchResult{}, err
+	}
+	result, err := executeCommand(ctx, client, internal.FtSearch, append([]string{indexName, query}, optionArgs...))
+	if err != nil {
+		return models.FtSearchResult{}, err
+	}
+	return internal.ConvertFtSearchResponse(result, opts.WithScores, opts.NoContent)
+}
+
+// Runs a search query on an index, and performs aggregate transformations on the results.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to query.
+//	query - The text query to search.
+//
+// Return value:
+//
+//	The rows of the aggregation, each of them mapping a property name to its value.
+//
+// [valkey.io]: https://valkey.io/commands/ft.aggregate/
+func Aggregate[C Client](ctx context.Context, client C, indexName string, query string) ([]map[string]any, error) {
+	return AggregateWithOptions(ctx, client, indexName, query, *options.NewFtAggregateOptions())
+}
+
+// Runs a search query on an index, and performs aggregate transformations on the results.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index to query.
+//	query - The text query to search.
+//	opts - The [options.FtAggregateOptions], such as the loaded fields and the clauses of the aggregation pipeline.
+//
+// Return value:
+//
+//	The rows of the aggregation, each of them mapping a property name to its value.
+//
+// [valkey.io]: https://valkey.io/commands/ft.aggregate/
+func AggregateWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	indexName string,
+	query string,
+	opts options.FtAggregateOptions,
+) ([]map[string]any, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	result, err := executeCommand(ctx, client, internal.FtAggregate, append([]string{indexName, query}, optionArgs...))
+	if err != nil {
+		return nil, err
+	}
+	return internal.ConvertFtAggregateResponse(result)
+}
+
+// Returns information about a given index.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index.
+//
+// Return value:
+//
+//	A [models.FtInfo] describing the index.
+//
+// [valkey.io]: https://valkey.io/commands/ft.info/
+func Info[C Client](ctx context.Context, client C, indexName string) (models.FtInfo, error) {
+	result, err := executeCommand(ctx, client, internal.FtInfo, []string{indexName})
+	if err != nil {
+		return models.FtInfo{}, err
+	}
+	return internal.ConvertFtInfoResponse(result)
+}
+
+// Parses a query and returns information about how that query was parsed.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index.
+//	query - The text query to explain.
+//
+// Return value:
+//
+//	The execution plan of the query.
+//
+// [valkey.io]: https://valkey.io/commands/ft.explain/
+func Explain[C Client](ctx context.Context, client C, indexName string, query string) (string, error) {
+	return executeStringCommand(ctx, client, internal.FtExplain, []string{indexName, query})
+}
+
+// Same as [Explain], except that the results are displayed in a different format, one line per element.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index.
+//	query - The text query to explain.
+//
+// Return value:
+//
+//	The lines of the execution plan of the query.
+//
+// [valkey.io]: https://valkey.io/commands/ft.explaincli/
+func ExplainCli[C Client](ctx context.Context, client C, indexName string, query string) ([]string, error) {
+	result, err := executeCommand(ctx, client, internal.FtExplainCli, []string{indexName, query})
+	if err != nil {
+		return nil, err
+	}
+	converted, err := internal.ConvertArrayOf[string](result)
+	if err != nil {
+		return nil, err
+	}
+	return converted.([]string), nil
+}
+
+// Runs a search or an aggregation query and collects performance profiling information.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	indexName - The name of the index.
+//	opts - The [options.FtProfileOptions] with the profiled query.
+//
+// Return value:
+//
+//	A [models.FtProfileResult] with the result of the query and the profiling report.
+//
+// [valkey.io]: https://valkey.io/commands/ft.profile/
+func Profile[C Client](
+	ctx context.Context,
+	client C,
+	indexName string,
+	opts options.FtProfileOptions,
+) (models.FtProfileResult, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.FtProfileResult{}, err
+	}
+	result, err := executeCommand(ctx, client, internal.FtProfile, append([]string{indexName}, optionArgs...))
+	if err != nil {
+		return models.FtProfileResult{}, err
+	}
+	isSearch := opts.QueryType == options.FtProfileQueryTypeSearch
+	withScores, noContent := false, false
+	if isSearch && opts.SearchOptions != nil {
+		withScores, noContent = opts.SearchOptions.WithScores, opts.SearchOptions.NoContent
+	}
+	return internal.ConvertFtProfileResponse(result, isSearch, withScores, noContent)
+}
+
+// Adds an alias for an index. The alias name can be used anywhere that an index name is required.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	aliasName - The alias to be added to the index.
+//	indexName - The index or alias to which the alias will be added.
+//
+// Return value:
+//
+//	`"OK"` if the alias is successfully added.
+//
+// [valkey.io]: https://valkey.io/commands/ft.aliasadd/
+func AliasAdd[C Client](ctx context.Context, client C, aliasName string, indexName string) (string, error) {
+	return executeStringCommand(ctx, client, internal.FtAliasAdd, []string{aliasName, indexName})
+}
+
+// Deletes an existing alias for an index.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	aliasName - The existing alias to be deleted.
+//
+// Return value:
+//
+//	`"OK"` if the alias is successfully deleted.
+//
+// [valkey.io]: https://valkey.io/commands/ft.aliasdel/
+func AliasDel[C Client](ctx context.Context, client C, aliasName string) (string, error) {
+	return executeStringCommand(ctx, client, internal.FtAliasDel, []string{aliasName})
+}
+
+// Updates an existing alias to point to a different physical index. This command only affects future references to
+// the alias.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	aliasName - The alias name. This alias will now be pointed to a different index.
+//	indexName - The index name for which an existing alias has to be updated.
+//
+// Return value:
+//
+//	`"OK"` if the alias is successfully updated.
+//
+// [valkey.io]: https://valkey.io/commands/ft.aliasupdate/
+func AliasUpdate[C Client](ctx context.Context, client C, aliasName string, indexName string) (string, error) {
+	return executeStringCommand(ctx, client, internal.FtAliasUpdate, []string{aliasName, indexName})
+}
+
+// Lists all the index aliases.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//
+// Return value:
+//
+//	A map of the aliases to the names of the indexes they point to.
+//
+// [valkey.io]: https://valkey.io/commands/ft._aliaslist/
+func AliasList[C Client](ctx context.Context, client C) (map[string]string, error) {
+	result, err := executeCommand(ctx, client, internal.FtAliasList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return internal.ConvertFtAliasListResponse(result)
+}
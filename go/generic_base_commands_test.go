@@ -1241,3 +1241,27 @@ func ExampleClusterClient_CopyWithOptions() {
 
 	// Output: someValue
 }
+
+func ExampleClient_MemoryUsage() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	result, err := client.MemoryUsage(context.Background(), "key1")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value() > 0)
+
+	// Output: true
+}
+
+func ExampleClient_MemoryUsageWithOptions() {
+	var client *Client = getExampleClient() // example helper function
+	client.HSet(context.Background(), "hash1", map[string]string{"field1": "value1", "field2": "value2"})
+	result, err := client.MemoryUsageWithOptions(context.Background(), "hash1", *options.NewMemoryUsageOptions().SetSamples(0))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value() > 0)
+
+	// Output: true
+}
@@ -7,10 +7,12 @@ import "C"
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
@@ -55,16 +57,24 @@ type Client struct {
 //	      in case of disconnections.
 //	  - **Pub/Sub Subscriptions**: Predefine Pub/Sub channels and patterns to subscribe to upon connection establishment.
 func NewClient(config *config.ClientConfiguration) (*Client, error) {
-	client, err := createClient(config)
-	if err != nil {
+	client := &Client{}
+	if err := createClient(config, &client.baseClient); err != nil {
 		return nil, err
 	}
-	if config.HasSubscription() {
-		subConfig := config.GetSubscription()
+	if subConfig := config.GetSubscription(); subConfig != nil {
 		client.setMessageHandler(NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()))
+		client.pubsub.setInitialSubscriptions(fromStandaloneSubscriptions(subConfig.GetSubscriptions()))
+	}
+
+	if cacheConfig := config.GetClientSideCache(); cacheConfig != nil {
+		if err := client.initClientSideCache(cacheConfig); err != nil {
+			client.Close()
+			return nil, err
+		}
 	}
 
-	return &Client{*client}, nil
+	client.startCredentialsRefresh()
+	return client, nil
 }
 
 // Executes a batch by processing the queued commands.
@@ -227,6 +237,7 @@ func (client *Client) Select(ctx context.Context, index int64) (string, error) {
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
+	client.flushClientSideCache()
 
 	return handleOkResponse(result)
 }
@@ -379,6 +390,7 @@ func (client *Client) FlushAll(ctx context.Context) (string, error) {
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
+	client.flushClientSideCache()
 	return handleOkResponse(result)
 }
 
@@ -401,6 +413,7 @@ func (client *Client) FlushAllWithOptions(ctx context.Context, mode options.Flus
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
+	client.flushClientSideCache()
 	return handleOkResponse(result)
 }
 
@@ -422,6 +435,7 @@ func (client *Client) FlushDB(ctx context.Context) (string, error) {
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
+	client.flushClientSideCache()
 	return handleOkResponse(result)
 }
 
@@ -444,6 +458,7 @@ func (client *Client) FlushDBWithOptions(ctx context.Context, mode options.Flush
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
+	client.flushClientSideCache()
 	return handleOkResponse(result)
 }
 
@@ -622,6 +637,7 @@ func (client *Client) Move(ctx context.Context, key string, dbIndex int64) (bool
 	if err != nil {
 		return models.DefaultBoolResponse, err
 	}
+	client.handleInvalidation([]byte(key))
 
 	return handleBoolResponse(result)
 }
@@ -704,6 +720,709 @@ func (client *Client) ConfigRewrite(ctx context.Context) (string, error) {
 	return handleOkResponse(response)
 }
 
+// Returns the 10 most recent entries of the slow log, which logs the commands exceeding the configured execution time.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [models.SlowLogEntry], from the most recent to the oldest.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *Client) SlowLogGet(ctx context.Context) ([]models.SlowLogEntry, error) {
+	response, err := client.executeCommand(ctx, C.SlowLogGet, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertSlowLogEntries)
+}
+
+// Returns the most recent entries of the slow log, which logs the commands exceeding the configured execution time.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	count - The number of entries to return, `-1` to return all the entries.
+//
+// Return value:
+//
+//	An array of [models.SlowLogEntry], from the most recent to the oldest.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *Client) SlowLogGetWithCount(ctx context.Context, count int64) ([]models.SlowLogEntry, error) {
+	response, err := client.executeCommand(ctx, C.SlowLogGet, []string{utils.IntToString(count)})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertSlowLogEntries)
+}
+
+// Returns the number of entries in the slow log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The number of entries in the slow log.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-len/
+func (client *Client) SlowLogLen(ctx context.Context) (int64, error) {
+	response, err := client.executeCommand(ctx, C.SlowLogLen, []string{})
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(response)
+}
+
+// Deletes all the entries of the slow log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the slow log was reset.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-reset/
+func (client *Client) SlowLogReset(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.SlowLogReset, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Returns the latest latency spike of each event monitored by the latency monitor.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [models.LatencyEvent], empty if the latency monitor is disabled or no spike was recorded.
+//
+// [valkey.io]: https://valkey.io/commands/latency-latest/
+func (client *Client) LatencyLatest(ctx context.Context) ([]models.LatencyEvent, error) {
+	response, err := client.executeCommand(ctx, C.LatencyLatest, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertLatencyLatest)
+}
+
+// Returns the latency spikes of an event recorded by the latency monitor.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, e.g. `command`.
+//
+// Return value:
+//
+//	An array of [models.LatencySample], from the oldest to the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/latency-history/
+func (client *Client) LatencyHistory(ctx context.Context, event string) ([]models.LatencySample, error) {
+	response, err := client.executeCommand(ctx, C.LatencyHistory, []string{event})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertLatencyHistory)
+}
+
+// Returns the latency distribution of the given commands, or of all the called commands if none are given.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands, e.g. `set`.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Return value:
+//
+//	A map of the command names to their [models.CommandLatencyHistogram]. The commands which were never called are
+//	omitted.
+//
+// [valkey.io]: https://valkey.io/commands/latency-histogram/
+func (client *Client) LatencyHistogram(
+	ctx context.Context,
+	commands ...string,
+) (map[string]models.CommandLatencyHistogram, error) {
+	response, err := client.executeCommand(ctx, C.LatencyHistogram, commands)
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertLatencyHistogram)
+}
+
+// Returns an ASCII-art graph of the latency spikes of an event.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, e.g. `command`.
+//
+// Return value:
+//
+//	The graph of the latency spikes of the event.
+//
+// [valkey.io]: https://valkey.io/commands/latency-graph/
+func (client *Client) LatencyGraph(ctx context.Context, event string) (string, error) {
+	response, err := client.executeCommand(ctx, C.LatencyGraph, []string{event})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Returns a human readable analysis of the latency issues of the server, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The report of the latency monitor.
+//
+// [valkey.io]: https://valkey.io/commands/latency-doctor/
+func (client *Client) LatencyDoctor(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.LatencyDoctor, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Resets the latency spikes of the given events, or of all the events if none are given.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	events - The names of the events, e.g. `command`.
+//
+// Return value:
+//
+//	The number of event time series that were reset.
+//
+// [valkey.io]: https://valkey.io/commands/latency-reset/
+func (client *Client) LatencyReset(ctx context.Context, events ...string) (int64, error) {
+	response, err := client.executeCommand(ctx, C.LatencyReset, events)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(response)
+}
+
+// Returns the memory usage of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The memory usage of the server, as [models.MemoryStats].
+//
+// [valkey.io]: https://valkey.io/commands/memory-stats/
+func (client *Client) MemoryStats(ctx context.Context) (models.MemoryStats, error) {
+	response, err := client.executeCommand(ctx, C.MemoryStats, []string{})
+	if err != nil {
+		return models.MemoryStats{}, err
+	}
+	return handleConvertedResponse(response, internal.ConvertMemoryStats)
+}
+
+// Returns a human readable analysis of the memory issues of the server, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The report of the memory doctor.
+//
+// [valkey.io]: https://valkey.io/commands/memory-doctor/
+func (client *Client) MemoryDoctor(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.MemoryDoctor, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Returns the internal statistics of the memory allocator of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The statistics of the memory allocator, in the format of the allocator.
+//
+// [valkey.io]: https://valkey.io/commands/memory-malloc-stats/
+func (client *Client) MemoryMallocStats(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.MemoryMallocStats, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Asks the memory allocator of the server to release the dirty pages, which may reduce the resident memory.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the pages were released.
+//
+// [valkey.io]: https://valkey.io/commands/memory-purge/
+func (client *Client) MemoryPurge(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.MemoryPurge, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Saves the dataset to disk synchronously, blocking the server until the save completes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the dataset was saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (client *Client) Save(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.Save, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Saves the dataset to disk in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"Background saving started" when the save started.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *Client) BgSave(ctx context.Context) (string, error) {
+	return client.BgSaveWithOptions(ctx, *options.NewBgSaveOptions())
+}
+
+// Saves the dataset to disk in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The options of the command, see [options.BgSaveOptions].
+//
+// Return value:
+//
+//	"Background saving started" when the save started, or "Background saving scheduled" when the save was scheduled.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *Client) BgSaveWithOptions(ctx context.Context, opts options.BgSaveOptions) (string, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommand(ctx, C.BgSave, args)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Rewrites the append-only file in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A message confirming that the rewrite started, or that it was scheduled.
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (client *Client) BgRewriteAof(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.BgRewriteAof, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Blocks the current client until all the previous writes of the connection are fsynced to the append-only file of the
+// local server and of the replicas, or until the timeout is reached.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	numLocal - The number of local servers to reach, `0` or `1`.
+//	numReplicas - The number of replicas to reach.
+//	timeout - The timeout value. A value of `0` will block indefinitely.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// Return value:
+//
+//	The number of local servers and of replicas which fsynced the writes, as [models.WaitAofResult].
+//
+// [valkey.io]: https://valkey.io/commands/waitaof/
+func (client *Client) WaitAof(
+	ctx context.Context,
+	numLocal int64,
+	numReplicas int64,
+	timeout time.Duration,
+) (models.WaitAofResult, error) {
+	args := []string{utils.IntToString(numLocal), utils.IntToString(numReplicas), utils.IntToString(timeout.Milliseconds())}
+	response, err := client.executeCommand(ctx, C.WaitAof, args)
+	if err != nil {
+		return models.WaitAofResult{}, err
+	}
+	return handleConvertedResponse(response, internal.ConvertWaitAofResult)
+}
+
+// Returns the replication role of the server, with the replicas of a primary or the primary of a replica.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The role of the server, as [models.RoleInfo].
+//
+// [valkey.io]: https://valkey.io/commands/role/
+func (client *Client) Role(ctx context.Context) (models.RoleInfo, error) {
+	response, err := client.executeCommand(ctx, C.Role, []string{})
+	if err != nil {
+		return models.RoleInfo{}, err
+	}
+	return handleConvertedResponse(response, internal.ConvertRoleInfo)
+}
+
+// Makes the server a replica of another server. The dataset of the server is discarded and replaced by the dataset of
+// the new primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	host - The host of the new primary.
+//	port - The port of the new primary.
+//
+// Return value:
+//
+//	"OK" when the replication was configured, or a message explaining that the server is already a replica of the
+//	primary.
+//
+// [valkey.io]: https://valkey.io/commands/replicaof/
+func (client *Client) ReplicaOf(ctx context.Context, host string, port int64) (string, error) {
+	response, err := client.executeCommand(ctx, C.ReplicaOf, []string{host, utils.IntToString(port)})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	res, err := handleOkOrStringOrNilResponse(response)
+	return res.Value(), err
+}
+
+// Stops the replication of the server and promotes it to a primary, keeping its dataset.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the replication was stopped.
+//
+// [valkey.io]: https://valkey.io/commands/replicaof/
+func (client *Client) ReplicaOfNoOne(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ReplicaOf, []string{"NO", "ONE"})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Starts a coordinated failover from the primary to one of its replicas in sync, without losing the acknowledged
+// writes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the failover started. Its progress is reported by the `master_failover_state` field of INFO.
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+func (client *Client) FailOver(ctx context.Context) (string, error) {
+	return client.FailOverWithOptions(ctx, *options.NewFailOverOptions())
+}
+
+// Starts a coordinated failover from the primary to one of its replicas, or aborts a failover in progress.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The options of the command, see [options.FailOverOptions].
+//
+// Return value:
+//
+//	"OK" when the failover started or was aborted. Its progress is reported by the `master_failover_state` field of
+//	INFO.
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+func (client *Client) FailOverWithOptions(ctx context.Context, opts options.FailOverOptions) (string, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommand(ctx, C.FailOver, args)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Swaps two databases, so that the clients connected to one database see the data of the other.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	index1 - The index of the first database.
+//	index2 - The index of the second database.
+//
+// Return value:
+//
+//	"OK" when the databases were swapped.
+//
+// [valkey.io]: https://valkey.io/commands/swapdb/
+func (client *Client) SwapDb(ctx context.Context, index1 int64, index2 int64) (string, error) {
+	response, err := client.executeCommand(ctx, C.SwapDb, []string{utils.IntToString(index1), utils.IntToString(index2)})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	client.flushClientSideCache()
+	return handleOkResponse(response)
+}
+
+// Shuts the server down, after saving the dataset if save points are configured. The client is disconnected once the
+// server exits.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the server exited. The server doesn't reply on success, so the disconnection is reported as success.
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+func (client *Client) ShutDown(ctx context.Context) (string, error) {
+	return client.ShutDownWithOptions(ctx, *options.NewShutDownOptions())
+}
+
+// Shuts the server down, or aborts a shutdown in progress. The client is disconnected once the server exits.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The options of the command, see [options.ShutDownOptions].
+//
+// Return value:
+//
+//	"OK" when the server exited or the shutdown was aborted. The server doesn't reply when it exits, so the
+//	disconnection is reported as success.
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+func (client *Client) ShutDownWithOptions(ctx context.Context, opts options.ShutDownOptions) (string, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommand(ctx, C.ShutDown, args)
+	return handleShutDownResponse(response, err, opts.Abort)
+}
+
+// Returns the modules loaded by the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The name, version, path and arguments of the loaded modules.
+//
+// [valkey.io]: https://valkey.io/commands/module-list/
+func (client *Client) ModuleList(ctx context.Context) ([]models.ModuleInfo, error) {
+	response, err := client.executeCommand(ctx, C.ModuleList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertModuleList)
+}
+
+// Loads a module from a dynamic library at runtime.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	path - The path of the dynamic library on the server.
+//	args - The arguments given to the module.
+//
+// Return value:
+//
+//	"OK" when the module was loaded.
+//
+// [valkey.io]: https://valkey.io/commands/module-load/
+func (client *Client) ModuleLoad(ctx context.Context, path string, args []string) (string, error) {
+	response, err := client.executeCommand(ctx, C.ModuleLoad, append([]string{path}, args...))
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Loads a module from a dynamic library at runtime, with configuration parameters.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	path - The path of the dynamic library on the server.
+//	opts - The configuration parameters and the arguments of the module, see [options.ModuleLoadExOptions].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Return value:
+//
+//	"OK" when the module was loaded.
+//
+// [valkey.io]: https://valkey.io/commands/module-loadex/
+func (client *Client) ModuleLoadEx(ctx context.Context, path string, opts options.ModuleLoadExOptions) (string, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommand(ctx, C.ModuleLoadEx, append([]string{path}, args...))
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Unloads a module.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name of the module, as returned by [Client.ModuleList].
+//
+// Return value:
+//
+//	"OK" when the module was unloaded.
+//
+// [valkey.io]: https://valkey.io/commands/module-unload/
+func (client *Client) ModuleUnload(ctx context.Context, name string) (string, error) {
+	response, err := client.executeCommand(ctx, C.ModuleUnload, []string{name})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
 // Returns a random existing key name from the currently selected database.
 //
 // See [valkey.io] for details.
@@ -8,10 +8,12 @@ import "C"
 import (
 	"context"
 	"errors"
+	"time"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
@@ -60,16 +62,17 @@ type ClusterClient struct {
 //	  - **Pub/Sub Subscriptions**: Predefine Pub/Sub channels and patterns to subscribe to upon connection establishment.
 //	      Supports exact channels, patterns, and sharded channels (available since Valkey version 7.0).
 func NewClusterClient(config *config.ClusterClientConfiguration) (*ClusterClient, error) {
-	client, err := createClient(config)
-	if err != nil {
+	client := &ClusterClient{}
+	if err := createClient(config, &client.baseClient); err != nil {
 		return nil, err
 	}
-	if config.HasSubscription() {
-		subConfig := config.GetSubscription()
+	if subConfig := config.GetSubscription(); subConfig != nil {
 		client.setMessageHandler(NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()))
+		client.pubsub.setInitialSubscriptions(subConfig.GetSubscriptions())
 	}
 
-	return &ClusterClient{*client}, nil
+	client.startCredentialsRefresh()
+	return client, nil
 }
 
 // Executes a batch by processing the queued commands.
@@ -622,10 +625,13 @@ func (client *ClusterClient) clusterScan(
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return nil, contextError(ctx)
 	default:
 		// Continue with execution
 	}
+	if err := client.connect(ctx); err != nil {
+		return nil, err
+	}
 
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
@@ -635,9 +641,13 @@ func (client *ClusterClient) clusterScan(
 	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
 	defer pinner.Unpin()
 
+	if err := client.inflight.acquire(ctx); err != nil {
+		return nil, err
+	}
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
+		client.inflight.release()
 		return nil, NewClosingError("Cluster Scan failed. The client is closed.")
 	}
 	client.pending[resultChannelPtr] = struct{}{}
@@ -661,6 +671,8 @@ func (client *ClusterClient) clusterScan(
 	C.request_cluster_scan(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
+		// Only the requests whose context can be done are registered for cancellation in the core
+		C._Bool(ctx.Done() != nil),
 		c_cursor,
 		C.size_t(len(args)),
 		cArgsPtr,
@@ -676,16 +688,23 @@ func (client *ClusterClient) clusterScan(
 		if client.pending != nil {
 			delete(client.pending, resultChannelPtr)
 		}
+		coreClient := client.coreClient
 		client.mu.Unlock()
+		// Abort the request in the core, which then replies with an error
+		if coreClient != nil {
+			C.cancel_request(coreClient, C.uintptr_t(pinnedChannelPtr))
+		}
 		// Start cleanup goroutine
 		go func() {
+			defer client.inflight.release()
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
 				C.free_command_response(payload.value)
 			}
 		}()
-		return nil, ctx.Err()
+		return nil, contextError(ctx)
 	case payload = <-resultChannel:
+		client.inflight.release()
 		// Continue with normal processing
 	}
 
@@ -1309,7 +1328,8 @@ func (client *ClusterClient) ConfigRewriteWithOptions(ctx context.Context, opts
 	return handleOkResponse(response)
 }
 
-// Returns a random key.
+// Returns the 10 most recent entries of the slow log of each node, which logs the commands exceeding the configured
+// execution time.
 //
 // See [valkey.io] for details.
 //
@@ -1319,423 +1339,3143 @@ func (client *ClusterClient) ConfigRewriteWithOptions(ctx context.Context, opts
 //
 // Return value:
 //
-//	A random key from the database.
+//	A map of the node addresses to their [models.SlowLogEntry], from the most recent to the oldest, wrapped by a
+//	[models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/randomkey/
-func (client *ClusterClient) RandomKey(ctx context.Context) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.RandomKey, []string{})
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	return handleStringOrNilResponse(result)
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *ClusterClient) SlowLogGet(ctx context.Context) (models.ClusterValue[[]models.SlowLogEntry], error) {
+	return executeWithReplyPerNode(ctx, client, C.SlowLogGet, []string{}, config.AllNodes, internal.ConvertSlowLogEntries)
 }
 
-// Returns a random key.
+// Returns the most recent entries of the slow log of the routed nodes, which logs the commands exceeding the configured
+// execution time.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	 opts - specifies the routing configuration for the command.
-//
-//		 The client will route the command to the nodes defined by route,
-//		 and will return the first successful result.
+//	count - The number of entries to return from each node, `-1` to return all the entries.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route, all the nodes by default.
 //
 // Return value:
 //
-//	A random key from the database.
+//	The [models.SlowLogEntry] of the nodes, from the most recent to the oldest, wrapped by a [models.ClusterValue]. In
+//	case of a multi-node route, the entries are mapped to the address of their node.
 //
-// [valkey.io]: https://valkey.io/commands/randomkey/
-func (client *ClusterClient) RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (models.Result[string], error) {
-	result, err := client.executeCommandWithRoute(ctx, C.RandomKey, []string{}, opts.Route)
-	if err != nil {
-		return models.CreateNilStringResult(), err
-	}
-	return handleStringOrNilResponse(result)
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *ClusterClient) SlowLogGetWithOptions(
+	ctx context.Context,
+	count int64,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.SlowLogEntry], error) {
+	args := []string{utils.IntToString(count)}
+	return executeWithReplyPerNode(ctx, client, C.SlowLogGet, args, opts.Route, internal.ConvertSlowLogEntries)
 }
 
-// Loads a library to Valkey.
-//
-// Since:
+// Returns the number of entries in the slow log of each node.
 //
-//	Valkey 7.0 and above.
-//
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	libraryCode - The source code that implements the library.
-//	replace - Whether the given library should overwrite a library with the same name if it
-//	already exists.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	command to the nodes defined by route.
 //
 // Return value:
 //
-//	The library name that was loaded.
+//	A map of the node addresses to the number of entries in their slow log, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/function-load/
-func (client *ClusterClient) FunctionLoadWithRoute(ctx context.Context,
-	libraryCode string,
-	replace bool,
-	route options.RouteOption,
-) (string, error) {
-	args := []string{}
-	if replace {
-		args = append(args, constants.ReplaceKeyword)
-	}
-	args = append(args, libraryCode)
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionLoad, args, route.Route)
-	if err != nil {
-		return models.DefaultStringResponse, err
-	}
-	return handleStringResponse(result)
+// [valkey.io]: https://valkey.io/commands/slowlog-len/
+func (client *ClusterClient) SlowLogLen(ctx context.Context) (models.ClusterValue[int64], error) {
+	return executeWithReplyPerNode(ctx, client, C.SlowLogLen, []string{}, config.AllNodes, internal.ConvertToInt64)
 }
 
-// Deletes all function libraries.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the number of entries in the slow log of the routed nodes.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route, all the nodes by default.
 //
 // Return value:
 //
-//	`OK`
+//	The number of entries in the slow log of the nodes, wrapped by a [models.ClusterValue]. In case of a multi-node
+//	route, the numbers are mapped to the address of their node.
 //
-// [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *ClusterClient) FunctionFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{}, route.Route)
-	if err != nil {
-		return models.DefaultStringResponse, err
-	}
-	return handleOkResponse(result)
+// [valkey.io]: https://valkey.io/commands/slowlog-len/
+func (client *ClusterClient) SlowLogLenWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	return executeWithReplyPerNode(ctx, client, C.SlowLogLen, []string{}, opts.Route, internal.ConvertToInt64)
 }
 
-// Deletes all function libraries in synchronous mode.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Deletes all the entries of the slow log of all the nodes.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK`
+//	"OK" when the slow logs were reset.
 //
-// [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *ClusterClient) FunctionFlushSyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{string(options.SYNC)}, route.Route)
+// [valkey.io]: https://valkey.io/commands/slowlog-reset/
+func (client *ClusterClient) SlowLogReset(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.SlowLogReset, []string{})
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	return handleOkResponse(response)
 }
 
-// Deletes all function libraries in asynchronous mode.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Deletes all the entries of the slow log of the routed nodes.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK`
+//	"OK" when the slow logs were reset.
 //
-// [valkey.io]: https://valkey.io/commands/function-flush/
-func (client *ClusterClient) FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{string(options.ASYNC)}, route.Route)
+// [valkey.io]: https://valkey.io/commands/slowlog-reset/
+func (client *ClusterClient) SlowLogResetWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.SlowLogReset, []string{}, opts.Route)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	return handleOkResponse(response)
 }
 
-// Invokes a previously loaded function.
-// To route to a replica please refer to [ClusterClient.FCallReadOnly].
+// Returns the latest latency spike of each event monitored by the latency monitor of each node.
 //
-// Since:
+// See [valkey.io] for details.
 //
-//	Valkey 7.0 and above.
+// Parameters:
 //
-// See [valkey.io] for more details.
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to their [models.LatencyEvent], wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/latency-latest/
+func (client *ClusterClient) LatencyLatest(ctx context.Context) (models.ClusterValue[[]models.LatencyEvent], error) {
+	return client.LatencyLatestWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+}
+
+// Returns the latest latency spike of each event monitored by the latency monitor of the routed nodes.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	The [models.LatencyEvent] of the nodes, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *ClusterClient) FCallWithRoute(
+// [valkey.io]: https://valkey.io/commands/latency-latest/
+func (client *ClusterClient) LatencyLatestWithOptions(
 	ctx context.Context,
-	function string,
-	route options.RouteOption,
-) (models.ClusterValue[any], error) {
-	result, err := client.executeCommandWithRoute(
-		ctx,
-		C.FCall,
-		[]string{function, utils.IntToString(0)},
-		route.Route,
-	)
-	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
-	}
-	if route.Route != nil &&
-		(route.Route).IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(result)
-		if err != nil {
-			return models.CreateEmptyClusterValue[any](), err
-		}
-		return models.CreateClusterMultiValue[any](data), nil
-	}
-	data, err := handleAnyResponse(result)
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.LatencyEvent], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LatencyLatest, []string{}, opts.Route)
 	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
+		return models.CreateEmptyClusterValue[[]models.LatencyEvent](), err
 	}
-	return models.CreateClusterSingleValue[any](data), nil
+	return handleClusterValueResponse(response, opts, internal.ConvertLatencyLatest)
 }
 
-// Invokes a previously loaded read-only function.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the latency spikes of an event recorded by the latency monitor of each node.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	event - The name of the event, e.g. `command`.
 //
 // Return value:
 //
-//	The invoked function's return value.
+//	A map of the node addresses to their [models.LatencySample], from the oldest to the most recent, wrapped by a
+//	[models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *ClusterClient) FCallReadOnlyWithRoute(ctx context.Context,
-	function string,
-	route options.RouteOption,
-) (models.ClusterValue[any], error) {
-	result, err := client.executeCommandWithRoute(
-		ctx,
-		C.FCallReadOnly,
-		[]string{function, utils.IntToString(0)},
-		route.Route,
-	)
-	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
-	}
-	if route.Route != nil &&
-		(route.Route).IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(result)
-		if err != nil {
-			return models.CreateEmptyClusterValue[any](), err
-		}
-		return models.CreateClusterMultiValue[any](data), nil
-	}
-	data, err := handleAnyResponse(result)
-	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
-	}
-	return models.CreateClusterSingleValue[any](data), nil
+// [valkey.io]: https://valkey.io/commands/latency-history/
+func (client *ClusterClient) LatencyHistory(
+	ctx context.Context,
+	event string,
+) (models.ClusterValue[[]models.LatencySample], error) {
+	return client.LatencyHistoryWithOptions(ctx, event, options.RouteOption{Route: config.AllNodes})
 }
 
-// Invokes a previously loaded function.
-// The command will be routed to a random primary node.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the latency spikes of an event recorded by the latency monitor of the routed nodes.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//	event - The name of the event, e.g. `command`.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	The invoked function's return value wrapped by a [models.ClusterValue].
+//	The [models.LatencySample] of the nodes, from the oldest to the most recent, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *ClusterClient) FCallWithArgs(
+// [valkey.io]: https://valkey.io/commands/latency-history/
+func (client *ClusterClient) LatencyHistoryWithOptions(
 	ctx context.Context,
-	function string,
-	args []string,
-) (models.ClusterValue[any], error) {
-	return client.FCallWithArgsWithRoute(ctx, function, args, options.RouteOption{})
+	event string,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.LatencySample], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LatencyHistory, []string{event}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.LatencySample](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertLatencyHistory)
 }
 
-// Invokes a previously loaded function.
+// Returns the latency distribution of the given commands on each node, or of all the called commands if none are
+// given.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands, e.g. `set`.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// Return value:
+//
+//	A map of the node addresses to the [models.CommandLatencyHistogram] of their commands, wrapped by a
+//	[models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/latency-histogram/
+func (client *ClusterClient) LatencyHistogram(
+	ctx context.Context,
+	commands ...string,
+) (models.ClusterValue[map[string]models.CommandLatencyHistogram], error) {
+	return client.LatencyHistogramWithOptions(ctx, commands, options.RouteOption{Route: config.AllNodes})
+}
+
+// Returns the latency distribution of the given commands on the routed nodes, or of all the called commands if none are
+// given.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	    command to the nodes defined by `route`.
+//	commands - The names of the commands, e.g. `set`.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
 //
 // Return value:
 //
-//	The invoked function's return value wrapped by a [models.ClusterValue].
+//	The [models.CommandLatencyHistogram] of the commands of the nodes, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall/
-func (client *ClusterClient) FCallWithArgsWithRoute(ctx context.Context,
-	function string,
-	args []string,
-	route options.RouteOption,
+// [valkey.io]: https://valkey.io/commands/latency-histogram/
+func (client *ClusterClient) LatencyHistogramWithOptions(
+	ctx context.Context,
+	commands []string,
+	opts options.RouteOption,
+) (models.ClusterValue[map[string]models.CommandLatencyHistogram], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LatencyHistogram, commands, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[map[string]models.CommandLatencyHistogram](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertLatencyHistogram)
+}
+
+// Returns an ASCII-art graph of the latency spikes of an event on each node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, e.g. `command`.
+//
+// Return value:
+//
+//	A map of the node addresses to their graph, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/latency-graph/
+func (client *ClusterClient) LatencyGraph(ctx context.Context, event string) (models.ClusterValue[string], error) {
+	return client.LatencyGraphWithOptions(ctx, event, options.RouteOption{Route: config.AllNodes})
+}
+
+// Returns an ASCII-art graph of the latency spikes of an event on the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, e.g. `command`.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The graph of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/latency-graph/
+func (client *ClusterClient) LatencyGraphWithOptions(
+	ctx context.Context,
+	event string,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LatencyGraph, []string{event}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertToString)
+}
+
+// Returns a human readable analysis of the latency issues of each node, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to their report, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/latency-doctor/
+func (client *ClusterClient) LatencyDoctor(ctx context.Context) (models.ClusterValue[string], error) {
+	return client.LatencyDoctorWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+}
+
+// Returns a human readable analysis of the latency issues of the routed nodes, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The report of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/latency-doctor/
+func (client *ClusterClient) LatencyDoctorWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LatencyDoctor, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertToString)
+}
+
+// Resets the latency spikes of the given events on all the nodes, or of all the events if none are given.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	events - The names of the events, e.g. `command`.
+//
+// Return value:
+//
+//	The number of event time series that were reset, summed over the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-reset/
+func (client *ClusterClient) LatencyReset(ctx context.Context, events ...string) (int64, error) {
+	response, err := client.executeCommand(ctx, C.LatencyReset, events)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(response)
+}
+
+// Resets the latency spikes of the given events on the routed nodes, or of all the events if none are given.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	events - The names of the events, e.g. `command`.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The number of event time series that were reset, summed over the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-reset/
+func (client *ClusterClient) LatencyResetWithOptions(
+	ctx context.Context,
+	events []string,
+	opts options.RouteOption,
+) (int64, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.LatencyReset, events, opts.Route)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(response)
+}
+
+// Returns the memory usage of each primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to their [models.MemoryStats], wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/memory-stats/
+func (client *ClusterClient) MemoryStats(ctx context.Context) (models.ClusterValue[models.MemoryStats], error) {
+	return client.MemoryStatsWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Returns the memory usage of the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The [models.MemoryStats] of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/memory-stats/
+func (client *ClusterClient) MemoryStatsWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.MemoryStats], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.MemoryStats, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.MemoryStats](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertMemoryStats)
+}
+
+// Returns a human readable analysis of the memory issues of each primary, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to their report, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/memory-doctor/
+func (client *ClusterClient) MemoryDoctor(ctx context.Context) (models.ClusterValue[string], error) {
+	return client.MemoryDoctorWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Returns a human readable analysis of the memory issues of the routed nodes, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The report of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/memory-doctor/
+func (client *ClusterClient) MemoryDoctorWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.MemoryDoctor, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertToString)
+}
+
+// Returns the internal statistics of the memory allocator of each primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to the statistics of their memory allocator, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/memory-malloc-stats/
+func (client *ClusterClient) MemoryMallocStats(ctx context.Context) (models.ClusterValue[string], error) {
+	return client.MemoryMallocStatsWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Returns the internal statistics of the memory allocator of the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The statistics of the memory allocator of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/memory-malloc-stats/
+func (client *ClusterClient) MemoryMallocStatsWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.MemoryMallocStats, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertToString)
+}
+
+// Asks the memory allocator of each primary to release the dirty pages, which may reduce the resident memory.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the pages were released.
+//
+// [valkey.io]: https://valkey.io/commands/memory-purge/
+func (client *ClusterClient) MemoryPurge(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.MemoryPurge, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Asks the memory allocator of the routed nodes to release the dirty pages, which may reduce the resident memory.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	"OK" when the pages were released.
+//
+// [valkey.io]: https://valkey.io/commands/memory-purge/
+func (client *ClusterClient) MemoryPurgeWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.MemoryPurge, []string{}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Saves the dataset of each primary to disk synchronously, blocking the primaries until the save completes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	"OK" when the datasets were saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (client *ClusterClient) Save(ctx context.Context) (string, error) {
+	return client.SaveWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Saves the dataset of the routed nodes to disk synchronously, blocking the nodes until the save completes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	"OK" when the datasets were saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (client *ClusterClient) SaveWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.Save, []string{}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkClusterResponse(response)
+}
+
+// Saves the dataset of each primary to disk in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to "Background saving started", wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *ClusterClient) BgSave(ctx context.Context) (models.ClusterValue[string], error) {
+	return client.BgSaveWithOptions(ctx, options.ClusterBgSaveOptions{})
+}
+
+// Saves the dataset of the routed nodes to disk in the background, in a forked process.
+// The command will be routed to all primary nodes, unless Route in opts is provided.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The options of the command and the routing configuration, see [options.ClusterBgSaveOptions].
+//
+// Return value:
+//
+//	"Background saving started" or "Background saving scheduled" for each node, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *ClusterClient) BgSaveWithOptions(
+	ctx context.Context,
+	opts options.ClusterBgSaveOptions,
+) (models.ClusterValue[string], error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	routeOption := options.RouteOption{Route: config.AllPrimaries}
+	if opts.RouteOption != nil && opts.RouteOption.Route != nil {
+		routeOption = *opts.RouteOption
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.BgSave, args, routeOption.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(response, routeOption, internal.ConvertToString)
+}
+
+// Rewrites the append-only file of each primary in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to a message confirming that the rewrite started, or that it was scheduled, wrapped by
+//	a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (client *ClusterClient) BgRewriteAof(ctx context.Context) (models.ClusterValue[string], error) {
+	return client.BgRewriteAofWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Rewrites the append-only file of the routed nodes in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	A message confirming that the rewrite started, or that it was scheduled, for each node, wrapped by a
+//	[models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (client *ClusterClient) BgRewriteAofWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.BgRewriteAof, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertToString)
+}
+
+// Blocks the current client until all the previous writes of the connections to the primaries are fsynced to the
+// append-only file of the primaries and of their replicas, or until the timeout is reached.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	numLocal - The number of local servers to reach, `0` or `1`.
+//	numReplicas - The number of replicas to reach.
+//	timeout - The timeout value. A value of `0` will block indefinitely.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// Return value:
+//
+//	A map of the node addresses to the number of local servers and of replicas which fsynced the writes, wrapped by a
+//	[models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/waitaof/
+func (client *ClusterClient) WaitAof(
+	ctx context.Context,
+	numLocal int64,
+	numReplicas int64,
+	timeout time.Duration,
+) (models.ClusterValue[models.WaitAofResult], error) {
+	return client.WaitAofWithOptions(ctx, numLocal, numReplicas, timeout, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Blocks the current client until all the previous writes of the connections to the routed nodes are fsynced to the
+// append-only file of the nodes and of their replicas, or until the timeout is reached.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	numLocal - The number of local servers to reach, `0` or `1`.
+//	numReplicas - The number of replicas to reach.
+//	timeout - The timeout value. A value of `0` will block indefinitely.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// Return value:
+//
+//	The number of local servers and of replicas which fsynced the writes for each node, wrapped by a
+//	[models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/waitaof/
+func (client *ClusterClient) WaitAofWithOptions(
+	ctx context.Context,
+	numLocal int64,
+	numReplicas int64,
+	timeout time.Duration,
+	opts options.RouteOption,
+) (models.ClusterValue[models.WaitAofResult], error) {
+	args := []string{utils.IntToString(numLocal), utils.IntToString(numReplicas), utils.IntToString(timeout.Milliseconds())}
+	response, err := client.executeCommandWithRoute(ctx, C.WaitAof, args, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.WaitAofResult](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertWaitAofResult)
+}
+
+// Returns the replication role of each node, with the replicas of the primaries and the primary of the replicas.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to their role, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/role/
+func (client *ClusterClient) Role(ctx context.Context) (models.ClusterValue[models.RoleInfo], error) {
+	return client.RoleWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+}
+
+// Returns the replication role of the routed nodes, with the replicas of the primaries and the primary of the replicas.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The role of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/role/
+func (client *ClusterClient) RoleWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.RoleInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.Role, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.RoleInfo](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertRoleInfo)
+}
+
+// Shuts the routed node down, or aborts a shutdown in progress. A route to a single node is required.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The options of the command and the routing configuration, see [options.ClusterShutDownOptions].
+//
+// Return value:
+//
+//	"OK" when the node exited or the shutdown was aborted. The node doesn't reply when it exits, so the disconnection is
+//	reported as success.
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+func (client *ClusterClient) ShutDownWithOptions(ctx context.Context, opts options.ClusterShutDownOptions) (string, error) {
+	if opts.RouteOption == nil || opts.RouteOption.Route == nil || opts.RouteOption.Route.IsMultiNode() {
+		return models.DefaultStringResponse, NewConfigurationError("SHUTDOWN requires a route to a single node")
+	}
+	args, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.ShutDown, args, opts.RouteOption.Route)
+	return handleShutDownResponse(response, err, opts.IsAbort())
+}
+
+// Returns the modules loaded by each node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of the node addresses to their loaded modules, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/module-list/
+func (client *ClusterClient) ModuleList(ctx context.Context) (models.ClusterValue[[]models.ModuleInfo], error) {
+	return client.ModuleListWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+}
+
+// Returns the modules loaded by the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The loaded modules of the nodes, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/module-list/
+func (client *ClusterClient) ModuleListWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.ModuleInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ModuleList, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.ModuleInfo](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertModuleList)
+}
+
+// Loads a module from a dynamic library on all the nodes at runtime.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	path - The path of the dynamic library on the nodes.
+//	args - The arguments given to the module.
+//
+// Return value:
+//
+//	"OK" when the module was loaded by all the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/module-load/
+func (client *ClusterClient) ModuleLoad(ctx context.Context, path string, args []string) (string, error) {
+	return client.ModuleLoadWithOptions(ctx, path, args, options.RouteOption{Route: config.AllNodes})
+}
+
+// Loads a module from a dynamic library on the routed nodes at runtime.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	path - The path of the dynamic library on the nodes.
+//	args - The arguments given to the module.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	"OK" when the module was loaded by the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/module-load/
+func (client *ClusterClient) ModuleLoadWithOptions(
+	ctx context.Context,
+	path string,
+	args []string,
+	opts options.RouteOption,
+) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ModuleLoad, append([]string{path}, args...), opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkClusterResponse(response)
+}
+
+// Loads a module from a dynamic library on all the nodes at runtime, with configuration parameters.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	path - The path of the dynamic library on the nodes.
+//	loadOptions - The configuration parameters and the arguments of the module, see [options.ModuleLoadExOptions].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Return value:
+//
+//	"OK" when the module was loaded by all the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/module-loadex/
+func (client *ClusterClient) ModuleLoadEx(
+	ctx context.Context,
+	path string,
+	loadOptions options.ModuleLoadExOptions,
+) (string, error) {
+	return client.ModuleLoadExWithOptions(ctx, path, loadOptions, options.RouteOption{Route: config.AllNodes})
+}
+
+// Loads a module from a dynamic library on the routed nodes at runtime, with configuration parameters.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	path - The path of the dynamic library on the nodes.
+//	loadOptions - The configuration parameters and the arguments of the module, see [options.ModuleLoadExOptions].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Return value:
+//
+//	"OK" when the module was loaded by the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/module-loadex/
+func (client *ClusterClient) ModuleLoadExWithOptions(
+	ctx context.Context,
+	path string,
+	loadOptions options.ModuleLoadExOptions,
+	opts options.RouteOption,
+) (string, error) {
+	args, err := loadOptions.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.ModuleLoadEx, append([]string{path}, args...), opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkClusterResponse(response)
+}
+
+// Unloads a module from all the nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name of the module, as returned by [ClusterClient.ModuleList].
+//
+// Return value:
+//
+//	"OK" when the module was unloaded by all the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/module-unload/
+func (client *ClusterClient) ModuleUnload(ctx context.Context, name string) (string, error) {
+	return client.ModuleUnloadWithOptions(ctx, name, options.RouteOption{Route: config.AllNodes})
+}
+
+// Unloads a module from the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name of the module, as returned by [ClusterClient.ModuleList].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	"OK" when the module was unloaded by the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/module-unload/
+func (client *ClusterClient) ModuleUnloadWithOptions(
+	ctx context.Context,
+	name string,
+	opts options.RouteOption,
+) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ModuleUnload, []string{name}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkClusterResponse(response)
+}
+
+// executeWithReplyPerNode executes a command the replies of which are aggregated by the core when it is routed to
+// multiple nodes, e.g. SLOWLOG GET. In case of a multi-node route, the command is sent to each node separately so that
+// the reply of each node is returned, mapped to the address of the node.
+func executeWithReplyPerNode[T any](
+	ctx context.Context,
+	client *ClusterClient,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	converter func(data any) (T, error),
+) (models.ClusterValue[T], error) {
+	if route == nil {
+		route = config.AllNodes
+	}
+	if !route.IsMultiNode() {
+		response, err := client.executeCommandWithRoute(ctx, requestType, args, route)
+		if err != nil {
+			return models.CreateEmptyClusterValue[T](), err
+		}
+		return handleClusterValueResponse(response, options.RouteOption{Route: route}, converter)
+	}
+
+	nodes, err := client.ClusterNodes(ctx)
+	if err != nil {
+		return models.CreateEmptyClusterValue[T](), err
+	}
+	values := make(map[string]T, len(nodes))
+	for _, node := range nodes {
+		if node.HasFlag("fail") || node.HasFlag("noaddr") || node.HasFlag("handshake") {
+			continue
+		}
+		if route == config.AllPrimaries && node.Role != models.PrimaryRole {
+			continue
+		}
+		nodeRoute := config.NewByAddressRoute(node.Host, int32(node.Port))
+		response, err := client.executeCommandWithRoute(ctx, requestType, args, nodeRoute)
+		if err != nil {
+			return models.CreateEmptyClusterValue[T](), err
+		}
+		value, err := handleConvertedResponse(response, converter)
+		if err != nil {
+			return models.CreateEmptyClusterValue[T](), err
+		}
+		values[node.Address] = value
+	}
+	return models.CreateClusterMultiValue(values), nil
+}
+
+// Returns a random key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A random key from the database.
+//
+// [valkey.io]: https://valkey.io/commands/randomkey/
+func (client *ClusterClient) RandomKey(ctx context.Context) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.RandomKey, []string{})
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return handleStringOrNilResponse(result)
+}
+
+// Returns a random key.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	 opts - specifies the routing configuration for the command.
+//
+//		 The client will route the command to the nodes defined by route,
+//		 and will return the first successful result.
+//
+// Return value:
+//
+//	A random key from the database.
+//
+// [valkey.io]: https://valkey.io/commands/randomkey/
+func (client *ClusterClient) RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (models.Result[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.RandomKey, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return handleStringOrNilResponse(result)
+}
+
+// Loads a library to Valkey.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	libraryCode - The source code that implements the library.
+//	replace - Whether the given library should overwrite a library with the same name if it
+//	already exists.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	command to the nodes defined by route.
+//
+// Return value:
+//
+//	The library name that was loaded.
+//
+// [valkey.io]: https://valkey.io/commands/function-load/
+func (client *ClusterClient) FunctionLoadWithRoute(ctx context.Context,
+	libraryCode string,
+	replace bool,
+	route options.RouteOption,
+) (string, error) {
+	args := []string{}
+	if replace {
+		args = append(args, constants.ReplaceKeyword)
+	}
+	args = append(args, libraryCode)
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionLoad, args, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Deletes all function libraries.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *ClusterClient) FunctionFlushWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Deletes all function libraries in synchronous mode.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *ClusterClient) FunctionFlushSyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{string(options.SYNC)}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Deletes all function libraries in asynchronous mode.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-flush/
+func (client *ClusterClient) FunctionFlushAsyncWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionFlush, []string{string(options.ASYNC)}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Invokes a previously loaded function.
+// To route to a replica please refer to [ClusterClient.FCallReadOnly].
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *ClusterClient) FCallWithRoute(
+	ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FCall,
+		[]string{function, utils.IntToString(0)},
+		route.Route,
+	)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	return models.CreateClusterSingleValue[any](data), nil
+}
+
+// Invokes a previously loaded read-only function.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The invoked function's return value.
+//
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *ClusterClient) FCallReadOnlyWithRoute(ctx context.Context,
+	function string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FCallReadOnly,
+		[]string{function, utils.IntToString(0)},
+		route.Route,
+	)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	return models.CreateClusterSingleValue[any](data), nil
+}
+
+// Invokes a previously loaded function.
+// The command will be routed to a random primary node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
+//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//
+// Return value:
+//
+//	The invoked function's return value wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *ClusterClient) FCallWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (models.ClusterValue[any], error) {
+	return client.FCallWithArgsWithRoute(ctx, function, args, options.RouteOption{})
+}
+
+// Invokes a previously loaded function.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
+//	arguments - An `array` of `function` arguments. `arguments` should not represent names of keys.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The invoked function's return value wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/fcall/
+func (client *ClusterClient) FCallWithArgsWithRoute(ctx context.Context,
+	function string,
+	args []string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	cmdArgs := append([]string{function, utils.IntToString(0)}, args...)
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FCall,
+		cmdArgs,
+		route.Route,
+	)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	return models.CreateClusterSingleValue[any](data), nil
+}
+
+// Invokes a previously loaded read-only function.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
+//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The invoked function's return value wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *ClusterClient) FCallReadOnlyWithArgsWithRoute(ctx context.Context,
+	function string,
+	args []string,
+	route options.RouteOption,
+) (models.ClusterValue[any], error) {
+	cmdArgs := append([]string{function, utils.IntToString(0)}, args...)
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FCallReadOnly,
+		cmdArgs,
+		route.Route,
+	)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	if route.Route != nil &&
+		(route.Route).IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(result)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(result)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	return models.CreateClusterSingleValue[any](data), nil
+}
+
+// Invokes a previously loaded read-only function.
+// The command will be routed to a random primary node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	function - The function name.
+//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//
+// Return value:
+//
+//	The invoked function's return value wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/fcall_ro/
+func (client *ClusterClient) FCallReadOnlyWithArgs(
+	ctx context.Context,
+	function string,
+	args []string,
+) (models.ClusterValue[any], error) {
+	return client.FCallReadOnlyWithArgsWithRoute(ctx, function, args, options.RouteOption{})
+}
+
+// FunctionStats returns information about the function that's currently running and information about the
+// available execution engines.
+// The command will be routed to all nodes by default.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map of node addresses to their function statistics represented by
+//	[FunctionStatsResult] object containing the following information:
+//	running_script - Information about the running script.
+//	engines - Information about available engines and their stats.
+//
+// [valkey.io]: https://valkey.io/commands/function-stats/
+func (client *ClusterClient) FunctionStats(ctx context.Context) (
+	map[string]models.FunctionStatsResult, error,
+) {
+	response, err := client.executeCommand(ctx, C.FunctionStats, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := handleFunctionStatsResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	// For multi-node routes, return the map of node addresses to FunctionStatsResult
+	return stats, nil
+}
+
+// FunctionStatsWithRoute returns information about the function that's currently running and information about the
+// available execution engines.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route. If no route is specified, the command
+//	       will be routed to all nodes.
+//
+// Return value:
+//
+//	A [models.ClusterValue] containing a map of node addresses to their function statistics.
+//
+// [valkey.io]: https://valkey.io/commands/function-stats/
+func (client *ClusterClient) FunctionStatsWithRoute(ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.FunctionStatsResult], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.FunctionStats, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.FunctionStatsResult](), err
+	}
+
+	stats, err := handleFunctionStatsResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.FunctionStatsResult](), err
+	}
+
+	// single node routes return a single stat response
+	if len(stats) == 1 {
+		for _, result := range stats {
+			return models.CreateClusterSingleValue[models.FunctionStatsResult](result), nil
+		}
+	}
+
+	// For multi-node routes, return the map of node addresses to FunctionStatsResult
+	return models.CreateClusterMultiValue[models.FunctionStatsResult](stats), nil
+}
+
+// Deletes a library and all its functions.
+// The command will be routed to all primary nodes.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	libName - The library name to delete.
+//
+// Return value:
+//
+//	"OK" if the library exists, otherwise an error is thrown.
+//
+// [valkey.io]: https://valkey.io/commands/function-delete/
+func (client *ClusterClient) FunctionDelete(ctx context.Context, libName string) (string, error) {
+	return client.FunctionDeleteWithRoute(ctx, libName, options.RouteOption{})
+}
+
+// Deletes a library and all its functions.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	libName - The library name to delete.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	"OK" if the library exists, otherwise an error is thrown.
+//
+// [valkey.io]: https://valkey.io/commands/function-delete/
+func (client *ClusterClient) FunctionDeleteWithRoute(
+	ctx context.Context,
+	libName string,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionDelete, []string{libName}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Kills a function that is currently executing.
+//
+// `FUNCTION KILL` terminates read-only functions only.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// Note:
+//
+//	This command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` if function is terminated. Otherwise, throws an error.
+//
+// [valkey.io]: https://valkey.io/commands/function-kill/
+func (client *ClusterClient) FunctionKill(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionKill, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Kills a function that is currently executing.
+//
+// `FUNCTION KILL` terminates read-only functions only.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK` if function is terminated. Otherwise, throws an error.
+//
+// [valkey.io]: https://valkey.io/commands/function-kill/
+func (client *ClusterClient) FunctionKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.FunctionKill,
+		[]string{},
+		route.Route,
+	)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Returns information about the functions and libraries.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	query - The query to use to filter the functions and libraries.
+//
+// Return value:
+//
+//	A list of info about queried libraries and their functions.
+//
+// [valkey.io]: https://valkey.io/commands/function-list/
+func (client *ClusterClient) FunctionList(ctx context.Context, query models.FunctionListQuery) ([]models.LibraryInfo, error) {
+	response, err := client.executeCommand(ctx, C.FunctionList, query.ToArgs())
+	if err != nil {
+		return nil, err
+	}
+	return handleFunctionListResponse(response)
+}
+
+// Returns information about the functions and libraries.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	query - The query to use to filter the functions and libraries.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	A [models.ClusterValue] containing a list of info about queried libraries and their functions.
+//
+// [valkey.io]: https://valkey.io/commands/function-list/
+func (client *ClusterClient) FunctionListWithRoute(ctx context.Context,
+	query models.FunctionListQuery,
+	route options.RouteOption,
+) (models.ClusterValue[[]models.LibraryInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.FunctionList, query.ToArgs(), route.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.LibraryInfo](), err
+	}
+
+	if route.Route != nil && route.Route.IsMultiNode() {
+		multiNodeLibs, err := handleFunctionListMultiNodeResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[[]models.LibraryInfo](), err
+		}
+		return models.CreateClusterMultiValue[[]models.LibraryInfo](multiNodeLibs), nil
+	}
+
+	libs, err := handleFunctionListResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.LibraryInfo](), err
+	}
+	return models.CreateClusterSingleValue[[]models.LibraryInfo](libs), nil
+}
+
+// Publish posts a message to the specified channel. Returns the number of clients that received the message.
+//
+// Channel can be any string, but common patterns include using "." to create namespaces like
+// "news.sports" or "news.weather".
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	channel - The channel to publish the message to.
+//	message - The message to publish.
+//	sharded - Whether the channel is sharded.
+//
+// Return value:
+//
+//	The number of clients that received the message.
+//
+// [valkey.io]: https://valkey.io/commands/publish
+func (client *ClusterClient) Publish(ctx context.Context, channel string, message string, sharded bool) (int64, error) {
+	args := []string{channel, message}
+
+	var requestType C.RequestType
+	if sharded {
+		requestType = C.SPublish
+	} else {
+		requestType = C.Publish
+	}
+	result, err := client.executeCommand(ctx, requestType, args)
+	if err != nil {
+		return 0, err
+	}
+
+	return handleIntResponse(result)
+}
+
+// Returns a list of all sharded channels.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A list of shard channels.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-shard-channels
+func (client *ClusterClient) PubSubShardChannels(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubShardChannels, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringArrayResponse(result)
+}
+
+// Returns a list of all sharded channels that match the given pattern.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	pattern - A glob-style pattern to match active shard channels.
+//
+// Return value:
+//
+//	A list of shard channels that match the given pattern.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-shard-channels-with-pattern
+func (client *ClusterClient) PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.PubSubShardChannels, []string{pattern})
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringArrayResponse(result)
+}
+
+// Returns the number of subscribers for a sharded channel.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	channels - The channel to get the number of subscribers for.
+//
+// Return value:
+//
+//	The number of subscribers for the sharded channel.
+//
+// [valkey.io]: https://valkey.io/commands/pubsub-shard-numsub
+func (client *ClusterClient) PubSubShardNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
+	result, err := client.executeCommand(ctx, C.PubSubShardNumSub, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleStringIntMapResponse(result)
+}
+
+// Subscribes the client to the specified shard channels. The messages are delivered to the callback given in the
+// subscription configuration of the client, or to the queue returned by [ClusterClient.GetQueue] otherwise. The client
+// must be created with a subscription configuration, which may have no subscriptions.
+//
+// Unlike the subscriptions given in the configuration, the subscriptions made at runtime are restored by the client once
+// it reconnects after a disconnection.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	channels - The shard channels to subscribe to.
+//
+// Return value:
+//
+//	An error if the client failed to subscribe to any of the shard channels.
+//
+// [valkey.io]: https://valkey.io/commands/ssubscribe/
+func (client *ClusterClient) SSubscribe(ctx context.Context, channels ...string) error {
+	return client.subscribe(ctx, config.ShardedClusterChannelMode, channels)
+}
+
+// Unsubscribes the client from the specified shard channels, or from all the shard channels it is subscribed to if none
+// are given. The messages already delivered to the queue of the client remain in the queue.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	channels - The shard channels to unsubscribe from.
+//
+// Return value:
+//
+//	An error if the client failed to unsubscribe from any of the shard channels.
+//
+// [valkey.io]: https://valkey.io/commands/sunsubscribe/
+func (client *ClusterClient) SUnsubscribe(ctx context.Context, channels ...string) error {
+	return client.unsubscribe(ctx, config.ShardedClusterChannelMode, channels)
+}
+
+// Returns the serialized payload of all loaded libraries.
+//
+// Note:
+//
+//	The command will be routed to a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The serialized payload of all loaded libraries.
+//
+// [valkey.io]: https://valkey.io/commands/function-dump/
+func (client *ClusterClient) FunctionDump(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionDump, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Returns the serialized payload of all loaded libraries.
+// The command will be routed to the nodes defined by the route parameter.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	A [models.ClusterValue] containing the serialized payload of all loaded libraries.
+//
+// [valkey.io]: https://valkey.io/commands/function-dump/
+func (client *ClusterClient) FunctionDumpWithRoute(
+	ctx context.Context,
+	route config.Route,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.FunctionDump, []string{}, route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	if route != nil && route.IsMultiNode() {
+		data, err := handleStringToStringMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[string](), err
+		}
+		return models.CreateClusterMultiValue[string](data), nil
+	}
+	data, err := handleStringResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return models.CreateClusterSingleValue[string](data), nil
+}
+
+// Restores libraries from the serialized payload returned by `FunctionDump`.
+//
+// Note:
+//
+//	The command will be routed to all primary nodes.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized data from `FunctionDump`.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *ClusterClient) FunctionRestore(ctx context.Context, payload string) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionRestore, []string{payload})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Restores libraries from the serialized payload.
+// The command will be routed to the nodes defined by the route parameter.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized data from dump operation.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *ClusterClient) FunctionRestoreWithRoute(
+	ctx context.Context,
+	payload string,
+	route config.Route,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionRestore, []string{payload}, route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Restores libraries from the serialized payload returned by `FunctionDump`.
+//
+// Note:
+//
+//	The command will be routed to all primary nodes.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized data from `FunctionDump`.
+//	policy - A policy for handling existing libraries.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *ClusterClient) FunctionRestoreWithPolicy(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.FunctionRestore, []string{payload, string(policy)})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Restores libraries from the serialized payload.
+// The command will be routed to the nodes defined by the route parameter.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The serialized data from dump operation.
+//	policy - A policy for handling existing libraries.
+//	route - Specifies the routing configuration for the command.
+//
+// Return value:
+//
+//	`OK`
+//
+// [valkey.io]: https://valkey.io/commands/function-restore/
+func (client *ClusterClient) FunctionRestoreWithPolicyWithRoute(
+	ctx context.Context,
+	payload string,
+	policy constants.FunctionRestorePolicy,
+	route config.Route,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.FunctionRestore, []string{payload, string(policy)}, route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Executes a Lua script on the server with routing information.
+//
+// This function simplifies the process of invoking scripts on the server by using an object that
+// represents a Lua script. The script loading and execution will all be handled internally. If
+// the script has not already been loaded, it will be loaded automatically using the
+// `SCRIPT LOAD` command. After that, it will be invoked using the `EVALSHA`
+// command.
+//
+// Note:
+//
+//	The command will be routed to a random node, unless `route` is provided.
+//
+// See [LOAD] and [EVALSHA] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The Lua script to execute.
+//	route - Routing information for the script execution.
+//
+// Return value:
+//
+//	The result of the script execution.
+//
+// [LOAD]: https://valkey.io/commands/script-load/
+// [EVALSHA]: https://valkey.io/commands/evalsha/
+func (client *ClusterClient) InvokeScriptWithRoute(
+	ctx context.Context,
+	script options.Script,
+	route options.RouteOption,
 ) (models.ClusterValue[any], error) {
-	cmdArgs := append([]string{function, utils.IntToString(0)}, args...)
+	response, err := client.baseClient.executeScriptWithRoute(ctx, script.GetHash(), []string{}, []string{}, route.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	if route.Route != nil && route.Route.IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+
+	return models.CreateClusterSingleValue[any](response), nil
+}
+
+// Executes a Lua script on the server with cluster script options.
+//
+// This function simplifies the process of invoking scripts on the server by using an object that
+// represents a Lua script. The script loading, argument preparation, and execution will all be
+// handled internally. If the script has not already been loaded, it will be loaded automatically
+// using the `SCRIPT LOAD` command. After that, it will be invoked using the
+// `EVALSHA` command.
+//
+// Note:
+//
+//   - all `keys` in `clusterScriptOptions` must map to the same hash slot.
+//   - the command will be routed based on the Route specified in clusterScriptOptions.
+//
+// See [LOAD] and [EVALSHA] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The script to execute.
+//	clusterScriptOptions - Combined options for script execution including keys, arguments, and routing information.
+//
+// Return value:
+//
+//	The result of the script execution.
+//
+// [LOAD]: https://valkey.io/commands/script-load/
+// [EVALSHA]: https://valkey.io/commands/evalsha/
+func (client *ClusterClient) InvokeScriptWithClusterOptions(
+	ctx context.Context,
+	script options.Script,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	args := clusterScriptOptions.Args
+	route := clusterScriptOptions.Route
+
+	response, err := client.baseClient.executeScriptWithRoute(ctx, script.GetHash(), []string{}, args, route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+
+	if route != nil && route.IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+
+	return models.CreateClusterSingleValue[any](response), nil
+}
+
+// Executes a Lua script on the server with `EVAL`, with arguments and routing information.
+//
+// Note:
+//
+//	The command will be routed to a random primary node, unless a route is provided in clusterScriptOptions.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The source code of the Lua script.
+//	clusterScriptOptions - Combined options for script execution including arguments and routing information.
+//
+// Return value:
+//
+//	The result of the script execution. When the route is multi-node, a map of the node addresses to their results.
+//
+// [valkey.io]: https://valkey.io/commands/eval/
+func (client *ClusterClient) EvalWithClusterOptions(
+	ctx context.Context,
+	script string,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	return client.evalWithClusterOptions(ctx, C.Eval, script, clusterScriptOptions)
+}
+
+// Executes a read-only Lua script on the server with `EVAL_RO`, with arguments and routing information. The script
+// fails if it modifies the data.
+//
+// Note:
+//
+//	The command will be routed to a random node, unless a route is provided in clusterScriptOptions.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The source code of the Lua script.
+//	clusterScriptOptions - Combined options for script execution including arguments and routing information.
+//
+// Return value:
+//
+//	The result of the script execution. When the route is multi-node, a map of the node addresses to their results.
+//
+// [valkey.io]: https://valkey.io/commands/eval_ro/
+func (client *ClusterClient) EvalReadOnlyWithClusterOptions(
+	ctx context.Context,
+	script string,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	return client.evalWithClusterOptions(ctx, C.EvalReadOnly, script, clusterScriptOptions)
+}
+
+// Executes a Lua script loaded on the server, by its SHA1 digest, with `EVALSHA`, with arguments and routing
+// information. The client doesn't load the script when it's missing, and returns a [NoScriptError] instead.
+//
+// Note:
+//
+//	The command will be routed to a random primary node, unless a route is provided in clusterScriptOptions.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1 - The SHA1 digest of the script, as returned by [ClusterClient.ScriptLoad].
+//	clusterScriptOptions - Combined options for script execution including arguments and routing information.
+//
+// Return value:
+//
+//	The result of the script execution. When the route is multi-node, a map of the node addresses to their results.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (client *ClusterClient) EvalShaWithClusterOptions(
+	ctx context.Context,
+	sha1 string,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	return client.evalWithClusterOptions(ctx, C.EvalSha, sha1, clusterScriptOptions)
+}
+
+// Executes a read-only Lua script loaded on the server, by its SHA1 digest, with `EVALSHA_RO`, with arguments and
+// routing information. The client doesn't load the script when it's missing, and returns a [NoScriptError] instead.
+//
+// Note:
+//
+//	The command will be routed to a random node, unless a route is provided in clusterScriptOptions.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	sha1 - The SHA1 digest of the script, as returned by [ClusterClient.ScriptLoad].
+//	clusterScriptOptions - Combined options for script execution including arguments and routing information.
+//
+// Return value:
+//
+//	The result of the script execution. When the route is multi-node, a map of the node addresses to their results.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha_ro/
+func (client *ClusterClient) EvalShaReadOnlyWithClusterOptions(
+	ctx context.Context,
+	sha1 string,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	return client.evalWithClusterOptions(ctx, C.EvalShaReadOnly, sha1, clusterScriptOptions)
+}
+
+func (client *ClusterClient) evalWithClusterOptions(
+	ctx context.Context,
+	requestType C.RequestType,
+	scriptOrSha1 string,
+	clusterScriptOptions options.ClusterScriptOptions,
+) (models.ClusterValue[any], error) {
+	args := []string{}
+	if clusterScriptOptions.ScriptArgOptions != nil {
+		args = clusterScriptOptions.Args
+	}
+	var route config.Route
+	if clusterScriptOptions.RouteOption != nil {
+		route = clusterScriptOptions.Route
+	}
+
+	response, err := client.executeCommandWithRoute(ctx, requestType, scriptArgs(scriptOrSha1, []string{}, args), route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	if route != nil && route.IsMultiNode() {
+		data, err := handleStringToAnyMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[any](), err
+		}
+		return models.CreateClusterMultiValue[any](data), nil
+	}
+	data, err := handleAnyResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[any](), err
+	}
+	return models.CreateClusterSingleValue[any](data), nil
+}
+
+// Loads a Lua script into the script cache of the nodes, without executing it. The script can then be executed by its
+// SHA1 digest with [ClusterClient.EvalSha].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	script - The source code of the Lua script.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	The SHA1 digest of the script.
+//
+// [valkey.io]: https://valkey.io/commands/script-load/
+func (client *ClusterClient) ScriptLoadWithRoute(
+	ctx context.Context,
+	script string,
+	route options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptLoad, []string{script}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Checks existence of scripts in the script cache by their SHA1 digest.
+//
+// Note:
+//
+//	The command will be routed to all primary nodes by default.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	sha1s - SHA1 digests of Lua scripts to be checked.
+//
+// Return value:
+//
+//	An array of boolean values indicating the existence of each script.
+//
+// [valkey.io]: https://valkey.io/commands/script-exists
+func (client *ClusterClient) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
+	response, err := client.executeCommand(ctx, C.ScriptExists, sha1s)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleBoolArrayResponse(response)
+}
+
+// Checks existence of scripts in the script cache by their SHA1 digest.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	sha1s - SHA1 digests of Lua scripts to be checked.
+//	route - Specifies the routing configuration for the command. The client will route the
+//		    command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	An array of boolean values indicating the existence of each script.
+//
+// [valkey.io]: https://valkey.io/commands/script-exists
+func (client *ClusterClient) ScriptExistsWithRoute(
+	ctx context.Context,
+	sha1s []string,
+	route options.RouteOption,
+) ([]bool, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ScriptExists, sha1s, route.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleBoolArrayResponse(response)
+}
+
+// Removes all the scripts from the script cache.
+// The command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *ClusterClient) ScriptFlush(ctx context.Context) (string, error) {
+	return client.baseClient.ScriptFlush(ctx)
+}
+
+// Removes all the scripts from the script cache with the specified route options.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	options - The ScriptFlushOptions containing the flush mode and route.
+//			  The mode can be either SYNC or ASYNC.
+//
+// Return value:
+//
+//	OK on success.
+//
+// [valkey.io]: https://valkey.io/commands/script-flush/
+func (client *ClusterClient) ScriptFlushWithOptions(
+	ctx context.Context,
+	options options.ScriptFlushOptions,
+) (string, error) {
+	args := []string{}
+	if options.Mode != "" {
+		args = append(args, string(options.Mode))
+	}
+	if options.Route == nil {
+		result, err := client.executeCommand(ctx, C.ScriptFlush, args)
+		if err != nil {
+			return models.DefaultStringResponse, err
+		}
+		return handleOkResponse(result)
+	}
+	result, err := client.executeCommandWithRoute(ctx, C.ScriptFlush, args, options.RouteOption.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Kills the currently executing Lua script, assuming no write operation was yet performed by the
+// script.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	`OK` if script is terminated. Otherwise, throws an error.
+//
+// [valkey.io]: https://valkey.io/commands/script-kill
+func (client *ClusterClient) ScriptKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
 	result, err := client.executeCommandWithRoute(
 		ctx,
-		C.FCall,
-		cmdArgs,
+		C.ScriptKill,
+		[]string{},
 		route.Route,
 	)
 	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Flushes all the previously watched keys for a transaction. Executing a transaction will
+// automatically flush all previously watched keys.
+// The command will be routed to all primary nodes.
+//
+// See [valkey.io] and [Valkey Glide Wiki] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A simple "OK" response.
+//
+// [valkey.io]: https://valkey.io/commands/unwatch
+// [Valkey Glide Wiki]: https://valkey.io/topics/transactions/#cas
+func (client *ClusterClient) Unwatch(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.UnWatch, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Flushes all the previously watched keys for a transaction. Executing a transaction will
+// automatically flush all previously watched keys.
+//
+// See [valkey.io] and [Valkey Glide Wiki] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by `route`.
+//
+// Return value:
+//
+//	A simple "OK" response.
+//
+// [valkey.io]: https://valkey.io/commands/unwatch
+// [Valkey Glide Wiki]: https://valkey.io/topics/transactions/#cas
+func (client *ClusterClient) UnwatchWithOptions(ctx context.Context, route options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.UnWatch, []string{}, route.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Returns the ACL categories.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	An array of the ACL categories, e.g. `keyspace`, `read` or `pubsub`, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *ClusterClient) AclCatWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclCat, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]string](), err
+	}
+	return handleStringArrayClusterResponse(response, opts)
+}
+
+// Deletes the ACL users and terminates their connections. Users which don't exist are ignored.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	usernames - The usernames of the users to delete.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The number of users that were deleted. When routed to multiple nodes, the number of users deleted on one of them.
+//
+// [valkey.io]: https://valkey.io/commands/acl-deluser/
+func (client *ClusterClient) AclDelUserWithOptions(
+	ctx context.Context,
+	usernames []string,
+	opts options.RouteOption,
+) (int64, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclDelUser, usernames, opts.Route)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(response)
+}
+
+// Returns the rules of an ACL user.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The username of the user.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	A [models.AclUser] describing the rules of the user, or a `nil` result if the user doesn't exist,
+//	wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/acl-getuser/
+func (client *ClusterClient) AclGetUserWithOptions(
+	ctx context.Context,
+	username string,
+	opts options.RouteOption,
+) (models.ClusterValue[models.Result[models.AclUser]], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclGetUser, []string{username}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.Result[models.AclUser]](), err
+	}
+	return handleAclUserClusterResponse(response, opts)
+}
+
+// Returns the rules of all the ACL users, in the format of the ACL configuration file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	An array of the rules of each user, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/acl-list/
+func (client *ClusterClient) AclListWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclList, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]string](), err
 	}
-	if route.Route != nil &&
-		(route.Route).IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(result)
-		if err != nil {
-			return models.CreateEmptyClusterValue[any](), err
-		}
-		return models.CreateClusterMultiValue[any](data), nil
+	return handleStringArrayClusterResponse(response, opts)
+}
+
+// Reloads the ACL users from the configured ACL file, replacing all the current users.
+// Fails if the nodes aren't configured to use an ACL file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-load/
+func (client *ClusterClient) AclLoadWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclLoad, []string{}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
 	}
-	data, err := handleAnyResponse(result)
+	return handleOkClusterResponse(response)
+}
+
+// Returns the 10 most recent ACL security events.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	An array of [models.AclLogEntry], from the most recent to the oldest, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *ClusterClient) AclLogWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.AclLogEntry], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclLog, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.AclLogEntry](), err
+	}
+	return handleAclLogClusterResponse(response, opts)
+}
+
+// Clears the ACL security events log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *ClusterClient) AclLogResetWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclLog, []string{options.AclLogResetKeyword}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkClusterResponse(response)
+}
+
+// Saves the current ACL users to the configured ACL file.
+// Fails if the nodes aren't configured to use an ACL file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-save/
+func (client *ClusterClient) AclSaveWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclSave, []string{}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Creates an ACL user, or modifies the rules of an existing user. ACL users aren't propagated between the nodes of
+// the cluster, use [config.AllNodes] to apply the rules on every node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The username of the user.
+//	rules - The rules to apply to the user, see [options.AclRules].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-setuser/
+func (client *ClusterClient) AclSetUserWithOptions(
+	ctx context.Context,
+	username string,
+	rules options.AclRules,
+	opts options.RouteOption,
+) (string, error) {
+	args, err := rules.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.AclSetSser, append([]string{username}, args...), opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(response)
+}
+
+// Returns the usernames of all the ACL users.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	An array of the usernames, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/acl-users/
+func (client *ClusterClient) AclUsersWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclUsers, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]string](), err
+	}
+	return handleStringArrayClusterResponse(response, opts)
+}
+
+// Returns the username the connection to each routed node is authenticated with.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The username of the connection, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/acl-whoami/
+func (client *ClusterClient) AclWhoamiWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.AclWhoami, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleStringClusterResponse(response, opts)
+}
+
+// Returns information about the state of the cluster, from the point of view of a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [models.ClusterInfo] describing the state of the cluster.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-info/
+func (client *ClusterClient) ClusterInfo(ctx context.Context) (models.ClusterInfo, error) {
+	response, err := client.executeCommand(ctx, C.ClusterInfo, []string{})
+	if err != nil {
+		return models.ClusterInfo{}, err
+	}
+	return handleConvertedResponse(response, internal.ConvertClusterInfo)
+}
+
+// Returns information about the state of the cluster, from the point of view of the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	A [models.ClusterInfo] describing the state of the cluster, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-info/
+func (client *ClusterClient) ClusterInfoWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.ClusterInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterInfo, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.ClusterInfo](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertClusterInfo)
+}
+
+// Returns the cluster bus links of a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [models.ClusterLink], with the links opened by the node and the links accepted by the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-links/
+func (client *ClusterClient) ClusterLinks(ctx context.Context) ([]models.ClusterLink, error) {
+	response, err := client.executeCommand(ctx, C.ClusterLinks, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertClusterLinks)
+}
+
+// Returns the cluster bus links of the routed nodes.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	An array of [models.ClusterLink], wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-links/
+func (client *ClusterClient) ClusterLinksWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.ClusterLink], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterLinks, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.ClusterLink](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertClusterLinks)
+}
+
+// Returns the ID of a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The ID of the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myid/
+func (client *ClusterClient) ClusterMyId(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ClusterMyId, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleStringResponse(response)
+}
+
+// Returns the ID of the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	The ID of the node, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myid/
+func (client *ClusterClient) ClusterMyIdWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterMyId, []string{}, opts.Route)
 	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
+		return models.CreateEmptyClusterValue[string](), err
 	}
-	return models.CreateClusterSingleValue[any](data), nil
+	return handleStringClusterResponse(response, opts)
 }
 
-// Invokes a previously loaded read-only function.
+// Returns the ID of the shard of a random node.
 //
 // Since:
 //
-//	Valkey 7.0 and above.
+//	Valkey 7.2 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	args - An `array` of `function` arguments. `args` should not represent names of keys.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	    command to the nodes defined by `route`.
 //
 // Return value:
 //
-//	The invoked function's return value wrapped by a [models.ClusterValue].
+//	The ID of the shard of the node.
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *ClusterClient) FCallReadOnlyWithArgsWithRoute(ctx context.Context,
-	function string,
-	args []string,
-	route options.RouteOption,
-) (models.ClusterValue[any], error) {
-	cmdArgs := append([]string{function, utils.IntToString(0)}, args...)
-	result, err := client.executeCommandWithRoute(
-		ctx,
-		C.FCallReadOnly,
-		cmdArgs,
-		route.Route,
-	)
+// [valkey.io]: https://valkey.io/commands/cluster-myshardid/
+func (client *ClusterClient) ClusterMyShardId(ctx context.Context) (string, error) {
+	response, err := client.executeCommand(ctx, C.ClusterMyShardId, []string{})
 	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
-	}
-	if route.Route != nil &&
-		(route.Route).IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(result)
-		if err != nil {
-			return models.CreateEmptyClusterValue[any](), err
-		}
-		return models.CreateClusterMultiValue[any](data), nil
-	}
-	data, err := handleAnyResponse(result)
-	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
+		return models.DefaultStringResponse, err
 	}
-	return models.CreateClusterSingleValue[any](data), nil
+	return handleStringResponse(response)
 }
 
-// Invokes a previously loaded read-only function.
-// The command will be routed to a random primary node.
+// Returns the ID of the shard of the routed nodes.
 //
 // Since:
 //
-//	Valkey 7.0 and above.
+//	Valkey 7.2 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	function - The function name.
-//	args - An `array` of `function` arguments. `args` should not represent names of keys.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	The invoked function's return value wrapped by a [models.ClusterValue].
+//	The ID of the shard of the node, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/fcall_ro/
-func (client *ClusterClient) FCallReadOnlyWithArgs(
+// [valkey.io]: https://valkey.io/commands/cluster-myshardid/
+func (client *ClusterClient) ClusterMyShardIdWithOptions(
 	ctx context.Context,
-	function string,
-	args []string,
-) (models.ClusterValue[any], error) {
-	return client.FCallReadOnlyWithArgsWithRoute(ctx, function, args, options.RouteOption{})
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterMyShardId, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[string](), err
+	}
+	return handleStringClusterResponse(response, opts)
 }
 
-// FunctionStats returns information about the function that's currently running and information about the
-// available execution engines.
-// The command will be routed to all nodes by default.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the nodes of the cluster, from the point of view of a random node.
 //
 // See [valkey.io] for details.
 //
@@ -1745,35 +4485,18 @@ func (client *ClusterClient) FCallReadOnlyWithArgs(
 //
 // Return value:
 //
-//	A map of node addresses to their function statistics represented by
-//	[FunctionStatsResult] object containing the following information:
-//	running_script - Information about the running script.
-//	engines - Information about available engines and their stats.
+//	An array of [models.ClusterNode], parsed from the CLUSTER NODES text format.
 //
-// [valkey.io]: https://valkey.io/commands/function-stats/
-func (client *ClusterClient) FunctionStats(ctx context.Context) (
-	map[string]models.FunctionStatsResult, error,
-) {
-	response, err := client.executeCommand(ctx, C.FunctionStats, []string{})
-	if err != nil {
-		return nil, err
-	}
-
-	stats, err := handleFunctionStatsResponse(response)
+// [valkey.io]: https://valkey.io/commands/cluster-nodes/
+func (client *ClusterClient) ClusterNodes(ctx context.Context) ([]models.ClusterNode, error) {
+	response, err := client.executeCommand(ctx, C.ClusterNodes, []string{})
 	if err != nil {
 		return nil, err
 	}
-
-	// For multi-node routes, return the map of node addresses to FunctionStatsResult
-	return stats, nil
+	return handleConvertedResponse(response, internal.ConvertClusterNodes)
 }
 
-// FunctionStatsWithRoute returns information about the function that's currently running and information about the
-// available execution engines.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the nodes of the cluster, from the point of view of the routed nodes.
 //
 // See [valkey.io] for details.
 //
@@ -1781,40 +4504,75 @@ func (client *ClusterClient) FunctionStats(ctx context.Context) (
 //
 //	ctx - The context for controlling the command execution.
 //	opts - Specifies the routing configuration for the command. The client will route the
-//	       command to the nodes defined by route. If no route is specified, the command
-//	       will be routed to all nodes.
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	A [models.ClusterValue] containing a map of node addresses to their function statistics.
+//	An array of [models.ClusterNode], wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/function-stats/
-func (client *ClusterClient) FunctionStatsWithRoute(ctx context.Context,
+// [valkey.io]: https://valkey.io/commands/cluster-nodes/
+func (client *ClusterClient) ClusterNodesWithOptions(
+	ctx context.Context,
 	opts options.RouteOption,
-) (models.ClusterValue[models.FunctionStatsResult], error) {
-	response, err := client.executeCommandWithRoute(ctx, C.FunctionStats, []string{}, opts.Route)
+) (models.ClusterValue[[]models.ClusterNode], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterNodes, []string{}, opts.Route)
 	if err != nil {
-		return models.CreateEmptyClusterValue[models.FunctionStatsResult](), err
+		return models.CreateEmptyClusterValue[[]models.ClusterNode](), err
 	}
+	return handleClusterValueResponse(response, opts, internal.ConvertClusterNodes)
+}
 
-	stats, err := handleFunctionStatsResponse(response)
+// Returns the replicas of a primary, from the point of view of a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	nodeId - The ID of the primary.
+//
+// Return value:
+//
+//	An array of [models.ClusterNode] describing the replicas of the primary.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-replicas/
+func (client *ClusterClient) ClusterReplicas(ctx context.Context, nodeId string) ([]models.ClusterNode, error) {
+	response, err := client.executeCommand(ctx, C.ClusterReplicas, []string{nodeId})
 	if err != nil {
-		return models.CreateEmptyClusterValue[models.FunctionStatsResult](), err
+		return nil, err
 	}
+	return handleConvertedResponse(response, internal.ConvertClusterNodes)
+}
 
-	// single node routes return a single stat response
-	if len(stats) == 1 {
-		for _, result := range stats {
-			return models.CreateClusterSingleValue[models.FunctionStatsResult](result), nil
-		}
+// Returns the replicas of a primary, from the point of view of the routed nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	nodeId - The ID of the primary.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	An array of [models.ClusterNode] describing the replicas of the primary, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/cluster-replicas/
+func (client *ClusterClient) ClusterReplicasWithOptions(
+	ctx context.Context,
+	nodeId string,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.ClusterNode], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterReplicas, []string{nodeId}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.ClusterNode](), err
 	}
-
-	// For multi-node routes, return the map of node addresses to FunctionStatsResult
-	return models.CreateClusterMultiValue[models.FunctionStatsResult](stats), nil
+	return handleClusterValueResponse(response, opts, internal.ConvertClusterNodes)
 }
 
-// Deletes a library and all its functions.
-// The command will be routed to all primary nodes.
+// Returns the shards of the cluster, from the point of view of a random node.
 //
 // Since:
 //
@@ -1825,18 +4583,21 @@ func (client *ClusterClient) FunctionStatsWithRoute(ctx context.Context,
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	libName - The library name to delete.
 //
 // Return value:
 //
-//	"OK" if the library exists, otherwise an error is thrown.
+//	An array of [models.ClusterShard], with the slots and the nodes of each shard.
 //
-// [valkey.io]: https://valkey.io/commands/function-delete/
-func (client *ClusterClient) FunctionDelete(ctx context.Context, libName string) (string, error) {
-	return client.FunctionDeleteWithRoute(ctx, libName, options.RouteOption{})
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *ClusterClient) ClusterShards(ctx context.Context) ([]models.ClusterShard, error) {
+	response, err := client.executeCommand(ctx, C.ClusterShards, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertClusterShards)
 }
 
-// Deletes a library and all its functions.
+// Returns the shards of the cluster, from the point of view of the routed nodes.
 //
 // Since:
 //
@@ -1847,119 +4608,201 @@ func (client *ClusterClient) FunctionDelete(ctx context.Context, libName string)
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	libName - The library name to delete.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	    command to the nodes defined by `route`.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	"OK" if the library exists, otherwise an error is thrown.
+//	An array of [models.ClusterShard], wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/function-delete/
-func (client *ClusterClient) FunctionDeleteWithRoute(
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *ClusterClient) ClusterShardsWithOptions(
 	ctx context.Context,
-	libName string,
-	route options.RouteOption,
-) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionDelete, []string{libName}, route.Route)
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.ClusterShard], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterShards, []string{}, opts.Route)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.CreateEmptyClusterValue[[]models.ClusterShard](), err
 	}
-	return handleOkResponse(result)
+	return handleClusterValueResponse(response, opts, internal.ConvertClusterShards)
 }
 
-// Kills a function that is currently executing.
+// Returns the mapping of the slots to the nodes, from the point of view of a random node.
 //
-// `FUNCTION KILL` terminates read-only functions only.
+// See [valkey.io] for details.
 //
-// Since:
+// Parameters:
 //
-//	Valkey 7.0 and above.
+//	ctx - The context for controlling the command execution.
 //
-// Note:
+// Return value:
 //
-//	This command will be routed to all nodes.
+//	An array of [models.ClusterSlotRange], with the primary and the replicas serving each range of slots.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slots/
+func (client *ClusterClient) ClusterSlots(ctx context.Context) ([]models.ClusterSlotRange, error) {
+	response, err := client.executeCommand(ctx, C.ClusterSlots, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleConvertedResponse(response, internal.ConvertClusterSlots)
+}
+
+// Returns the mapping of the slots to the nodes, from the point of view of the routed nodes.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK` if function is terminated. Otherwise, throws an error.
+//	An array of [models.ClusterSlotRange], wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/function-kill/
-func (client *ClusterClient) FunctionKill(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionKill, []string{})
+// [valkey.io]: https://valkey.io/commands/cluster-slots/
+func (client *ClusterClient) ClusterSlotsWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.ClusterSlotRange], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClusterSlots, []string{}, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.ClusterSlotRange](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertClusterSlots)
+}
+
+// Executes a command which applies to a specific node, like the cluster administration commands.
+func (client *ClusterClient) executeNodeCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.SingleNodeRoute,
+) (string, error) {
+	if route == nil {
+		return models.DefaultStringResponse, errors.New("a route to the node is required for this command")
+	}
+	response, err := client.executeCommandWithRoute(ctx, requestType, args, route)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleStringResponse(result)
+	return handleOkResponse(response)
 }
 
-// Kills a function that is currently executing.
+func slotsToArgs(slots []int64) []string {
+	args := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		args = append(args, utils.IntToString(slot))
+	}
+	return args
+}
+
+func slotRangesToArgs(slotRanges []models.SlotRange) []string {
+	args := make([]string, 0, 2*len(slotRanges))
+	for _, slotRange := range slotRanges {
+		args = append(args, utils.IntToString(slotRange.Start), utils.IntToString(slotRange.End))
+	}
+	return args
+}
+
+// Assigns the slots to the node.
 //
-// `FUNCTION KILL` terminates read-only functions only.
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slots - The slots to assign.
+//	route - The route to the node, for example a [config.ByAddressRoute].
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-addslots/
+func (client *ClusterClient) ClusterAddSlots(
+	ctx context.Context,
+	slots []int64,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterAddSlots, slotsToArgs(slots), route)
+}
+
+// Assigns the ranges of slots to the node.
 //
 // Since:
 //
 //	Valkey 7.0 and above.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	slotRanges - The inclusive ranges of slots to assign.
+//	route - The route to the node, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	`OK` if function is terminated. Otherwise, throws an error.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-kill/
-func (client *ClusterClient) FunctionKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(
-		ctx,
-		C.FunctionKill,
-		[]string{},
-		route.Route,
-	)
+// [valkey.io]: https://valkey.io/commands/cluster-addslotsrange/
+func (client *ClusterClient) ClusterAddSlotsRange(
+	ctx context.Context,
+	slotRanges []models.SlotRange,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterAddSlotsRange, slotRangesToArgs(slotRanges), route)
+}
+
+// Returns the number of keys in the slot. The command is routed to the primary serving the slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The slot to count the keys of.
+//
+// Return value:
+//
+//	The number of keys in the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-countkeysinslot/
+func (client *ClusterClient) ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error) {
+	response, err := client.executeCommand(ctx, C.ClusterCountKeysInSlot, []string{utils.IntToString(slot)})
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.DefaultIntResponse, err
 	}
-	return handleOkResponse(result)
+	return handleIntResponse(response)
 }
 
-// Returns information about the functions and libraries.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Removes the slots from the node.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	query - The query to use to filter the functions and libraries.
+//	slots - The slots to remove.
+//	route - The route to the node, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	A list of info about queried libraries and their functions.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-list/
-func (client *ClusterClient) FunctionList(ctx context.Context, query models.FunctionListQuery) ([]models.LibraryInfo, error) {
-	response, err := client.executeCommand(ctx, C.FunctionList, query.ToArgs())
-	if err != nil {
-		return nil, err
-	}
-	return handleFunctionListResponse(response)
+// [valkey.io]: https://valkey.io/commands/cluster-delslots/
+func (client *ClusterClient) ClusterDelSlots(
+	ctx context.Context,
+	slots []int64,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterDelSlots, slotsToArgs(slots), route)
 }
 
-// Returns information about the functions and libraries.
+// Removes the ranges of slots from the node.
 //
 // Since:
 //
@@ -1970,324 +4813,321 @@ func (client *ClusterClient) FunctionList(ctx context.Context, query models.Func
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	query - The query to use to filter the functions and libraries.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by route.
+//	slotRanges - The inclusive ranges of slots to remove.
+//	route - The route to the node, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	A [models.ClusterValue] containing a list of info about queried libraries and their functions.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-list/
-func (client *ClusterClient) FunctionListWithRoute(ctx context.Context,
-	query models.FunctionListQuery,
-	route options.RouteOption,
-) (models.ClusterValue[[]models.LibraryInfo], error) {
-	response, err := client.executeCommandWithRoute(ctx, C.FunctionList, query.ToArgs(), route.Route)
-	if err != nil {
-		return models.CreateEmptyClusterValue[[]models.LibraryInfo](), err
-	}
-
-	if route.Route != nil && route.Route.IsMultiNode() {
-		multiNodeLibs, err := handleFunctionListMultiNodeResponse(response)
-		if err != nil {
-			return models.CreateEmptyClusterValue[[]models.LibraryInfo](), err
-		}
-		return models.CreateClusterMultiValue[[]models.LibraryInfo](multiNodeLibs), nil
-	}
-
-	libs, err := handleFunctionListResponse(response)
-	if err != nil {
-		return models.CreateEmptyClusterValue[[]models.LibraryInfo](), err
-	}
-	return models.CreateClusterSingleValue[[]models.LibraryInfo](libs), nil
+// [valkey.io]: https://valkey.io/commands/cluster-delslotsrange/
+func (client *ClusterClient) ClusterDelSlotsRange(
+	ctx context.Context,
+	slotRanges []models.SlotRange,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterDelSlotsRange, slotRangesToArgs(slotRanges), route)
 }
 
-// Publish posts a message to the specified channel. Returns the number of clients that received the message.
-//
-// Channel can be any string, but common patterns include using "." to create namespaces like
-// "news.sports" or "news.weather".
+// Starts a manual failover of the primary of the replica. The command only starts the failover, use
+// [ClusterClient.ClusterNodes] to check its progress.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	channel - The channel to publish the message to.
-//	message - The message to publish.
-//	sharded - Whether the channel is sharded.
+//	route - The route to the replica, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	The number of clients that received the message.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/publish
-func (client *ClusterClient) Publish(ctx context.Context, channel string, message string, sharded bool) (int64, error) {
-	args := []string{channel, message}
-
-	var requestType C.RequestType
-	if sharded {
-		requestType = C.SPublish
-	} else {
-		requestType = C.Publish
-	}
-	result, err := client.executeCommand(ctx, requestType, args)
-	if err != nil {
-		return 0, err
-	}
-
-	return handleIntResponse(result)
+// [valkey.io]: https://valkey.io/commands/cluster-failover/
+func (client *ClusterClient) ClusterFailover(ctx context.Context, route config.SingleNodeRoute) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterFailover, []string{}, route)
 }
 
-// Returns a list of all sharded channels.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Starts a manual failover of the primary of the replica, without the agreement of the primary or of the cluster.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	mode - The failover mode, [options.ClusterFailoverForce] or [options.ClusterFailoverTakeover].
+//	route - The route to the replica, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	A list of shard channels.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-shard-channels
-func (client *ClusterClient) PubSubShardChannels(ctx context.Context) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.PubSubShardChannels, []string{})
-	if err != nil {
-		return nil, err
-	}
-
-	return handleStringArrayResponse(result)
+// [valkey.io]: https://valkey.io/commands/cluster-failover/
+func (client *ClusterClient) ClusterFailoverWithMode(
+	ctx context.Context,
+	mode options.ClusterFailoverMode,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterFailover, []string{string(mode)}, route)
 }
 
-// Returns a list of all sharded channels that match the given pattern.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Removes a node from the nodes table of the node. The node must be forgotten by every node of the cluster within 60
+// seconds, otherwise it's added back by the gossip.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	pattern - A glob-style pattern to match active shard channels.
+//	nodeId - The ID of the node to forget.
+//	route - The route to the node which forgets, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	A list of shard channels that match the given pattern.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-shard-channels-with-pattern
-func (client *ClusterClient) PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error) {
-	result, err := client.executeCommand(ctx, C.PubSubShardChannels, []string{pattern})
-	if err != nil {
-		return nil, err
-	}
-
-	return handleStringArrayResponse(result)
+// [valkey.io]: https://valkey.io/commands/cluster-forget/
+func (client *ClusterClient) ClusterForget(ctx context.Context, nodeId string, route config.SingleNodeRoute) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterForget, []string{nodeId}, route)
 }
 
-// Returns the number of subscribers for a sharded channel.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns the names of the keys in the slot. The command is routed to the primary serving the slot.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	channels - The channel to get the number of subscribers for.
+//	slot - The slot to get the keys of.
+//	count - The maximum number of keys to return.
 //
 // Return value:
 //
-//	The number of subscribers for the sharded channel.
+//	An array of the key names.
 //
-// [valkey.io]: https://valkey.io/commands/pubsub-shard-numsub
-func (client *ClusterClient) PubSubShardNumSub(ctx context.Context, channels ...string) (map[string]int64, error) {
-	result, err := client.executeCommand(ctx, C.PubSubShardNumSub, channels)
+// [valkey.io]: https://valkey.io/commands/cluster-getkeysinslot/
+func (client *ClusterClient) ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error) {
+	response, err := client.executeCommand(
+		ctx,
+		C.ClusterGetKeysInSlot,
+		[]string{utils.IntToString(slot), utils.IntToString(count)},
+	)
 	if err != nil {
 		return nil, err
 	}
-
-	return handleStringIntMapResponse(result)
+	return handleStringArrayResponse(response)
 }
 
-// Returns the serialized payload of all loaded libraries.
+// Connects the node to another node, to add it to the cluster.
 //
-// Note:
+// See [valkey.io] for details.
 //
-//	The command will be routed to a random node.
+// Parameters:
 //
-// Since:
+//	ctx - The context for controlling the command execution.
+//	host - The IP address or the hostname of the node to meet.
+//	port - The port of the node to meet.
+//	route - The route to the node which meets the other node, for example a [config.ByAddressRoute].
 //
-//	Valkey 7.0 and above.
+// Return value:
 //
-// See [valkey.io] for more details.
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-meet/
+func (client *ClusterClient) ClusterMeet(
+	ctx context.Context,
+	host string,
+	port int64,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterMeet, []string{host, utils.IntToString(port)}, route)
+}
+
+// Reconfigures the node as a replica of a primary.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	nodeId - The ID of the primary to replicate.
+//	route - The route to the node to reconfigure, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	The serialized payload of all loaded libraries.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-dump/
-func (client *ClusterClient) FunctionDump(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionDump, []string{})
-	if err != nil {
-		return models.DefaultStringResponse, err
-	}
-	return handleStringResponse(result)
+// [valkey.io]: https://valkey.io/commands/cluster-replicate/
+func (client *ClusterClient) ClusterReplicate(
+	ctx context.Context,
+	nodeId string,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterReplicate, []string{nodeId}, route)
 }
 
-// Returns the serialized payload of all loaded libraries.
-// The command will be routed to the nodes defined by the route parameter.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Performs a soft reset of the node. The node must have no keys.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command.
+//	ctx - The context for controlling the command execution.
+//	route - The route to the node to reset, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	A [models.ClusterValue] containing the serialized payload of all loaded libraries.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-dump/
-func (client *ClusterClient) FunctionDumpWithRoute(
-	ctx context.Context,
-	route config.Route,
-) (models.ClusterValue[string], error) {
-	response, err := client.executeCommandWithRoute(ctx, C.FunctionDump, []string{}, route)
-	if err != nil {
-		return models.CreateEmptyClusterValue[string](), err
-	}
-	if route != nil && route.IsMultiNode() {
-		data, err := handleStringToStringMapResponse(response)
-		if err != nil {
-			return models.CreateEmptyClusterValue[string](), err
-		}
-		return models.CreateClusterMultiValue[string](data), nil
-	}
-	data, err := handleStringResponse(response)
-	if err != nil {
-		return models.CreateEmptyClusterValue[string](), err
-	}
-	return models.CreateClusterSingleValue[string](data), nil
+// [valkey.io]: https://valkey.io/commands/cluster-reset/
+func (client *ClusterClient) ClusterReset(ctx context.Context, route config.SingleNodeRoute) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterReset, []string{}, route)
 }
 
-// Restores libraries from the serialized payload returned by `FunctionDump`.
+// Resets the node. The node must have no keys.
 //
-// Note:
+// See [valkey.io] for details.
 //
-//	The command will be routed to all primary nodes.
+// Parameters:
 //
-// Since:
+//	ctx - The context for controlling the command execution.
+//	mode - The reset mode, [options.ClusterResetSoft] or [options.ClusterResetHard].
+//	route - The route to the node to reset, for example a [config.ByAddressRoute].
 //
-//	Valkey 7.0 and above.
+// Return value:
 //
-// See [valkey.io] for more details.
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-reset/
+func (client *ClusterClient) ClusterResetWithMode(
+	ctx context.Context,
+	mode options.ClusterResetMode,
+	route config.SingleNodeRoute,
+) (string, error) {
+	return client.executeNodeCommand(ctx, C.ClusterReset, []string{string(mode)}, route)
+}
+
+// Changes the state of a slot in the node, to migrate the slot between nodes.
+//
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	payload - The serialized data from `FunctionDump`.
+//	slot - The slot.
+//	opts - The state to set, see [options.ClusterSetSlotOptions].
+//	route - The route to the node, for example a [config.ByAddressRoute].
 //
 // Return value:
 //
-//	`OK`
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-restore/
-func (client *ClusterClient) FunctionRestore(ctx context.Context, payload string) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionRestore, []string{payload})
+// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+func (client *ClusterClient) ClusterSetSlot(
+	ctx context.Context,
+	slot int64,
+	opts options.ClusterSetSlotOptions,
+	route config.SingleNodeRoute,
+) (string, error) {
+	optionArgs, err := opts.ToArgs()
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	return client.executeNodeCommand(ctx, C.ClusterSetslot, append([]string{utils.IntToString(slot)}, optionArgs...), route)
 }
 
-// Restores libraries from the serialized payload.
-// The command will be routed to the nodes defined by the route parameter.
-//
-// Since:
-//
-//	Valkey 7.0 and above.
+// Returns information about the connections to the routed nodes.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	payload - The serialized data from dump operation.
-//	route - Specifies the routing configuration for the command.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK`
+//	A [models.ClientInfo] describing the connection, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/function-restore/
-func (client *ClusterClient) FunctionRestoreWithRoute(
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *ClusterClient) ClientInfoWithOptions(
 	ctx context.Context,
-	payload string,
-	route config.Route,
-) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionRestore, []string{payload}, route)
+	opts options.RouteOption,
+) (models.ClusterValue[models.ClientInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientInfo, []string{}, opts.Route)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.CreateEmptyClusterValue[models.ClientInfo](), err
 	}
-	return handleOkResponse(result)
+	return handleClusterValueResponse(response, opts, internal.ConvertClientInfo)
 }
 
-// Restores libraries from the serialized payload returned by `FunctionDump`.
+// Closes the client connections matching the filter on the routed nodes. The current connection is skipped, unless
+// [options.ClientFilter.SetSkipMe] is set to `false`.
 //
-// Note:
+// See [valkey.io] for details.
 //
-//	The command will be routed to all primary nodes.
+// Parameters:
 //
-// Since:
+//	ctx - The context for controlling the command execution.
+//	filter - The filter selecting the connections to close, see [options.ClientFilter].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
-//	Valkey 7.0 and above.
+// Return value:
+//
+//	The number of connections closed, wrapped by a [models.ClusterValue].
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *ClusterClient) ClientKillWithOptions(
+	ctx context.Context,
+	filter options.ClientFilter,
+	opts options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	args, err := filter.ToArgs()
+	if err != nil {
+		return models.CreateEmptyClusterValue[int64](), err
+	}
+	response, err := client.executeCommandWithRoute(ctx, C.ClientKill, args, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[int64](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertToInt64)
+}
+
+// Returns information about the client connections of the routed nodes matching the filter.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	payload - The serialized data from `FunctionDump`.
-//	policy - A policy for handling existing libraries.
+//	filter - The filter selecting the connections, see [options.ClientFilter].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK`
+//	An array of [models.ClientInfo] describing the connections, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/function-restore/
-func (client *ClusterClient) FunctionRestoreWithPolicy(
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *ClusterClient) ClientListWithOptions(
 	ctx context.Context,
-	payload string,
-	policy constants.FunctionRestorePolicy,
-) (string, error) {
-	result, err := client.executeCommand(ctx, C.FunctionRestore, []string{payload, string(policy)})
+	filter options.ClientFilter,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.ClientInfo], error) {
+	args, err := filter.ToArgs()
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.CreateEmptyClusterValue[[]models.ClientInfo](), err
 	}
-	return handleOkResponse(result)
+	response, err := client.executeCommandWithRoute(ctx, C.ClientList, args, opts.Route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[[]models.ClientInfo](), err
+	}
+	return handleClusterValueResponse(response, opts, internal.ConvertClientList)
 }
 
-// Restores libraries from the serialized payload.
-// The command will be routed to the nodes defined by the route parameter.
+// Sets whether the connections to the routed nodes are excluded from the client eviction.
 //
 // Since:
 //
@@ -2298,310 +5138,265 @@ func (client *ClusterClient) FunctionRestoreWithPolicy(
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	payload - The serialized data from dump operation.
-//	policy - A policy for handling existing libraries.
-//	route - Specifies the routing configuration for the command.
+//	enabled - Whether the connections are excluded from the client eviction.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK`
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/function-restore/
-func (client *ClusterClient) FunctionRestoreWithPolicyWithRoute(
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *ClusterClient) ClientNoEvictWithOptions(
 	ctx context.Context,
-	payload string,
-	policy constants.FunctionRestorePolicy,
-	route config.Route,
+	enabled bool,
+	opts options.RouteOption,
 ) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.FunctionRestore, []string{payload, string(policy)}, route)
+	response, err := client.executeCommandWithRoute(ctx, C.ClientNoEvict, []string{onOffArg(enabled)}, opts.Route)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	return handleOkClusterResponse(response)
 }
 
-// Executes a Lua script on the server with routing information.
-//
-// This function simplifies the process of invoking scripts on the server by using an object that
-// represents a Lua script. The script loading and execution will all be handled internally. If
-// the script has not already been loaded, it will be loaded automatically using the
-// `SCRIPT LOAD` command. After that, it will be invoked using the `EVALSHA`
-// command.
+// Sets whether the commands sent to the routed nodes alter the LRU/LFU of the keys they access.
 //
-// Note:
+// Since:
 //
-//	The command will be routed to a random node, unless `route` is provided.
+//	Valkey 7.2 and above.
 //
-// See [LOAD] and [EVALSHA] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	script - The Lua script to execute.
-//	route - Routing information for the script execution.
+//	enabled - Whether the commands don't alter the LRU/LFU of the keys.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	The result of the script execution.
+//	`"OK"` response on success.
 //
-// [LOAD]: https://valkey.io/commands/script-load/
-// [EVALSHA]: https://valkey.io/commands/evalsha/
-func (client *ClusterClient) InvokeScriptWithRoute(
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *ClusterClient) ClientNoTouchWithOptions(
 	ctx context.Context,
-	script options.Script,
-	route options.RouteOption,
-) (models.ClusterValue[any], error) {
-	response, err := client.baseClient.executeScriptWithRoute(ctx, script.GetHash(), []string{}, []string{}, route.Route)
+	enabled bool,
+	opts options.RouteOption,
+) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientNoTouch, []string{onOffArg(enabled)}, opts.Route)
 	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
-	}
-	if route.Route != nil && route.Route.IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(response)
-		if err != nil {
-			return models.CreateEmptyClusterValue[any](), err
-		}
-		return models.CreateClusterMultiValue[any](data), nil
+		return models.DefaultStringResponse, err
 	}
-
-	return models.CreateClusterSingleValue[any](response), nil
+	return handleOkClusterResponse(response)
 }
 
-// Executes a Lua script on the server with cluster script options.
-//
-// This function simplifies the process of invoking scripts on the server by using an object that
-// represents a Lua script. The script loading, argument preparation, and execution will all be
-// handled internally. If the script has not already been loaded, it will be loaded automatically
-// using the `SCRIPT LOAD` command. After that, it will be invoked using the
-// `EVALSHA` command.
+// Suspends the commands of all the clients of the routed nodes for the given duration.
 //
-// Note:
-//
-//   - all `keys` in `clusterScriptOptions` must map to the same hash slot.
-//   - the command will be routed based on the Route specified in clusterScriptOptions.
-//
-// See [LOAD] and [EVALSHA] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	script - The script to execute.
-//	clusterScriptOptions - Combined options for script execution including keys, arguments, and routing information.
+//	timeout - The duration of the pause, in milliseconds precision.
+//	mode - The commands to suspend, [options.ClientPauseAll] or [options.ClientPauseWrite].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	The result of the script execution.
+//	`"OK"` response on success.
 //
-// [LOAD]: https://valkey.io/commands/script-load/
-// [EVALSHA]: https://valkey.io/commands/evalsha/
-func (client *ClusterClient) InvokeScriptWithClusterOptions(
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *ClusterClient) ClientPauseWithOptions(
 	ctx context.Context,
-	script options.Script,
-	clusterScriptOptions options.ClusterScriptOptions,
-) (models.ClusterValue[any], error) {
-	args := clusterScriptOptions.Args
-	route := clusterScriptOptions.Route
-
-	response, err := client.baseClient.executeScriptWithRoute(ctx, script.GetHash(), []string{}, args, route)
+	timeout time.Duration,
+	mode options.ClientPauseMode,
+	opts options.RouteOption,
+) (string, error) {
+	args := []string{utils.IntToString(timeout.Milliseconds()), string(mode)}
+	response, err := client.executeCommandWithRoute(ctx, C.ClientPause, args, opts.Route)
 	if err != nil {
-		return models.CreateEmptyClusterValue[any](), err
-	}
-
-	if route != nil && route.IsMultiNode() {
-		data, err := handleStringToAnyMapResponse(response)
-		if err != nil {
-			return models.CreateEmptyClusterValue[any](), err
-		}
-		return models.CreateClusterMultiValue[any](data), nil
+		return models.DefaultStringResponse, err
 	}
-
-	return models.CreateClusterSingleValue[any](response), nil
+	return handleOkClusterResponse(response)
 }
 
-// Checks existence of scripts in the script cache by their SHA1 digest.
+// Sets an attribute of the connections to the routed nodes.
 //
-// Note:
+// Since:
 //
-//	The command will be routed to all primary nodes by default.
+//	Valkey 7.2 and above.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	sha1s - SHA1 digests of Lua scripts to be checked.
+//	ctx - The context for controlling the command execution.
+//	attribute - The attribute to set, [options.ClientLibName] or [options.ClientLibVersion].
+//	value - The value of the attribute.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	An array of boolean values indicating the existence of each script.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/script-exists
-func (client *ClusterClient) ScriptExists(ctx context.Context, sha1s []string) ([]bool, error) {
-	response, err := client.executeCommand(ctx, C.ScriptExists, sha1s)
+// [valkey.io]: https://valkey.io/commands/client-setinfo/
+func (client *ClusterClient) ClientSetInfoWithOptions(
+	ctx context.Context,
+	attribute options.ClientSetInfoAttribute,
+	value string,
+	opts options.RouteOption,
+) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientSetInfo, []string{string(attribute), value}, opts.Route)
 	if err != nil {
-		return nil, err
+		return models.DefaultStringResponse, err
 	}
-
-	return handleBoolArrayResponse(response)
+	return handleOkClusterResponse(response)
 }
 
-// Checks existence of scripts in the script cache by their SHA1 digest.
+// Unblocks a client of the routed nodes blocked by a blocking command. Client IDs are unique per node only, so the
+// command is usually routed to the node the client is connected to.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	sha1s - SHA1 digests of Lua scripts to be checked.
-//	route - Specifies the routing configuration for the command. The client will route the
-//		    command to the nodes defined by `route`.
+//	ctx - The context for controlling the command execution.
+//	clientId - The ID of the blocked client.
+//	mode - How the client is unblocked, [options.ClientUnblockTimeout] or [options.ClientUnblockError].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	An array of boolean values indicating the existence of each script.
+//	`true` if the client was unblocked, `false` if it wasn't blocked, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/script-exists
-func (client *ClusterClient) ScriptExistsWithRoute(
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *ClusterClient) ClientUnblockWithOptions(
 	ctx context.Context,
-	sha1s []string,
-	route options.RouteOption,
-) ([]bool, error) {
-	response, err := client.executeCommandWithRoute(ctx, C.ScriptExists, sha1s, route.Route)
+	clientId int64,
+	mode options.ClientUnblockMode,
+	opts options.RouteOption,
+) (models.ClusterValue[bool], error) {
+	args := []string{utils.IntToString(clientId), string(mode)}
+	response, err := client.executeCommandWithRoute(ctx, C.ClientUnblock, args, opts.Route)
 	if err != nil {
-		return nil, err
+		return models.CreateEmptyClusterValue[bool](), err
 	}
-
-	return handleBoolArrayResponse(response)
-}
-
-// Removes all the scripts from the script cache.
-// The command will be routed to all nodes.
-//
-// See [valkey.io] for details.
-//
-// Parameters:
-//
-//	ctx - The context for controlling the command execution.
-//
-// Return value:
-//
-//	OK on success.
-//
-// [valkey.io]: https://valkey.io/commands/script-flush/
-func (client *ClusterClient) ScriptFlush(ctx context.Context) (string, error) {
-	return client.baseClient.ScriptFlush(ctx)
+	return handleClusterValueResponse(response, opts, func(data any) (bool, error) {
+		unblocked, err := internal.ConvertToInt64(data)
+		return unblocked == 1, err
+	})
 }
 
-// Removes all the scripts from the script cache with the specified route options.
+// Enables or disables the tracking of the keys read by the connections to the routed nodes, for the server assisted client
+// side caching. The tracking is a state of each connection, so the command is usually routed to all the nodes.
 //
 // See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	options - The ScriptFlushOptions containing the flush mode and route.
-//			  The mode can be either SYNC or ASYNC.
+//	trackingOptions - The tracking mode and options, see [options.ClientTrackingOptions].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	OK on success.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/script-flush/
-func (client *ClusterClient) ScriptFlushWithOptions(
+// [valkey.io]: https://valkey.io/commands/client-tracking/
+func (client *ClusterClient) ClientTrackingWithOptions(
 	ctx context.Context,
-	options options.ScriptFlushOptions,
+	trackingOptions options.ClientTrackingOptions,
+	opts options.RouteOption,
 ) (string, error) {
-	args := []string{}
-	if options.Mode != "" {
-		args = append(args, string(options.Mode))
-	}
-	if options.Route == nil {
-		result, err := client.executeCommand(ctx, C.ScriptFlush, args)
-		if err != nil {
-			return models.DefaultStringResponse, err
-		}
-		return handleOkResponse(result)
+	args, err := trackingOptions.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
 	}
-	result, err := client.executeCommandWithRoute(ctx, C.ScriptFlush, args, options.RouteOption.Route)
+	response, err := client.executeCommandWithRoute(ctx, C.ClientTracking, args, opts.Route)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	return handleOkClusterResponse(response)
 }
 
-// Kills the currently executing Lua script, assuming no write operation was yet performed by the
-// script.
+// Returns the ID of the client the tracking invalidation messages of the connections to the routed nodes are redirected
+// to.
 //
-// See [valkey.io] for more details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by `route`.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	`OK` if script is terminated. Otherwise, throws an error.
+//	The ID of the client, `0` if the tracking is enabled without redirection, or `-1` if the tracking is disabled,
+//	wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/script-kill
-func (client *ClusterClient) ScriptKillWithRoute(ctx context.Context, route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(
-		ctx,
-		C.ScriptKill,
-		[]string{},
-		route.Route,
-	)
+// [valkey.io]: https://valkey.io/commands/client-getredir/
+func (client *ClusterClient) ClientGetRedirWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[int64], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientGetRedir, []string{}, opts.Route)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.CreateEmptyClusterValue[int64](), err
 	}
-	return handleOkResponse(result)
+	return handleClusterValueResponse(response, opts, internal.ConvertToInt64)
 }
 
-// Flushes all the previously watched keys for a transaction. Executing a transaction will
-// automatically flush all previously watched keys.
-// The command will be routed to all primary nodes.
+// Returns the tracking state of the connections to the routed nodes, for the server assisted client side caching.
 //
-// See [valkey.io] and [Valkey Glide Wiki] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
 //	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	A simple "OK" response.
+//	The tracking flags, redirection and prefixes of the connections, wrapped by a [models.ClusterValue].
 //
-// [valkey.io]: https://valkey.io/commands/unwatch
-// [Valkey Glide Wiki]: https://valkey.io/topics/transactions/#cas
-func (client *ClusterClient) Unwatch(ctx context.Context) (string, error) {
-	result, err := client.executeCommand(ctx, C.UnWatch, []string{})
+// [valkey.io]: https://valkey.io/commands/client-trackinginfo/
+func (client *ClusterClient) ClientTrackingInfoWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.ClientTrackingInfo], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientTrackingInfo, []string{}, opts.Route)
 	if err != nil {
-		return models.DefaultStringResponse, err
+		return models.CreateEmptyClusterValue[models.ClientTrackingInfo](), err
 	}
-	return handleOkResponse(result)
+	return handleClusterValueResponse(response, opts, internal.ConvertClientTrackingInfo)
 }
 
-// Flushes all the previously watched keys for a transaction. Executing a transaction will
-// automatically flush all previously watched keys.
+// Resumes the clients of the routed nodes suspended by [ClusterClient.ClientPause].
 //
-// See [valkey.io] and [Valkey Glide Wiki] for details.
+// See [valkey.io] for details.
 //
 // Parameters:
 //
-//	ctx   - The context for controlling the command execution.
-//	route - Specifies the routing configuration for the command. The client will route the
-//	        command to the nodes defined by `route`.
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
 //
 // Return value:
 //
-//	A simple "OK" response.
+//	`"OK"` response on success.
 //
-// [valkey.io]: https://valkey.io/commands/unwatch
-// [Valkey Glide Wiki]: https://valkey.io/topics/transactions/#cas
-func (client *ClusterClient) UnwatchWithOptions(ctx context.Context, route options.RouteOption) (string, error) {
-	result, err := client.executeCommandWithRoute(ctx, C.UnWatch, []string{}, route.Route)
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *ClusterClient) ClientUnpauseWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientUnpause, []string{}, opts.Route)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	return handleOkClusterResponse(response)
 }
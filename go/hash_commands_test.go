@@ -5,6 +5,7 @@ package glide
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -770,3 +771,52 @@ func ExampleClusterClient_HScanWithOptions() {
 	// Cursor: 0
 	// Collection: [a 1]
 }
+
+func ExampleClient_HExpire() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.HSet(context.Background(), "my_hash", map[string]string{"field1": "value1"})
+	result, err := client.HExpire(context.Background(), "my_hash", 10*time.Second, []string{"field1", "field2"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result[0] == models.HashFieldExpireSet)
+	fmt.Println(result[1] == models.HashFieldExpireNoSuchField)
+
+	ttl, err := client.HTTL(context.Background(), "my_hash", []string{"field1"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(ttl[0] > 0)
+
+	// Output:
+	// true
+	// true
+	// true
+}
+
+func ExampleClient_HSetEx() {
+	var client *Client = getExampleClient() // example helper function
+
+	opts := options.NewHSetExOptions().SetOnlyIfNoFieldExists().SetExpiry(options.NewExpiryIn(time.Minute))
+	result, err := client.HSetEx(context.Background(), "my_hash", map[string]string{"field1": "value1"}, *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	values, err := client.HGetEx(
+		context.Background(),
+		"my_hash",
+		[]string{"field1"},
+		*options.NewHGetExOptions().SetExpiry(options.NewExpiryPersist()),
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(values[0].Value())
+
+	// Output:
+	// true
+	// value1
+}
@@ -0,0 +1,41 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// inflightRequests is a semaphore holding a token per inflight request, so that the requests wait for a free slot while
+// the client has as many inflight requests as its limit, instead of failing with an [InflightRequestsLimitError] from
+// the core. A nil semaphore doesn't limit the requests.
+type inflightRequests chan struct{}
+
+func newInflightRequests(limit uint32) inflightRequests {
+	if limit == 0 {
+		limit = config.DefaultInflightRequestsLimit
+	}
+	return make(inflightRequests, limit)
+}
+
+// acquire waits for a free slot, or for the context to be done.
+func (requests inflightRequests) acquire(ctx context.Context) error {
+	if requests == nil {
+		return nil
+	}
+	select {
+	case requests <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return contextError(ctx)
+	}
+}
+
+// release frees the slot of a request once the core replied to it.
+func (requests inflightRequests) release() {
+	if requests != nil {
+		<-requests
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInflightRequests(t *testing.T) {
+	requests := newInflightRequests(1)
+	assert.NoError(t, requests.acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, requests.acquire(ctx), context.DeadlineExceeded)
+
+	requests.release()
+	assert.NoError(t, requests.acquire(context.Background()))
+
+	// a nil semaphore doesn't limit the requests
+	var unlimited inflightRequests
+	assert.NoError(t, unlimited.acquire(ctx))
+	unlimited.release()
+}
+
+func TestInflightRequestsLimitError(t *testing.T) {
+	var limitErr *InflightRequestsLimitError
+	// the error type of the core identifies the error, not its message
+	assert.False(t, errors.As(GoError(0, "Reached maximum inflight requests"), &limitErr))
+	assert.False(t, errors.As(GoError(0, "ERR unknown command"), &limitErr))
+}
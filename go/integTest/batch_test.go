@@ -140,6 +140,109 @@ func (suite *GlideTestSuite) TestBatchMove() {
 	})
 }
 
+func (suite *GlideTestSuite) TestBatchInvokeScript() {
+	suite.runBatchTest(func(client interfaces.BaseClientCommands, isAtomic bool) {
+		key := "{prefix}-" + uuid.NewString()
+		// The scripts are unique, so they aren't loaded on the server yet
+		setScript := options.NewScript(fmt.Sprintf("-- %s\nreturn redis.call('SET', KEYS[1], ARGV[1])", uuid.NewString()))
+		defer setScript.Close()
+		getScript := options.NewScript(fmt.Sprintf("-- %s\nreturn redis.call('GET', KEYS[1])", uuid.NewString()))
+		defer getScript.Close()
+		closedScript := options.NewScript(fmt.Sprintf("-- %s\nreturn 1", uuid.NewString()))
+		closedScript.Close()
+		setOptions := *options.NewScriptOptions().WithKeys([]string{key}).WithArgs([]string{"value"})
+		getOptions := *options.NewScriptOptions().WithKeys([]string{key})
+
+		var res []any
+		var err, closedErr error
+		switch c := client.(type) {
+		case *glide.ClusterClient:
+			batch := pipeline.NewClusterBatch(isAtomic).
+				InvokeScriptWithOptions(*setScript, setOptions).
+				InvokeScriptWithOptions(*getScript, getOptions).
+				InvokeScript(*getScript)
+			res, err = c.Exec(context.Background(), *batch, false)
+			closedBatch := pipeline.NewClusterBatch(isAtomic).InvokeScript(*closedScript)
+			_, closedErr = c.Exec(context.Background(), *closedBatch, true)
+		case *glide.Client:
+			batch := pipeline.NewStandaloneBatch(isAtomic).
+				InvokeScriptWithOptions(*setScript, setOptions).
+				InvokeScriptWithOptions(*getScript, getOptions).
+				InvokeScript(*getScript)
+			res, err = c.Exec(context.Background(), *batch, false)
+			closedBatch := pipeline.NewStandaloneBatch(isAtomic).InvokeScript(*closedScript)
+			_, closedErr = c.Exec(context.Background(), *closedBatch, true)
+		}
+		suite.NoError(err)
+		suite.Equal([]any{"OK", "value", nil}, res)
+		// A closed script can't be loaded
+		suite.Error(closedErr)
+	})
+}
+
+func (suite *GlideTestSuite) TestBatchInvokeScriptOrder() {
+	suite.runBatchTest(func(client interfaces.BaseClientCommands, isAtomic bool) {
+		key := "{prefix}-" + uuid.NewString()
+		// The script is unique, so it isn't loaded on the server yet
+		incrScript := options.NewScript(fmt.Sprintf("-- %s\nreturn redis.call('INCR', KEYS[1])", uuid.NewString()))
+		defer incrScript.Close()
+		incrOptions := *options.NewScriptOptions().WithKeys([]string{key})
+
+		var res []any
+		var err error
+		switch c := client.(type) {
+		case *glide.ClusterClient:
+			batch := pipeline.NewClusterBatch(isAtomic).InvokeScriptWithOptions(*incrScript, incrOptions).Get(key)
+			res, err = c.Exec(context.Background(), *batch, true)
+		case *glide.Client:
+			batch := pipeline.NewStandaloneBatch(isAtomic).InvokeScriptWithOptions(*incrScript, incrOptions).Get(key)
+			res, err = c.Exec(context.Background(), *batch, true)
+		}
+		suite.NoError(err)
+		// The command after the script reads the value written by the script
+		suite.Equal([]any{int64(1), "1"}, res)
+	})
+}
+
+func (suite *GlideTestSuite) TestBatchInvokeFlushedScript() {
+	suite.runBatchTest(func(client interfaces.BaseClientCommands, isAtomic bool) {
+		key := "{prefix}-" + uuid.NewString()
+		incrScript := options.NewScript(fmt.Sprintf("-- %s\nreturn redis.call('INCR', KEYS[1])", uuid.NewString()))
+		defer incrScript.Close()
+		incrOptions := *options.NewScriptOptions().WithKeys([]string{key})
+
+		exec := func() []any {
+			var res []any
+			var err error
+			switch c := client.(type) {
+			case *glide.ClusterClient:
+				batch := pipeline.NewClusterBatch(isAtomic).InvokeScriptWithOptions(*incrScript, incrOptions).Get(key)
+				res, err = c.Exec(context.Background(), *batch, false)
+			case *glide.Client:
+				batch := pipeline.NewStandaloneBatch(isAtomic).InvokeScriptWithOptions(*incrScript, incrOptions).Get(key)
+				res, err = c.Exec(context.Background(), *batch, false)
+			}
+			suite.NoError(err)
+			return res
+		}
+		suite.Equal([]any{int64(1), "1"}, exec())
+
+		// The script loaded by the client for the first batch is flushed
+		_, err := client.ScriptFlush(context.Background())
+		suite.NoError(err)
+		res := exec()
+		if isAtomic {
+			suite.IsType(&glide.NoScriptError{}, res[0])
+			suite.Equal("1", res[1])
+			// The script is loaded again before the next batch
+			suite.Equal([]any{int64(2), "2"}, exec())
+		} else {
+			// The script is retried once the pipeline completes
+			suite.Equal([]any{int64(2), "1"}, res)
+		}
+	})
+}
+
 func (suite *GlideTestSuite) TestWatch_and_Unwatch() {
 	suite.runWithDefaultClients(func(client1 interfaces.BaseClientCommands) {
 		key1 := "{prefix}" + uuid.NewString()
@@ -1230,6 +1333,53 @@ func CreateHashTest(batch *pipeline.ClusterBatch, isAtomic bool, serverVer strin
 		CommandTestData{ExpectedResponse: [][]string{{"counter", "10"}}, TestName: "HRandFieldWithCountWithValues(key, 1)"},
 	)
 
+	if serverVer >= "9.0.0" {
+		batch.HSetEx(key, map[string]string{"field": "value"}, *options.NewHSetExOptions().SetOnlyIfNoFieldExists())
+		testData = append(testData, CommandTestData{ExpectedResponse: true, TestName: "HSetEx(key, field, FNX)"})
+		batch.HExpire(key, 100*time.Second, []string{"field", "nonexistent"})
+		testData = append(
+			testData,
+			CommandTestData{
+				ExpectedResponse: []models.HashFieldExpireResult{
+					models.HashFieldExpireSet,
+					models.HashFieldExpireNoSuchField,
+				},
+				TestName: "HExpire(key, 100s, [field, nonexistent])",
+			},
+		)
+		batch.HPExpireWithOptions(key, 200*time.Second, []string{"field"}, constants.NewExpiryLessThanCurrent)
+		testData = append(
+			testData,
+			CommandTestData{
+				ExpectedResponse: []models.HashFieldExpireResult{models.HashFieldExpireConditionNotMet},
+				TestName:         "HPExpireWithOptions(key, 200s, [field], LT)",
+			},
+		)
+		batch.HTTL(key, []string{"field", "counter"})
+		testData = append(
+			testData,
+			CommandTestData{ExpectedResponse: []int64{}, CheckTypeOnly: true, TestName: "HTTL(key, [field, counter])"},
+		)
+		batch.HGetEx(key, []string{"field"}, *options.NewHGetExOptions().SetExpiry(options.NewExpiryPersist()))
+		testData = append(
+			testData,
+			CommandTestData{
+				ExpectedResponse: []models.Result[string]{models.CreateStringResult("value")},
+				TestName:         "HGetEx(key, [field], PERSIST)",
+			},
+		)
+		batch.HPersist(key, []string{"field"})
+		testData = append(
+			testData,
+			CommandTestData{
+				ExpectedResponse: []models.HashFieldPersistResult{models.HashFieldPersistNoExpiry},
+				TestName:         "HPersist(key, [field])",
+			},
+		)
+		batch.HPExpireTime(key, []string{"field"})
+		testData = append(testData, CommandTestData{ExpectedResponse: []int64{-1}, TestName: "HPExpireTime(key, [field])"})
+	}
+
 	return BatchTestData{CommandTestData: testData, TestName: "Hash commands"}
 }
 
@@ -2383,7 +2533,10 @@ func CreateServerManagementTests(batch *pipeline.ClusterBatch, isAtomic bool, se
 	testData = append(testData, CommandTestData{ExpectedResponse: int64(0), CheckTypeOnly: true, TestName: "LastSave()"})
 	batch.ConfigResetStat()
 	testData = append(testData, CommandTestData{ExpectedResponse: "OK", TestName: "ConfigResetStat()"})
+	batch.Role()
+	testData = append(testData, CommandTestData{ExpectedResponse: models.RoleInfo{}, CheckTypeOnly: true, TestName: "Role()"})
 	// ConfigRewrite skipped, because depends on config
+	// Save, BgSave and BgRewriteAof skipped, because they conflict with the saves in progress
 
 	return BatchTestData{CommandTestData: testData, TestName: "Server Management commands"}
 }
@@ -2391,6 +2544,17 @@ func CreateServerManagementTests(batch *pipeline.ClusterBatch, isAtomic bool, se
 func CreateScriptTest(batch *pipeline.ClusterBatch, isAtomic bool, serverVer string) BatchTestData {
 	testData := make([]CommandTestData, 0)
 
+	scriptSha1 := "af6b5d19da06755d789858a67760f34bbd2e9e52"
+	batch.ScriptLoad("return 'Hello'")
+	testData = append(testData, CommandTestData{ExpectedResponse: scriptSha1, TestName: "ScriptLoad(return 'Hello')"})
+	batch.EvalSha(scriptSha1)
+	testData = append(testData, CommandTestData{ExpectedResponse: "Hello", TestName: "EvalSha(scriptSha1)"})
+	batch.EvalWithOptions("return ARGV[1]", *options.NewScriptOptions().WithArgs([]string{"arg"}))
+	testData = append(testData, CommandTestData{ExpectedResponse: "arg", TestName: "EvalWithOptions(return ARGV[1], arg)"})
+	if serverVer >= "7.0.0" {
+		batch.EvalReadOnly("return 'Hello'")
+		testData = append(testData, CommandTestData{ExpectedResponse: "Hello", TestName: "EvalReadOnly(return 'Hello')"})
+	}
 	batch.ScriptExists([]string{"abc"})
 	testData = append(testData, CommandTestData{ExpectedResponse: []bool{false}, TestName: "ScriptExists([abc])"})
 	batch.ScriptFlush()
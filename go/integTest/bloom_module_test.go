@@ -0,0 +1,203 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/valkey-io/valkey-glide/go/v2/bloom"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func (suite *GlideTestSuite) TestModuleBloomAddExists() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	added, err := bloom.Add(ctx, client, key, "item1")
+	suite.NoError(err)
+	suite.True(added)
+
+	added, err = bloom.Add(ctx, client, key, "item1")
+	suite.NoError(err)
+	suite.False(added)
+
+	addedItems, err := bloom.MAdd(ctx, client, key, []string{"item1", "item2", "item3"})
+	suite.NoError(err)
+	suite.Equal([]bool{false, true, true}, addedItems)
+
+	exists, err := bloom.Exists(ctx, client, key, "item2")
+	suite.NoError(err)
+	suite.True(exists)
+
+	exists, err = bloom.Exists(ctx, client, uuid.NewString(), "item2")
+	suite.NoError(err)
+	suite.False(exists)
+
+	existing, err := bloom.MExists(ctx, client, key, []string{"item1", "item3"})
+	suite.NoError(err)
+	suite.Equal([]bool{true, true}, existing)
+
+	card, err := bloom.Card(ctx, client, key)
+	suite.NoError(err)
+	suite.Equal(int64(3), card)
+
+	card, err = bloom.Card(ctx, client, uuid.NewString())
+	suite.NoError(err)
+	suite.Equal(int64(0), card)
+}
+
+func (suite *GlideTestSuite) TestModuleBloomReserveInfo() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	res, err := bloom.ReserveWithOptions(ctx, client, key, 0.01, 100, *options.NewBloomReserveOptions().SetExpansion(4))
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	_, err = bloom.Reserve(ctx, client, key, 0.01, 100)
+	suite.Error(err)
+
+	_, err = bloom.Add(ctx, client, key, "item")
+	suite.NoError(err)
+
+	info, err := bloom.Info(ctx, client, key)
+	suite.NoError(err)
+	suite.Equal(int64(100), info.Capacity)
+	suite.Equal(int64(1), info.NumberOfFilters)
+	suite.Equal(int64(1), info.NumberOfItems)
+	suite.InDelta(0.01, info.ErrorRate, 1e-9)
+	suite.Equal(models.CreateInt64Result(4), info.Expansion)
+	suite.Positive(info.Size)
+
+	nonScalingKey := uuid.NewString()
+	nonScaling := *options.NewBloomReserveOptions().SetNonScaling()
+	res, err = bloom.ReserveWithOptions(ctx, client, nonScalingKey, 0.01, 2, nonScaling)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	info, err = bloom.Info(ctx, client, nonScalingKey)
+	suite.NoError(err)
+	suite.Equal(int64(2), info.Capacity)
+	suite.True(info.Expansion.IsNil())
+
+	_, err = bloom.Info(ctx, client, uuid.NewString())
+	suite.Error(err)
+
+	// option validation errors are reported before the command is sent
+	_, err = bloom.ReserveWithOptions(
+		ctx,
+		client,
+		uuid.NewString(),
+		0.01,
+		100,
+		*options.NewBloomReserveOptions().SetExpansion(2).SetNonScaling(),
+	)
+	suite.Error(err)
+}
+
+func (suite *GlideTestSuite) TestModuleBloomInsert() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	added, err := bloom.InsertWithOptions(
+		ctx,
+		client,
+		key,
+		[]string{"item1", "item2"},
+		*options.NewBloomInsertOptions().SetCapacity(2).SetErrorRate(0.001).SetNonScaling(),
+	)
+	suite.NoError(err)
+	suite.Equal([]bool{true, true}, added)
+
+	info, err := bloom.Info(ctx, client, key)
+	suite.NoError(err)
+	suite.Equal(int64(2), info.Capacity)
+	suite.InDelta(0.001, info.ErrorRate, 1e-9)
+	suite.True(info.Expansion.IsNil())
+
+	// the non-scaling filter is full
+	_, err = bloom.Insert(ctx, client, key, []string{"item3"})
+	suite.Error(err)
+
+	noCreate := *options.NewBloomInsertOptions().SetNoCreate()
+	_, err = bloom.InsertWithOptions(ctx, client, uuid.NewString(), []string{"item"}, noCreate)
+	suite.Error(err)
+
+	added, err = bloom.Insert(ctx, client, uuid.NewString(), []string{"item1", "item1"})
+	suite.NoError(err)
+	suite.Equal([]bool{true, false}, added)
+
+	_, err = bloom.InsertWithOptions(
+		ctx,
+		client,
+		uuid.NewString(),
+		[]string{"item"},
+		*options.NewBloomInsertOptions().SetNoCreate().SetCapacity(10),
+	)
+	suite.Error(err)
+}
+
+func (suite *GlideTestSuite) TestModuleBloomStandalone() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	added, err := bloom.MAdd(ctx, client, key, []string{"item1", "item2"})
+	suite.NoError(err)
+	suite.Equal([]bool{true, true}, added)
+
+	exists, err := bloom.Exists(ctx, client, key, "item1")
+	suite.NoError(err)
+	suite.True(exists)
+}
+
+func (suite *GlideTestSuite) TestModuleBloomBatch() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	prefix := "{" + uuid.NewString() + "}"
+	key := prefix + "scaling"
+	nonScalingKey := prefix + "nonscaling"
+
+	batch := pipeline.NewClusterBatch(true).
+		BfReserveWithOptions(key, 0.01, 100, *options.NewBloomReserveOptions().SetExpansion(2)).
+		BfAdd(key, "item1").
+		BfMAdd(key, []string{"item1", "item2"}).
+		BfExists(key, "item2").
+		BfMExists(key, []string{"item2", "item3"}).
+		BfCard(key).
+		BfInsertWithOptions(
+			nonScalingKey,
+			[]string{"item1", "item2"},
+			*options.NewBloomInsertOptions().SetCapacity(1).SetNonScaling(),
+		).
+		BfInfo(key)
+
+	res, err := client.Exec(ctx, *batch, false)
+	suite.NoError(err)
+	suite.Len(res, 8)
+	suite.Equal([]any{"OK", true, []bool{false, true}, true, []bool{true, false}, int64(2)}, res[:6])
+	// the non-scaling filter is full after the first item
+	suite.IsType(errors.New(""), res[6])
+	info, ok := res[7].(models.BloomInfo)
+	suite.True(ok)
+	suite.Equal(int64(100), info.Capacity)
+	suite.Equal(int64(2), info.NumberOfItems)
+	suite.Equal(models.CreateInt64Result(2), info.Expansion)
+
+	// option validation errors are reported before the batch is sent
+	_, err = client.Exec(
+		ctx,
+		*pipeline.NewClusterBatch(false).
+			BfInsertWithOptions(key, []string{"item"}, *options.NewBloomInsertOptions().SetNoCreate().SetCapacity(1)),
+		true,
+	)
+	suite.Error(err)
+}
@@ -5,6 +5,7 @@ package integTest
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
@@ -2339,6 +2340,59 @@ func (suite *GlideTestSuite) TestInvokeScript() {
 	script3.Close()
 }
 
+func (suite *GlideTestSuite) TestEvalWithClusterOptions() {
+	client := suite.defaultClusterClient()
+	suffix := uuid.NewString()[:5]
+	code := fmt.Sprintf("return ARGV[1] .. '%s'", suffix)
+
+	// Test a script executed on all the primaries
+	allPrimaries := options.NewClusterScriptOptions().
+		WithScriptArgOptions(options.NewScriptArgOptions().WithArgs([]string{"Hello"})).
+		WithRouteOptions(&options.RouteOption{Route: config.AllPrimaries})
+	response, err := client.EvalWithClusterOptions(context.Background(), code, *allPrimaries)
+	suite.NoError(err)
+	assert.True(suite.T(), response.IsMultiValue())
+	for _, value := range response.MultiValue() {
+		assert.Equal(suite.T(), "Hello"+suffix, value)
+	}
+
+	// Test a script loaded on a single node
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")}
+	sha1, err := client.ScriptLoadWithRoute(context.Background(), code, route)
+	suite.NoError(err)
+	singleNode := options.NewClusterScriptOptions().
+		WithScriptArgOptions(options.NewScriptArgOptions().WithArgs([]string{"Hello"})).
+		WithRouteOptions(&route)
+	response, err = client.EvalShaWithClusterOptions(context.Background(), sha1, *singleNode)
+	suite.NoError(err)
+	assert.True(suite.T(), response.IsSingleValue())
+	assert.Equal(suite.T(), "Hello"+suffix, response.SingleValue())
+
+	// The script is missing once the script cache of the node is flushed
+	_, err = client.ScriptFlushWithOptions(context.Background(), options.ScriptFlushOptions{RouteOption: &route})
+	suite.NoError(err)
+	_, err = client.EvalShaWithClusterOptions(context.Background(), sha1, *singleNode)
+	assert.IsType(suite.T(), &glide.NoScriptError{}, err)
+
+	if suite.serverVersion < "7.0.0" {
+		return
+	}
+
+	response, err = client.EvalReadOnlyWithClusterOptions(context.Background(), code, *allPrimaries)
+	suite.NoError(err)
+	for _, value := range response.MultiValue() {
+		assert.Equal(suite.T(), "Hello"+suffix, value)
+	}
+
+	sha1, err = client.ScriptLoad(context.Background(), code)
+	suite.NoError(err)
+	response, err = client.EvalShaReadOnlyWithClusterOptions(context.Background(), sha1, *allPrimaries)
+	suite.NoError(err)
+	for _, value := range response.MultiValue() {
+		assert.Equal(suite.T(), "Hello"+suffix, value)
+	}
+}
+
 func (suite *GlideTestSuite) TestScriptExistsWithoutRoute() {
 	client := suite.defaultClusterClient()
 
@@ -2661,3 +2715,716 @@ func (suite *GlideTestSuite) TestBatchWithSingleNodeRoute() {
 		assert.Contains(suite.T(), res[0], "# Replication", "isAtomic = %v", isAtomic)
 	}
 }
+
+func (suite *GlideTestSuite) TestAclSetUserWithOptionsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	username := "user-" + uuid.NewString()
+	allNodes := options.RouteOption{Route: config.AllNodes}
+
+	rules := options.NewAclRules().Reset().On().NoPass().AllKeys().AllowCategory("read")
+	result, err := client.AclSetUserWithOptions(context.Background(), username, *rules, allNodes)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	users, err := client.AclGetUserWithOptions(context.Background(), username, allNodes)
+	require.NoError(t, err)
+	require.True(t, users.IsMultiValue())
+	for _, user := range users.MultiValue() {
+		require.False(t, user.IsNil())
+		assert.Contains(t, user.Value().Flags, "nopass")
+		assert.Contains(t, user.Value().Commands, "+@read")
+	}
+
+	user, err := client.AclGetUserWithOptions(context.Background(), username, options.RouteOption{Route: config.RandomRoute})
+	require.NoError(t, err)
+	require.True(t, user.IsSingleValue())
+	assert.False(t, user.SingleValue().IsNil())
+
+	usernames, err := client.AclUsersWithOptions(context.Background(), allNodes)
+	require.NoError(t, err)
+	for _, nodeUsers := range usernames.MultiValue() {
+		assert.Contains(t, nodeUsers, username)
+	}
+
+	list, err := client.AclListWithOptions(context.Background(), allNodes)
+	require.NoError(t, err)
+	assert.True(t, list.IsMultiValue())
+
+	deleted, err := client.AclDelUserWithOptions(context.Background(), []string{username}, allNodes)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func (suite *GlideTestSuite) TestAclCatLogWhoamiWithOptionsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	allPrimaries := options.RouteOption{Route: config.AllPrimaries}
+
+	categories, err := client.AclCatWithOptions(context.Background(), allPrimaries)
+	require.NoError(t, err)
+	require.True(t, categories.IsMultiValue())
+	for _, nodeCategories := range categories.MultiValue() {
+		assert.Contains(t, nodeCategories, "read")
+	}
+
+	username, err := client.AclWhoamiWithOptions(context.Background(), options.RouteOption{Route: nil})
+	require.NoError(t, err)
+	require.True(t, username.IsSingleValue())
+	assert.Equal(t, "default", username.SingleValue())
+
+	usernames, err := client.AclWhoamiWithOptions(context.Background(), allPrimaries)
+	require.NoError(t, err)
+	for _, nodeUsername := range usernames.MultiValue() {
+		assert.Equal(t, "default", nodeUsername)
+	}
+
+	result, err := client.AclLogResetWithOptions(context.Background(), allPrimaries)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	entries, err := client.AclLogWithOptions(context.Background(), allPrimaries)
+	require.NoError(t, err)
+	require.True(t, entries.IsMultiValue())
+	for _, nodeEntries := range entries.MultiValue() {
+		assert.Empty(t, nodeEntries)
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterNodesAndMyId() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	nodes, err := client.ClusterNodes(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, nodes)
+
+	primaries := 0
+	var slots int64
+	for _, node := range nodes {
+		assert.NotEmpty(t, node.Id)
+		assert.NotEmpty(t, node.Address)
+		assert.NotZero(t, node.Port)
+		assert.Equal(t, "connected", node.LinkState)
+		if node.Role == models.PrimaryRole {
+			primaries++
+			assert.Empty(t, node.PrimaryId)
+			for _, slotRange := range node.Slots {
+				slots += slotRange.End - slotRange.Start + 1
+			}
+		} else {
+			assert.NotEmpty(t, node.PrimaryId)
+			assert.Empty(t, node.Slots)
+		}
+	}
+	assert.Equal(t, int64(16384), slots)
+
+	myIds, err := client.ClusterMyIdWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	require.True(t, myIds.IsMultiValue())
+	assert.Len(t, myIds.MultiValue(), len(nodes))
+
+	myId, err := client.ClusterMyId(context.Background())
+	require.NoError(t, err)
+	found := false
+	for _, nodeId := range myIds.MultiValue() {
+		found = found || nodeId == myId
+	}
+	assert.True(t, found)
+
+	// each node flags itself as `myself`
+	allNodes, err := client.ClusterNodesWithOptions(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	require.True(t, allNodes.IsMultiValue())
+	assert.Len(t, allNodes.MultiValue(), primaries)
+	for _, nodeView := range allNodes.MultiValue() {
+		myself := 0
+		for _, node := range nodeView {
+			if node.HasFlag("myself") {
+				myself++
+				assert.Equal(t, models.PrimaryRole, node.Role)
+			}
+		}
+		assert.Equal(t, 1, myself)
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterReplicas() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	nodes, err := client.ClusterNodes(context.Background())
+	require.NoError(t, err)
+	replicasOf := map[string]int{}
+	for _, node := range nodes {
+		if node.Role == models.ReplicaRole {
+			replicasOf[node.PrimaryId]++
+		}
+	}
+
+	for _, node := range nodes {
+		if node.Role != models.PrimaryRole {
+			continue
+		}
+		replicas, err := client.ClusterReplicas(context.Background(), node.Id)
+		require.NoError(t, err)
+		assert.Len(t, replicas, replicasOf[node.Id])
+		for _, replica := range replicas {
+			assert.Equal(t, node.Id, replica.PrimaryId)
+			assert.Equal(t, models.ReplicaRole, replica.Role)
+		}
+	}
+
+	_, err = client.ClusterReplicas(context.Background(), "nonexistent")
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClusterInfoAndSlots() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	info, err := client.ClusterInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ok", info.State)
+	assert.Equal(t, int64(16384), info.SlotsAssigned)
+	assert.Equal(t, int64(16384), info.SlotsOk)
+	assert.NotZero(t, info.KnownNodes)
+	assert.NotZero(t, info.Size)
+	assert.Equal(t, "ok", info.Raw["cluster_state"])
+
+	infos, err := client.ClusterInfoWithOptions(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	require.True(t, infos.IsMultiValue())
+	for _, nodeInfo := range infos.MultiValue() {
+		assert.Equal(t, info.Size, nodeInfo.Size)
+	}
+
+	slotRanges, err := client.ClusterSlots(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, slotRanges)
+	var slots int64
+	for _, slotRange := range slotRanges {
+		slots += slotRange.End - slotRange.Start + 1
+		assert.NotZero(t, slotRange.Primary.Port)
+		assert.NotEmpty(t, slotRange.Primary.Id)
+	}
+	assert.Equal(t, int64(16384), slots)
+	assert.Equal(t, int(info.Size), len(uniquePrimaries(slotRanges)))
+}
+
+func uniquePrimaries(slotRanges []models.ClusterSlotRange) map[string]struct{} {
+	primaries := map[string]struct{}{}
+	for _, slotRange := range slotRanges {
+		primaries[slotRange.Primary.Id] = struct{}{}
+	}
+	return primaries
+}
+
+func (suite *GlideTestSuite) TestClusterShardsAndLinks() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	shards, err := client.ClusterShards(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, shards)
+	var slots int64
+	for _, shard := range shards {
+		for _, slotRange := range shard.Slots {
+			slots += slotRange.End - slotRange.Start + 1
+		}
+		require.NotEmpty(t, shard.Nodes)
+		primaries := 0
+		for _, node := range shard.Nodes {
+			assert.NotEmpty(t, node.Id)
+			assert.NotEmpty(t, node.Health)
+			if node.Role == models.PrimaryRole {
+				primaries++
+			}
+		}
+		assert.Equal(t, 1, primaries)
+	}
+	assert.Equal(t, int64(16384), slots)
+
+	links, err := client.ClusterLinks(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, links)
+	for _, link := range links {
+		assert.Contains(t, []string{"to", "from"}, link.Direction)
+		assert.NotEmpty(t, link.Node)
+		assert.NotZero(t, link.CreateTime)
+	}
+
+	allLinks, err := client.ClusterLinksWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	assert.True(t, allLinks.IsMultiValue())
+}
+
+func (suite *GlideTestSuite) TestClusterMyShardId() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	shards, err := client.ClusterShardsWithOptions(context.Background(), options.RouteOption{Route: config.RandomRoute})
+	require.NoError(t, err)
+	require.True(t, shards.IsSingleValue())
+
+	shardIds, err := client.ClusterMyShardIdWithOptions(context.Background(), options.RouteOption{Route: config.AllPrimaries})
+	require.NoError(t, err)
+	require.True(t, shardIds.IsMultiValue())
+	unique := map[string]struct{}{}
+	for _, shardId := range shardIds.MultiValue() {
+		assert.Len(t, shardId, 40)
+		unique[shardId] = struct{}{}
+	}
+	assert.Len(t, unique, len(shards.SingleValue()))
+}
+
+func (suite *GlideTestSuite) TestClusterCountAndGetKeysInSlot() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	key := "{" + uuid.NewString() + "}"
+
+	slotResponse, err := client.CustomCommand(context.Background(), []string{"CLUSTER", "KEYSLOT", key})
+	require.NoError(t, err)
+	slot := slotResponse.SingleValue().(int64)
+
+	_, err = client.Set(context.Background(), key+"1", "value")
+	require.NoError(t, err)
+	_, err = client.Set(context.Background(), key+"2", "value")
+	require.NoError(t, err)
+
+	count, err := client.ClusterCountKeysInSlot(context.Background(), slot)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, count, int64(2))
+
+	keys, err := client.ClusterGetKeysInSlot(context.Background(), slot, count)
+	require.NoError(t, err)
+	assert.Contains(t, keys, key+"1")
+	assert.Contains(t, keys, key+"2")
+
+	keys, err = client.ClusterGetKeysInSlot(context.Background(), slot, 1)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	_, err = client.ClusterCountKeysInSlot(context.Background(), 16384)
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClusterAdministrationCommands() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	route := config.NewSlotIdRoute(config.SlotTypePrimary, 42)
+
+	myIds, err := client.ClusterMyIdWithOptions(context.Background(), options.RouteOption{Route: route})
+	require.NoError(t, err)
+	myId := myIds.SingleValue()
+
+	// the slot is already served by the node, so the state is unchanged
+	result, err := client.ClusterSetSlot(context.Background(), 42, *options.NewClusterSetSlotStableOptions(), route)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	result, err = client.ClusterSetSlot(context.Background(), 42, *options.NewClusterSetSlotNodeOptions(myId), route)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	// the commands are rejected by the server without changing the topology
+	_, err = client.ClusterAddSlots(context.Background(), []int64{42}, route)
+	assert.ErrorContains(t, err, "busy")
+
+	_, err = client.ClusterForget(context.Background(), myId, route)
+	assert.Error(t, err)
+
+	_, err = client.ClusterReplicate(context.Background(), myId, route)
+	assert.Error(t, err)
+
+	_, err = client.ClusterFailover(context.Background(), route)
+	assert.Error(t, err)
+
+	_, err = client.ClusterFailoverWithMode(context.Background(), options.ClusterFailoverForce, route)
+	assert.Error(t, err)
+
+	// the client rejects the invalid arguments without sending the commands
+	_, err = client.ClusterSetSlot(context.Background(), 42, *options.NewClusterSetSlotImportingOptions(""), route)
+	assert.ErrorContains(t, err, "node ID is required")
+
+	_, err = client.ClusterMeet(context.Background(), "127.0.0.1", 6379, nil)
+	assert.ErrorContains(t, err, "route")
+
+	_, err = client.ClusterResetWithMode(context.Background(), options.ClusterResetHard, nil)
+	assert.ErrorContains(t, err, "route")
+}
+
+func (suite *GlideTestSuite) TestClientCommandsWithOptionsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	allPrimaries := options.RouteOption{Route: config.AllPrimaries}
+
+	info, err := client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.NotZero(t, info.Id)
+
+	infos, err := client.ClientInfoWithOptions(context.Background(), allPrimaries)
+	require.NoError(t, err)
+	require.True(t, infos.IsMultiValue())
+	for _, nodeInfo := range infos.MultiValue() {
+		assert.Contains(t, nodeInfo.Cmd, "client")
+	}
+
+	clients, err := client.ClientListWithOptions(
+		context.Background(),
+		*options.NewClientFilter().SetType(options.ClientTypeNormal),
+		allPrimaries,
+	)
+	require.NoError(t, err)
+	require.True(t, clients.IsMultiValue())
+	for _, nodeClients := range clients.MultiValue() {
+		assert.NotEmpty(t, nodeClients)
+	}
+
+	killed, err := client.ClientKillWithOptions(
+		context.Background(),
+		*options.NewClientFilter().SetIds(math.MaxInt32),
+		allPrimaries,
+	)
+	require.NoError(t, err)
+	for _, count := range killed.MultiValue() {
+		assert.Equal(t, int64(0), count)
+	}
+
+	unblocked, err := client.ClientUnblockWithOptions(
+		context.Background(),
+		math.MaxInt32,
+		options.ClientUnblockTimeout,
+		allPrimaries,
+	)
+	require.NoError(t, err)
+	for _, nodeUnblocked := range unblocked.MultiValue() {
+		assert.False(t, nodeUnblocked)
+	}
+
+	result, err := client.ClientPauseWithOptions(
+		context.Background(),
+		100*time.Millisecond,
+		options.ClientPauseWrite,
+		allPrimaries,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	result, err = client.ClientUnpauseWithOptions(context.Background(), allPrimaries)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+}
+
+func (suite *GlideTestSuite) TestClientTrackingWithOptionsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	allNodes := options.RouteOption{Route: config.AllNodes}
+
+	result, err := client.ClientTrackingWithOptions(
+		context.Background(),
+		*options.NewClientTrackingOptions(true).SetOptOut(),
+		allNodes,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	infos, err := client.ClientTrackingInfoWithOptions(context.Background(), allNodes)
+	require.NoError(t, err)
+	require.True(t, infos.IsMultiValue())
+	for _, info := range infos.MultiValue() {
+		assert.True(t, info.HasFlag("optout"))
+	}
+
+	redirects, err := client.ClientGetRedirWithOptions(context.Background(), allNodes)
+	require.NoError(t, err)
+	for _, redirect := range redirects.MultiValue() {
+		assert.Equal(t, int64(0), redirect)
+	}
+
+	result, err = client.ClientTrackingWithOptions(context.Background(), *options.NewClientTrackingOptions(false), allNodes)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+}
+
+func (suite *GlideTestSuite) TestSlowLogCommandsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	key := uuid.NewString()
+	keyRoute := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, key)}
+
+	_, err := client.ConfigSetWithOptions(
+		context.Background(),
+		map[string]string{"slowlog-log-slower-than": "0"},
+		options.RouteOption{Route: config.AllNodes},
+	)
+	require.NoError(t, err)
+	defer client.ConfigSetWithOptions(
+		context.Background(),
+		map[string]string{"slowlog-log-slower-than": "10000"},
+		options.RouteOption{Route: config.AllNodes},
+	)
+
+	result, err := client.SlowLogReset(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	_, err = client.Set(context.Background(), key, "value")
+	require.NoError(t, err)
+
+	nodes, err := client.ClusterNodes(context.Background())
+	require.NoError(t, err)
+	lengths, err := client.SlowLogLen(context.Background())
+	require.NoError(t, err)
+	require.True(t, lengths.IsMultiValue())
+	assert.Len(t, lengths.MultiValue(), len(nodes))
+
+	entries, err := client.SlowLogGet(context.Background())
+	require.NoError(t, err)
+	require.True(t, entries.IsMultiValue())
+	assert.Len(t, entries.MultiValue(), len(nodes))
+
+	primaryEntries, err := client.SlowLogGetWithOptions(
+		context.Background(),
+		-1,
+		options.RouteOption{Route: config.AllPrimaries},
+	)
+	require.NoError(t, err)
+	require.True(t, primaryEntries.IsMultiValue())
+	assert.LessOrEqual(t, len(primaryEntries.MultiValue()), len(nodes))
+	found := 0
+	for _, nodeEntries := range primaryEntries.MultiValue() {
+		for _, entry := range nodeEntries {
+			if len(entry.Args) > 1 && entry.Args[0] == "SET" && entry.Args[1] == key {
+				found++
+			}
+		}
+	}
+	assert.Equal(t, 1, found)
+
+	keyEntries, err := client.SlowLogGetWithOptions(context.Background(), -1, keyRoute)
+	require.NoError(t, err)
+	require.False(t, keyEntries.IsMultiValue())
+	assert.NotEmpty(t, keyEntries.SingleValue())
+
+	keyLength, err := client.SlowLogLenWithOptions(context.Background(), keyRoute)
+	require.NoError(t, err)
+	assert.Positive(t, keyLength.SingleValue())
+
+	result, err = client.SlowLogResetWithOptions(context.Background(), keyRoute)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+}
+
+func (suite *GlideTestSuite) TestLatencyCommandsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	randomRoute := options.RouteOption{Route: config.RandomRoute}
+
+	_, err := client.LatencyReset(context.Background())
+	require.NoError(t, err)
+
+	events, err := client.LatencyLatest(context.Background())
+	require.NoError(t, err)
+	require.True(t, events.IsMultiValue())
+	for _, nodeEvents := range events.MultiValue() {
+		assert.Empty(t, nodeEvents)
+	}
+
+	samples, err := client.LatencyHistoryWithOptions(context.Background(), "command", randomRoute)
+	require.NoError(t, err)
+	assert.Empty(t, samples.SingleValue())
+
+	reports, err := client.LatencyDoctor(context.Background())
+	require.NoError(t, err)
+	for _, report := range reports.MultiValue() {
+		assert.NotEmpty(t, report)
+	}
+
+	count, err := client.LatencyResetWithOptions(context.Background(), []string{"command"}, randomRoute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	suite.SkipIfServerVersionLowerThan("7.0.0", t)
+	key := uuid.NewString()
+	_, err = client.Set(context.Background(), key, "value")
+	require.NoError(t, err)
+	histograms, err := client.LatencyHistogramWithOptions(
+		context.Background(),
+		[]string{"set"},
+		options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, key)},
+	)
+	require.NoError(t, err)
+	assert.Positive(t, histograms.SingleValue()["set"].Calls)
+
+	allHistograms, err := client.LatencyHistogram(context.Background(), "set")
+	require.NoError(t, err)
+	assert.True(t, allHistograms.IsMultiValue())
+}
+
+func (suite *GlideTestSuite) TestMemoryCommandsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+	key := uuid.NewString()
+	keyRoute := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, key)}
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	stats, err := client.MemoryStats(context.Background())
+	require.NoError(t, err)
+	require.True(t, stats.IsMultiValue())
+	var keysCount int64
+	for _, nodeStats := range stats.MultiValue() {
+		assert.Positive(t, nodeStats.TotalAllocated)
+		keysCount += nodeStats.KeysCount
+	}
+	assert.Positive(t, keysCount)
+
+	keyStats, err := client.MemoryStatsWithOptions(context.Background(), keyRoute)
+	require.NoError(t, err)
+	assert.Positive(t, keyStats.SingleValue().KeysCount)
+
+	allStats, err := client.MemoryStatsWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(allStats.MultiValue()), len(stats.MultiValue()))
+
+	reports, err := client.MemoryDoctor(context.Background())
+	require.NoError(t, err)
+	for _, report := range reports.MultiValue() {
+		assert.NotEmpty(t, report)
+	}
+
+	mallocStats, err := client.MemoryMallocStatsWithOptions(context.Background(), keyRoute)
+	require.NoError(t, err)
+	assert.NotEmpty(t, mallocStats.SingleValue())
+
+	result, err := client.MemoryPurge(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	result, err = client.MemoryPurgeWithOptions(context.Background(), options.RouteOption{Route: config.AllNodes})
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+}
+
+func (suite *GlideTestSuite) TestRoleCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	roles, err := client.Role(context.Background())
+	require.NoError(t, err)
+	assert.True(t, roles.IsMultiValue())
+	primaries := 0
+	for _, role := range roles.MultiValue() {
+		if role.IsPrimary() {
+			primaries++
+		} else {
+			assert.True(t, role.IsReplica())
+			assert.NotEmpty(t, role.PrimaryHost)
+			assert.Positive(t, role.PrimaryPort)
+		}
+	}
+	assert.Positive(t, primaries)
+
+	role, err := client.RoleWithOptions(
+		context.Background(),
+		options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")},
+	)
+	require.NoError(t, err)
+	assert.True(t, role.IsSingleValue())
+	assert.True(t, role.SingleValue().IsPrimary())
+}
+
+func (suite *GlideTestSuite) TestSaveAndBgSaveCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	suite.verifyOK(client.Save(context.Background()))
+
+	route := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.BgSaveWithOptions(
+		context.Background(),
+		options.ClusterBgSaveOptions{BgSaveOptions: options.NewBgSaveOptions().SetSchedule(), RouteOption: &route},
+	)
+	require.NoError(t, err)
+	for _, message := range result.MultiValue() {
+		assert.Contains(t, message, "Background saving")
+	}
+}
+
+func (suite *GlideTestSuite) TestWaitAofCluster() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	result, err := client.WaitAof(context.Background(), 0, 0, time.Second)
+	require.NoError(t, err)
+	assert.True(t, result.IsMultiValue())
+	for _, nodeResult := range result.MultiValue() {
+		assert.Equal(t, int64(0), nodeResult.Local)
+	}
+}
+
+func (suite *GlideTestSuite) TestShutDownCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	_, err := client.ShutDownWithOptions(context.Background(), options.ClusterShutDownOptions{})
+	assert.IsType(t, &glide.ConfigurationError{}, err)
+
+	suite.SkipIfServerVersionLowerThan("7.0.0", t)
+	route := options.RouteOption{Route: config.RandomRoute}
+	_, err = client.ShutDownWithOptions(
+		context.Background(),
+		options.ClusterShutDownOptions{ShutDownOptions: options.NewShutDownOptions().SetAbort(), RouteOption: &route},
+	)
+	assert.ErrorContains(t, err, "No shutdown in progress")
+}
+
+func (suite *GlideTestSuite) TestCommandIntrospectionCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	count, err := client.CommandCount(context.Background())
+	require.NoError(t, err)
+	assert.Greater(t, count, int64(100))
+
+	infos, err := client.CommandInfo(context.Background(), "mset")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-3), infos["mset"].Arity)
+	assert.Equal(t, int64(2), infos["mset"].Step)
+
+	keys, err := client.CommandGetKeys(context.Background(), []string{"MSET", "{k}1", "v1", "{k}2", "v2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"{k}1", "{k}2"}, keys)
+
+	suite.SkipIfServerVersionLowerThan("7.0.0", t)
+	names, err := client.CommandListWithOptions(
+		context.Background(),
+		*options.NewCommandListOptions().SetFilterByPattern("cluster"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster"}, names)
+}
+
+func (suite *GlideTestSuite) TestModuleCommandsCluster() {
+	client := suite.defaultClusterClient()
+	t := suite.T()
+
+	modules, err := client.ModuleList(context.Background())
+	require.NoError(t, err)
+	assert.True(t, modules.IsMultiValue())
+	assert.NotEmpty(t, modules.MultiValue())
+
+	modules, err = client.ModuleListWithOptions(context.Background(), options.RouteOption{Route: config.RandomRoute})
+	require.NoError(t, err)
+	assert.True(t, modules.IsSingleValue())
+
+	_, err = client.ModuleLoad(context.Background(), "/nonexistent/module.so", nil)
+	assert.Error(t, err)
+	_, err = client.ModuleUnloadWithOptions(
+		context.Background(),
+		"nonexistentmodule",
+		options.RouteOption{Route: config.AllPrimaries},
+	)
+	assert.Error(t, err)
+}
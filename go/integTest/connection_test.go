@@ -4,10 +4,13 @@ package integTest
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/config"
@@ -156,3 +159,287 @@ func (suite *GlideTestSuite) TestConnectionTimeout() {
 		}
 	})
 }
+
+func (suite *GlideTestSuite) lazyClients() []interfaces.BaseClientCommands {
+	client, err := suite.client(suite.defaultClientConfig().WithLazyConnect(true))
+	suite.Require().NoError(err)
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithLazyConnect(true))
+	suite.Require().NoError(err)
+	return []interfaces.BaseClientCommands{client, clusterClient}
+}
+
+func (suite *GlideTestSuite) TestLazyConnect() {
+	ctx := context.Background()
+	for _, client := range suite.lazyClients() {
+		suite.False(client.IsConnected())
+		suite.NoError(client.WaitUntilReady(ctx))
+		suite.True(client.IsConnected())
+		// a connected client is ready right away
+		suite.NoError(client.WaitUntilReady(ctx))
+
+		client.Close()
+		suite.False(client.IsConnected())
+	}
+}
+
+func (suite *GlideTestSuite) TestLazyConnect_firstCommandConnects() {
+	for _, client := range suite.lazyClients() {
+		suite.False(client.IsConnected())
+		result, err := client.Set(context.Background(), uuid.NewString(), "value")
+		suite.NoError(err)
+		suite.Equal("OK", result)
+		suite.True(client.IsConnected())
+	}
+}
+
+func (suite *GlideTestSuite) TestLazyConnectWithInvalidAddress() {
+	config := config.NewClientConfiguration().
+		WithAddress(&config.NodeAddress{Host: "invalid-host"}).
+		WithLazyConnect(true)
+	client, err := glide.NewClient(config)
+	suite.NoError(err)
+	defer client.Close()
+	suite.False(client.IsConnected())
+
+	var connErr *glide.ConnectionError
+	_, err = client.Get(context.Background(), "key")
+	suite.ErrorAs(err, &connErr)
+	// a failed connection is attempted again
+	suite.ErrorAs(client.WaitUntilReady(context.Background()), &connErr)
+	suite.False(client.IsConnected())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	suite.ErrorIs(client.WaitUntilReady(ctx), context.Canceled)
+
+	// the connection attempt times out as the requests
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	var timeoutErr *glide.TimeoutError
+	err = client.WaitUntilReady(expired)
+	suite.ErrorAs(err, &timeoutErr)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (suite *GlideTestSuite) TestIsConnected() {
+	client, err := suite.client(suite.defaultClientConfig())
+	suite.Require().NoError(err)
+	suite.True(client.IsConnected())
+	client.Close()
+	suite.False(client.IsConnected())
+}
+
+func (suite *GlideTestSuite) TestInflightRequestsLimit() {
+	client, err := suite.client(suite.defaultClientConfig().
+		WithRequestTimeout(5 * time.Second).
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithInflightRequestsLimit(1)))
+	suite.Require().NoError(err)
+	blockingDone := make(chan struct{})
+	go func() {
+		defer close(blockingDone)
+		client.BLPop(context.Background(), []string{uuid.NewString()}, time.Second)
+	}()
+	time.Sleep(200 * time.Millisecond) // Wait to ensure the blocking command is inflight
+
+	var limitErr *glide.InflightRequestsLimitError
+	_, err = client.Get(context.Background(), "key")
+	suite.ErrorAs(err, &limitErr)
+
+	<-blockingDone
+	_, err = client.Get(context.Background(), "key")
+	suite.NoError(err)
+}
+
+func (suite *GlideTestSuite) TestWaitForInflightRequests() {
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().
+		WithRequestTimeout(5 * time.Second).
+		WithAdvancedConfiguration(
+			config.NewAdvancedClusterClientConfiguration().WithInflightRequestsLimit(1).WithWaitForInflightRequests(true),
+		))
+	suite.Require().NoError(err)
+	blockingDone := make(chan struct{})
+	go func() {
+		defer close(blockingDone)
+		client.BLPop(context.Background(), []string{uuid.NewString()}, time.Second)
+	}()
+	time.Sleep(200 * time.Millisecond) // Wait to ensure the blocking command is inflight
+
+	// the command waits until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.Get(ctx, "key")
+	suite.ErrorIs(err, context.DeadlineExceeded)
+
+	// the command waits for the blocking command to complete
+	_, err = client.Get(context.Background(), "key")
+	suite.NoError(err)
+	<-blockingDone
+}
+
+func (suite *GlideTestSuite) TestPeriodicChecks() {
+	for _, advancedConfig := range []*config.AdvancedClusterClientConfiguration{
+		config.NewAdvancedClusterClientConfiguration().WithPeriodicChecksManualInterval(5 * time.Second),
+		config.NewAdvancedClusterClientConfiguration().WithPeriodicChecksDisabled(),
+	} {
+		client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithAdvancedConfiguration(advancedConfig))
+		suite.Require().NoError(err)
+		result, err := client.Ping(context.Background())
+		suite.NoError(err)
+		suite.Equal("PONG", result)
+	}
+}
+
+func (suite *GlideTestSuite) TestTLSConfigInsecure() {
+	if !suite.tls {
+		suite.T().Skip("TLS is not enabled")
+	}
+	// the certificates of the test servers are self-signed
+	client, err := suite.client(suite.defaultClientConfig().WithTLSConfig(config.NewTLSConfig().WithInsecure(true)))
+	suite.Require().NoError(err)
+	result, err := client.Ping(context.Background())
+	suite.NoError(err)
+	suite.Equal("PONG", result)
+
+	clusterClient, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithTLSConfig(config.NewTLSConfig().WithInsecure(true)),
+	)
+	suite.Require().NoError(err)
+	result, err = clusterClient.Ping(context.Background())
+	suite.NoError(err)
+	suite.Equal("PONG", result)
+}
+
+func (suite *GlideTestSuite) TestInvalidTLSConfig() {
+	_, err := glide.NewClient(
+		suite.defaultClientConfig().WithTLSConfig(config.NewTLSConfig().WithRootCertificates([]byte("not a certificate"))),
+	)
+	suite.ErrorContains(err, "invalid root certificates")
+}
+
+func (suite *GlideTestSuite) TestCredentialsProvider() {
+	ctx := context.Background()
+	adminClient := suite.defaultClient()
+	password := uuid.NewString()
+	_, err := adminClient.CustomCommand(ctx, []string{"CONFIG", "SET", "requirepass", password})
+	suite.Require().NoError(err)
+	defer adminClient.CustomCommand(ctx, []string{"CONFIG", "SET", "requirepass", ""})
+
+	var mu sync.Mutex
+	currentPassword := password
+	calls := 0
+	provider := config.CredentialsProviderFunc(func(ctx context.Context) (*config.ServerCredentials, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return config.NewServerCredentialsWithDefaultUsername(currentPassword), nil
+	})
+	setPassword := func(newPassword string) {
+		mu.Lock()
+		defer mu.Unlock()
+		currentPassword = newPassword
+		calls = 0
+	}
+	// a refresh is complete once the provider is called again
+	waitForRefresh := func() {
+		suite.Eventually(func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return calls >= 2
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+	errs := make(chan error, 100)
+	providerConfig := config.NewCredentialsProviderConfig(provider, 100*time.Millisecond).
+		WithErrorCallback(func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		})
+
+	client, err := suite.client(suite.defaultClientConfig().WithCredentialsProvider(providerConfig))
+	suite.Require().NoError(err)
+	result, err := client.Ping(ctx)
+	suite.NoError(err)
+	suite.Equal("PONG", result)
+
+	// the client reconnects with the rotated password
+	newPassword := uuid.NewString()
+	_, err = adminClient.CustomCommand(ctx, []string{"CONFIG", "SET", "requirepass", newPassword})
+	suite.Require().NoError(err)
+	setPassword(newPassword)
+	waitForRefresh()
+	suite.Empty(errs)
+	_, err = adminClient.CustomCommand(ctx, []string{"CLIENT", "KILL", "TYPE", "NORMAL", "SKIPME", "YES"})
+	suite.NoError(err)
+	result, err = client.Ping(ctx)
+	suite.NoError(err)
+	suite.Equal("PONG", result)
+
+	// a wrong password is reported, and the client keeps the previous one
+	setPassword(uuid.NewString())
+	waitForRefresh()
+	select {
+	case err := <-errs:
+		suite.ErrorContains(err, "failed to update the password")
+	case <-time.After(5 * time.Second):
+		suite.Fail("the error of the refresh wasn't reported")
+	}
+	_, err = adminClient.CustomCommand(ctx, []string{"CLIENT", "KILL", "TYPE", "NORMAL", "SKIPME", "YES"})
+	suite.NoError(err)
+	result, err = client.Ping(ctx)
+	suite.NoError(err)
+	suite.Equal("PONG", result)
+}
+
+func (suite *GlideTestSuite) TestCredentialsProviderError() {
+	provider := config.CredentialsProviderFunc(func(ctx context.Context) (*config.ServerCredentials, error) {
+		return nil, errors.New("the credentials are unavailable")
+	})
+	_, err := glide.NewClient(
+		suite.defaultClientConfig().WithCredentialsProvider(config.NewCredentialsProviderConfig(provider, time.Minute)),
+	)
+	var connErr *glide.ConnectionError
+	suite.ErrorAs(err, &connErr)
+	suite.ErrorContains(err, "the credentials are unavailable")
+
+	_, err = glide.NewClusterClient(
+		suite.defaultClusterClientConfig().
+			WithCredentials(config.NewServerCredentialsWithDefaultUsername("password")).
+			WithCredentialsProvider(config.NewCredentialsProviderConfig(provider, time.Minute)),
+	)
+	suite.ErrorContains(err, "can't be set together")
+}
+
+func (suite *GlideTestSuite) TestClientFromURI() {
+	scheme := "valkey"
+	if suite.tls {
+		scheme = "valkeys"
+	}
+	host := suite.standaloneHosts[0]
+	clientConfig, err := config.NewClientConfigurationFromURI(
+		fmt.Sprintf("%s://%s:%d/1?client_name=uri_client&request_timeout=5s", scheme, host.Host, host.Port),
+	)
+	suite.Require().NoError(err)
+	client, err := suite.client(clientConfig)
+	suite.Require().NoError(err)
+	name, err := client.ClientGetName(context.Background())
+	suite.NoError(err)
+	suite.Equal("uri_client", name.Value())
+	info, err := client.CustomCommand(context.Background(), []string{"CLIENT", "INFO"})
+	suite.NoError(err)
+	suite.Contains(info, "db=1")
+
+	hosts := make([]string, 0, len(suite.clusterHosts))
+	for _, host := range suite.clusterHosts {
+		hosts = append(hosts, fmt.Sprintf("%s:%d", host.Host, host.Port))
+	}
+	clusterConfig, err := config.NewClusterClientConfigurationFromURI(
+		fmt.Sprintf("%s://%s?client_name=uri_client", scheme, strings.Join(hosts, ",")),
+	)
+	suite.Require().NoError(err)
+	clusterClient, err := suite.clusterClient(clusterConfig)
+	suite.Require().NoError(err)
+	result, err := clusterClient.Ping(context.Background())
+	suite.NoError(err)
+	suite.Equal("PONG", result)
+}
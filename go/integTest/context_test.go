@@ -4,9 +4,12 @@ package integTest
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -45,6 +48,31 @@ func (suite *GlideTestSuite) TestContext_CancelDuringExecution() {
 	})
 }
 
+// TestContext_DeadlineAsRequestTimeout tests that the deadline of the context is the timeout of the request in the core,
+// which fails with a timeout error once it's exceeded
+func (suite *GlideTestSuite) TestContext_DeadlineAsRequestTimeout() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.BLPop(ctx, []string{key}, 10*time.Second)
+
+		var timeoutErr *glide.TimeoutError
+		suite.True(errors.As(err, &timeoutErr))
+		suite.ErrorIs(err, context.DeadlineExceeded)
+		suite.Less(time.Since(start), time.Second)
+
+		// the client keeps serving the requests once the request is aborted
+		_, err = client.Set(context.Background(), key, "value")
+		suite.NoError(err)
+		result, err := client.Get(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal("value", result.Value())
+	})
+}
+
 // TestContext_CancelWithConnectionPasswordUpdate tests context cancellation
 // with connection password update operation
 func (suite *GlideTestSuite) TestContext_CancelWithConnectionPasswordUpdate() {
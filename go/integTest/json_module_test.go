@@ -0,0 +1,263 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/json"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+func (suite *GlideTestSuite) TestModuleVerifyJsonLoaded() {
+	client := suite.defaultClusterClient()
+	result, err := client.InfoWithOptions(context.Background(),
+		options.ClusterInfoOptions{
+			InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Server}},
+			RouteOption: nil,
+		},
+	)
+
+	suite.NoError(err)
+	for _, value := range result.MultiValue() {
+		assert.True(suite.T(), strings.Contains(value, "# json_core_metrics"))
+	}
+}
+
+func (suite *GlideTestSuite) TestModuleJsonSetGet() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	res, err := json.Set(ctx, client, key, "$", `{"a": 1.0, "b": 2}`)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	doc, err := json.Get(ctx, client, key)
+	suite.NoError(err)
+	suite.Equal(`{"a":1.0,"b":2}`, doc.Value())
+
+	doc, err = json.GetWithOptions(ctx, client, key, *options.NewJsonGetOptions().SetPaths("$.a", "$.b"))
+	suite.NoError(err)
+	suite.Equal(`{"$.a":[1.0],"$.b":[2]}`, doc.Value())
+
+	doc, err = json.GetWithOptions(ctx, client, key, *options.NewJsonGetOptions().SetPaths("b"))
+	suite.NoError(err)
+	suite.Equal("2", doc.Value())
+
+	doc, err = json.GetWithOptions(ctx, client, key, *options.NewJsonGetOptions().SetPaths("$.c"))
+	suite.NoError(err)
+	suite.Equal("[]", doc.Value())
+
+	doc, err = json.Get(ctx, client, uuid.NewString())
+	suite.NoError(err)
+	suite.True(doc.IsNil())
+
+	// conditional set
+	set, err := json.SetWithOptions(ctx, client, key, "$.a", "4.5", *options.NewJsonSetOptions().SetOnlyIfDoesNotExist())
+	suite.NoError(err)
+	suite.True(set.IsNil())
+
+	set, err = json.SetWithOptions(ctx, client, key, "$.a", "4.5", *options.NewJsonSetOptions().SetOnlyIfExists())
+	suite.NoError(err)
+	suite.Equal("OK", set.Value())
+
+	formatted, err := json.GetWithOptions(
+		ctx,
+		client,
+		key,
+		*options.NewJsonGetOptions().SetPaths("$").SetIndent("  ").SetNewline("\n").SetSpace(" "),
+	)
+	suite.NoError(err)
+	suite.Equal("[\n  {\n    \"a\": 4.5,\n    \"b\": 2\n  }\n]", formatted.Value())
+}
+
+func (suite *GlideTestSuite) TestModuleJsonMGet() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key1 := "{key}-1" + uuid.NewString()
+	key2 := "{key}-2" + uuid.NewString()
+
+	_, err := json.Set(ctx, client, key1, "$", `{"a": 1}`)
+	suite.NoError(err)
+	_, err = json.Set(ctx, client, key2, "$", `{"a": 2}`)
+	suite.NoError(err)
+
+	res, err := json.MGet(ctx, client, []string{key1, key2, "{key}-3" + uuid.NewString()}, "$.a")
+	suite.NoError(err)
+	suite.Equal(
+		[]models.Result[string]{
+			models.CreateStringResult("[1]"),
+			models.CreateStringResult("[2]"),
+			models.CreateNilStringResult(),
+		},
+		res,
+	)
+}
+
+func (suite *GlideTestSuite) TestModuleJsonArrayCommands() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	_, err := json.Set(ctx, client, key, "$", `{"a": [1, 2], "b": {"a": [3]}, "c": "str"}`)
+	suite.NoError(err)
+
+	lengths, err := json.ArrAppend(ctx, client, key, "$..a", []string{"4"})
+	suite.NoError(err)
+	suite.ElementsMatch([]models.Result[int64]{models.CreateInt64Result(3), models.CreateInt64Result(2)}, lengths)
+
+	lengths, err = json.ArrAppend(ctx, client, key, "a", []string{"5", "6"})
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(5)}, lengths)
+
+	lengths, err = json.ArrLenWithPath(ctx, client, key, "$.c")
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateNilInt64Result()}, lengths)
+
+	lengths, err = json.ArrLen(ctx, client, uuid.NewString())
+	suite.NoError(err)
+	suite.Nil(lengths)
+
+	indexes, err := json.ArrIndex(ctx, client, key, "$.a", "5")
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(3)}, indexes)
+
+	indexes, err = json.ArrIndexWithOptions(ctx, client, key, "a", "1", *options.NewJsonArrIndexOptions().SetStart(1))
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(-1)}, indexes)
+
+	_, err = json.ArrIndexWithOptions(ctx, client, key, "a", "1", *options.NewJsonArrIndexOptions().SetEnd(1))
+	suite.Error(err)
+
+	lengths, err = json.ArrInsert(ctx, client, key, "$.a", 0, []string{`"x"`})
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(6)}, lengths)
+
+	popped, err := json.ArrPopWithPathAndIndex(ctx, client, key, "$.a", 0)
+	suite.NoError(err)
+	suite.Equal([]models.Result[string]{models.CreateStringResult(`"x"`)}, popped)
+
+	lengths, err = json.ArrTrim(ctx, client, key, "$.a", 0, 1)
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(2)}, lengths)
+
+	doc, err := json.GetWithOptions(ctx, client, key, *options.NewJsonGetOptions().SetPaths("a"))
+	suite.NoError(err)
+	suite.Equal("[1,2]", doc.Value())
+}
+
+func (suite *GlideTestSuite) TestModuleJsonObjectAndStringCommands() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	_, err := json.Set(ctx, client, key, "$", `{"a": "foo", "b": {"c": true, "d": 1}}`)
+	suite.NoError(err)
+
+	objLen, err := json.ObjLen(ctx, client, key)
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(2)}, objLen)
+
+	keys, err := json.ObjKeysWithPath(ctx, client, key, "$.b")
+	suite.NoError(err)
+	suite.Equal([]models.Result[[]string]{models.CreateResultOf([]string{"c", "d"})}, keys)
+
+	strLen, err := json.StrAppendWithPath(ctx, client, key, "$.a", `"bar"`)
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(6)}, strLen)
+
+	strLen, err = json.StrLenWithPath(ctx, client, key, "a")
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(6)}, strLen)
+
+	toggled, err := json.ToggleWithPath(ctx, client, key, "$..c")
+	suite.NoError(err)
+	suite.Equal([]models.Result[bool]{models.CreateResultOf(false)}, toggled)
+
+	toggled, err = json.ToggleWithPath(ctx, client, key, "b.c")
+	suite.NoError(err)
+	suite.Equal([]models.Result[bool]{models.CreateResultOf(true)}, toggled)
+
+	types, err := json.TypeWithPath(ctx, client, key, "$.*")
+	suite.NoError(err)
+	suite.Equal([]models.Result[string]{models.CreateStringResult("string"), models.CreateStringResult("object")}, types)
+
+	incr, err := json.NumIncrBy(ctx, client, key, "$.b.d", 2)
+	suite.NoError(err)
+	suite.Equal("[3]", incr)
+
+	mult, err := json.NumMultBy(ctx, client, key, "b.d", 1.5)
+	suite.NoError(err)
+	suite.Equal("4.5", mult)
+
+	memory, err := json.DebugMemory(ctx, client, key)
+	suite.NoError(err)
+	suite.Len(memory, 1)
+	suite.Positive(memory[0].Value())
+
+	fields, err := json.DebugFieldsWithPath(ctx, client, key, "$.b")
+	suite.NoError(err)
+	suite.Equal([]models.Result[int64]{models.CreateInt64Result(2)}, fields)
+
+	resp, err := json.RespWithPath(ctx, client, key, "a")
+	suite.NoError(err)
+	suite.Equal("foobar", resp)
+
+	cleared, err := json.ClearWithPath(ctx, client, key, "$.b")
+	suite.NoError(err)
+	suite.Equal(int64(1), cleared)
+
+	deleted, err := json.DelWithPath(ctx, client, key, "$.a")
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+
+	deleted, err = json.Forget(ctx, client, key)
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+}
+
+func (suite *GlideTestSuite) TestModuleJsonBatch() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	batch := pipeline.NewClusterBatch(true).
+		JsonSet(key, "$", `{"a": [1, 2], "b": true}`).
+		JsonArrAppend(key, "$.a", []string{"3"}).
+		JsonArrLenWithPath(key, "a").
+		JsonToggleWithPath(key, "$.b").
+		JsonGetWithOptions(key, *options.NewJsonGetOptions().SetPaths("a")).
+		JsonTypeWithPath(key, "$.a").
+		JsonDel(key)
+
+	res, err := client.Exec(ctx, *batch, true)
+	suite.NoError(err)
+	suite.Equal(
+		[]any{
+			"OK",
+			[]models.Result[int64]{models.CreateInt64Result(3)},
+			[]models.Result[int64]{models.CreateInt64Result(3)},
+			[]models.Result[bool]{models.CreateResultOf(false)},
+			"[1,2,3]",
+			[]models.Result[string]{models.CreateStringResult("array")},
+			int64(1),
+		},
+		res,
+	)
+
+	// option validation errors are reported before the batch is sent
+	_, err = client.Exec(
+		ctx,
+		*pipeline.NewClusterBatch(false).JsonArrIndexWithOptions(key, "$", "1", *options.NewJsonArrIndexOptions().SetEnd(1)),
+		true,
+	)
+	suite.Error(err)
+}
@@ -5,13 +5,17 @@ package integTest
 import (
 	"context"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 )
 
 // TestPubSubChannels tests the PubSubChannels command for standalone client
@@ -414,3 +418,168 @@ func (suite *GlideTestSuite) TestPubSub_Commands_NumSub() {
 		})
 	}
 }
+
+// TestPubSub_Commands_SubscribeUnsubscribe tests the subscriptions made at runtime by clients created without any
+func (suite *GlideTestSuite) TestPubSub_Commands_SubscribeUnsubscribe() {
+	if !*pubsubtest {
+		suite.T().Skip("Pubsub tests are disabled")
+	}
+	ctx := context.Background()
+	suite.runWithDefaultClients(func(publisher interfaces.BaseClientCommands) {
+		t := suite.T()
+		// subscribing at runtime requires a subscription configuration, which can be empty
+		var subscriber interfaces.BaseClientCommands
+		var err error
+		if _, ok := publisher.(*glide.ClusterClient); ok {
+			subscriber, err = suite.clusterClient(
+				suite.defaultClusterClientConfig().WithSubscriptionConfig(config.NewClusterSubscriptionConfig()),
+			)
+		} else {
+			subscriber, err = suite.client(
+				suite.defaultClientConfig().WithSubscriptionConfig(config.NewStandaloneSubscriptionConfig()),
+			)
+		}
+		require.NoError(t, err)
+		defer subscriber.Close()
+		assert.Error(t, publisher.Subscribe(ctx, "channel-"+uuid.NewString()))
+		publish := func(channel, message string) int64 {
+			var count int64
+			var err error
+			switch client := publisher.(type) {
+			case *glide.ClusterClient:
+				count, err = client.Publish(ctx, channel, message, false)
+			case *glide.Client:
+				count, err = client.Publish(ctx, channel, message)
+			}
+			require.NoError(t, err)
+			return count
+		}
+
+		_, err = publisher.(PubSubQueuer).GetQueue()
+		assert.Error(t, err)
+
+		channel := "runtime-channel-" + uuid.NewString()
+		pattern := "runtime-pattern-" + uuid.NewString() + ".*"
+		require.NoError(t, subscriber.Subscribe(ctx, channel))
+		require.NoError(t, subscriber.PSubscribe(ctx, pattern))
+		queue, err := subscriber.(PubSubQueuer).GetQueue()
+		require.NoError(t, err)
+
+		numSub, err := publisher.PubSubNumSub(ctx, channel)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{channel: 1}, numSub)
+
+		publish(channel, "exact message")
+		publish(strings.TrimSuffix(pattern, "*")+"news", "pattern message")
+		received := map[string]string{}
+		for len(received) < 2 {
+			select {
+			case msg := <-queue.WaitForMessage():
+				received[msg.Message] = msg.Channel
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "timed out waiting for the messages", "received: %v", received)
+			}
+		}
+		assert.Equal(t, channel, received["exact message"])
+		assert.Equal(t, strings.TrimSuffix(pattern, "*")+"news", received["pattern message"])
+
+		// no channels unsubscribes from all of them
+		require.NoError(t, subscriber.Unsubscribe(ctx))
+		require.NoError(t, subscriber.PUnsubscribe(ctx, pattern))
+		numSub, err = publisher.PubSubNumSub(ctx, channel)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{channel: 0}, numSub)
+		assert.Equal(t, int64(0), publish(channel, "after unsubscribe"))
+
+		assert.Error(t, subscriber.Subscribe(ctx))
+	})
+}
+
+// TestPubSub_Commands_SubscribeWithCallback tests that runtime subscriptions are delivered to the configured callback
+func (suite *GlideTestSuite) TestPubSub_Commands_SubscribeWithCallback() {
+	if !*pubsubtest {
+		suite.T().Skip("Pubsub tests are disabled")
+	}
+	ctx := context.Background()
+	t := suite.T()
+	initialChannel := "initial-channel-" + uuid.NewString()
+	runtimeChannel := "runtime-channel-" + uuid.NewString()
+	messages := make(chan *models.PubSubMessage, 10)
+	callback := func(message *models.PubSubMessage, _ any) { messages <- message }
+
+	subscriber, err := suite.client(suite.defaultClientConfig().WithSubscriptionConfig(
+		config.NewStandaloneSubscriptionConfig().
+			WithSubscription(config.ExactChannelMode, initialChannel).
+			WithCallback(callback, nil),
+	))
+	require.NoError(t, err)
+	defer subscriber.Close()
+	publisher := suite.defaultClient()
+	defer publisher.Close()
+
+	require.NoError(t, subscriber.Subscribe(ctx, runtimeChannel))
+	// unsubscribing from a channel of the configuration is supported as well
+	require.NoError(t, subscriber.Unsubscribe(ctx, initialChannel))
+
+	count, err := publisher.Publish(ctx, initialChannel, "initial")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+	count, err = publisher.Publish(ctx, runtimeChannel, "runtime")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	select {
+	case msg := <-messages:
+		assert.Equal(t, runtimeChannel, msg.Channel)
+		assert.Equal(t, "runtime", msg.Message)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the message")
+	}
+}
+
+// TestPubSub_Commands_SSubscribe tests the shard channel subscriptions made at runtime
+func (suite *GlideTestSuite) TestPubSub_Commands_SSubscribe() {
+	if !*pubsubtest {
+		suite.T().Skip("Pubsub tests are disabled")
+	}
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	ctx := context.Background()
+	t := suite.T()
+	subscriber, err := suite.clusterClient(
+		suite.defaultClusterClientConfig().WithSubscriptionConfig(config.NewClusterSubscriptionConfig()),
+	)
+	require.NoError(t, err)
+	defer subscriber.Close()
+	publisher := suite.defaultClusterClient()
+	defer publisher.Close()
+
+	channels := []string{"{shard}-" + uuid.NewString(), "{other}-" + uuid.NewString()}
+	require.NoError(t, subscriber.SSubscribe(ctx, channels...))
+	queue, err := subscriber.GetQueue()
+	require.NoError(t, err)
+
+	numSub, err := publisher.PubSubShardNumSub(ctx, channels...)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{channels[0]: 1, channels[1]: 1}, numSub)
+
+	count, err := publisher.Publish(ctx, channels[1], "sharded message", true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	select {
+	case msg := <-queue.WaitForMessage():
+		assert.Equal(t, channels[1], msg.Channel)
+		assert.Equal(t, "sharded message", msg.Message)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the message")
+	}
+
+	require.NoError(t, subscriber.SUnsubscribe(ctx, channels[0]))
+	numSub, err = publisher.PubSubShardNumSub(ctx, channels...)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{channels[0]: 0, channels[1]: 1}, numSub)
+
+	require.NoError(t, subscriber.SUnsubscribe(ctx))
+	numSub, err = publisher.PubSubShardNumSub(ctx, channels[1])
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{channels[1]: 0}, numSub)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -1476,6 +1477,161 @@ func (suite *GlideTestSuite) TestHRandField() {
 	})
 }
 
+func (suite *GlideTestSuite) TestHashFieldExpiration() {
+	suite.SkipIfServerVersionLowerThan("9.0.0", suite.T())
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		fields := []string{"f1", "f2", "nonexistent"}
+
+		// key does not exist
+		expireRes, err := client.HExpire(context.Background(), key, 10*time.Second, fields)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []models.HashFieldExpireResult{-2, -2, -2}, expireRes)
+		ttlRes, err := client.HTTL(context.Background(), key, fields)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []int64{-2, -2, -2}, ttlRes)
+
+		hset, err := client.HSet(context.Background(), key, map[string]string{"f1": "v1", "f2": "v2"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), int64(2), hset)
+
+		expireRes, err = client.HExpire(context.Background(), key, 100*time.Second, fields)
+		suite.NoError(err)
+		assert.Equal(
+			suite.T(),
+			[]models.HashFieldExpireResult{
+				models.HashFieldExpireSet,
+				models.HashFieldExpireSet,
+				models.HashFieldExpireNoSuchField,
+			},
+			expireRes,
+		)
+		ttlRes, err = client.HTTL(context.Background(), key, []string{"f1"})
+		suite.NoError(err)
+		assert.True(suite.T(), ttlRes[0] > 0 && ttlRes[0] <= 100)
+		ttlRes, err = client.HPTTL(context.Background(), key, []string{"f1"})
+		suite.NoError(err)
+		assert.True(suite.T(), ttlRes[0] > 0 && ttlRes[0] <= 100000)
+
+		// The conditions compare the new expiration to the current one
+		expireRes, err = client.HPExpireWithOptions(
+			context.Background(),
+			key,
+			50*time.Second,
+			[]string{"f1"},
+			constants.NewExpiryGreaterThanCurrent,
+		)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []models.HashFieldExpireResult{models.HashFieldExpireConditionNotMet}, expireRes)
+		expireRes, err = client.HPExpireWithOptions(
+			context.Background(),
+			key,
+			50*time.Second,
+			[]string{"f1"},
+			constants.NewExpiryLessThanCurrent,
+		)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []models.HashFieldExpireResult{models.HashFieldExpireSet}, expireRes)
+
+		expireAt := time.Now().Add(time.Hour)
+		expireRes, err = client.HExpireAtWithOptions(
+			context.Background(),
+			key,
+			expireAt,
+			[]string{"f2"},
+			constants.HasExistingExpiry,
+		)
+		suite.NoError(err)
+		assert.Equal(suite.T(), []models.HashFieldExpireResult{models.HashFieldExpireSet}, expireRes)
+		expireTimeRes, err := client.HExpireTime(context.Background(), key, []string{"f2"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []int64{expireAt.Unix()}, expireTimeRes)
+		expireRes, err = client.HPExpireAt(context.Background(), key, expireAt, []string{"f2"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []models.HashFieldExpireResult{models.HashFieldExpireSet}, expireRes)
+		expireTimeRes, err = client.HPExpireTime(context.Background(), key, []string{"f2"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []int64{expireAt.UnixMilli()}, expireTimeRes)
+
+		// Removing the expiration
+		persistRes, err := client.HPersist(context.Background(), key, fields)
+		suite.NoError(err)
+		assert.Equal(
+			suite.T(),
+			[]models.HashFieldPersistResult{
+				models.HashFieldPersistRemoved,
+				models.HashFieldPersistRemoved,
+				models.HashFieldPersistNoSuchField,
+			},
+			persistRes,
+		)
+		ttlRes, err = client.HTTL(context.Background(), key, []string{"f1"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []int64{-1}, ttlRes)
+
+		// An expiration in the past deletes the field
+		expireRes, err = client.HExpireAt(context.Background(), key, time.Now().Add(-time.Hour), []string{"f2"})
+		suite.NoError(err)
+		assert.Equal(suite.T(), []models.HashFieldExpireResult{models.HashFieldExpireDeleted}, expireRes)
+		exists, err := client.HExists(context.Background(), key, "f2")
+		suite.NoError(err)
+		assert.False(suite.T(), exists)
+
+		// HGETEX sets the expiration of the fields it returns
+		getExRes, err := client.HGetEx(
+			context.Background(),
+			key,
+			[]string{"f1", "nonexistent"},
+			*options.NewHGetExOptions().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+		)
+		suite.NoError(err)
+		assert.Equal(
+			suite.T(),
+			[]models.Result[string]{models.CreateStringResult("v1"), models.CreateNilStringResult()},
+			getExRes,
+		)
+		ttlRes, err = client.HTTL(context.Background(), key, []string{"f1"})
+		suite.NoError(err)
+		assert.True(suite.T(), ttlRes[0] > 0)
+
+		// HSETEX sets the fields only if the conditions are met
+		setEx, err := client.HSetEx(
+			context.Background(),
+			key,
+			map[string]string{"f1": "v3", "f3": "v3"},
+			*options.NewHSetExOptions().SetOnlyIfNoFieldExists().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+		)
+		suite.NoError(err)
+		assert.False(suite.T(), setEx)
+		setEx, err = client.HSetEx(
+			context.Background(),
+			key,
+			map[string]string{"f3": "v3"},
+			*options.NewHSetExOptions().SetOnlyIfKeyExists().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+		)
+		suite.NoError(err)
+		assert.True(suite.T(), setEx)
+		ttlRes, err = client.HTTL(context.Background(), key, []string{"f3"})
+		suite.NoError(err)
+		assert.True(suite.T(), ttlRes[0] > 0)
+
+		// An invalid expiry type is rejected before sending the command
+		_, err = client.HSetEx(
+			context.Background(),
+			key,
+			map[string]string{"f3": "v3"},
+			*options.NewHSetExOptions().SetExpiry(options.NewExpiryPersist()),
+		)
+		suite.Error(err)
+
+		// key exists but holds non hash type value
+		key = uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+		_, err = client.HExpire(context.Background(), key, 10*time.Second, fields)
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestLPushLPop_WithExistingKey() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		list := []string{"value4", "value3", "value2", "value1"}
@@ -7380,6 +7536,44 @@ func (suite *GlideTestSuite) TestObjectRefCount() {
 	})
 }
 
+func (suite *GlideTestSuite) TestMemoryUsage() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := "testKey1_" + uuid.New().String()
+		hashKey := "testKey2_" + uuid.New().String()
+		t := suite.T()
+
+		result, err := client.MemoryUsage(context.Background(), key)
+		require.NoError(t, err)
+		assert.True(t, result.IsNil())
+
+		suite.verifyOK(client.Set(context.Background(), key, "hello"))
+		result, err = client.MemoryUsage(context.Background(), key)
+		require.NoError(t, err)
+		assert.Positive(t, result.Value())
+
+		fields := map[string]string{}
+		for i := 0; i < 100; i++ {
+			fields["field"+strconv.Itoa(i)] = strings.Repeat("value", i)
+		}
+		_, err = client.HSet(context.Background(), hashKey, fields)
+		require.NoError(t, err)
+		result, err = client.MemoryUsageWithOptions(
+			context.Background(),
+			hashKey,
+			*options.NewMemoryUsageOptions().SetSamples(0),
+		)
+		require.NoError(t, err)
+		assert.Greater(t, result.Value(), int64(100*5))
+
+		_, err = client.MemoryUsageWithOptions(
+			context.Background(),
+			hashKey,
+			*options.NewMemoryUsageOptions().SetSamples(-1),
+		)
+		assert.Error(t, err)
+	})
+}
+
 func (suite *GlideTestSuite) TestObjectFreq() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		defaultClient := suite.defaultClient()
@@ -11237,6 +11431,58 @@ func (suite *GlideTestSuite) TestScriptShow() {
 	})
 }
 
+func (suite *GlideTestSuite) TestEvalAndEvalSha() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		suffix := uuid.NewString()[:5]
+		code := fmt.Sprintf("redis.call('SET', KEYS[1], ARGV[1]) return '%s'", suffix)
+
+		// Test a script sent with each call
+		response, err := client.Eval(context.Background(), "return 'Hello'")
+		suite.NoError(err)
+		assert.Equal(suite.T(), "Hello", response)
+
+		scriptOptions := options.NewScriptOptions().WithKeys([]string{key}).WithArgs([]string{"value"})
+		response, err = client.EvalWithOptions(context.Background(), code, *scriptOptions)
+		suite.NoError(err)
+		assert.Equal(suite.T(), suffix, response)
+
+		// Test a script loaded beforehand
+		sha1, err := client.ScriptLoad(context.Background(), code)
+		suite.NoError(err)
+		assert.Len(suite.T(), sha1, 40)
+
+		response, err = client.EvalShaWithOptions(context.Background(), sha1, *scriptOptions)
+		suite.NoError(err)
+		assert.Equal(suite.T(), suffix, response)
+
+		// Test a script missing from the script cache
+		_, err = client.ScriptFlush(context.Background())
+		suite.NoError(err)
+		_, err = client.EvalSha(context.Background(), sha1)
+		assert.IsType(suite.T(), &glide.NoScriptError{}, err)
+
+		if suite.serverVersion < "7.0.0" {
+			return
+		}
+
+		getOptions := options.NewScriptOptions().WithKeys([]string{key})
+		response, err = client.EvalReadOnlyWithOptions(context.Background(), "return redis.call('GET', KEYS[1])", *getOptions)
+		suite.NoError(err)
+		assert.Equal(suite.T(), "value", response)
+
+		// A read-only script fails when it modifies the data
+		_, err = client.EvalReadOnlyWithOptions(context.Background(), code, *scriptOptions)
+		suite.Error(err)
+
+		sha1, err = client.ScriptLoad(context.Background(), "return redis.call('GET', KEYS[1])")
+		suite.NoError(err)
+		response, err = client.EvalShaReadOnlyWithOptions(context.Background(), sha1, *getOptions)
+		suite.NoError(err)
+		assert.Equal(suite.T(), "value", response)
+	})
+}
+
 func (suite *GlideTestSuite) TestRegisterClientNameAndVersion() {
 	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
@@ -11258,3 +11504,60 @@ func (suite *GlideTestSuite) TestRegisterClientNameAndVersion() {
 		assert.Regexp(suite.T(), "lib-ver=unknown|lib-ver=v", infoStr, "lib-ver not found or incorrect")
 	})
 }
+
+func (suite *GlideTestSuite) TestResp2Protocol() {
+	standaloneClient, err := suite.client(suite.defaultClientConfig().WithProtocol(config.RESP2))
+	require.NoError(suite.T(), err)
+	clusterClient, err := suite.clusterClient(suite.defaultClusterClientConfig().WithProtocol(config.RESP2))
+	require.NoError(suite.T(), err)
+
+	// the replies are returned as the same Go types as with RESP3
+	clients := []interfaces.BaseClientCommands{standaloneClient, clusterClient}
+	suite.runWithClients(clients, func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		prefix := "{" + uuid.NewString() + "}"
+		hashKey, setKey, zsetKey, streamKey := prefix+"hash", prefix+"set", prefix+"zset", prefix+"stream"
+
+		_, err := client.HSet(ctx, hashKey, map[string]string{"field1": "value1", "field2": "value2"})
+		suite.NoError(err)
+		hash, err := client.HGetAll(ctx, hashKey)
+		suite.NoError(err)
+		suite.Equal(map[string]string{"field1": "value1", "field2": "value2"}, hash)
+
+		_, err = client.SAdd(ctx, setKey, []string{"a", "b"})
+		suite.NoError(err)
+		members, err := client.SMembers(ctx, setKey)
+		suite.NoError(err)
+		suite.Equal(map[string]struct{}{"a": {}, "b": {}}, members)
+		isMember, err := client.SIsMember(ctx, setKey, "a")
+		suite.NoError(err)
+		suite.True(isMember)
+		areMembers, err := client.SMIsMember(ctx, setKey, []string{"a", "c"})
+		suite.NoError(err)
+		suite.Equal([]bool{true, false}, areMembers)
+
+		_, err = client.ZAdd(ctx, zsetKey, map[string]float64{"one": 1.5, "two": 2})
+		suite.NoError(err)
+		score, err := client.ZScore(ctx, zsetKey, "one")
+		suite.NoError(err)
+		suite.Equal(models.CreateFloat64Result(1.5), score)
+		scores, err := client.ZRangeWithScores(ctx, zsetKey, options.NewRangeByIndexQuery(0, -1))
+		suite.NoError(err)
+		suite.Equal([]models.MemberAndScore{{Member: "one", Score: 1.5}, {Member: "two", Score: 2}}, scores)
+
+		incremented, err := client.IncrByFloat(ctx, prefix+"float", 2.5)
+		suite.NoError(err)
+		suite.Equal(2.5, incremented)
+
+		id, err := client.XAdd(ctx, streamKey, []models.FieldValue{{Field: "field", Value: "value"}})
+		suite.NoError(err)
+		entries, err := client.XRange(
+			ctx,
+			streamKey,
+			options.NewInfiniteStreamBoundary(constants.NegativeInfinity),
+			options.NewInfiniteStreamBoundary(constants.PositiveInfinity),
+		)
+		suite.NoError(err)
+		suite.Equal([]models.StreamEntry{{ID: id, Fields: []models.FieldValue{{Field: "field", Value: "value"}}}}, entries)
+	})
+}
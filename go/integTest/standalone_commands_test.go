@@ -1320,3 +1320,734 @@ func (suite *GlideTestSuite) TestScriptKill() {
 	assert.Error(suite.T(), err)
 	assert.True(suite.T(), strings.Contains(strings.ToLower(err.Error()), "notbusy"))
 }
+
+func (suite *GlideTestSuite) TestAclSetUserGetUserDelUser() {
+	client := suite.defaultClient()
+	t := suite.T()
+	username := "user-" + uuid.NewString()
+	password := "password-" + uuid.NewString()
+
+	rules := options.NewAclRules().
+		Reset().
+		On().
+		AddPassword(password).
+		AddKeyPattern("service:*").
+		AddChannelPattern("events:*").
+		AllowCategory("read").
+		AllowCommand("set")
+	result, err := client.AclSetUser(context.Background(), username, *rules)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	user, err := client.AclGetUser(context.Background(), username)
+	require.NoError(t, err)
+	require.False(t, user.IsNil())
+	assert.Contains(t, user.Value().Flags, "on")
+	assert.Len(t, user.Value().Passwords, 1)
+	assert.Contains(t, user.Value().Commands, "+@read")
+	assert.Contains(t, user.Value().Commands, "+set")
+	assert.Equal(t, "~service:*", user.Value().Keys)
+	assert.Equal(t, "&events:*", user.Value().Channels)
+
+	users, err := client.AclUsers(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, users, username)
+
+	list, err := client.AclList(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(list, "\n"), "user "+username+" on")
+
+	deleted, err := client.AclDelUser(context.Background(), []string{username, "nonexistent-" + uuid.NewString()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	user, err = client.AclGetUser(context.Background(), username)
+	require.NoError(t, err)
+	assert.True(t, user.IsNil())
+
+	// an empty pattern is rejected before sending the command
+	_, err = client.AclSetUser(context.Background(), username, *options.NewAclRules().AddKeyPattern(""))
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestAclSelectors() {
+	suite.SkipIfServerVersionLowerThan("7.0.0", suite.T())
+	client := suite.defaultClient()
+	t := suite.T()
+	username := "user-" + uuid.NewString()
+
+	selector := options.NewAclRules().AddReadKeyPattern("read:*").AllowCommand("get")
+	rules := options.NewAclRules().Reset().On().NoPass().AddSelector(*selector)
+	_, err := client.AclSetUser(context.Background(), username, *rules)
+	require.NoError(t, err)
+	defer client.AclDelUser(context.Background(), []string{username})
+
+	user, err := client.AclGetUser(context.Background(), username)
+	require.NoError(t, err)
+	require.Len(t, user.Value().Selectors, 1)
+	assert.Equal(t, "%R~read:*", user.Value().Selectors[0].Keys)
+	assert.Contains(t, user.Value().Selectors[0].Commands, "+get")
+
+	result, err := client.AclDryRun(context.Background(), username, "get", []string{"read:key"})
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	result, err = client.AclDryRun(context.Background(), username, "set", []string{"read:key", "value"})
+	require.NoError(t, err)
+	assert.NotEqual(t, "OK", result)
+}
+
+func (suite *GlideTestSuite) TestAclCatGenPassWhoami() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	categories, err := client.AclCat(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, categories, "read")
+
+	commands, err := client.AclCatWithCategory(context.Background(), "keyspace")
+	require.NoError(t, err)
+	assert.Contains(t, commands, "del")
+
+	_, err = client.AclCatWithCategory(context.Background(), "nonexistent")
+	assert.Error(t, err)
+
+	password, err := client.AclGenPass(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, password, 64)
+
+	password, err = client.AclGenPassWithBits(context.Background(), 32)
+	require.NoError(t, err)
+	assert.Len(t, password, 8)
+
+	username, err := client.AclWhoami(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "default", username)
+}
+
+func (suite *GlideTestSuite) TestAclLog() {
+	client := suite.defaultClient()
+	t := suite.T()
+	username := "user-" + uuid.NewString()
+	password := "password-" + uuid.NewString()
+
+	result, err := client.AclLogReset(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	_, err = client.AclSetUser(context.Background(), username, *options.NewAclRules().Reset().On().AddPassword(password))
+	require.NoError(t, err)
+	defer client.AclDelUser(context.Background(), []string{username})
+
+	// a failed authentication is logged
+	_, err = client.CustomCommand(context.Background(), []string{"AUTH", username, "wrong-" + password})
+	assert.Error(t, err)
+
+	entries, err := client.AclLog(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	assert.Equal(t, "auth", entries[0].Reason)
+	assert.Equal(t, username, entries[0].Username)
+	assert.Equal(t, int64(1), entries[0].Count)
+
+	entries, err = client.AclLogWithCount(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	_, err = client.AclLogReset(context.Background())
+	require.NoError(t, err)
+	entries, err = client.AclLog(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func (suite *GlideTestSuite) TestClientInfoAndList() {
+	client := suite.defaultClient()
+	t := suite.T()
+	name := "client-" + uuid.NewString()
+
+	id, err := client.ClientId(context.Background())
+	require.NoError(t, err)
+	_, err = client.ClientSetName(context.Background(), name)
+	require.NoError(t, err)
+
+	info, err := client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, id, info.Id)
+	assert.Equal(t, name, info.Name)
+	assert.Contains(t, info.Cmd, "client")
+	assert.NotEmpty(t, info.Addr)
+	assert.Equal(t, info.Raw["addr"], info.Addr)
+
+	clients, err := client.ClientListWithFilter(context.Background(), *options.NewClientFilter().SetIds(id))
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+	assert.Equal(t, name, clients[0].Name)
+
+	clients, err = client.ClientListWithFilter(
+		context.Background(),
+		*options.NewClientFilter().SetType(options.ClientTypeNormal),
+	)
+	require.NoError(t, err)
+	found := false
+	for _, clientInfo := range clients {
+		found = found || clientInfo.Id == id
+	}
+	assert.True(t, found)
+
+	clients, err = client.ClientList(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, clients)
+}
+
+func (suite *GlideTestSuite) TestClientSetInfoNoEvictNoTouch() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	client := suite.defaultClient()
+	t := suite.T()
+
+	result, err := client.ClientSetInfo(context.Background(), options.ClientLibName, "service-lib")
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	result, err = client.ClientSetInfo(context.Background(), options.ClientLibVersion, "1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	info, err := client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "service-lib", info.LibName)
+	assert.Equal(t, "1.2.3", info.LibVer)
+
+	result, err = client.ClientNoEvict(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	info, err = client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, info.Flags, "e")
+	_, err = client.ClientNoEvict(context.Background(), false)
+	require.NoError(t, err)
+
+	result, err = client.ClientNoTouch(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	info, err = client.ClientInfo(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, info.Flags, "T")
+	_, err = client.ClientNoTouch(context.Background(), false)
+	require.NoError(t, err)
+}
+
+func (suite *GlideTestSuite) TestClientKill() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	victim := suite.defaultClient()
+	victimId, err := victim.ClientId(context.Background())
+	require.NoError(t, err)
+
+	killed, err := client.ClientKill(context.Background(), *options.NewClientFilter().SetIds(victimId))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), killed)
+
+	killed, err = client.ClientKill(
+		context.Background(),
+		*options.NewClientFilter().SetIds(victimId).SetType(options.ClientTypeNormal),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), killed)
+
+	victim = suite.defaultClient()
+	victimInfo, err := victim.ClientInfo(context.Background())
+	require.NoError(t, err)
+	result, err := client.ClientKillSimple(context.Background(), victimInfo.Addr)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	_, err = client.ClientKillSimple(context.Background(), victimInfo.Addr)
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClientPauseAndUnblock() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	result, err := client.ClientPauseWithMode(context.Background(), 100*time.Millisecond, options.ClientPauseWrite)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	result, err = client.ClientUnpause(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	blocked := suite.defaultClient()
+	blockedId, err := blocked.ClientId(context.Background())
+	require.NoError(t, err)
+
+	unblocked, err := client.ClientUnblock(context.Background(), blockedId)
+	require.NoError(t, err)
+	assert.False(t, unblocked)
+
+	done := make(chan error)
+	go func() {
+		_, err := blocked.BLPop(context.Background(), []string{uuid.NewString()}, 10*time.Second)
+		done <- err
+	}()
+	require.Eventually(t, func() bool {
+		clients, err := client.ClientListWithFilter(context.Background(), *options.NewClientFilter().SetIds(blockedId))
+		return err == nil && len(clients) == 1 && clients[0].Cmd == "blpop"
+	}, 5*time.Second, 50*time.Millisecond)
+
+	unblocked, err = client.ClientUnblockWithMode(context.Background(), blockedId, options.ClientUnblockError)
+	require.NoError(t, err)
+	assert.True(t, unblocked)
+	assert.ErrorContains(t, <-done, "UNBLOCKED")
+}
+
+func (suite *GlideTestSuite) TestClientTracking() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	redirect, err := client.ClientGetRedir(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), redirect)
+
+	result, err := client.ClientTracking(
+		context.Background(),
+		*options.NewClientTrackingOptions(true).SetOptIn().SetNoLoop(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	result, err = client.ClientCaching(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	info, err := client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, info.HasFlag("on"))
+	assert.True(t, info.HasFlag("optin"))
+	assert.True(t, info.HasFlag("noloop"))
+	assert.Equal(t, int64(0), info.Redirect)
+
+	redirect, err = client.ClientGetRedir(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), redirect)
+
+	result, err = client.ClientTracking(context.Background(), *options.NewClientTrackingOptions(false))
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	info, err = client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, info.HasFlag("off"))
+
+	_, err = client.ClientTracking(context.Background(), *options.NewClientTrackingOptions(true).SetOptIn().SetOptOut())
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClientSideCache() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithClientSideCache(config.NewClientSideCacheConfig(100)))
+	require.NoError(t, err)
+	writer := suite.defaultClient()
+	key := uuid.NewString()
+	hashKey := uuid.NewString()
+
+	_, err = writer.Set(context.Background(), key, "value")
+	require.NoError(t, err)
+	_, err = writer.HSet(context.Background(), hashKey, map[string]string{"field": "value"})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		value, err := client.Get(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "value", value.Value())
+		hash, err := client.HGetAll(context.Background(), hashKey)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"field": "value"}, hash)
+	}
+	stats, err := client.CacheStatistics()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Equal(t, int64(2), stats.Size)
+
+	values, err := client.MGet(context.Background(), []string{key, uuid.NewString()})
+	require.NoError(t, err)
+	assert.Equal(t, "value", values[0].Value())
+	assert.True(t, values[1].IsNil())
+
+	info, err := client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, info.HasFlag("on"))
+
+	// the modification of the key by another client invalidates the cached value
+	_, err = writer.Set(context.Background(), key, "modified")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		value, err := client.Get(context.Background(), key)
+		return err == nil && value.Value() == "modified"
+	}, 5*time.Second, 50*time.Millisecond)
+	stats, err = client.CacheStatistics()
+	require.NoError(t, err)
+	assert.Positive(t, stats.Invalidations)
+
+	_, err = suite.defaultClient().CacheStatistics()
+	assert.Error(t, err)
+}
+
+func (suite *GlideTestSuite) TestClientSideCacheSelect() {
+	t := suite.T()
+	client, err := suite.client(suite.defaultClientConfig().WithClientSideCache(config.NewClientSideCacheConfig(100)))
+	require.NoError(t, err)
+	key := uuid.NewString()
+
+	for _, index := range []int64{1, 0} {
+		_, err = client.Select(context.Background(), index)
+		require.NoError(t, err)
+		_, err = client.Set(context.Background(), key, "db"+strconv.FormatInt(index, 10))
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		value, err := client.Get(context.Background(), key)
+		return err == nil && value.Value() == "db0"
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the value cached for the other database isn't returned once another database is selected
+	_, err = client.Select(context.Background(), 1)
+	require.NoError(t, err)
+	value, err := client.Get(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "db1", value.Value())
+	values, err := client.MGet(context.Background(), []string{key})
+	require.NoError(t, err)
+	assert.Equal(t, "db1", values[0].Value())
+}
+
+func (suite *GlideTestSuite) TestClientSideCacheBroadcastMode() {
+	t := suite.T()
+	prefix := uuid.NewString() + ":"
+	client, err := suite.client(suite.defaultClientConfig().WithClientSideCache(
+		config.NewClientSideCacheConfig(100).WithBroadcastMode(prefix),
+	))
+	require.NoError(t, err)
+	writer := suite.defaultClient()
+	cachedKey := prefix + "key"
+	otherKey := uuid.NewString()
+
+	for _, key := range []string{cachedKey, otherKey} {
+		_, err = writer.Set(context.Background(), key, "value")
+		require.NoError(t, err)
+		_, err = client.Get(context.Background(), key)
+		require.NoError(t, err)
+	}
+	stats, err := client.CacheStatistics()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Size)
+
+	info, err := client.ClientTrackingInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, info.HasFlag("bcast"))
+	assert.Equal(t, []string{prefix}, info.Prefixes)
+
+	_, err = writer.Set(context.Background(), cachedKey, "modified")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		value, err := client.Get(context.Background(), cachedKey)
+		return err == nil && value.Value() == "modified"
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func (suite *GlideTestSuite) TestSlowLogCommands() {
+	client := suite.defaultClient()
+	t := suite.T()
+	key := uuid.NewString()
+
+	_, err := client.ConfigSet(context.Background(), map[string]string{"slowlog-log-slower-than": "0"})
+	require.NoError(t, err)
+	defer client.ConfigSet(context.Background(), map[string]string{"slowlog-log-slower-than": "10000"})
+
+	result, err := client.SlowLogReset(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+
+	_, err = client.Set(context.Background(), key, "value")
+	require.NoError(t, err)
+	_, err = client.ClientSetName(context.Background(), "slowlog-client")
+	require.NoError(t, err)
+
+	length, err := client.SlowLogLen(context.Background())
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, length, int64(2))
+
+	entries, err := client.SlowLogGetWithCount(context.Background(), -1)
+	require.NoError(t, err)
+	var setEntry *models.SlowLogEntry
+	for i := range entries {
+		if entries[i].Args[0] == "SET" && entries[i].Args[1] == key {
+			setEntry = &entries[i]
+		}
+	}
+	require.NotNil(t, setEntry)
+	assert.Equal(t, []string{"SET", key, "value"}, setEntry.Args)
+	assert.Positive(t, setEntry.Timestamp)
+	assert.GreaterOrEqual(t, setEntry.Duration, int64(0))
+	assert.NotEmpty(t, setEntry.ClientAddr)
+
+	entries, err = client.SlowLogGetWithCount(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	entries, err = client.SlowLogGet(context.Background())
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(entries), 10)
+}
+
+func (suite *GlideTestSuite) TestLatencyCommands() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	_, err := client.LatencyReset(context.Background())
+	require.NoError(t, err)
+
+	events, err := client.LatencyLatest(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	samples, err := client.LatencyHistory(context.Background(), "command")
+	require.NoError(t, err)
+	assert.Empty(t, samples)
+
+	report, err := client.LatencyDoctor(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, report)
+
+	_, err = client.LatencyGraph(context.Background(), "command")
+	assert.Error(t, err)
+
+	count, err := client.LatencyReset(context.Background(), "command")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	suite.SkipIfServerVersionLowerThan("7.0.0", t)
+	_, err = client.Set(context.Background(), uuid.NewString(), "value")
+	require.NoError(t, err)
+	histograms, err := client.LatencyHistogram(context.Background(), "set", "unknown-command")
+	require.NoError(t, err)
+	require.Contains(t, histograms, "set")
+	assert.NotContains(t, histograms, "unknown-command")
+	assert.Positive(t, histograms["set"].Calls)
+	assert.NotEmpty(t, histograms["set"].Histogram)
+}
+
+func (suite *GlideTestSuite) TestMemoryCommands() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	suite.verifyOK(client.Set(context.Background(), uuid.NewString(), "value"))
+	stats, err := client.MemoryStats(context.Background())
+	require.NoError(t, err)
+	assert.Positive(t, stats.PeakAllocated)
+	assert.Positive(t, stats.TotalAllocated)
+	assert.Positive(t, stats.KeysCount)
+	assert.Positive(t, stats.DatasetBytes)
+	assert.Positive(t, stats.Fragmentation)
+	assert.Contains(t, stats.Databases, int64(0))
+	assert.Contains(t, stats.Raw, "overhead.total")
+
+	report, err := client.MemoryDoctor(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, report)
+
+	mallocStats, err := client.MemoryMallocStats(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, mallocStats)
+
+	result, err := client.MemoryPurge(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", result)
+}
+
+func (suite *GlideTestSuite) TestRole() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	role, err := client.Role(context.Background())
+	require.NoError(t, err)
+	assert.True(t, role.IsPrimary())
+	assert.False(t, role.IsReplica())
+	assert.GreaterOrEqual(t, role.ReplicationOffset, int64(0))
+	assert.NotNil(t, role.Replicas)
+}
+
+func (suite *GlideTestSuite) TestSaveAndBgSave() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	suite.verifyOK(client.Save(context.Background()))
+
+	result, err := client.BgSaveWithOptions(context.Background(), *options.NewBgSaveOptions().SetSchedule())
+	require.NoError(t, err)
+	assert.Contains(t, result, "Background saving")
+
+	result, err = client.BgRewriteAof(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, result, "append only file rewriting")
+}
+
+func (suite *GlideTestSuite) TestWaitAof() {
+	suite.SkipIfServerVersionLowerThan("7.2.0", suite.T())
+	client := suite.defaultClient()
+	t := suite.T()
+
+	suite.verifyOK(client.Set(context.Background(), uuid.NewString(), "value"))
+	result, err := client.WaitAof(context.Background(), 0, 0, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), result.Local)
+	assert.Equal(t, int64(0), result.Replicas)
+}
+
+func (suite *GlideTestSuite) TestSwapDb() {
+	client := suite.defaultClient()
+	t := suite.T()
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	suite.verifyOK(client.SwapDb(context.Background(), 0, 1))
+	result, err := client.Get(context.Background(), key)
+	require.NoError(t, err)
+	assert.True(t, result.IsNil())
+
+	suite.verifyOK(client.SwapDb(context.Background(), 0, 1))
+	result, err = client.Get(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestFailOverAndShutDownOptions() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	// FORCE requires a target and a timeout
+	_, err := client.FailOverWithOptions(context.Background(), *options.NewFailOverOptions().SetForce())
+	assert.Error(t, err)
+	_, err = client.FailOverWithOptions(context.Background(), *options.NewFailOverOptions().SetAbort())
+	assert.ErrorContains(t, err, "No failover in progress")
+
+	_, err = client.ShutDownWithOptions(context.Background(), *options.NewShutDownOptions().SetAbort().SetNow())
+	assert.Error(t, err)
+	if suite.serverVersion >= "7.0.0" {
+		_, err = client.ShutDownWithOptions(context.Background(), *options.NewShutDownOptions().SetAbort())
+		assert.ErrorContains(t, err, "No shutdown in progress")
+	}
+}
+
+func (suite *GlideTestSuite) TestCommandIntrospection() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	count, err := client.CommandCount(context.Background())
+	require.NoError(t, err)
+	assert.Greater(t, count, int64(100))
+
+	infos, err := client.CommandInfo(context.Background(), "get", "set", "unknowncommand")
+	require.NoError(t, err)
+	assert.Len(t, infos, 2)
+	get := infos["get"]
+	assert.Equal(t, "get", get.Name)
+	assert.Equal(t, int64(2), get.Arity)
+	assert.Contains(t, get.Flags, "readonly")
+	assert.Equal(t, int64(1), get.FirstKey)
+	assert.Equal(t, int64(1), get.LastKey)
+	assert.Equal(t, int64(1), get.Step)
+	assert.Contains(t, get.AclCategories, "@read")
+	assert.Contains(t, infos["set"].Flags, "write")
+
+	keys, err := client.CommandGetKeys(context.Background(), []string{"MSET", "k1", "v1", "k2", "v2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"k1", "k2"}, keys)
+	_, err = client.CommandGetKeys(context.Background(), []string{"PING"})
+	assert.Error(t, err)
+
+	suite.SkipIfServerVersionLowerThan("7.0.0", t)
+
+	infos, err = client.CommandInfo(context.Background(), "get", "config")
+	require.NoError(t, err)
+	require.Len(t, infos["get"].KeySpecs, 1)
+	keySpec := infos["get"].KeySpecs[0]
+	assert.Contains(t, keySpec.Flags, "RO")
+	assert.Equal(t, "index", keySpec.BeginSearch.Type)
+	assert.Equal(t, int64(1), keySpec.BeginSearch.Index)
+	assert.Equal(t, "range", keySpec.FindKeys.Type)
+	assert.Equal(t, int64(0), keySpec.FindKeys.LastKey)
+	assert.NotEmpty(t, infos["config"].Subcommands)
+
+	infos, err = client.CommandInfo(context.Background(), "xread")
+	require.NoError(t, err)
+	require.Len(t, infos["xread"].KeySpecs, 1)
+	assert.Equal(t, "keyword", infos["xread"].KeySpecs[0].BeginSearch.Type)
+	assert.Equal(t, "STREAMS", infos["xread"].KeySpecs[0].BeginSearch.Keyword)
+
+	docs, err := client.CommandDocs(context.Background(), "set", "config")
+	require.NoError(t, err)
+	set := docs["set"]
+	assert.Equal(t, "string", set.Group)
+	assert.NotEmpty(t, set.Summary)
+	assert.NotEmpty(t, set.History)
+	require.NotEmpty(t, set.Arguments)
+	assert.Equal(t, "key", set.Arguments[0].Type)
+	assert.Equal(t, int64(0), set.Arguments[0].KeySpecIndex)
+	assert.Equal(t, int64(-1), set.Arguments[1].KeySpecIndex)
+	assert.Contains(t, docs["config"].Subcommands, "config|set")
+
+	names, err := client.CommandList(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, names, int(count))
+	names, err = client.CommandListWithOptions(
+		context.Background(),
+		*options.NewCommandListOptions().SetFilterByPattern("xr*"),
+	)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"xrange", "xread", "xreadgroup", "xrevrange"}, names)
+	names, err = client.CommandListWithOptions(
+		context.Background(),
+		*options.NewCommandListOptions().SetFilterByAclCategory("hyperloglog"),
+	)
+	require.NoError(t, err)
+	assert.Contains(t, names, "pfadd")
+
+	keysWithFlags, err := client.CommandGetKeysAndFlags(
+		context.Background(),
+		[]string{"LMOVE", "src", "dst", "LEFT", "RIGHT"},
+	)
+	require.NoError(t, err)
+	require.Len(t, keysWithFlags, 2)
+	assert.Equal(t, "src", keysWithFlags[0].Key)
+	assert.Contains(t, keysWithFlags[0].Flags, "RW")
+	assert.Contains(t, keysWithFlags[0].Flags, "delete")
+	assert.Equal(t, "dst", keysWithFlags[1].Key)
+	assert.Contains(t, keysWithFlags[1].Flags, "insert")
+}
+
+func (suite *GlideTestSuite) TestModuleCommands() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	modules, err := client.ModuleList(context.Background())
+	require.NoError(t, err)
+	for _, module := range modules {
+		assert.NotEmpty(t, module.Name)
+		assert.Positive(t, module.Version)
+	}
+
+	_, err = client.ModuleLoad(context.Background(), "/nonexistent/module.so", []string{"arg"})
+	assert.Error(t, err)
+	_, err = client.ModuleUnload(context.Background(), "nonexistentmodule")
+	assert.Error(t, err)
+
+	suite.SkipIfServerVersionLowerThan("7.0.0", t)
+	_, err = client.ModuleLoadEx(
+		context.Background(),
+		"/nonexistent/module.so",
+		*options.NewModuleLoadExOptions().AddConfig("name", "value").SetArgs("arg"),
+	)
+	assert.Error(t, err)
+}
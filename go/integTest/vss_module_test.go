@@ -4,9 +4,18 @@ package integTest
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/ft"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -26,3 +35,234 @@ func (suite *GlideTestSuite) TestModuleVerifyVssLoaded() {
 		assert.True(suite.T(), strings.Contains(value, "# search_index_stats"))
 	}
 }
+
+// Encodes a vector of FLOAT32 elements in the binary form expected by the search module.
+func vectorToString(vector []float32) string {
+	buf := make([]byte, 4*len(vector))
+	for i, value := range vector {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(value))
+	}
+	return string(buf)
+}
+
+func (suite *GlideTestSuite) TestModuleFtCreateAndDropIndex() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	index := uuid.NewString()
+
+	schema := options.NewFtSchema().
+		AddField(options.NewFtTextField("title")).
+		AddField(options.NewFtNumericField("price").SetAlias("cost")).
+		AddField(options.NewFtTagField("category").SetSeparator("|").SetCaseSensitive(true)).
+		AddField(options.NewFtVectorFieldHnsw("vec", options.FtDistanceMetricL2, 2).
+			SetNumberOfEdges(16).
+			SetVectorsExaminedOnConstruction(200).
+			SetVectorsExaminedOnRuntime(10).
+			SetInitialCapacity(100)).
+		AddField(options.NewFtVectorFieldFlat("vec2", options.FtDistanceMetricCosine, 4))
+	res, err := ft.CreateWithOptions(
+		ctx,
+		client,
+		index,
+		*schema,
+		*options.NewFtCreateOptions().SetDataType(options.FtDataTypeHash).SetPrefixes("{" + index + "}:"),
+	)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	indexes, err := ft.List(ctx, client)
+	suite.NoError(err)
+	suite.Contains(indexes, index)
+
+	info, err := ft.Info(ctx, client, index)
+	suite.NoError(err)
+	suite.Equal(index, info.IndexName)
+	suite.Equal("HASH", info.KeyType)
+	suite.Equal([]string{"{" + index + "}:"}, info.Prefixes)
+	suite.Len(info.Attributes, 5)
+	suite.Equal("price", info.Attributes[1].Identifier)
+	suite.Equal("cost", info.Attributes[1].Attribute)
+	suite.Equal("NUMERIC", info.Attributes[1].Type)
+
+	// creating an index with the same name fails
+	_, err = ft.Create(ctx, client, index, *options.NewFtSchema().AddField(options.NewFtTextField("title")))
+	suite.Error(err)
+
+	res, err = ft.DropIndex(ctx, client, index)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	_, err = ft.DropIndex(ctx, client, index)
+	suite.Error(err)
+}
+
+func (suite *GlideTestSuite) TestModuleFtCreateInvalidSchema() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+
+	_, err := ft.Create(ctx, client, uuid.NewString(), *options.NewFtSchema())
+	suite.Error(err)
+
+	_, err = ft.Create(
+		ctx,
+		client,
+		uuid.NewString(),
+		*options.NewFtSchema().
+			AddField(options.NewFtVectorFieldFlat("vec", options.FtDistanceMetricL2, 2).SetNumberOfEdges(4)),
+	)
+	suite.Error(err)
+
+	_, err = ft.Create(
+		ctx,
+		client,
+		uuid.NewString(),
+		*options.NewFtSchema().AddField(options.NewFtTagField("tag").SetSeparator("||")),
+	)
+	suite.Error(err)
+}
+
+func (suite *GlideTestSuite) TestModuleFtSearch() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	index := uuid.NewString()
+	prefix := "{" + index + "}:"
+
+	schema := options.NewFtSchema().AddField(options.NewFtVectorFieldHnsw("vec", options.FtDistanceMetricL2, 2))
+	_, err := ft.CreateWithOptions(ctx, client, index, *schema, *options.NewFtCreateOptions().SetPrefixes(prefix))
+	suite.NoError(err)
+	defer ft.DropIndex(ctx, client, index)
+
+	_, err = client.HSet(ctx, prefix+"0", map[string]string{"vec": vectorToString([]float32{0, 0})})
+	suite.NoError(err)
+	_, err = client.HSet(ctx, prefix+"1", map[string]string{"vec": vectorToString([]float32{1, 1})})
+	suite.NoError(err)
+	// let the index be updated
+	time.Sleep(time.Second)
+
+	result, err := ft.SearchWithOptions(
+		ctx,
+		client,
+		index,
+		"*=>[KNN 2 @vec $query_vec]",
+		*options.NewFtSearchOptions().AddParam("query_vec", vectorToString([]float32{0, 0})).SetLimit(0, 1),
+	)
+	suite.NoError(err)
+	suite.Equal(int64(2), result.TotalResults)
+	suite.Len(result.Documents, 1)
+	suite.Equal(prefix+"0", result.Documents[0].Key)
+	suite.Equal(
+		map[string]string{"vec": vectorToString([]float32{0, 0}), "__vec_score": "0"},
+		result.Documents[0].Fields,
+	)
+
+	result, err = ft.SearchWithOptions(
+		ctx,
+		client,
+		index,
+		"*=>[KNN 2 @vec $query_vec]",
+		*options.NewFtSearchOptions().
+			AddParam("query_vec", vectorToString([]float32{0, 0})).
+			AddReturnFieldWithAlias("__vec_score", "score").
+			SetTimeout(time.Second),
+	)
+	suite.NoError(err)
+	suite.Equal(
+		models.FtSearchResult{
+			TotalResults: 2,
+			Documents: []models.FtSearchDocument{
+				{Key: prefix + "0", Score: models.CreateNilFloat64Result(), Fields: map[string]string{"score": "0"}},
+				{Key: prefix + "1", Score: models.CreateNilFloat64Result(), Fields: map[string]string{"score": "2"}},
+			},
+		},
+		result,
+	)
+
+	profile, err := ft.Profile(
+		ctx,
+		client,
+		index,
+		*options.NewFtProfileSearchOptions(
+			"*=>[KNN 2 @vec $query_vec]",
+			*options.NewFtSearchOptions().AddParam("query_vec", vectorToString([]float32{0, 0})),
+		),
+	)
+	suite.NoError(err)
+	suite.Equal(int64(2), profile.SearchResult.TotalResults)
+	suite.NotEmpty(profile.Profile)
+
+	explain, err := ft.Explain(ctx, client, index, "*=>[KNN 2 @vec $query_vec]")
+	suite.NoError(err)
+	suite.Contains(explain, "vec")
+}
+
+func (suite *GlideTestSuite) TestModuleFtAggregate() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	index := uuid.NewString()
+	prefix := "{" + index + "}:"
+
+	schema := options.NewFtSchema().
+		AddField(options.NewFtTagField("condition")).
+		AddField(options.NewFtNumericField("price"))
+	_, err := ft.CreateWithOptions(ctx, client, index, *schema, *options.NewFtCreateOptions().SetPrefixes(prefix))
+	suite.NoError(err)
+	defer ft.DropIndex(ctx, client, index)
+
+	for i, doc := range []map[string]string{
+		{"condition": "new", "price": "10"},
+		{"condition": "new", "price": "20"},
+		{"condition": "used", "price": "5"},
+	} {
+		_, err = client.HSet(ctx, prefix+strconv.Itoa(i), doc)
+		suite.NoError(err)
+	}
+	time.Sleep(time.Second)
+
+	rows, err := ft.AggregateWithOptions(
+		ctx,
+		client,
+		index,
+		"*",
+		*options.NewFtAggregateOptions().
+			SetLoadFields("__key").
+			AddClause(options.NewFtAggregateGroupBy("@condition").
+				AddReducer(*options.NewFtAggregateReducer("COUNT").SetName("bicycles"))).
+			AddClause(options.NewFtAggregateSortBy().AddProperty("@condition", options.ASC)),
+	)
+	suite.NoError(err)
+	suite.Len(rows, 2)
+	suite.Equal("new", fmt.Sprint(rows[0]["condition"]))
+	suite.Equal("2", fmt.Sprint(rows[0]["bicycles"]))
+	suite.Equal("used", fmt.Sprint(rows[1]["condition"]))
+	suite.Equal("1", fmt.Sprint(rows[1]["bicycles"]))
+}
+
+func (suite *GlideTestSuite) TestModuleFtAliases() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	index := uuid.NewString()
+	alias := "alias-" + uuid.NewString()
+
+	_, err := ft.Create(ctx, client, index, *options.NewFtSchema().AddField(options.NewFtTextField("title")))
+	suite.NoError(err)
+	defer ft.DropIndex(ctx, client, index)
+
+	res, err := ft.AliasAdd(ctx, client, alias, index)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	aliases, err := ft.AliasList(ctx, client)
+	suite.NoError(err)
+	suite.Equal(index, aliases[alias])
+
+	res, err = ft.AliasUpdate(ctx, client, alias, index)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	res, err = ft.AliasDel(ctx, client, alias)
+	suite.NoError(err)
+	suite.Equal("OK", res)
+
+	_, err = ft.AliasDel(ctx, client, alias)
+	suite.Error(err)
+}
@@ -0,0 +1,100 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertAclUser converts an ACL GETUSER reply. A `nil` reply means the user doesn't exist.
+func ConvertAclUser(data any) (models.Result[models.AclUser], error) {
+	if data == nil {
+		return models.CreateNilResultOf[models.AclUser](), nil
+	}
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.CreateNilResultOf[models.AclUser](), err
+	}
+	user := models.AclUser{
+		Flags:     convertToStringSlice(fields["flags"]),
+		Passwords: convertToStringSlice(fields["passwords"]),
+		Commands:  joinAclPatterns(fields["commands"]),
+		Keys:      joinAclPatterns(fields["keys"]),
+		Channels:  joinAclPatterns(fields["channels"]),
+		Selectors: []models.AclSelector{},
+	}
+	if selectors, ok := fields["selectors"].([]any); ok {
+		for _, item := range selectors {
+			selector, err := ConvertToStringAnyMap(item)
+			if err != nil {
+				return models.CreateNilResultOf[models.AclUser](), err
+			}
+			user.Selectors = append(user.Selectors, models.AclSelector{
+				Commands: joinAclPatterns(selector["commands"]),
+				Keys:     joinAclPatterns(selector["keys"]),
+				Channels: joinAclPatterns(selector["channels"]),
+			})
+		}
+	}
+	return models.CreateResultOf(user), nil
+}
+
+// ConvertAclLogEntries converts an ACL LOG reply, which is an array of entries.
+func ConvertAclLogEntries(data any) ([]models.AclLogEntry, error) {
+	if data == nil {
+		return []models.AclLogEntry{}, nil
+	}
+	arr, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+	entries := make([]models.AclLogEntry, 0, len(arr))
+	for _, item := range arr {
+		fields, err := ConvertToStringAnyMap(item)
+		if err != nil {
+			return nil, err
+		}
+		entry := models.AclLogEntry{}
+		ReadValue(fields, "reason", &entry.Reason)
+		ReadValue(fields, "context", &entry.Context)
+		ReadValue(fields, "object", &entry.Object)
+		ReadValue(fields, "username", &entry.Username)
+		ReadValue(fields, "client-info", &entry.ClientInfo)
+		entry.Count, _ = ConvertToInt64(fields["count"])
+		entry.AgeSeconds, _ = convertToFloat64(fields["age-seconds"])
+		entry.EntryId, _ = ConvertToInt64(fields["entry-id"])
+		entry.TimestampCreated, _ = ConvertToInt64(fields["timestamp-created"])
+		entry.TimestampLastUpdated, _ = ConvertToInt64(fields["timestamp-last-updated"])
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Converts an array or a set of strings into a slice, ignoring the other types.
+func convertToStringSlice(data any) []string {
+	result := []string{}
+	switch value := data.(type) {
+	case []any:
+		for _, item := range value {
+			if str, ok := item.(string); ok {
+				result = append(result, str)
+			}
+		}
+	case map[string]struct{}:
+		for item := range value {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Key and channel patterns are sent as a string since Valkey 7.0, and as an array of patterns before.
+func joinAclPatterns(data any) string {
+	if str, ok := data.(string); ok {
+		return str
+	}
+	return strings.Join(convertToStringSlice(data), " ")
+}
@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertBloomBool converts the reply of BF.ADD and BF.EXISTS, which is `1` or `0`.
+func ConvertBloomBool(data any) (bool, error) {
+	switch value := data.(type) {
+	case int64:
+		return value == 1, nil
+	case bool:
+		return value, nil
+	default:
+		return false, fmt.Errorf("unexpected type received: %T, expected: int64 or bool", data)
+	}
+}
+
+// ConvertBloomBools converts the replies of BF.MADD, BF.MEXISTS and BF.INSERT, an array with a `1` or a `0` per item.
+// BF.MADD and BF.INSERT reply with an error in place of an item which can't be added, e.g. when a non-scaling filter
+// is full, which is returned as the error of the conversion.
+func ConvertBloomBools(data any) ([]bool, error) {
+	arr, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+	result := make([]bool, 0, len(arr))
+	for _, item := range arr {
+		if err, ok := item.(error); ok {
+			return nil, err
+		}
+		value, err := ConvertBloomBool(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// ConvertBloomInfo converts the reply of BF.INFO, an array of pairs of the name and the value of each property. The
+// expansion rate is nil and the tightening ratio and the max scaled capacity are omitted for a non-scaling filter.
+func ConvertBloomInfo(data any) (models.BloomInfo, error) {
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.BloomInfo{}, err
+	}
+	info := models.BloomInfo{Expansion: models.CreateNilInt64Result()}
+	info.Capacity, _ = ConvertToInt64(fields["Capacity"])
+	info.Size, _ = ConvertToInt64(fields["Size"])
+	info.NumberOfFilters, _ = ConvertToInt64(fields["Number of filters"])
+	info.NumberOfItems, _ = ConvertToInt64(fields["Number of items inserted"])
+	info.ErrorRate, _ = convertToFloat64(fields["Error rate"])
+	if fields["Expansion rate"] != nil {
+		expansion, err := ConvertToInt64(fields["Expansion rate"])
+		if err != nil {
+			return models.BloomInfo{}, err
+		}
+		info.Expansion = models.CreateInt64Result(expansion)
+	}
+	info.TighteningRatio, _ = convertToFloat64(fields["Tightening ratio"])
+	info.MaxScaledCapacity, _ = ConvertToInt64(fields["Max scaled capacity"])
+	return info, nil
+}
+
+// ConvertBloomBatchBools converts the same replies as [ConvertBloomBools] in a batch, where the error of an item which
+// can't be added is the response of the command instead of failing the whole batch.
+func ConvertBloomBatchBools(data any) (any, error) {
+	arr, _ := data.([]any)
+	for _, item := range arr {
+		if err, ok := item.(error); ok {
+			return err, nil
+		}
+	}
+	return ConvertBloomBools(data)
+}
@@ -0,0 +1,91 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertClientList parses the text returned by CLIENT LIST, with a line per client.
+func ConvertClientList(data any) ([]models.ClientInfo, error) {
+	text, ok := data.(string)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: string", data)
+	}
+
+	clients := []models.ClientInfo{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		clients = append(clients, parseClientInfo(line))
+	}
+	return clients, nil
+}
+
+// ConvertClientInfo parses the text returned by CLIENT INFO.
+func ConvertClientInfo(data any) (models.ClientInfo, error) {
+	text, ok := data.(string)
+	if !ok {
+		return models.ClientInfo{}, fmt.Errorf("unexpected type received: %T, expected: string", data)
+	}
+	return parseClientInfo(strings.TrimSpace(text)), nil
+}
+
+// Parses a line of space-separated `field=value` pairs.
+func parseClientInfo(line string) models.ClientInfo {
+	info := models.ClientInfo{Raw: map[string]string{}}
+	for _, pair := range strings.Fields(line) {
+		field, value, _ := strings.Cut(pair, "=")
+		info.Raw[field] = value
+	}
+	readInt := func(field string) int64 {
+		value, _ := strconv.ParseInt(info.Raw[field], 10, 64)
+		return value
+	}
+
+	info.Id = readInt("id")
+	info.Addr = info.Raw["addr"]
+	info.LAddr = info.Raw["laddr"]
+	info.Fd = readInt("fd")
+	info.Name = info.Raw["name"]
+	info.Age = readInt("age")
+	info.Idle = readInt("idle")
+	info.Flags = info.Raw["flags"]
+	info.Db = readInt("db")
+	info.Sub = readInt("sub")
+	info.PSub = readInt("psub")
+	info.SSub = readInt("ssub")
+	info.Multi = readInt("multi")
+	info.Watch = readInt("watch")
+	info.QBuf = readInt("qbuf")
+	info.OMem = readInt("omem")
+	info.TotMem = readInt("tot-mem")
+	info.Events = info.Raw["events"]
+	info.Cmd = info.Raw["cmd"]
+	info.User = info.Raw["user"]
+	info.Redir = readInt("redir")
+	info.Resp = readInt("resp")
+	info.LibName = info.Raw["lib-name"]
+	info.LibVer = info.Raw["lib-ver"]
+	return info
+}
+
+// ConvertClientTrackingInfo converts the reply of CLIENT TRACKINGINFO.
+func ConvertClientTrackingInfo(data any) (models.ClientTrackingInfo, error) {
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.ClientTrackingInfo{}, err
+	}
+	info := models.ClientTrackingInfo{
+		Flags:    convertToStringSlice(fields["flags"]),
+		Prefixes: convertToStringSlice(fields["prefixes"]),
+	}
+	info.Redirect, _ = ConvertToInt64(fields["redirect"])
+	return info, nil
+}
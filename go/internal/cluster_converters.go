@@ -0,0 +1,293 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertClusterNodes parses the text returned by CLUSTER NODES and CLUSTER REPLICAS. CLUSTER NODES returns a single
+// string with a line per node, while CLUSTER REPLICAS returns an array of lines.
+func ConvertClusterNodes(data any) ([]models.ClusterNode, error) {
+	var lines []string
+	switch value := data.(type) {
+	case string:
+		lines = strings.Split(value, "\n")
+	case []any:
+		for _, item := range value {
+			line, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type received: %T, expected: string", item)
+			}
+			lines = append(lines, line)
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("unexpected type received: %T, expected: string or []any", data)
+	}
+
+	nodes := []models.ClusterNode{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		node, err := parseClusterNode(line)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// Parses a line in the format:
+// `<id> <ip:port@cport[,hostname]> <flags> <primary> <ping-sent> <pong-recv> <config-epoch> <link-state> <slot> ...`
+func parseClusterNode(line string) (models.ClusterNode, error) {
+	parts := strings.Fields(line)
+	if len(parts) < 8 {
+		return models.ClusterNode{}, fmt.Errorf("unexpected cluster node format: %q", line)
+	}
+
+	node := models.ClusterNode{
+		Id:             parts[0],
+		Flags:          strings.Split(parts[2], ","),
+		LinkState:      parts[7],
+		Slots:          []models.SlotRange{},
+		MigratingSlots: map[int64]string{},
+		ImportingSlots: map[int64]string{},
+	}
+
+	address, hostname, _ := strings.Cut(parts[1], ",")
+	node.Hostname = hostname
+	address, busPort, _ := strings.Cut(address, "@")
+	node.Address = address
+	node.ClusterBusPort, _ = strconv.ParseInt(busPort, 10, 64)
+	if idx := strings.LastIndex(address, ":"); idx >= 0 {
+		node.Host = address[:idx]
+		node.Port, _ = strconv.ParseInt(address[idx+1:], 10, 64)
+	}
+
+	node.Role = models.PrimaryRole
+	if node.HasFlag("slave") {
+		node.Role = models.ReplicaRole
+	}
+	if parts[3] != "-" {
+		node.PrimaryId = parts[3]
+	}
+	node.PingSent, _ = strconv.ParseInt(parts[4], 10, 64)
+	node.PongReceived, _ = strconv.ParseInt(parts[5], 10, 64)
+	node.ConfigEpoch, _ = strconv.ParseInt(parts[6], 10, 64)
+
+	for _, slot := range parts[8:] {
+		if strings.HasPrefix(slot, "[") {
+			// slots in migration: `[slot->-target-id]` or `[slot-<-source-id]`
+			migration := strings.Trim(slot, "[]")
+			if slotNum, target, ok := strings.Cut(migration, "->-"); ok {
+				num, err := strconv.ParseInt(slotNum, 10, 64)
+				if err != nil {
+					return models.ClusterNode{}, fmt.Errorf("unexpected slot format: %q", slot)
+				}
+				node.MigratingSlots[num] = target
+			} else if slotNum, source, ok := strings.Cut(migration, "-<-"); ok {
+				num, err := strconv.ParseInt(slotNum, 10, 64)
+				if err != nil {
+					return models.ClusterNode{}, fmt.Errorf("unexpected slot format: %q", slot)
+				}
+				node.ImportingSlots[num] = source
+			}
+			continue
+		}
+		start, end, isRange := strings.Cut(slot, "-")
+		if !isRange {
+			end = start
+		}
+		startNum, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return models.ClusterNode{}, fmt.Errorf("unexpected slot format: %q", slot)
+		}
+		endNum, err := strconv.ParseInt(end, 10, 64)
+		if err != nil {
+			return models.ClusterNode{}, fmt.Errorf("unexpected slot format: %q", slot)
+		}
+		node.Slots = append(node.Slots, models.SlotRange{Start: startNum, End: endNum})
+	}
+	return node, nil
+}
+
+// ConvertClusterInfo parses the `field:value` lines returned by CLUSTER INFO.
+func ConvertClusterInfo(data any) (models.ClusterInfo, error) {
+	text, ok := data.(string)
+	if !ok {
+		return models.ClusterInfo{}, fmt.Errorf("unexpected type received: %T, expected: string", data)
+	}
+
+	info := models.ClusterInfo{Raw: map[string]string{}}
+	for _, line := range strings.Split(text, "\n") {
+		field, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if ok {
+			info.Raw[field] = value
+		}
+	}
+	readInt := func(field string) int64 {
+		value, _ := strconv.ParseInt(info.Raw[field], 10, 64)
+		return value
+	}
+	info.State = info.Raw["cluster_state"]
+	info.SlotsAssigned = readInt("cluster_slots_assigned")
+	info.SlotsOk = readInt("cluster_slots_ok")
+	info.SlotsPfail = readInt("cluster_slots_pfail")
+	info.SlotsFail = readInt("cluster_slots_fail")
+	info.KnownNodes = readInt("cluster_known_nodes")
+	info.Size = readInt("cluster_size")
+	info.CurrentEpoch = readInt("cluster_current_epoch")
+	info.MyEpoch = readInt("cluster_my_epoch")
+	info.StatsMessagesSent = readInt("cluster_stats_messages_sent")
+	info.StatsMessagesReceived = readInt("cluster_stats_messages_received")
+	return info, nil
+}
+
+// ConvertClusterShards converts the reply of CLUSTER SHARDS.
+func ConvertClusterShards(data any) ([]models.ClusterShard, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	shards := make([]models.ClusterShard, 0, len(arr))
+	for _, item := range arr {
+		fields, err := ConvertToStringAnyMap(item)
+		if err != nil {
+			return nil, err
+		}
+		shard := models.ClusterShard{Slots: []models.SlotRange{}, Nodes: []models.ClusterShardNode{}}
+		slots, _ := fields["slots"].([]any)
+		for i := 0; i+1 < len(slots); i += 2 {
+			start, err := ConvertToInt64(slots[i])
+			if err != nil {
+				return nil, err
+			}
+			end, err := ConvertToInt64(slots[i+1])
+			if err != nil {
+				return nil, err
+			}
+			shard.Slots = append(shard.Slots, models.SlotRange{Start: start, End: end})
+		}
+		nodes, _ := fields["nodes"].([]any)
+		for _, nodeData := range nodes {
+			nodeFields, err := ConvertToStringAnyMap(nodeData)
+			if err != nil {
+				return nil, err
+			}
+			node := models.ClusterShardNode{}
+			ReadValue(nodeFields, "id", &node.Id)
+			ReadValue(nodeFields, "ip", &node.Ip)
+			ReadValue(nodeFields, "endpoint", &node.Endpoint)
+			ReadValue(nodeFields, "hostname", &node.Hostname)
+			ReadValue(nodeFields, "health", &node.Health)
+			node.Port, _ = ConvertToInt64(nodeFields["port"])
+			node.TlsPort, _ = ConvertToInt64(nodeFields["tls-port"])
+			node.ReplicationOffset, _ = ConvertToInt64(nodeFields["replication-offset"])
+			node.Role = models.ReplicaRole
+			if nodeFields["role"] == "master" || nodeFields["role"] == "primary" {
+				node.Role = models.PrimaryRole
+			}
+			shard.Nodes = append(shard.Nodes, node)
+		}
+		shards = append(shards, shard)
+	}
+	return shards, nil
+}
+
+// ConvertClusterSlots converts the reply of CLUSTER SLOTS.
+func ConvertClusterSlots(data any) ([]models.ClusterSlotRange, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	ranges := make([]models.ClusterSlotRange, 0, len(arr))
+	for _, item := range arr {
+		entry, ok := item.([]any)
+		if !ok || len(entry) < 3 {
+			return nil, fmt.Errorf("unexpected cluster slots entry: %v", item)
+		}
+		start, err := ConvertToInt64(entry[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := ConvertToInt64(entry[1])
+		if err != nil {
+			return nil, err
+		}
+		slotRange := models.ClusterSlotRange{Start: start, End: end, Replicas: []models.ClusterSlotNode{}}
+		for i, nodeData := range entry[2:] {
+			node, err := parseClusterSlotNode(nodeData)
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 {
+				slotRange.Primary = node
+			} else {
+				slotRange.Replicas = append(slotRange.Replicas, node)
+			}
+		}
+		ranges = append(ranges, slotRange)
+	}
+	return ranges, nil
+}
+
+// Parses a node in the format `[endpoint, port, id, {metadata}]`.
+func parseClusterSlotNode(data any) (models.ClusterSlotNode, error) {
+	fields, ok := data.([]any)
+	if !ok || len(fields) < 2 {
+		return models.ClusterSlotNode{}, fmt.Errorf("unexpected cluster slots node: %v", data)
+	}
+	node := models.ClusterSlotNode{}
+	node.Endpoint, _ = fields[0].(string)
+	port, err := ConvertToInt64(fields[1])
+	if err != nil {
+		return models.ClusterSlotNode{}, err
+	}
+	node.Port = port
+	if len(fields) > 2 {
+		node.Id, _ = fields[2].(string)
+	}
+	if len(fields) > 3 {
+		metadata, err := ConvertToStringAnyMap(fields[3])
+		if err != nil {
+			return models.ClusterSlotNode{}, err
+		}
+		ReadValue(metadata, "hostname", &node.Hostname)
+	}
+	return node, nil
+}
+
+// ConvertClusterLinks converts the reply of CLUSTER LINKS.
+func ConvertClusterLinks(data any) ([]models.ClusterLink, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	links := make([]models.ClusterLink, 0, len(arr))
+	for _, item := range arr {
+		fields, err := ConvertToStringAnyMap(item)
+		if err != nil {
+			return nil, err
+		}
+		link := models.ClusterLink{}
+		ReadValue(fields, "direction", &link.Direction)
+		ReadValue(fields, "node", &link.Node)
+		ReadValue(fields, "events", &link.Events)
+		link.CreateTime, _ = ConvertToInt64(fields["create-time"])
+		link.SendBufferAllocated, _ = ConvertToInt64(fields["send-buffer-allocated"])
+		link.SendBufferUsed, _ = ConvertToInt64(fields["send-buffer-used"])
+		links = append(links, link)
+	}
+	return links, nil
+}
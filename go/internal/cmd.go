@@ -21,6 +21,7 @@ type Cmd struct {
 	RequestType uint32
 	Args        []string
 	Converter   func(any) (any, error) // Response converter
+	ScriptHash  string                 // SHA1 of the script object invoked by the command, if any
 }
 
 func MakeCmd(requestType uint32, args []string, converter func(any) (any, error)) Cmd {
@@ -41,6 +42,22 @@ func (b Batch) Convert(response []any) ([]any, error) {
 	return response, nil
 }
 
+// ScriptHashes returns the distinct hashes of the script objects invoked by the batch.
+func (b Batch) ScriptHashes() []string {
+	hashes := []string{}
+	seen := map[string]struct{}{}
+	for _, cmd := range b.Commands {
+		if cmd.ScriptHash == "" {
+			continue
+		}
+		if _, ok := seen[cmd.ScriptHash]; !ok {
+			seen[cmd.ScriptHash] = struct{}{}
+			hashes = append(hashes, cmd.ScriptHash)
+		}
+	}
+	return hashes
+}
+
 type BatchOptions struct {
 	Timeout              *uint32
 	Route                config.Route
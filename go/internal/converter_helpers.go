@@ -284,6 +284,77 @@ func ConvertToInt64(value any) (int64, error) {
 	}
 }
 
+// ConvertToString converts a string reply, failing on any other type.
+func ConvertToString(value any) (string, error) {
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type received: %T, expected: string", value)
+	}
+	return str, nil
+}
+
+// ConvertToStringAnyMap converts a map, or the flat array of names and values which replaces it under RESP2, into a map.
+// An array of name-value pairs, e.g. the RESP2 reply of XRANGE, is converted as well.
+func ConvertToStringAnyMap(data any) (map[string]any, error) {
+	switch value := data.(type) {
+	case map[string]any:
+		return value, nil
+	case []any:
+		if len(value) > 0 {
+			if _, isPair := value[0].([]any); isPair {
+				return convertPairsToStringAnyMap(value)
+			}
+		}
+		if len(value)%2 != 0 {
+			return nil, fmt.Errorf("unexpected array length: %d, expected an even number of elements", len(value))
+		}
+		result := make(map[string]any, len(value)/2)
+		for i := 0; i < len(value); i += 2 {
+			name, ok := value[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type of name: %T, expected: string", value[i])
+			}
+			result[name] = value[i+1]
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unexpected type received: %T, expected: map or []any", data)
+	}
+}
+
+func convertPairsToStringAnyMap(pairs []any) (map[string]any, error) {
+	result := make(map[string]any, len(pairs))
+	for _, item := range pairs {
+		pair, ok := item.([]any)
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected name-value pair: %v", item)
+		}
+		name, ok := pair[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type of name: %T, expected: string", pair[0])
+		}
+		result[name] = pair[1]
+	}
+	return result, nil
+}
+
+func convertToFloat64(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("cannot convert string %q to float64: %w", v, err)
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to float64", value)
+	}
+}
+
 // Parse entry - it's an array where first element is ID and second is array of field-value pairs
 func CreateStreamEntry(infoMap map[string]any, entryKey string) models.StreamEntry {
 	entry := models.StreamEntry{}
@@ -0,0 +1,173 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertFtSearchResponse converts a FT.SEARCH reply. The reply is an array starting with the total number of matched
+// documents, followed by each document key, optionally followed by its score (WITHSCORES) and its fields (unless
+// NOCONTENT was given). The fields are sent either as a map or as a flat array of field-value pairs.
+func ConvertFtSearchResponse(data any, withScores bool, noContent bool) (models.FtSearchResult, error) {
+	arr, ok := data.([]any)
+	if !ok || len(arr) == 0 {
+		return models.FtSearchResult{}, fmt.Errorf("unexpected type received: %T, expected: non-empty []any", data)
+	}
+	total, err := ConvertToInt64(arr[0])
+	if err != nil {
+		return models.FtSearchResult{}, err
+	}
+	result := models.FtSearchResult{TotalResults: total, Documents: []models.FtSearchDocument{}}
+	for i := 1; i < len(arr); {
+		key, ok := arr[i].(string)
+		if !ok {
+			return models.FtSearchResult{}, fmt.Errorf("unexpected type of document key: %T, expected: string", arr[i])
+		}
+		i++
+		document := models.FtSearchDocument{
+			Key:    key,
+			Score:  models.CreateNilFloat64Result(),
+			Fields: map[string]string{},
+		}
+		if withScores && i < len(arr) {
+			score, err := convertToFloat64(arr[i])
+			if err != nil {
+				return models.FtSearchResult{}, err
+			}
+			document.Score = models.CreateFloat64Result(score)
+			i++
+		}
+		if !noContent && i < len(arr) {
+			fields, err := ConvertToStringAnyMap(arr[i])
+			if err != nil {
+				return models.FtSearchResult{}, err
+			}
+			for field, value := range fields {
+				document.Fields[field] = fmt.Sprint(value)
+			}
+			i++
+		}
+		result.Documents = append(result.Documents, document)
+	}
+	return result, nil
+}
+
+// ConvertFtAggregateResponse converts a FT.AGGREGATE reply. The reply is either an array of rows, or an array
+// starting with the number of rows followed by the rows, where each row is a map or a flat array of property-value pairs.
+func ConvertFtAggregateResponse(data any) ([]map[string]any, error) {
+	arr, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+	if len(arr) > 0 {
+		if _, isCount := arr[0].(int64); isCount {
+			arr = arr[1:]
+		}
+	}
+	rows := make([]map[string]any, 0, len(arr))
+	for _, item := range arr {
+		row, err := ConvertToStringAnyMap(item)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// ConvertFtInfoResponse converts a FT.INFO reply, which is a map or a flat array of name-value pairs.
+func ConvertFtInfoResponse(data any) (models.FtInfo, error) {
+	raw, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.FtInfo{}, err
+	}
+	info := models.FtInfo{Raw: raw, Prefixes: []string{}, Attributes: []models.FtInfoAttribute{}}
+	ReadValue(raw, "index_name", &info.IndexName)
+	ReadValue(raw, "state", &info.State)
+	info.NumDocs = readFtInt64(raw, "num_docs")
+	info.NumRecords = readFtInt64(raw, "num_records")
+	info.NumTerms = readFtInt64(raw, "num_terms")
+	info.HashIndexingFailures = readFtInt64(raw, "hash_indexing_failures")
+	info.MutationQueueSize = readFtInt64(raw, "mutation_queue_size")
+	info.BackfillInProgress = readFtInt64(raw, "backfill_in_progress") != 0
+	if percent, err := convertToFloat64(raw["backfill_complete_percent"]); err == nil {
+		info.BackfillCompletePercent = percent
+	}
+
+	if definition, err := ConvertToStringAnyMap(raw["index_definition"]); err == nil {
+		ReadValue(definition, "key_type", &info.KeyType)
+		if prefixes, err := ConvertArrayOf[string](definition["prefixes"]); err == nil {
+			info.Prefixes = prefixes.([]string)
+		}
+	}
+
+	if attributes, ok := raw["attributes"].([]any); ok {
+		for _, item := range attributes {
+			properties, err := ConvertToStringAnyMap(item)
+			if err != nil {
+				return models.FtInfo{}, err
+			}
+			attribute := models.FtInfoAttribute{Properties: map[string]any{}}
+			ReadValue(properties, "identifier", &attribute.Identifier)
+			ReadValue(properties, "attribute", &attribute.Attribute)
+			ReadValue(properties, "type", &attribute.Type)
+			for name, value := range properties {
+				if name != "identifier" && name != "attribute" && name != "type" {
+					attribute.Properties[name] = value
+				}
+			}
+			info.Attributes = append(info.Attributes, attribute)
+		}
+	}
+	return info, nil
+}
+
+// ConvertFtProfileResponse converts a FT.PROFILE reply, which is an array of the query result and the profiling report.
+func ConvertFtProfileResponse(data any, isSearch bool, withScores bool, noContent bool) (models.FtProfileResult, error) {
+	arr, ok := data.([]any)
+	if !ok || len(arr) != 2 {
+		return models.FtProfileResult{}, fmt.Errorf("unexpected type received: %T, expected: []any of length 2", data)
+	}
+	result := models.FtProfileResult{}
+	var err error
+	if isSearch {
+		result.SearchResult, err = ConvertFtSearchResponse(arr[0], withScores, noContent)
+	} else {
+		result.AggregateResult, err = ConvertFtAggregateResponse(arr[0])
+	}
+	if err != nil {
+		return models.FtProfileResult{}, err
+	}
+	result.Profile, err = ConvertToStringAnyMap(arr[1])
+	if err != nil {
+		return models.FtProfileResult{}, err
+	}
+	return result, nil
+}
+
+// ConvertFtAliasListResponse converts a FT._ALIASLIST reply, which is a map or a flat array of alias-index pairs.
+func ConvertFtAliasListResponse(data any) (map[string]string, error) {
+	raw, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return nil, err
+	}
+	aliases := make(map[string]string, len(raw))
+	for alias, index := range raw {
+		aliases[alias] = fmt.Sprint(index)
+	}
+	return aliases, nil
+}
+
+// FT.INFO sends some numbers as strings, and some of them as floats, e.g. `num_docs` may be `"10"` or `10`.
+func readFtInt64(data map[string]any, field string) int64 {
+	if value, err := ConvertToInt64(data[field]); err == nil {
+		return value
+	}
+	if value, err := convertToFloat64(data[field]); err == nil {
+		return int64(value)
+	}
+	return 0
+}
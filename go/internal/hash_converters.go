@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertHashFieldExpireResults converts the reply of HEXPIRE and of its variants, an array of the statuses of the
+// fields.
+func ConvertHashFieldExpireResults(data any) ([]models.HashFieldExpireResult, error) {
+	return convertHashFieldStatuses[models.HashFieldExpireResult](data)
+}
+
+// ConvertHashFieldPersistResults converts the reply of HPERSIST, an array of the statuses of the fields.
+func ConvertHashFieldPersistResults(data any) ([]models.HashFieldPersistResult, error) {
+	return convertHashFieldStatuses[models.HashFieldPersistResult](data)
+}
+
+func convertHashFieldStatuses[T ~int64](data any) ([]T, error) {
+	arr, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+	statuses := make([]T, 0, len(arr))
+	for _, item := range arr {
+		status, ok := item.(int64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type received: %T, expected: int64", item)
+		}
+		statuses = append(statuses, T(status))
+	}
+	return statuses, nil
+}
@@ -0,0 +1,87 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// AclCommands supports the ACL commands of the "Server Management" group for both standalone and cluster clients.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/topics/acl/
+type AclCommands interface {
+	AclCat(ctx context.Context) ([]string, error)
+
+	AclCatWithCategory(ctx context.Context, category string) ([]string, error)
+
+	AclDelUser(ctx context.Context, usernames []string) (int64, error)
+
+	AclDryRun(ctx context.Context, username string, command string, args []string) (string, error)
+
+	AclGenPass(ctx context.Context) (string, error)
+
+	AclGenPassWithBits(ctx context.Context, bits int64) (string, error)
+
+	AclGetUser(ctx context.Context, username string) (models.Result[models.AclUser], error)
+
+	AclList(ctx context.Context) ([]string, error)
+
+	AclLoad(ctx context.Context) (string, error)
+
+	AclLog(ctx context.Context) ([]models.AclLogEntry, error)
+
+	AclLogWithCount(ctx context.Context, count int64) ([]models.AclLogEntry, error)
+
+	AclLogReset(ctx context.Context) (string, error)
+
+	AclSave(ctx context.Context) (string, error)
+
+	AclSetUser(ctx context.Context, username string, rules options.AclRules) (string, error)
+
+	AclUsers(ctx context.Context) ([]string, error)
+
+	AclWhoami(ctx context.Context) (string, error)
+}
+
+// AclClusterCommands supports the ACL commands of the "Server Management" group with routing for a cluster client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/topics/acl/
+type AclClusterCommands interface {
+	AclCatWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]string], error)
+
+	AclDelUserWithOptions(ctx context.Context, usernames []string, opts options.RouteOption) (int64, error)
+
+	AclGetUserWithOptions(
+		ctx context.Context,
+		username string,
+		opts options.RouteOption,
+	) (models.ClusterValue[models.Result[models.AclUser]], error)
+
+	AclListWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]string], error)
+
+	AclLoadWithOptions(ctx context.Context, opts options.RouteOption) (string, error)
+
+	AclLogWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]models.AclLogEntry], error)
+
+	AclLogResetWithOptions(ctx context.Context, opts options.RouteOption) (string, error)
+
+	AclSaveWithOptions(ctx context.Context, opts options.RouteOption) (string, error)
+
+	AclSetUserWithOptions(
+		ctx context.Context,
+		username string,
+		rules options.AclRules,
+		opts options.RouteOption,
+	) (string, error)
+
+	AclUsersWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]string], error)
+
+	AclWhoamiWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[string], error)
+}
@@ -26,6 +26,11 @@ type BaseClientCommands interface {
 	Watch(ctx context.Context, keys []string) (string, error)
 	Unwatch(ctx context.Context) (string, error)
 
+	// WaitUntilReady establishes the connection of a client created with lazy connection.
+	WaitUntilReady(ctx context.Context) error
+	// IsConnected returns whether the connection of the client was established and the client isn't closed.
+	IsConnected() bool
+
 	// Close terminates the client by closing all associated resources.
 	Close()
 }
@@ -38,6 +43,7 @@ type GlideClientCommands interface {
 	ConnectionManagementCommands
 	ScriptingAndFunctionStandaloneCommands
 	PubSubStandaloneCommands
+	AclCommands
 
 	Exec(ctx context.Context, batch pipeline.StandaloneBatch, raiseOnError bool) ([]any, error)
 	ExecWithOptions(
@@ -55,6 +61,9 @@ type GlideClusterClientCommands interface {
 	ConnectionManagementClusterCommands
 	ScriptingAndFunctionClusterCommands
 	PubSubClusterCommands
+	AclCommands
+	AclClusterCommands
+	ClusterCommands
 
 	UnwatchWithOptions(ctx context.Context, route options.RouteOption) (string, error)
 	Exec(ctx context.Context, batch pipeline.ClusterBatch, raiseOnError bool) ([]any, error)
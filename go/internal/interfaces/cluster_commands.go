@@ -0,0 +1,94 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ClusterCommands supports commands for the "Cluster Management" group for a cluster client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#cluster
+type ClusterCommands interface {
+	ClusterAddSlots(ctx context.Context, slots []int64, route config.SingleNodeRoute) (string, error)
+
+	ClusterAddSlotsRange(ctx context.Context, slotRanges []models.SlotRange, route config.SingleNodeRoute) (string, error)
+
+	ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error)
+
+	ClusterDelSlots(ctx context.Context, slots []int64, route config.SingleNodeRoute) (string, error)
+
+	ClusterDelSlotsRange(ctx context.Context, slotRanges []models.SlotRange, route config.SingleNodeRoute) (string, error)
+
+	ClusterFailover(ctx context.Context, route config.SingleNodeRoute) (string, error)
+
+	ClusterFailoverWithMode(
+		ctx context.Context,
+		mode options.ClusterFailoverMode,
+		route config.SingleNodeRoute,
+	) (string, error)
+
+	ClusterForget(ctx context.Context, nodeId string, route config.SingleNodeRoute) (string, error)
+
+	ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error)
+
+	ClusterInfo(ctx context.Context) (models.ClusterInfo, error)
+
+	ClusterInfoWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[models.ClusterInfo], error)
+
+	ClusterLinks(ctx context.Context) ([]models.ClusterLink, error)
+
+	ClusterLinksWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]models.ClusterLink], error)
+
+	ClusterMeet(ctx context.Context, host string, port int64, route config.SingleNodeRoute) (string, error)
+
+	ClusterMyId(ctx context.Context) (string, error)
+
+	ClusterMyIdWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[string], error)
+
+	ClusterMyShardId(ctx context.Context) (string, error)
+
+	ClusterMyShardIdWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[string], error)
+
+	ClusterNodes(ctx context.Context) ([]models.ClusterNode, error)
+
+	ClusterNodesWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]models.ClusterNode], error)
+
+	ClusterReplicas(ctx context.Context, nodeId string) ([]models.ClusterNode, error)
+
+	ClusterReplicasWithOptions(
+		ctx context.Context,
+		nodeId string,
+		opts options.RouteOption,
+	) (models.ClusterValue[[]models.ClusterNode], error)
+
+	ClusterReplicate(ctx context.Context, nodeId string, route config.SingleNodeRoute) (string, error)
+
+	ClusterReset(ctx context.Context, route config.SingleNodeRoute) (string, error)
+
+	ClusterResetWithMode(ctx context.Context, mode options.ClusterResetMode, route config.SingleNodeRoute) (string, error)
+
+	ClusterSetSlot(
+		ctx context.Context,
+		slot int64,
+		opts options.ClusterSetSlotOptions,
+		route config.SingleNodeRoute,
+	) (string, error)
+
+	ClusterShards(ctx context.Context) ([]models.ClusterShard, error)
+
+	ClusterShardsWithOptions(ctx context.Context, opts options.RouteOption) (models.ClusterValue[[]models.ClusterShard], error)
+
+	ClusterSlots(ctx context.Context) ([]models.ClusterSlotRange, error)
+
+	ClusterSlotsWithOptions(
+		ctx context.Context,
+		opts options.RouteOption,
+	) (models.ClusterValue[[]models.ClusterSlotRange], error)
+}
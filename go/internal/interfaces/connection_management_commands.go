@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -26,4 +27,40 @@ type ConnectionManagementCommands interface {
 	ClientGetName(ctx context.Context) (models.Result[string], error)
 
 	ClientSetName(ctx context.Context, connectionName string) (string, error)
+
+	ClientInfo(ctx context.Context) (models.ClientInfo, error)
+
+	ClientKill(ctx context.Context, filter options.ClientFilter) (int64, error)
+
+	ClientKillSimple(ctx context.Context, addr string) (string, error)
+
+	ClientList(ctx context.Context) ([]models.ClientInfo, error)
+
+	ClientListWithFilter(ctx context.Context, filter options.ClientFilter) ([]models.ClientInfo, error)
+
+	ClientNoEvict(ctx context.Context, enabled bool) (string, error)
+
+	ClientNoTouch(ctx context.Context, enabled bool) (string, error)
+
+	ClientPause(ctx context.Context, timeout time.Duration) (string, error)
+
+	ClientPauseWithMode(ctx context.Context, timeout time.Duration, mode options.ClientPauseMode) (string, error)
+
+	ClientSetInfo(ctx context.Context, attribute options.ClientSetInfoAttribute, value string) (string, error)
+
+	ClientUnblock(ctx context.Context, clientId int64) (bool, error)
+
+	ClientUnblockWithMode(ctx context.Context, clientId int64, mode options.ClientUnblockMode) (bool, error)
+
+	ClientUnpause(ctx context.Context) (string, error)
+
+	ClientTracking(ctx context.Context, trackingOptions options.ClientTrackingOptions) (string, error)
+
+	ClientCaching(ctx context.Context, enabled bool) (string, error)
+
+	ClientGetRedir(ctx context.Context) (int64, error)
+
+	ClientTrackingInfo(ctx context.Context) (models.ClientTrackingInfo, error)
+
+	CacheStatistics() (models.CacheStatistics, error)
 }
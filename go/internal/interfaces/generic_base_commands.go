@@ -97,6 +97,10 @@ type GenericBaseCommands interface {
 
 	ObjectRefCount(ctx context.Context, key string) (models.Result[int64], error)
 
+	MemoryUsage(ctx context.Context, key string) (models.Result[int64], error)
+
+	MemoryUsageWithOptions(ctx context.Context, key string, opts options.MemoryUsageOptions) (models.Result[int64], error)
+
 	Sort(ctx context.Context, key string) ([]models.Result[string], error)
 
 	SortWithOptions(ctx context.Context, key string, sortOptions options.SortOptions) ([]models.Result[string], error)
@@ -4,7 +4,9 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
@@ -55,4 +57,78 @@ type HashCommands interface {
 	HRandFieldWithCount(ctx context.Context, key string, count int64) ([]string, error)
 
 	HRandFieldWithCountWithValues(ctx context.Context, key string, count int64) ([][]string, error)
+
+	HExpire(
+		ctx context.Context,
+		key string,
+		expireTime time.Duration,
+		fields []string,
+	) ([]models.HashFieldExpireResult, error)
+
+	HExpireWithOptions(
+		ctx context.Context,
+		key string,
+		expireTime time.Duration,
+		fields []string,
+		expireCondition constants.ExpireCondition,
+	) ([]models.HashFieldExpireResult, error)
+
+	HPExpire(
+		ctx context.Context,
+		key string,
+		expireTime time.Duration,
+		fields []string,
+	) ([]models.HashFieldExpireResult, error)
+
+	HPExpireWithOptions(
+		ctx context.Context,
+		key string,
+		expireTime time.Duration,
+		fields []string,
+		expireCondition constants.ExpireCondition,
+	) ([]models.HashFieldExpireResult, error)
+
+	HExpireAt(
+		ctx context.Context,
+		key string,
+		expireTime time.Time,
+		fields []string,
+	) ([]models.HashFieldExpireResult, error)
+
+	HExpireAtWithOptions(
+		ctx context.Context,
+		key string,
+		expireTime time.Time,
+		fields []string,
+		expireCondition constants.ExpireCondition,
+	) ([]models.HashFieldExpireResult, error)
+
+	HPExpireAt(
+		ctx context.Context,
+		key string,
+		expireTime time.Time,
+		fields []string,
+	) ([]models.HashFieldExpireResult, error)
+
+	HPExpireAtWithOptions(
+		ctx context.Context,
+		key string,
+		expireTime time.Time,
+		fields []string,
+		expireCondition constants.ExpireCondition,
+	) ([]models.HashFieldExpireResult, error)
+
+	HPersist(ctx context.Context, key string, fields []string) ([]models.HashFieldPersistResult, error)
+
+	HTTL(ctx context.Context, key string, fields []string) ([]int64, error)
+
+	HPTTL(ctx context.Context, key string, fields []string) ([]int64, error)
+
+	HExpireTime(ctx context.Context, key string, fields []string) ([]int64, error)
+
+	HPExpireTime(ctx context.Context, key string, fields []string) ([]int64, error)
+
+	HGetEx(ctx context.Context, key string, fields []string, options options.HGetExOptions) ([]models.Result[string], error)
+
+	HSetEx(ctx context.Context, key string, values map[string]string, options options.HSetExOptions) (bool, error)
 }
@@ -14,6 +14,14 @@ type PubSubCommands interface {
 	PubSubNumPat(ctx context.Context) (int64, error)
 	// PubSubNumSub returns the number of subscribers for a channel.
 	PubSubNumSub(ctx context.Context, channels ...string) (map[string]int64, error)
+	// Subscribe subscribes the client to the given channels.
+	Subscribe(ctx context.Context, channels ...string) error
+	// PSubscribe subscribes the client to the given patterns.
+	PSubscribe(ctx context.Context, patterns ...string) error
+	// Unsubscribe unsubscribes the client from the given channels, or from all of them if none are given.
+	Unsubscribe(ctx context.Context, channels ...string) error
+	// PUnsubscribe unsubscribes the client from the given patterns, or from all of them if none are given.
+	PUnsubscribe(ctx context.Context, patterns ...string) error
 }
 
 type PubSubStandaloneCommands interface {
@@ -28,4 +36,8 @@ type PubSubClusterCommands interface {
 	PubSubShardChannels(ctx context.Context) ([]string, error)
 	PubSubShardChannelsWithPattern(ctx context.Context, pattern string) ([]string, error)
 	PubSubShardNumSub(ctx context.Context, channels ...string) (map[string]int64, error)
+	// SSubscribe subscribes the client to the given shard channels.
+	SSubscribe(ctx context.Context, channels ...string) error
+	// SUnsubscribe unsubscribes the client from the given shard channels, or from all of them if none are given.
+	SUnsubscribe(ctx context.Context, channels ...string) error
 }
@@ -35,6 +35,24 @@ type ScriptingAndFunctionBaseCommands interface {
 
 	InvokeScriptWithOptions(ctx context.Context, script options.Script, scriptOptions options.ScriptOptions) (any, error)
 
+	Eval(ctx context.Context, script string) (any, error)
+
+	EvalWithOptions(ctx context.Context, script string, scriptOptions options.ScriptOptions) (any, error)
+
+	EvalReadOnly(ctx context.Context, script string) (any, error)
+
+	EvalReadOnlyWithOptions(ctx context.Context, script string, scriptOptions options.ScriptOptions) (any, error)
+
+	EvalSha(ctx context.Context, sha1 string) (any, error)
+
+	EvalShaWithOptions(ctx context.Context, sha1 string, scriptOptions options.ScriptOptions) (any, error)
+
+	EvalShaReadOnly(ctx context.Context, sha1 string) (any, error)
+
+	EvalShaReadOnlyWithOptions(ctx context.Context, sha1 string, scriptOptions options.ScriptOptions) (any, error)
+
+	ScriptLoad(ctx context.Context, script string) (string, error)
+
 	ScriptExists(ctx context.Context, sha1s []string) ([]bool, error)
 
 	ScriptFlush(ctx context.Context) (string, error)
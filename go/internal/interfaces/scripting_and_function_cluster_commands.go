@@ -100,6 +100,32 @@ type ScriptingAndFunctionClusterCommands interface {
 		clusterScriptOptions options.ClusterScriptOptions,
 	) (models.ClusterValue[any], error)
 
+	EvalWithClusterOptions(
+		ctx context.Context,
+		script string,
+		clusterScriptOptions options.ClusterScriptOptions,
+	) (models.ClusterValue[any], error)
+
+	EvalReadOnlyWithClusterOptions(
+		ctx context.Context,
+		script string,
+		clusterScriptOptions options.ClusterScriptOptions,
+	) (models.ClusterValue[any], error)
+
+	EvalShaWithClusterOptions(
+		ctx context.Context,
+		sha1 string,
+		clusterScriptOptions options.ClusterScriptOptions,
+	) (models.ClusterValue[any], error)
+
+	EvalShaReadOnlyWithClusterOptions(
+		ctx context.Context,
+		sha1 string,
+		clusterScriptOptions options.ClusterScriptOptions,
+	) (models.ClusterValue[any], error)
+
+	ScriptLoadWithRoute(ctx context.Context, script string, route options.RouteOption) (string, error)
+
 	ScriptExists(ctx context.Context, sha1s []string) ([]bool, error)
 
 	ScriptExistsWithRoute(ctx context.Context, sha1s []string, route options.RouteOption) ([]bool, error)
@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -58,4 +59,152 @@ type ServerManagementClusterCommands interface {
 	ConfigRewrite(ctx context.Context) (string, error)
 
 	ConfigRewriteWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	SlowLogGet(ctx context.Context) (models.ClusterValue[[]models.SlowLogEntry], error)
+
+	SlowLogGetWithOptions(
+		ctx context.Context,
+		count int64,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[[]models.SlowLogEntry], error)
+
+	SlowLogLen(ctx context.Context) (models.ClusterValue[int64], error)
+
+	SlowLogLenWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[int64], error)
+
+	SlowLogReset(ctx context.Context) (string, error)
+
+	SlowLogResetWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	LatencyLatest(ctx context.Context) (models.ClusterValue[[]models.LatencyEvent], error)
+
+	LatencyLatestWithOptions(
+		ctx context.Context,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[[]models.LatencyEvent], error)
+
+	LatencyHistory(ctx context.Context, event string) (models.ClusterValue[[]models.LatencySample], error)
+
+	LatencyHistoryWithOptions(
+		ctx context.Context,
+		event string,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[[]models.LatencySample], error)
+
+	LatencyHistogram(
+		ctx context.Context,
+		commands ...string,
+	) (models.ClusterValue[map[string]models.CommandLatencyHistogram], error)
+
+	LatencyHistogramWithOptions(
+		ctx context.Context,
+		commands []string,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[map[string]models.CommandLatencyHistogram], error)
+
+	LatencyGraph(ctx context.Context, event string) (models.ClusterValue[string], error)
+
+	LatencyGraphWithOptions(
+		ctx context.Context,
+		event string,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[string], error)
+
+	LatencyDoctor(ctx context.Context) (models.ClusterValue[string], error)
+
+	LatencyDoctorWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[string], error)
+
+	LatencyReset(ctx context.Context, events ...string) (int64, error)
+
+	LatencyResetWithOptions(ctx context.Context, events []string, routeOption options.RouteOption) (int64, error)
+
+	MemoryStats(ctx context.Context) (models.ClusterValue[models.MemoryStats], error)
+
+	MemoryStatsWithOptions(
+		ctx context.Context,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[models.MemoryStats], error)
+
+	MemoryDoctor(ctx context.Context) (models.ClusterValue[string], error)
+
+	MemoryDoctorWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[string], error)
+
+	MemoryMallocStats(ctx context.Context) (models.ClusterValue[string], error)
+
+	MemoryMallocStatsWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[string], error)
+
+	MemoryPurge(ctx context.Context) (string, error)
+
+	MemoryPurgeWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	Save(ctx context.Context) (string, error)
+
+	SaveWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	BgSave(ctx context.Context) (models.ClusterValue[string], error)
+
+	BgSaveWithOptions(ctx context.Context, opts options.ClusterBgSaveOptions) (models.ClusterValue[string], error)
+
+	BgRewriteAof(ctx context.Context) (models.ClusterValue[string], error)
+
+	BgRewriteAofWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[string], error)
+
+	WaitAof(
+		ctx context.Context,
+		numLocal int64,
+		numReplicas int64,
+		timeout time.Duration,
+	) (models.ClusterValue[models.WaitAofResult], error)
+
+	WaitAofWithOptions(
+		ctx context.Context,
+		numLocal int64,
+		numReplicas int64,
+		timeout time.Duration,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[models.WaitAofResult], error)
+
+	Role(ctx context.Context) (models.ClusterValue[models.RoleInfo], error)
+
+	RoleWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[models.RoleInfo], error)
+
+	ShutDownWithOptions(ctx context.Context, opts options.ClusterShutDownOptions) (string, error)
+
+	CommandCount(ctx context.Context) (int64, error)
+
+	CommandList(ctx context.Context) ([]string, error)
+
+	CommandListWithOptions(ctx context.Context, opts options.CommandListOptions) ([]string, error)
+
+	CommandInfo(ctx context.Context, commands ...string) (map[string]models.CommandInfo, error)
+
+	CommandDocs(ctx context.Context, commands ...string) (map[string]models.CommandDocs, error)
+
+	CommandGetKeys(ctx context.Context, args []string) ([]string, error)
+
+	CommandGetKeysAndFlags(ctx context.Context, args []string) ([]models.KeyWithFlags, error)
+
+	ModuleList(ctx context.Context) (models.ClusterValue[[]models.ModuleInfo], error)
+
+	ModuleListWithOptions(
+		ctx context.Context,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[[]models.ModuleInfo], error)
+
+	ModuleLoad(ctx context.Context, path string, args []string) (string, error)
+
+	ModuleLoadWithOptions(ctx context.Context, path string, args []string, routeOption options.RouteOption) (string, error)
+
+	ModuleLoadEx(ctx context.Context, path string, loadOptions options.ModuleLoadExOptions) (string, error)
+
+	ModuleLoadExWithOptions(
+		ctx context.Context,
+		path string,
+		loadOptions options.ModuleLoadExOptions,
+		routeOption options.RouteOption,
+	) (string, error)
+
+	ModuleUnload(ctx context.Context, name string) (string, error)
+
+	ModuleUnloadWithOptions(ctx context.Context, name string, routeOption options.RouteOption) (string, error)
 }
@@ -4,7 +4,9 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
 
@@ -45,4 +47,80 @@ type ServerManagementCommands interface {
 	ConfigResetStat(ctx context.Context) (string, error)
 
 	ConfigRewrite(ctx context.Context) (string, error)
+
+	SlowLogGet(ctx context.Context) ([]models.SlowLogEntry, error)
+
+	SlowLogGetWithCount(ctx context.Context, count int64) ([]models.SlowLogEntry, error)
+
+	SlowLogLen(ctx context.Context) (int64, error)
+
+	SlowLogReset(ctx context.Context) (string, error)
+
+	LatencyLatest(ctx context.Context) ([]models.LatencyEvent, error)
+
+	LatencyHistory(ctx context.Context, event string) ([]models.LatencySample, error)
+
+	LatencyHistogram(ctx context.Context, commands ...string) (map[string]models.CommandLatencyHistogram, error)
+
+	LatencyGraph(ctx context.Context, event string) (string, error)
+
+	LatencyDoctor(ctx context.Context) (string, error)
+
+	LatencyReset(ctx context.Context, events ...string) (int64, error)
+
+	MemoryStats(ctx context.Context) (models.MemoryStats, error)
+
+	MemoryDoctor(ctx context.Context) (string, error)
+
+	MemoryMallocStats(ctx context.Context) (string, error)
+
+	MemoryPurge(ctx context.Context) (string, error)
+
+	Save(ctx context.Context) (string, error)
+
+	BgSave(ctx context.Context) (string, error)
+
+	BgSaveWithOptions(ctx context.Context, opts options.BgSaveOptions) (string, error)
+
+	BgRewriteAof(ctx context.Context) (string, error)
+
+	WaitAof(ctx context.Context, numLocal int64, numReplicas int64, timeout time.Duration) (models.WaitAofResult, error)
+
+	Role(ctx context.Context) (models.RoleInfo, error)
+
+	ReplicaOf(ctx context.Context, host string, port int64) (string, error)
+
+	ReplicaOfNoOne(ctx context.Context) (string, error)
+
+	FailOver(ctx context.Context) (string, error)
+
+	FailOverWithOptions(ctx context.Context, opts options.FailOverOptions) (string, error)
+
+	SwapDb(ctx context.Context, index1 int64, index2 int64) (string, error)
+
+	ShutDown(ctx context.Context) (string, error)
+
+	ShutDownWithOptions(ctx context.Context, opts options.ShutDownOptions) (string, error)
+
+	CommandCount(ctx context.Context) (int64, error)
+
+	CommandList(ctx context.Context) ([]string, error)
+
+	CommandListWithOptions(ctx context.Context, opts options.CommandListOptions) ([]string, error)
+
+	CommandInfo(ctx context.Context, commands ...string) (map[string]models.CommandInfo, error)
+
+	CommandDocs(ctx context.Context, commands ...string) (map[string]models.CommandDocs, error)
+
+	CommandGetKeys(ctx context.Context, args []string) ([]string, error)
+
+	CommandGetKeysAndFlags(ctx context.Context, args []string) ([]models.KeyWithFlags, error)
+
+	ModuleList(ctx context.Context) ([]models.ModuleInfo, error)
+
+	ModuleLoad(ctx context.Context, path string, args []string) (string, error)
+
+	ModuleLoadEx(ctx context.Context, path string, opts options.ModuleLoadExOptions) (string, error)
+
+	ModuleUnload(ctx context.Context, name string) (string, error)
 }
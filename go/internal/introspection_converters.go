@@ -0,0 +1,239 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertCommandInfo converts the reply of COMMAND INFO, an array of the commands. The unknown commands are nil, and
+// are omitted from the result.
+func ConvertCommandInfo(data any) (map[string]models.CommandInfo, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	commands := make(map[string]models.CommandInfo, len(arr))
+	for _, item := range arr {
+		if item == nil {
+			continue
+		}
+		info, err := convertCommandInfoEntry(item)
+		if err != nil {
+			return nil, err
+		}
+		commands[info.Name] = info
+	}
+	return commands, nil
+}
+
+// Each command is an array of the name, the arity, the flags, the first key, the last key and the step, followed by
+// the ACL categories since Valkey 6.0, and by the tips, the key specifications and the subcommands since Valkey 7.0.
+func convertCommandInfoEntry(data any) (models.CommandInfo, error) {
+	fields, ok := data.([]any)
+	if !ok || len(fields) < 6 {
+		return models.CommandInfo{}, fmt.Errorf("unexpected command info: %v", data)
+	}
+	info := models.CommandInfo{Flags: convertToSortedStringSlice(fields[2])}
+	info.Name, _ = fields[0].(string)
+	info.Arity, _ = ConvertToInt64(fields[1])
+	info.FirstKey, _ = ConvertToInt64(fields[3])
+	info.LastKey, _ = ConvertToInt64(fields[4])
+	info.Step, _ = ConvertToInt64(fields[5])
+	if len(fields) > 6 {
+		info.AclCategories = convertToSortedStringSlice(fields[6])
+	}
+	if len(fields) > 7 {
+		info.Tips = convertToStringSlice(fields[7])
+	}
+	if len(fields) > 8 {
+		specs, _ := fields[8].([]any)
+		info.KeySpecs = make([]models.KeySpec, 0, len(specs))
+		for _, spec := range specs {
+			keySpec, err := convertKeySpec(spec)
+			if err != nil {
+				return models.CommandInfo{}, err
+			}
+			info.KeySpecs = append(info.KeySpecs, keySpec)
+		}
+	}
+	if len(fields) > 9 {
+		subcommands, _ := fields[9].([]any)
+		info.Subcommands = make([]models.CommandInfo, 0, len(subcommands))
+		for _, subcommand := range subcommands {
+			subcommandInfo, err := convertCommandInfoEntry(subcommand)
+			if err != nil {
+				return models.CommandInfo{}, err
+			}
+			info.Subcommands = append(info.Subcommands, subcommandInfo)
+		}
+	}
+	return info, nil
+}
+
+func convertKeySpec(data any) (models.KeySpec, error) {
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.KeySpec{}, err
+	}
+	keySpec := models.KeySpec{Flags: convertToSortedStringSlice(fields["flags"])}
+	keySpec.Notes, _ = fields["notes"].(string)
+
+	beginSearchType, beginSearch, err := convertKeySpecStep(fields["begin_search"])
+	if err != nil {
+		return models.KeySpec{}, err
+	}
+	keySpec.BeginSearch.Type = beginSearchType
+	keySpec.BeginSearch.Index, _ = ConvertToInt64(beginSearch["index"])
+	keySpec.BeginSearch.Keyword, _ = beginSearch["keyword"].(string)
+	keySpec.BeginSearch.StartFrom, _ = ConvertToInt64(beginSearch["startfrom"])
+
+	findKeysType, findKeys, err := convertKeySpecStep(fields["find_keys"])
+	if err != nil {
+		return models.KeySpec{}, err
+	}
+	keySpec.FindKeys.Type = findKeysType
+	keySpec.FindKeys.LastKey, _ = ConvertToInt64(findKeys["lastkey"])
+	keySpec.FindKeys.KeyStep, _ = ConvertToInt64(findKeys["keystep"])
+	keySpec.FindKeys.Limit, _ = ConvertToInt64(findKeys["limit"])
+	keySpec.FindKeys.KeyNumIdx, _ = ConvertToInt64(findKeys["keynumidx"])
+	keySpec.FindKeys.FirstKey, _ = ConvertToInt64(findKeys["firstkey"])
+	return keySpec, nil
+}
+
+// The steps of a key specification are a map of the type and of the specification, which depends on the type.
+func convertKeySpecStep(data any) (string, map[string]any, error) {
+	if data == nil {
+		return "", map[string]any{}, nil
+	}
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return "", nil, err
+	}
+	stepType, _ := fields["type"].(string)
+	spec := map[string]any{}
+	if fields["spec"] != nil {
+		if spec, err = ConvertToStringAnyMap(fields["spec"]); err != nil {
+			return "", nil, err
+		}
+	}
+	return stepType, spec, nil
+}
+
+// ConvertCommandDocs converts the reply of COMMAND DOCS, which maps the names of the commands to their documentation.
+func ConvertCommandDocs(data any) (map[string]models.CommandDocs, error) {
+	if data == nil {
+		return map[string]models.CommandDocs{}, nil
+	}
+	commands, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return nil, err
+	}
+	docs := make(map[string]models.CommandDocs, len(commands))
+	for name, commandData := range commands {
+		commandDocs, err := convertCommandDocsEntry(commandData)
+		if err != nil {
+			return nil, err
+		}
+		docs[name] = commandDocs
+	}
+	return docs, nil
+}
+
+func convertCommandDocsEntry(data any) (models.CommandDocs, error) {
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.CommandDocs{}, err
+	}
+	docs := models.CommandDocs{DocFlags: convertToSortedStringSlice(fields["doc_flags"])}
+	docs.Summary, _ = fields["summary"].(string)
+	docs.Since, _ = fields["since"].(string)
+	docs.Group, _ = fields["group"].(string)
+	docs.Complexity, _ = fields["complexity"].(string)
+	docs.DeprecatedSince, _ = fields["deprecated_since"].(string)
+	docs.ReplacedBy, _ = fields["replaced_by"].(string)
+
+	if history, ok := fields["history"].([]any); ok {
+		docs.History = make([]models.CommandHistoryEntry, 0, len(history))
+		for _, item := range history {
+			change, ok := item.([]any)
+			if !ok || len(change) < 2 {
+				return models.CommandDocs{}, fmt.Errorf("unexpected history entry: %v", item)
+			}
+			entry := models.CommandHistoryEntry{}
+			entry.Version, _ = change[0].(string)
+			entry.Description, _ = change[1].(string)
+			docs.History = append(docs.History, entry)
+		}
+	}
+	if docs.Arguments, err = convertCommandArguments(fields["arguments"]); err != nil {
+		return models.CommandDocs{}, err
+	}
+	if fields["subcommands"] != nil {
+		if docs.Subcommands, err = ConvertCommandDocs(fields["subcommands"]); err != nil {
+			return models.CommandDocs{}, err
+		}
+	}
+	return docs, nil
+}
+
+func convertCommandArguments(data any) ([]models.CommandArgument, error) {
+	arr, _ := data.([]any)
+	arguments := make([]models.CommandArgument, 0, len(arr))
+	for _, item := range arr {
+		fields, err := ConvertToStringAnyMap(item)
+		if err != nil {
+			return nil, err
+		}
+		argument := models.CommandArgument{KeySpecIndex: -1, Flags: convertToSortedStringSlice(fields["flags"])}
+		argument.Name, _ = fields["name"].(string)
+		argument.Type, _ = fields["type"].(string)
+		argument.DisplayText, _ = fields["display_text"].(string)
+		argument.Token, _ = fields["token"].(string)
+		argument.Summary, _ = fields["summary"].(string)
+		argument.Since, _ = fields["since"].(string)
+		argument.DeprecatedSince, _ = fields["deprecated_since"].(string)
+		if fields["key_spec_index"] != nil {
+			argument.KeySpecIndex, _ = ConvertToInt64(fields["key_spec_index"])
+		}
+		argument.Value, _ = fields["value"].(string)
+		// Only the `oneof` and `block` types have nested arguments
+		if fields["arguments"] != nil {
+			if argument.Arguments, err = convertCommandArguments(fields["arguments"]); err != nil {
+				return nil, err
+			}
+		}
+		arguments = append(arguments, argument)
+	}
+	return arguments, nil
+}
+
+// ConvertKeysWithFlags converts the reply of COMMAND GETKEYSANDFLAGS, an array of pairs of the key and its flags.
+func ConvertKeysWithFlags(data any) ([]models.KeyWithFlags, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+	keys := make([]models.KeyWithFlags, 0, len(arr))
+	for _, item := range arr {
+		fields, ok := item.([]any)
+		if !ok || len(fields) < 2 {
+			return nil, fmt.Errorf("unexpected key with flags: %v", item)
+		}
+		key := models.KeyWithFlags{Flags: convertToSortedStringSlice(fields[1])}
+		key.Key, _ = fields[0].(string)
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// The flags are a set in RESP3, so they are sorted for a stable order.
+func convertToSortedStringSlice(data any) []string {
+	result := convertToStringSlice(data)
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,87 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// The root path of a JSON document, in the legacy path syntax.
+const JsonLegacyRootPath = "."
+
+// IsJsonPath reports whether the path uses the JSONPath syntax (i.e. starts with `$`) rather than the legacy path syntax.
+// JSON commands reply with an array containing one value per matched path for JSONPath, and with a single value for
+// legacy paths.
+func IsJsonPath(path string) bool {
+	return strings.HasPrefix(path, "$")
+}
+
+// MakeConvertJsonPathResult creates a converter for JSON module replies whose shape depends on the path syntax.
+// A JSONPath reply is an array with a value (or `nil`) per matched path, a legacy path reply is a single value, which is
+// wrapped into a single-element slice. A `nil` reply (e.g. the key does not exist) is converted into a `nil` slice.
+func MakeConvertJsonPathResult[T any](path string) func(data any) (any, error) {
+	return func(data any) (any, error) {
+		return ConvertJsonPathResult[T](data, path)
+	}
+}
+
+// ConvertJsonPathResult converts a JSON module reply into a slice of [models.Result], see [MakeConvertJsonPathResult].
+func ConvertJsonPathResult[T any](data any, path string) ([]models.Result[T], error) {
+	if data == nil {
+		return nil, nil
+	}
+	if !IsJsonPath(path) {
+		value, err := convertJsonValue[T](data)
+		if err != nil {
+			return nil, err
+		}
+		return []models.Result[T]{models.CreateResultOf(value)}, nil
+	}
+	arr, ok := data.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+	result := make([]models.Result[T], 0, len(arr))
+	for _, item := range arr {
+		if item == nil {
+			result = append(result, models.CreateNilResultOf[T]())
+			continue
+		}
+		value, err := convertJsonValue[T](item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, models.CreateResultOf(value))
+	}
+	return result, nil
+}
+
+// JSON.TOGGLE replies with `0`/`1` integers for JSONPath and with `true`/`false` for legacy paths.
+func convertJsonValue[T any](data any) (T, error) {
+	var zero T
+	switch any(zero).(type) {
+	case bool:
+		switch value := data.(type) {
+		case bool:
+			return any(value).(T), nil
+		case int64:
+			return any(value != 0).(T), nil
+		case string:
+			return any(value == "true").(T), nil
+		}
+	case []string:
+		converted, err := ConvertArrayOf[string](data)
+		if err != nil {
+			return zero, err
+		}
+		return converted.(T), nil
+	default:
+		if value, ok := data.(T); ok {
+			return value, nil
+		}
+	}
+	return zero, fmt.Errorf("unexpected type received: %T, expected: %v", data, GetType[T]())
+}
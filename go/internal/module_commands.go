@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+// #include "../lib.h"
+import "C"
+
+import "context"
+
+// ExecuteModuleCommand executes a command of a module package with its request type, and returns the reply of the node
+// for the commands routed to a single node. The module packages can't reach the core of the clients, so it's set by the
+// glide package.
+var ExecuteModuleCommand func(ctx context.Context, client any, requestType uint32, args []string) (any, error)
+
+// The request types of the JSON module commands, for the json package.
+const (
+	JsonArrAppend = uint32(C.JsonArrAppend)
+	JsonArrIndex  = uint32(C.JsonArrIndex)
+	JsonArrInsert = uint32(C.JsonArrInsert)
+	JsonArrLen    = uint32(C.JsonArrLen)
+	JsonArrPop    = uint32(C.JsonArrPop)
+	JsonArrTrim   = uint32(C.JsonArrTrim)
+	JsonClear     = uint32(C.JsonClear)
+	JsonDebug     = uint32(C.JsonDebug)
+	JsonDel       = uint32(C.JsonDel)
+	JsonForget    = uint32(C.JsonForget)
+	JsonGet       = uint32(C.JsonGet)
+	JsonMGet      = uint32(C.JsonMGet)
+	JsonNumIncrBy = uint32(C.JsonNumIncrBy)
+	JsonNumMultBy = uint32(C.JsonNumMultBy)
+	JsonObjKeys   = uint32(C.JsonObjKeys)
+	JsonObjLen    = uint32(C.JsonObjLen)
+	JsonResp      = uint32(C.JsonResp)
+	JsonSet       = uint32(C.JsonSet)
+	JsonStrAppend = uint32(C.JsonStrAppend)
+	JsonStrLen    = uint32(C.JsonStrLen)
+	JsonToggle    = uint32(C.JsonToggle)
+	JsonType      = uint32(C.JsonType)
+)
+
+// The request types of the search module commands, for the ft package.
+const (
+	FtAggregate   = uint32(C.FtAggregate)
+	FtAliasAdd    = uint32(C.FtAliasAdd)
+	FtAliasDel    = uint32(C.FtAliasDel)
+	FtAliasList   = uint32(C.FtAliasList)
+	FtAliasUpdate = uint32(C.FtAliasUpdate)
+	FtCreate      = uint32(C.FtCreate)
+	FtDropIndex   = uint32(C.FtDropIndex)
+	FtExplain     = uint32(C.FtExplain)
+	FtExplainCli  = uint32(C.FtExplainCli)
+	FtInfo        = uint32(C.FtInfo)
+	FtList        = uint32(C.FtList)
+	FtProfile     = uint32(C.FtProfile)
+	FtSearch      = uint32(C.FtSearch)
+)
+
+// The request types of the Bloom module commands, for the bloom package.
+const (
+	BfAdd     = uint32(C.BfAdd)
+	BfCard    = uint32(C.BfCard)
+	BfExists  = uint32(C.BfExists)
+	BfInfo    = uint32(C.BfInfo)
+	BfInsert  = uint32(C.BfInsert)
+	BfMAdd    = uint32(C.BfMAdd)
+	BfMExists = uint32(C.BfMExists)
+	BfReserve = uint32(C.BfReserve)
+)
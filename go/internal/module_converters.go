@@ -0,0 +1,32 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertModuleList converts the reply of MODULE LIST, an array of maps of the name, the version, the path and the
+// arguments of the modules.
+func ConvertModuleList(data any) ([]models.ModuleInfo, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	modules := make([]models.ModuleInfo, 0, len(arr))
+	for _, item := range arr {
+		fields, err := ConvertToStringAnyMap(item)
+		if err != nil {
+			return nil, err
+		}
+		module := models.ModuleInfo{Args: convertToStringSlice(fields["args"])}
+		module.Name, _ = fields["name"].(string)
+		module.Version, _ = ConvertToInt64(fields["ver"])
+		module.Path, _ = fields["path"].(string)
+		modules = append(modules, module)
+	}
+	return modules, nil
+}
@@ -0,0 +1,204 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertSlowLogEntries converts the reply of SLOWLOG GET. Each entry is an array of the ID, the timestamp, the
+// duration, the arguments, the client address and the client name.
+func ConvertSlowLogEntries(data any) ([]models.SlowLogEntry, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	entries := make([]models.SlowLogEntry, 0, len(arr))
+	for _, item := range arr {
+		fields, ok := item.([]any)
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected slow log entry: %v", item)
+		}
+		entry := models.SlowLogEntry{Args: convertToStringSlice(fields[3])}
+		entry.Id, _ = ConvertToInt64(fields[0])
+		entry.Timestamp, _ = ConvertToInt64(fields[1])
+		entry.Duration, _ = ConvertToInt64(fields[2])
+		if len(fields) > 5 {
+			entry.ClientAddr, _ = fields[4].(string)
+			entry.ClientName, _ = fields[5].(string)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ConvertLatencyLatest converts the reply of LATENCY LATEST, which is an array of events. Each event is an array of the
+// name, the timestamp, the latest latency and the maximum latency.
+func ConvertLatencyLatest(data any) ([]models.LatencyEvent, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	events := make([]models.LatencyEvent, 0, len(arr))
+	for _, item := range arr {
+		fields, ok := item.([]any)
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected latency event: %v", item)
+		}
+		event := models.LatencyEvent{}
+		event.Name, _ = fields[0].(string)
+		event.Timestamp, _ = ConvertToInt64(fields[1])
+		event.Latest, _ = ConvertToInt64(fields[2])
+		event.Max, _ = ConvertToInt64(fields[3])
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ConvertLatencyHistory converts the reply of LATENCY HISTORY, which is an array of pairs of the timestamp and the
+// latency.
+func ConvertLatencyHistory(data any) ([]models.LatencySample, error) {
+	arr, ok := data.([]any)
+	if !ok && data != nil {
+		return nil, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	samples := make([]models.LatencySample, 0, len(arr))
+	for _, item := range arr {
+		fields, ok := item.([]any)
+		if !ok || len(fields) < 2 {
+			return nil, fmt.Errorf("unexpected latency sample: %v", item)
+		}
+		sample := models.LatencySample{}
+		sample.Timestamp, _ = ConvertToInt64(fields[0])
+		sample.Latency, _ = ConvertToInt64(fields[1])
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+// ConvertLatencyHistogram converts the reply of LATENCY HISTOGRAM, which maps the commands to their number of calls and
+// to their histogram.
+func ConvertLatencyHistogram(data any) (map[string]models.CommandLatencyHistogram, error) {
+	if data == nil {
+		return map[string]models.CommandLatencyHistogram{}, nil
+	}
+	commands, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return nil, err
+	}
+
+	histograms := make(map[string]models.CommandLatencyHistogram, len(commands))
+	for command, commandData := range commands {
+		fields, err := ConvertToStringAnyMap(commandData)
+		if err != nil {
+			return nil, err
+		}
+		histogram := models.CommandLatencyHistogram{Histogram: map[int64]int64{}}
+		histogram.Calls, _ = ConvertToInt64(fields["calls"])
+		// The buckets are a map in RESP3, and a flat array of pairs in RESP2
+		switch buckets := fields["histogram_usec"].(type) {
+		case map[string]any:
+			for bound, count := range buckets {
+				if err := addLatencyBucket(histogram.Histogram, bound, count); err != nil {
+					return nil, err
+				}
+			}
+		case []any:
+			for i := 0; i+1 < len(buckets); i += 2 {
+				if err := addLatencyBucket(histogram.Histogram, buckets[i], buckets[i+1]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		histograms[command] = histogram
+	}
+	return histograms, nil
+}
+
+func addLatencyBucket(histogram map[int64]int64, bound any, count any) error {
+	boundValue, err := ConvertToInt64(bound)
+	if err != nil {
+		return err
+	}
+	countValue, err := ConvertToInt64(count)
+	if err != nil {
+		return err
+	}
+	histogram[boundValue] = countValue
+	return nil
+}
+
+// ConvertMemoryStats converts the reply of MEMORY STATS, which is a map in RESP3 and a flat array of the fields and
+// their values in RESP2.
+func ConvertMemoryStats(data any) (models.MemoryStats, error) {
+	fields, err := ConvertToStringAnyMap(data)
+	if err != nil {
+		return models.MemoryStats{}, err
+	}
+	readInt := func(field string) int64 {
+		value, _ := ConvertToInt64(fields[field])
+		return value
+	}
+	readFloat := func(field string) float64 {
+		value, _ := convertToFloat64(fields[field])
+		return value
+	}
+
+	stats := models.MemoryStats{
+		PeakAllocated:               readInt("peak.allocated"),
+		TotalAllocated:              readInt("total.allocated"),
+		StartupAllocated:            readInt("startup.allocated"),
+		ReplicationBacklog:          readInt("replication.backlog"),
+		ClientsReplicas:             readInt("clients.slaves"),
+		ClientsNormal:               readInt("clients.normal"),
+		ClusterLinks:                readInt("cluster.links"),
+		AofBuffer:                   readInt("aof.buffer"),
+		LuaCaches:                   readInt("lua.caches"),
+		FunctionsCaches:             readInt("functions.caches"),
+		OverheadTotal:               readInt("overhead.total"),
+		KeysCount:                   readInt("keys.count"),
+		KeysBytesPerKey:             readInt("keys.bytes-per-key"),
+		DatasetBytes:                readInt("dataset.bytes"),
+		DatasetPercentage:           readFloat("dataset.percentage"),
+		PeakPercentage:              readFloat("peak.percentage"),
+		AllocatorAllocated:          readInt("allocator.allocated"),
+		AllocatorActive:             readInt("allocator.active"),
+		AllocatorResident:           readInt("allocator.resident"),
+		AllocatorFragmentationRatio: readFloat("allocator-fragmentation.ratio"),
+		AllocatorFragmentationBytes: readInt("allocator-fragmentation.bytes"),
+		AllocatorRssRatio:           readFloat("allocator.rss-ratio"),
+		AllocatorRssBytes:           readInt("allocator.rss-bytes"),
+		RssOverheadRatio:            readFloat("rss-overhead.ratio"),
+		RssOverheadBytes:            readInt("rss-overhead.bytes"),
+		Fragmentation:               readFloat("fragmentation"),
+		FragmentationBytes:          readInt("fragmentation.bytes"),
+		Databases:                   map[int64]models.DatabaseMemoryOverhead{},
+		Raw:                         fields,
+	}
+	for field, value := range fields {
+		index, ok := strings.CutPrefix(field, "db.")
+		if !ok {
+			continue
+		}
+		db, err := strconv.ParseInt(index, 10, 64)
+		if err != nil {
+			continue
+		}
+		dbFields, err := ConvertToStringAnyMap(value)
+		if err != nil {
+			return models.MemoryStats{}, err
+		}
+		overhead := models.DatabaseMemoryOverhead{}
+		overhead.HashTableMain, _ = ConvertToInt64(dbFields["overhead.hashtable.main"])
+		overhead.HashTableExpires, _ = ConvertToInt64(dbFields["overhead.hashtable.expires"])
+		stats.Databases[db] = overhead
+	}
+	return stats, nil
+}
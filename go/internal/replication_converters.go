@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package internal
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// ConvertRoleInfo converts the reply of ROLE, an array the first element of which is the role and the other elements
+// depend on the role.
+func ConvertRoleInfo(data any) (models.RoleInfo, error) {
+	fields, ok := data.([]any)
+	if !ok || len(fields) == 0 {
+		return models.RoleInfo{}, fmt.Errorf("unexpected type received: %T, expected: []any", data)
+	}
+
+	info := models.RoleInfo{}
+	switch fields[0] {
+	case "master":
+		if len(fields) < 3 {
+			return models.RoleInfo{}, fmt.Errorf("unexpected role of a primary: %v", fields)
+		}
+		info.Role = models.ServerRolePrimary
+		info.ReplicationOffset, _ = ConvertToInt64(fields[1])
+		replicas, _ := fields[2].([]any)
+		info.Replicas = make([]models.ReplicaInfo, 0, len(replicas))
+		for _, item := range replicas {
+			replicaFields, ok := item.([]any)
+			if !ok || len(replicaFields) < 3 {
+				return models.RoleInfo{}, fmt.Errorf("unexpected replica: %v", item)
+			}
+			replica := models.ReplicaInfo{}
+			replica.Host, _ = replicaFields[0].(string)
+			replica.Port, _ = ConvertToInt64(replicaFields[1])
+			replica.ReplicationOffset, _ = ConvertToInt64(replicaFields[2])
+			info.Replicas = append(info.Replicas, replica)
+		}
+	case "slave":
+		if len(fields) < 5 {
+			return models.RoleInfo{}, fmt.Errorf("unexpected role of a replica: %v", fields)
+		}
+		info.Role = models.ServerRoleReplica
+		info.PrimaryHost, _ = fields[1].(string)
+		info.PrimaryPort, _ = ConvertToInt64(fields[2])
+		info.LinkState, _ = fields[3].(string)
+		info.ReplicationOffset, _ = ConvertToInt64(fields[4])
+	case "sentinel":
+		info.Role = models.ServerRoleSentinel
+		if len(fields) > 1 {
+			info.MonitoredPrimaries = convertToStringSlice(fields[1])
+		}
+	default:
+		return models.RoleInfo{}, fmt.Errorf("unexpected role: %v", fields[0])
+	}
+	return info, nil
+}
+
+// ConvertWaitAofResult converts the reply of WAITAOF, an array of the number of local servers and the number of replicas
+// which fsynced the writes.
+func ConvertWaitAofResult(data any) (models.WaitAofResult, error) {
+	fields, ok := data.([]any)
+	if !ok || len(fields) != 2 {
+		return models.WaitAofResult{}, fmt.Errorf("unexpected WAITAOF reply: %v", data)
+	}
+	local, err := ConvertToInt64(fields[0])
+	if err != nil {
+		return models.WaitAofResult{}, err
+	}
+	replicas, err := ConvertToInt64(fields[1])
+	if err != nil {
+		return models.WaitAofResult{}, err
+	}
+	return models.WaitAofResult{Local: local, Replicas: replicas}, nil
+}
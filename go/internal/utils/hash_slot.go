@@ -0,0 +1,35 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import "strings"
+
+// The number of hash slots of a cluster.
+const hashSlots = 16384
+
+// HashSlot returns the hash slot of a key in cluster mode, which is the CRC16 of the key, or of its hash tag if it has
+// one, modulo the number of slots.
+func HashSlot(key string) uint16 {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return crc16(key) % hashSlots
+}
+
+// crc16 computes the CRC16-CCITT (XMODEM) checksum used by the cluster to map the keys to slots.
+func crc16(data string) uint16 {
+	var crc uint16
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,21 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSlot(t *testing.T) {
+	assert.Equal(t, uint16(0x31C3), crc16("123456789"))
+	assert.Equal(t, uint16(12182), HashSlot("foo"))
+	assert.Equal(t, uint16(5061), HashSlot("bar"))
+
+	// only the hash tag is hashed, unless it's empty
+	assert.Equal(t, HashSlot("user1000"), HashSlot("{user1000}.following"))
+	assert.Equal(t, HashSlot("user1000"), HashSlot("prefix{user1000}{other}"))
+	assert.Equal(t, crc16("{}.key")%hashSlots, HashSlot("{}.key"))
+	assert.Equal(t, crc16("{key")%hashSlots, HashSlot("{key"))
+}
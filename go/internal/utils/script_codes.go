@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import "sync"
+
+// The code of the script objects, by hash. Batches need the code to load the scripts with SCRIPT LOAD, since the
+// scripts stored in the core can only be invoked. Scripts with the same code share an entry, which is dropped once
+// all of them are closed.
+var scriptCodes = struct {
+	sync.Mutex
+	entries map[string]*scriptCode
+}{entries: map[string]*scriptCode{}}
+
+type scriptCode struct {
+	code string
+	refs int
+}
+
+// StoreScriptCode stores the code of a script object.
+func StoreScriptCode(hash string, code string) {
+	scriptCodes.Lock()
+	defer scriptCodes.Unlock()
+	if entry, ok := scriptCodes.entries[hash]; ok {
+		entry.refs++
+		return
+	}
+	scriptCodes.entries[hash] = &scriptCode{code: code, refs: 1}
+}
+
+// DropScriptCode releases the code of a closed script object.
+func DropScriptCode(hash string) {
+	scriptCodes.Lock()
+	defer scriptCodes.Unlock()
+	if entry, ok := scriptCodes.entries[hash]; ok {
+		entry.refs--
+		if entry.refs <= 0 {
+			delete(scriptCodes.entries, hash)
+		}
+	}
+}
+
+// GetScriptCode returns the code of a script object, or false if all the script objects with this hash were closed.
+func GetScriptCode(hash string) (string, bool) {
+	scriptCodes.Lock()
+	defer scriptCodes.Unlock()
+	if entry, ok := scriptCodes.entries[hash]; ok {
+		return entry.code, true
+	}
+	return "", false
+}
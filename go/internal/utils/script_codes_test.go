@@ -0,0 +1,30 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptCodes(t *testing.T) {
+	StoreScriptCode("hash", "return 1")
+	StoreScriptCode("hash", "return 1")
+
+	code, ok := GetScriptCode("hash")
+	assert.True(t, ok)
+	assert.Equal(t, "return 1", code)
+
+	// The code is kept until all the scripts with the same hash are dropped
+	DropScriptCode("hash")
+	_, ok = GetScriptCode("hash")
+	assert.True(t, ok)
+	DropScriptCode("hash")
+	_, ok = GetScriptCode("hash")
+	assert.False(t, ok)
+
+	DropScriptCode("unknown")
+	_, ok = GetScriptCode("unknown")
+	assert.False(t, ok)
+}
@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"time"
 	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
 )
 
 // Convert `s` of type `string` into `[]byte`
@@ -73,6 +75,12 @@ func ConvertMapToValueKeyStringArray[V any](args map[string]V) []string {
 	return result
 }
 
+// ConvertToHashFieldsArgs builds the arguments of the hash field expiration commands: the key, the options of the
+// command, and the fields preceded by their number.
+func ConvertToHashFieldsArgs(key string, opts []string, fields []string) []string {
+	return Concat([]string{key}, opts, []string{constants.FieldsKeyword, strconv.Itoa(len(fields))}, fields)
+}
+
 // Concat concatenates multiple slices of strings into a single slice.
 func Concat(slices ...[]string) []string {
 	size := 0
@@ -105,6 +113,15 @@ func ToString(v any) (string, bool) {
 	}
 }
 
+// DurationToSeconds converts a duration to a whole number of seconds, for the commands which don't accept fractions of a
+// second.
+func DurationToSeconds(d time.Duration) (string, error) {
+	if d%time.Second != 0 {
+		return "", errors.New("the duration must be a whole number of seconds")
+	}
+	return IntToString(int64(d / time.Second)), nil
+}
+
 // Convert to and perform bound checks for uint32 representation for milliseconds
 func DurationToMilliseconds(d time.Duration) (uint32, error) {
 	milliseconds := d.Milliseconds()
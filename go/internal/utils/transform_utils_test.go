@@ -4,6 +4,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -99,3 +100,17 @@ func TestConcat(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationToSeconds(t *testing.T) {
+	seconds, err := DurationToSeconds(2 * time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", seconds)
+
+	// large durations aren't formatted with an exponent
+	seconds, err = DurationToSeconds(1_000_000 * time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000000", seconds)
+
+	_, err = DurationToSeconds(1500 * time.Millisecond)
+	assert.EqualError(t, err, "the duration must be a whole number of seconds")
+}
@@ -0,0 +1,20 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package json provides the commands of the Valkey JSON module for Valkey GLIDE clients.
+//
+// The commands are executed with a standalone [glide.Client] or a cluster [glide.ClusterClient]:
+//
+//	client, _ := glide.NewClusterClient(cfg)
+//	_, err := json.Set(ctx, client, "doc", "$", `{"a": 1, "b": [1, 2]}`)
+//	lengths, err := json.ArrLenWithPath(ctx, client, "doc", "$..b")
+//
+// Paths can be given either in the JSONPath syntax (starting with `$`) or in the legacy path syntax (starting with `.`
+// or a key name). Most of the commands reply with one value per path matched by a JSONPath and with a single value for
+// a legacy path, so their results are returned as a slice of [models.Result] in both cases. For a JSONPath, the slice
+// holds a nil [models.Result] for each matched value the command is not applicable to. For a legacy path, the slice
+// holds a single element.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/topics/valkey-json/
+package json
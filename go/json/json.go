@@ -0,0 +1,1025 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package json
+
+import (
+	"context"
+	"fmt"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	debugMemory = "MEMORY"
+	debugFields = "FIELDS"
+)
+
+// Client is a constraint satisfied by the standalone [glide.Client] and the cluster [glide.ClusterClient].
+type Client interface {
+	*glide.Client | *glide.ClusterClient
+}
+
+// Executes a JSON module command with its request type. Commands routed to a single node (all JSON commands are keyed)
+// return the node value.
+func executeCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) (any, error) {
+	return internal.ExecuteModuleCommand(ctx, client, requestType, args)
+}
+
+func executePathCommand[T any, C Client](
+	ctx context.Context,
+	client C,
+	path string,
+	requestType uint32,
+	args []string,
+) ([]models.Result[T], error) {
+	result, err := executeCommand(ctx, client, requestType, args)
+	if err != nil {
+		return nil, err
+	}
+	return internal.ConvertJsonPathResult[T](result, path)
+}
+
+func executeIntCommand[C Client](ctx context.Context, client C, requestType uint32, args []string) (int64, error) {
+	result, err := executeCommand(ctx, client, requestType, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	value, ok := result.(int64)
+	if !ok {
+		return models.DefaultIntResponse, fmt.Errorf("unexpected type received: %T, expected: int64", result)
+	}
+	return value, nil
+}
+
+func executeStringOrNilCommand[C Client](
+	ctx context.Context,
+	client C,
+	requestType uint32,
+	args []string,
+) (models.Result[string], error) {
+	result, err := executeCommand(ctx, client, requestType, args)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	if result == nil {
+		return models.CreateNilStringResult(), nil
+	}
+	value, ok := utils.ToString(result)
+	if !ok {
+		return models.CreateNilStringResult(), fmt.Errorf("unexpected type received: %T, expected: string", result)
+	}
+	return models.CreateStringResult(value), nil
+}
+
+// Sets the JSON value at the specified `path` stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - Represents the path within the JSON document where the value will be set.
+//	  The key will be modified only if `value` is added as the last child in the specified `path`,
+//	  or if the specified `path` acts as the parent of a new child being added.
+//	value - The value to set at the specific path, in JSON formatted string.
+//
+// Return value:
+//
+//	`"OK"` if the value is successfully set.
+//
+// [valkey.io]: https://valkey.io/commands/json.set/
+func Set[C Client](ctx context.Context, client C, key string, path string, value string) (string, error) {
+	result, err := SetWithOptions(ctx, client, key, path, value, *options.NewJsonSetOptions())
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return result.Value(), nil
+}
+
+// Sets the JSON value at the specified `path` stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - Represents the path within the JSON document where the value will be set.
+//	value - The value to set at the specific path, in JSON formatted string.
+//	opts - The [options.JsonSetOptions].
+//
+// Return value:
+//
+//	`"OK"` if the value is successfully set.
+//	If the value isn't set because of the [options.JsonSetOptions.ConditionalSet] condition, returns
+//	[models.CreateNilStringResult()].
+//
+// [valkey.io]: https://valkey.io/commands/json.set/
+func SetWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	value string,
+	opts options.JsonSetOptions,
+) (models.Result[string], error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return executeStringOrNilCommand(ctx, client, internal.JsonSet, append([]string{key, path, value}, optionArgs...))
+}
+
+// Retrieves the JSON value stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	The JSON document stored at `key`, serialized to a string.
+//	If `key` doesn't exist, returns [models.CreateNilStringResult()].
+//
+// [valkey.io]: https://valkey.io/commands/json.get/
+func Get[C Client](ctx context.Context, client C, key string) (models.Result[string], error) {
+	return executeStringOrNilCommand(ctx, client, internal.JsonGet, []string{key})
+}
+
+// Retrieves the JSON value at the specified paths stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	opts - The [options.JsonGetOptions], including the paths to retrieve and the formatting of the returned value.
+//
+// Return value:
+//
+//	The value at the requested paths, serialized to a string:
+//	  - For a single JSONPath, a JSON array of the values matched by the path.
+//	  - For a single legacy path, the value matched by the path.
+//	  - For multiple paths, a JSON object where each path is a key, and its value is the value or the array of
+//	    values matched by the path.
+//	If `key` doesn't exist, returns [models.CreateNilStringResult()].
+//
+// [valkey.io]: https://valkey.io/commands/json.get/
+func GetWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	opts options.JsonGetOptions,
+) (models.Result[string], error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return executeStringOrNilCommand(ctx, client, internal.JsonGet, append([]string{key}, optionArgs...))
+}
+
+// Retrieves the JSON values at the specified `path` stored at multiple `keys`.
+//
+// Note:
+//
+//	In cluster mode, if keys in `keys` map to different hash slots, the command will be split across these slots and
+//	executed separately for each. This means the command is atomic only at the slot level.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	keys - The keys of the JSON documents.
+//	path - The path within the JSON documents.
+//
+// Return value:
+//
+//	A slice with a value per key, in the same order as `keys`, serialized to a string:
+//	  - For a JSONPath, a JSON array of the values matched by the path.
+//	  - For a legacy path, the value matched by the path.
+//	A nil [models.Result] is returned for keys which don't exist or when the path doesn't match.
+//
+// [valkey.io]: https://valkey.io/commands/json.mget/
+func MGet[C Client](ctx context.Context, client C, keys []string, path string) ([]models.Result[string], error) {
+	result, err := executeCommand(ctx, client, internal.JsonMGet, utils.Concat(keys, []string{path}))
+	if err != nil {
+		return nil, err
+	}
+	converted, err := internal.ConvertArrayOfNilOr[string](result)
+	if err != nil {
+		return nil, err
+	}
+	return converted.([]models.Result[string]), nil
+}
+
+// Appends one or more `values` to the JSON array at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	values - The JSON values to be appended to the array, in JSON formatted strings.
+//
+// Return value:
+//
+//	The new length of each array matched by `path`. A nil [models.Result] is returned for each matched value
+//	which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrappend/
+func ArrAppend[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	values []string,
+) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonArrAppend, append([]string{key, path}, values...))
+}
+
+// Searches for the first occurrence of a `scalar` JSON value in the arrays at the `path`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	scalar - The scalar value to search for, in JSON formatted string.
+//
+// Return value:
+//
+//	The index of the first occurrence of `scalar` in each array matched by `path`, or `-1` if it's not found.
+//	A nil [models.Result] is returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrindex/
+func ArrIndex[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	scalar string,
+) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonArrIndex, []string{key, path, scalar})
+}
+
+// Searches for the first occurrence of a `scalar` JSON value in the arrays at the `path`, within the range given by
+// `opts`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	scalar - The scalar value to search for, in JSON formatted string.
+//	opts - The [options.JsonArrIndexOptions].
+//
+// Return value:
+//
+//	The index of the first occurrence of `scalar` in each array matched by `path`, or `-1` if it's not found.
+//	A nil [models.Result] is returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrindex/
+func ArrIndexWithOptions[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	scalar string,
+	opts options.JsonArrIndexOptions,
+) ([]models.Result[int64], error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args := append([]string{key, path, scalar}, optionArgs...)
+	return executePathCommand[int64](ctx, client, path, internal.JsonArrIndex, args)
+}
+
+// Inserts one or more `values` into the array at the specified `path` within the JSON document stored at `key`, before
+// the given `index`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	index - The array index before which values are inserted. Negative values count from the end of the array.
+//	values - The JSON values to be inserted into the array, in JSON formatted strings.
+//
+// Return value:
+//
+//	The new length of each array matched by `path`. A nil [models.Result] is returned for each matched value
+//	which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrinsert/
+func ArrInsert[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	index int64,
+	values []string,
+) ([]models.Result[int64], error) {
+	return executePathCommand[int64](
+		ctx,
+		client,
+		path,
+		internal.JsonArrInsert, append([]string{key, path, utils.IntToString(index)}, values...),
+	)
+}
+
+// Retrieves the length of the array at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the length of the array. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrlen/
+func ArrLen[C Client](ctx context.Context, client C, key string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, internal.JsonLegacyRootPath, internal.JsonArrLen, []string{key})
+}
+
+// Retrieves the length of the arrays at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The length of each array matched by `path`. A nil [models.Result] is returned for each matched value which is
+//	not an array. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrlen/
+func ArrLenWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonArrLen, []string{key, path})
+}
+
+// Pops the last element from the array at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the popped JSON value, serialized to a string, or a nil [models.Result] if the
+//	array is empty.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrpop/
+func ArrPop[C Client](ctx context.Context, client C, key string) ([]models.Result[string], error) {
+	return executePathCommand[string](ctx, client, internal.JsonLegacyRootPath, internal.JsonArrPop, []string{key})
+}
+
+// Pops an element from the arrays at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	index - The index of the element to pop. Negative values count from the end of the array. Out of bound indexes
+//	  are rounded to their respective array boundaries.
+//
+// Return value:
+//
+//	The popped JSON value from each array matched by `path`, serialized to a string. A nil [models.Result] is
+//	returned for each matched value which is not an array or is an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrpop/
+func ArrPopWithPathAndIndex[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	index int64,
+) ([]models.Result[string], error) {
+	return executePathCommand[string](ctx, client, path, internal.JsonArrPop, []string{key, path, utils.IntToString(index)})
+}
+
+// Trims the arrays at the specified `path` within the JSON document stored at `key` so that they become subarrays
+// [`start`, `end`], both inclusive.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	start - The start index, inclusive.
+//	end - The end index, inclusive.
+//
+// Return value:
+//
+//	The new length of each array matched by `path`. A nil [models.Result] is returned for each matched value
+//	which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrtrim/
+func ArrTrim[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	start int64,
+	end int64,
+) ([]models.Result[int64], error) {
+	return executePathCommand[int64](
+		ctx,
+		client,
+		path,
+		internal.JsonArrTrim, []string{key, path, utils.IntToString(start), utils.IntToString(end)},
+	)
+}
+
+// Clears the arrays and objects at the root of the JSON document stored at `key`. Numeric values are set to `0`,
+// boolean values are set to `false` and string values are converted to empty strings.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	The number of containers cleared.
+//
+// [valkey.io]: https://valkey.io/commands/json.clear/
+func Clear[C Client](ctx context.Context, client C, key string) (int64, error) {
+	return executeIntCommand(ctx, client, internal.JsonClear, []string{key})
+}
+
+// Clears the arrays and objects at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The number of containers cleared.
+//
+// [valkey.io]: https://valkey.io/commands/json.clear/
+func ClearWithPath[C Client](ctx context.Context, client C, key string, path string) (int64, error) {
+	return executeIntCommand(ctx, client, internal.JsonClear, []string{key, path})
+}
+
+// Reports the memory usage in bytes of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the memory usage. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func DebugMemory[C Client](ctx context.Context, client C, key string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, internal.JsonLegacyRootPath, internal.JsonDebug, []string{debugMemory, key})
+}
+
+// Reports the memory usage in bytes of the JSON values at the specified `path` within the JSON document stored at
+// `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The memory usage of each value matched by `path`. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func DebugMemoryWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonDebug, []string{debugMemory, key, path})
+}
+
+// Reports the number of fields of the JSON document stored at `key`. Each container counts as one field, plus the
+// fields it contains.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the number of fields. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func DebugFields[C Client](ctx context.Context, client C, key string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, internal.JsonLegacyRootPath, internal.JsonDebug, []string{debugFields, key})
+}
+
+// Reports the number of fields of the JSON values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The number of fields of each value matched by `path`. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func DebugFieldsWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonDebug, []string{debugFields, key, path})
+}
+
+// Deletes the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	The number of elements deleted. `0` if the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.del/
+func Del[C Client](ctx context.Context, client C, key string) (int64, error) {
+	return executeIntCommand(ctx, client, internal.JsonDel, []string{key})
+}
+
+// Deletes the JSON values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document. If the path is the root, the whole key is deleted.
+//
+// Return value:
+//
+//	The number of elements deleted. `0` if the key does not exist, or if the path doesn't match.
+//
+// [valkey.io]: https://valkey.io/commands/json.del/
+func DelWithPath[C Client](ctx context.Context, client C, key string, path string) (int64, error) {
+	return executeIntCommand(ctx, client, internal.JsonDel, []string{key, path})
+}
+
+// Deletes the JSON document stored at `key`. An alias of [Del].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	The number of elements deleted. `0` if the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.forget/
+func Forget[C Client](ctx context.Context, client C, key string) (int64, error) {
+	return executeIntCommand(ctx, client, internal.JsonForget, []string{key})
+}
+
+// Deletes the JSON values at the specified `path` within the JSON document stored at `key`. An alias of
+// [DelWithPath].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document. If the path is the root, the whole key is deleted.
+//
+// Return value:
+//
+//	The number of elements deleted. `0` if the key does not exist, or if the path doesn't match.
+//
+// [valkey.io]: https://valkey.io/commands/json.forget/
+func ForgetWithPath[C Client](ctx context.Context, client C, key string, path string) (int64, error) {
+	return executeIntCommand(ctx, client, internal.JsonForget, []string{key, path})
+}
+
+// Increments the numbers at the specified `path` within the JSON document stored at `key` by `number`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	number - The number to increment by.
+//
+// Return value:
+//
+//	For a JSONPath, a JSON array of the new values, with `null` for each matched value which is not a number.
+//	For a legacy path, the new value.
+//
+// [valkey.io]: https://valkey.io/commands/json.numincrby/
+func NumIncrBy[C Client](ctx context.Context, client C, key string, path string, number float64) (string, error) {
+	args := []string{key, path, utils.FloatToString(number)}
+	result, err := executeStringOrNilCommand(ctx, client, internal.JsonNumIncrBy, args)
+	return result.Value(), err
+}
+
+// Multiplies the numbers at the specified `path` within the JSON document stored at `key` by `number`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	number - The number to multiply by.
+//
+// Return value:
+//
+//	For a JSONPath, a JSON array of the new values, with `null` for each matched value which is not a number.
+//	For a legacy path, the new value.
+//
+// [valkey.io]: https://valkey.io/commands/json.nummultby/
+func NumMultBy[C Client](ctx context.Context, client C, key string, path string, number float64) (string, error) {
+	args := []string{key, path, utils.FloatToString(number)}
+	result, err := executeStringOrNilCommand(ctx, client, internal.JsonNumMultBy, args)
+	return result.Value(), err
+}
+
+// Retrieves the key names of the object at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the key names of the object. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.objkeys/
+func ObjKeys[C Client](ctx context.Context, client C, key string) ([]models.Result[[]string], error) {
+	return executePathCommand[[]string](ctx, client, internal.JsonLegacyRootPath, internal.JsonObjKeys, []string{key})
+}
+
+// Retrieves the key names of the objects at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The key names of each object matched by `path`. A nil [models.Result] is returned for each matched value which
+//	is not an object. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.objkeys/
+func ObjKeysWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[[]string], error) {
+	return executePathCommand[[]string](ctx, client, path, internal.JsonObjKeys, []string{key, path})
+}
+
+// Retrieves the number of keys of the object at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the number of keys of the object. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.objlen/
+func ObjLen[C Client](ctx context.Context, client C, key string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, internal.JsonLegacyRootPath, internal.JsonObjLen, []string{key})
+}
+
+// Retrieves the number of keys of the objects at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The number of keys of each object matched by `path`. A nil [models.Result] is returned for each matched value
+//	which is not an object. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.objlen/
+func ObjLenWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonObjLen, []string{key, path})
+}
+
+// Retrieves the JSON document stored at `key` in the Valkey Serialization Protocol (RESP) form:
+//   - JSON null is mapped to `nil`.
+//   - JSON booleans are mapped to `"true"` and `"false"` strings.
+//   - JSON integers are mapped to `int64`, JSON floats to strings.
+//   - JSON strings are mapped to strings.
+//   - JSON arrays are mapped to slices, where the first element is `"["`, followed by the array elements.
+//   - JSON objects are mapped to slices, where the first element is `"{"`, followed by key-value pairs, each of them
+//     as a slice of two elements.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	The JSON document in its RESP form. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.resp/
+func Resp[C Client](ctx context.Context, client C, key string) (any, error) {
+	return executeCommand(ctx, client, internal.JsonResp, []string{key})
+}
+
+// Retrieves the JSON values at the specified `path` within the JSON document stored at `key` in the Valkey
+// Serialization Protocol (RESP) form, see [Resp].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	For a JSONPath, a slice with the RESP form of each matched value.
+//	For a legacy path, the RESP form of the matched value.
+//	If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.resp/
+func RespWithPath[C Client](ctx context.Context, client C, key string, path string) (any, error) {
+	return executeCommand(ctx, client, internal.JsonResp, []string{key, path})
+}
+
+// Appends the `value` to the strings at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	value - The value to append to the string, in JSON formatted string (e.g. `"\"foo\""`).
+//
+// Return value:
+//
+//	A single-element slice with the new length of the string.
+//
+// [valkey.io]: https://valkey.io/commands/json.strappend/
+func StrAppend[C Client](ctx context.Context, client C, key string, value string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, internal.JsonLegacyRootPath, internal.JsonStrAppend, []string{key, value})
+}
+
+// Appends the `value` to the strings at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	value - The value to append to the string, in JSON formatted string (e.g. `"\"foo\""`).
+//
+// Return value:
+//
+//	The new length of each string matched by `path`. A nil [models.Result] is returned for each matched value
+//	which is not a string.
+//
+// [valkey.io]: https://valkey.io/commands/json.strappend/
+func StrAppendWithPath[C Client](
+	ctx context.Context,
+	client C,
+	key string,
+	path string,
+	value string,
+) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonStrAppend, []string{key, path, value})
+}
+
+// Retrieves the length of the string at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the length of the string. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.strlen/
+func StrLen[C Client](ctx context.Context, client C, key string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, internal.JsonLegacyRootPath, internal.JsonStrLen, []string{key})
+}
+
+// Retrieves the length of the strings at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The length of each string matched by `path`. A nil [models.Result] is returned for each matched value which is
+//	not a string. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.strlen/
+func StrLenWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[int64], error) {
+	return executePathCommand[int64](ctx, client, path, internal.JsonStrLen, []string{key, path})
+}
+
+// Toggles the boolean value at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the new boolean value.
+//
+// [valkey.io]: https://valkey.io/commands/json.toggle/
+func Toggle[C Client](ctx context.Context, client C, key string) ([]models.Result[bool], error) {
+	return executePathCommand[bool](ctx, client, internal.JsonLegacyRootPath, internal.JsonToggle, []string{key})
+}
+
+// Toggles the boolean values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The new value of each boolean matched by `path`. A nil [models.Result] is returned for each matched value
+//	which is not a boolean.
+//
+// [valkey.io]: https://valkey.io/commands/json.toggle/
+func ToggleWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[bool], error) {
+	return executePathCommand[bool](ctx, client, path, internal.JsonToggle, []string{key, path})
+}
+
+// Retrieves the type of the JSON value at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//
+// Return value:
+//
+//	A single-element slice with the type of the value, e.g. `"object"`, `"array"` or `"string"`.
+//	If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.type/
+func Type[C Client](ctx context.Context, client C, key string) ([]models.Result[string], error) {
+	return executePathCommand[string](ctx, client, internal.JsonLegacyRootPath, internal.JsonType, []string{key})
+}
+
+// Retrieves the type of the JSON values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The Valkey GLIDE client to execute the command.
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Return value:
+//
+//	The type of each value matched by `path`. If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.type/
+func TypeWithPath[C Client](ctx context.Context, client C, key string, path string) ([]models.Result[string], error) {
+	return executePathCommand[string](ctx, client, path, internal.JsonType, []string{key, path})
+}
@@ -0,0 +1,91 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// connectionState tracks whether the connection of the client was established. The core establishes the connection of
+// a lazy client when it receives the first request, so the first request is preceded by a PING, which reports a failure
+// to connect as a [ConnectionError] rather than as a failure of the request.
+type connectionState struct {
+	connected atomic.Bool
+	// Holds a token while connecting, so that the concurrent requests wait for the same attempt to connect, or for
+	// their context to be done.
+	connecting chan struct{}
+}
+
+func newConnectionState(connected bool) *connectionState {
+	state := &connectionState{connecting: make(chan struct{}, 1)}
+	state.connected.Store(connected)
+	return state
+}
+
+// connect establishes the connection of a lazy client, unless it is already established. A failed attempt is retried
+// by the next request.
+func (client *baseClient) connect(ctx context.Context) error {
+	state := client.connection
+	if state.connected.Load() {
+		return nil
+	}
+	select {
+	case state.connecting <- struct{}{}:
+		defer func() { <-state.connecting }()
+	case <-ctx.Done():
+		return contextError(ctx)
+	}
+	if state.connected.Load() {
+		return nil
+	}
+
+	response, err := client.sendCommand(ctx, C.Ping, []string{}, nil)
+	if err != nil {
+		var closingErr *ClosingError
+		if errors.As(err, &closingErr) || ctx.Err() != nil {
+			return err
+		}
+		return NewConnectionError("failed to connect: " + err.Error())
+	}
+	C.free_command_response(response)
+
+	// The tracking is enabled before any read is sent, so that the cache never holds values read without tracking
+	if client.cache != nil {
+		if err := client.enableTracking(ctx); err != nil {
+			return NewConfigurationError("failed to enable the tracking of the client side cache: " + err.Error())
+		}
+	}
+	state.connected.Store(true)
+	return nil
+}
+
+// WaitUntilReady establishes the connection of a client created with lazy connection, see
+// [config.ClientConfiguration.WithLazyConnect]. It returns immediately if the client is already connected.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the connection attempt.
+//
+// Return value:
+//
+//	`nil` once the client is connected, a [ConnectionError] if the connection failed, or the error of the context if it
+//	is done before the client is connected, which is a [TimeoutError] once its deadline is exceeded. A failed
+//	connection is attempted again by the next call or command.
+func (client *baseClient) WaitUntilReady(ctx context.Context) error {
+	return client.connect(ctx)
+}
+
+// IsConnected returns whether the connection of the client was established and the client isn't closed. A client
+// created with lazy connection isn't connected until [Client.WaitUntilReady] or its first command succeeds.
+//
+// Transient disconnections, which the client recovers from by reconnecting, aren't reported.
+func (client *baseClient) IsConnected() bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.coreClient != nil && client.connection.connected.Load()
+}
@@ -0,0 +1,24 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import "unsafe"
+
+// The identifier of the logs of the client among the logs of the core.
+const logIdentifier = "glide-go"
+
+// logError logs an error of the client with the logger of the core.
+func logError(message string) {
+	logMessage(C.LogError, message)
+}
+
+func logMessage(level C.LogLevel, message string) {
+	cIdentifier := C.CString(logIdentifier)
+	defer C.free(unsafe.Pointer(cIdentifier))
+	cMessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cMessage))
+	C.log_message(level, cIdentifier, cMessage)
+}
@@ -0,0 +1,53 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// AclSelector is a set of additional permissions of an ACL user, as returned by ACL GETUSER.
+type AclSelector struct {
+	// The commands rules of the selector, e.g. `+@read`.
+	Commands string
+	// The key patterns of the selector, e.g. `~key*`.
+	Keys string
+	// The Pub/Sub channel patterns of the selector, e.g. `&channel*`.
+	Channels string
+}
+
+// AclUser describes the rules of an ACL user, as returned by ACL GETUSER.
+type AclUser struct {
+	// The flags of the user, e.g. `on`, `nopass` or `sanitize-payload`.
+	Flags []string
+	// The SHA-256 hashes of the passwords of the user.
+	Passwords []string
+	// The commands rules of the user, e.g. `+@all -debug`.
+	Commands string
+	// The key patterns of the user, e.g. `~*`.
+	Keys string
+	// The Pub/Sub channel patterns of the user, e.g. `&*`.
+	Channels string
+	// The selectors of the user. Supported since Valkey 7.0.
+	Selectors []AclSelector
+}
+
+// AclLogEntry is an entry of the ACL security events log, as returned by ACL LOG.
+type AclLogEntry struct {
+	// The number of security events logged within a 60 seconds period into this entry.
+	Count int64
+	// The reason of the event, e.g. `command`, `key`, `channel` or `auth`.
+	Reason string
+	// The context of the event, e.g. `toplevel`, `multi`, `lua` or `module`.
+	Context string
+	// The resource that was accessed, e.g. the command or the key name.
+	Object string
+	// The username used by the client.
+	Username string
+	// The age of the entry, in seconds.
+	AgeSeconds float64
+	// The information about the client, in the same format as CLIENT INFO.
+	ClientInfo string
+	// The unique ID of the entry. Supported since Valkey 7.2.
+	EntryId int64
+	// The UNIX timestamp of the first event of the entry, in milliseconds. Supported since Valkey 7.2.
+	TimestampCreated int64
+	// The UNIX timestamp of the last event of the entry, in milliseconds. Supported since Valkey 7.2.
+	TimestampLastUpdated int64
+}
@@ -0,0 +1,24 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// BloomInfo describes a bloom filter, as returned by BF.INFO.
+type BloomInfo struct {
+	// The number of items the filter can hold, across all its sub-filters.
+	Capacity int64
+	// The memory used by the filter, in bytes.
+	Size int64
+	// The number of sub-filters, which grows each time the filter scales out.
+	NumberOfFilters int64
+	// The number of items added to the filter.
+	NumberOfItems int64
+	// The false positive rate of the filter.
+	ErrorRate float64
+	// The growth rate of the capacity when the filter scales out. Nil for a non-scaling filter.
+	Expansion Result[int64]
+	// The ratio by which the false positive rate of each new sub-filter is tightened. Only returned for a scaling
+	// filter, 0 otherwise.
+	TighteningRatio float64
+	// The capacity beyond which the filter can't scale out. Only returned for a scaling filter, 0 otherwise.
+	MaxScaledCapacity int64
+}
@@ -0,0 +1,102 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// ClientInfo describes a client connection, as returned by CLIENT INFO and CLIENT LIST.
+type ClientInfo struct {
+	// The unique ID of the client.
+	Id int64
+	// The address of the client, in `ip:port` format.
+	Addr string
+	// The address of the server the client is connected to, in `ip:port` format.
+	LAddr string
+	// The file descriptor of the socket, -1 for internal clients.
+	Fd int64
+	// The name of the connection, as set by CLIENT SETNAME.
+	Name string
+	// The total duration of the connection, in seconds.
+	Age int64
+	// The idle time of the connection, in seconds.
+	Idle int64
+	// The flags of the client, e.g. `N` for a normal client or `P` for a Pub/Sub subscriber.
+	Flags string
+	// The current database of the client.
+	Db int64
+	// The number of channel subscriptions.
+	Sub int64
+	// The number of pattern subscriptions.
+	PSub int64
+	// The number of shard channel subscriptions. Supported since Valkey 7.0.
+	SSub int64
+	// The number of commands queued in a MULTI/EXEC context, -1 if not in a transaction.
+	Multi int64
+	// The number of keys watched by the client. Supported since Valkey 7.4.
+	Watch int64
+	// The length of the query buffer, in bytes.
+	QBuf int64
+	// The memory used by the output buffer, in bytes.
+	OMem int64
+	// The total memory consumed by the client, in bytes.
+	TotMem int64
+	// The file descriptor events, `r` and/or `w`.
+	Events string
+	// The last command executed by the client.
+	Cmd string
+	// The authenticated username of the client.
+	User string
+	// The ID of the client the tracking invalidations are redirected to, -1 if not redirected.
+	Redir int64
+	// The protocol version of the client.
+	Resp int64
+	// The name of the client library, as set by CLIENT SETINFO.
+	LibName string
+	// The version of the client library, as set by CLIENT SETINFO.
+	LibVer string
+	// All the fields returned by the command, including the fields which have no dedicated member.
+	Raw map[string]string
+}
+
+// ClientTrackingInfo describes the client side caching state of a connection, as returned by CLIENT TRACKINGINFO.
+type ClientTrackingInfo struct {
+	// The tracking flags, e.g. `off`, `on`, `bcast`, `optin`, `noloop` or `broken_redirect`.
+	Flags []string
+	// The ID of the client the invalidation messages are redirected to, 0 if not redirected and -1 if tracking is off.
+	Redirect int64
+	// The key prefixes of the broadcasting mode.
+	Prefixes []string
+}
+
+// HasFlag returns whether the tracking state has the given flag.
+func (info ClientTrackingInfo) HasFlag(flag string) bool {
+	for _, f := range info.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// CacheStatistics are the statistics of the client side cache, accumulated since the client was created.
+type CacheStatistics struct {
+	// The number of reads served from the cache.
+	Hits int64
+	// The number of reads sent to the server because the value wasn't cached.
+	Misses int64
+	// The number of entries evicted to bound the size of the cache.
+	Evictions int64
+	// The number of entries removed once their time to live had elapsed.
+	Expirations int64
+	// The number of entries removed because the server notified that their key was modified.
+	Invalidations int64
+	// The current number of entries in the cache.
+	Size int64
+}
+
+// HitRate returns the ratio of the reads served from the cache, 0 if no read was made.
+func (stats CacheStatistics) HitRate() float64 {
+	total := stats.Hits + stats.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(total)
+}
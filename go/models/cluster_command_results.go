@@ -0,0 +1,162 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import "slices"
+
+// ClusterNodeRole is the role of a node in the cluster.
+type ClusterNodeRole string
+
+const (
+	// The node is a primary, which serves hash slots.
+	PrimaryRole ClusterNodeRole = "primary"
+	// The node is a replica of a primary.
+	ReplicaRole ClusterNodeRole = "replica"
+)
+
+// SlotRange is an inclusive range of hash slots.
+type SlotRange struct {
+	// The first slot of the range.
+	Start int64
+	// The last slot of the range.
+	End int64
+}
+
+// ClusterNode describes a node of the cluster, as returned by CLUSTER NODES and CLUSTER REPLICAS.
+type ClusterNode struct {
+	// The ID of the node.
+	Id string
+	// The address of the node, in `host:port` format.
+	Address string
+	// The IP address of the node.
+	Host string
+	// The port the node serves clients on.
+	Port int64
+	// The port of the cluster bus of the node.
+	ClusterBusPort int64
+	// The hostname of the node, empty if not announced.
+	Hostname string
+	// The flags of the node, e.g. `myself`, `master`, `slave`, `fail?` or `fail`.
+	Flags []string
+	// The role of the node.
+	Role ClusterNodeRole
+	// The ID of the primary of the node, empty if the node is a primary.
+	PrimaryId string
+	// The UNIX time the last ping was sent, in milliseconds. 0 if there are no pending pings.
+	PingSent int64
+	// The UNIX time the last pong was received, in milliseconds.
+	PongReceived int64
+	// The configuration epoch of the node, or of its primary if the node is a replica.
+	ConfigEpoch int64
+	// The state of the link to the node, `connected` or `disconnected`.
+	LinkState string
+	// The slots served by the node.
+	Slots []SlotRange
+	// The slots being migrated from the node, mapped to the ID of the target node.
+	MigratingSlots map[int64]string
+	// The slots being imported into the node, mapped to the ID of the source node.
+	ImportingSlots map[int64]string
+}
+
+// HasFlag returns whether the node has the given flag, e.g. `myself` or `fail`.
+func (node ClusterNode) HasFlag(flag string) bool {
+	return slices.Contains(node.Flags, flag)
+}
+
+// ClusterShardNode describes a node of a shard, as returned by CLUSTER SHARDS.
+type ClusterShardNode struct {
+	// The ID of the node.
+	Id string
+	// The port the node serves clients on, 0 if the node only serves TLS clients.
+	Port int64
+	// The TLS port of the node, 0 if TLS isn't enabled.
+	TlsPort int64
+	// The IP address of the node.
+	Ip string
+	// The preferred endpoint to connect to the node.
+	Endpoint string
+	// The hostname of the node, empty if not announced.
+	Hostname string
+	// The role of the node.
+	Role ClusterNodeRole
+	// The replication offset of the node.
+	ReplicationOffset int64
+	// The health of the node, `online`, `failed` or `loading`.
+	Health string
+}
+
+// ClusterShard describes a shard of the cluster, as returned by CLUSTER SHARDS.
+type ClusterShard struct {
+	// The slots served by the shard.
+	Slots []SlotRange
+	// The nodes of the shard.
+	Nodes []ClusterShardNode
+}
+
+// ClusterSlotNode describes a node serving a range of slots, as returned by CLUSTER SLOTS.
+type ClusterSlotNode struct {
+	// The preferred endpoint to connect to the node.
+	Endpoint string
+	// The port of the node.
+	Port int64
+	// The ID of the node.
+	Id string
+	// The hostname of the node, empty if not announced.
+	Hostname string
+}
+
+// ClusterSlotRange describes the nodes serving a range of slots, as returned by CLUSTER SLOTS.
+type ClusterSlotRange struct {
+	// The first slot of the range.
+	Start int64
+	// The last slot of the range.
+	End int64
+	// The primary serving the slots.
+	Primary ClusterSlotNode
+	// The replicas of the primary.
+	Replicas []ClusterSlotNode
+}
+
+// ClusterInfo describes the state of the cluster, as returned by CLUSTER INFO.
+type ClusterInfo struct {
+	// The state of the cluster, `ok` or `fail`.
+	State string
+	// The number of slots which are associated to a node.
+	SlotsAssigned int64
+	// The number of slots served by nodes in the `ok` state.
+	SlotsOk int64
+	// The number of slots served by nodes in the `pfail` state.
+	SlotsPfail int64
+	// The number of slots served by nodes in the `fail` state.
+	SlotsFail int64
+	// The number of nodes known by the node, including the nodes in handshake state.
+	KnownNodes int64
+	// The number of primaries serving at least one slot.
+	Size int64
+	// The highest configuration epoch known by the node.
+	CurrentEpoch int64
+	// The configuration epoch of the node.
+	MyEpoch int64
+	// The number of messages sent through the cluster bus.
+	StatsMessagesSent int64
+	// The number of messages received through the cluster bus.
+	StatsMessagesReceived int64
+	// All the fields returned by the command, including the fields which have no dedicated member.
+	Raw map[string]string
+}
+
+// ClusterLink describes a cluster bus link of a node, as returned by CLUSTER LINKS.
+type ClusterLink struct {
+	// The direction of the link, `to` if the link was opened by the node, or `from` if it was accepted.
+	Direction string
+	// The ID of the peer node.
+	Node string
+	// The UNIX time the link was created, in milliseconds.
+	CreateTime int64
+	// The events currently registered for the link, `r` or `w`.
+	Events string
+	// The size of the send buffer of the link, in bytes.
+	SendBufferAllocated int64
+	// The number of bytes used by the send buffer of the link.
+	SendBufferUsed int64
+}
@@ -0,0 +1,75 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// FtSearchDocument is a document matched by FT.SEARCH.
+type FtSearchDocument struct {
+	// The key of the document.
+	Key string
+	// The score of the document. Only set when the query was sent with the WITHSCORES option.
+	Score Result[float64]
+	// The returned fields of the document. Empty when the query was sent with the NOCONTENT option.
+	Fields map[string]string
+}
+
+// FtSearchResult is the result of FT.SEARCH.
+type FtSearchResult struct {
+	// The total number of documents matched by the query. Can be greater than the number of returned documents when
+	// the results are limited.
+	TotalResults int64
+	// The returned documents, in the order the server sent them.
+	Documents []FtSearchDocument
+}
+
+// FtInfoAttribute describes a field of an index, as returned by FT.INFO.
+type FtInfoAttribute struct {
+	// The name of the hash field or the JSON path to the field.
+	Identifier string
+	// The name used to reference the field in queries.
+	Attribute string
+	// The type of the field, e.g. `TEXT`, `TAG`, `NUMERIC` or `VECTOR`.
+	Type string
+	// The remaining properties of the field, such as the separator of a TAG field or the index parameters of a VECTOR
+	// field.
+	Properties map[string]any
+}
+
+// FtInfo is the information about an index, as returned by FT.INFO.
+type FtInfo struct {
+	// The name of the index.
+	IndexName string
+	// The type of the indexed keys, `HASH` or `JSON`.
+	KeyType string
+	// The prefixes of the indexed keys.
+	Prefixes []string
+	// The fields of the index.
+	Attributes []FtInfoAttribute
+	// The number of indexed documents.
+	NumDocs int64
+	// The number of indexed records.
+	NumRecords int64
+	// The number of distinct indexed terms.
+	NumTerms int64
+	// The number of keys which failed to be indexed.
+	HashIndexingFailures int64
+	// Whether the index is being backfilled with the existing keys.
+	BackfillInProgress bool
+	// The progress of the backfill, between 0 and 1.
+	BackfillCompletePercent float64
+	// The number of pending updates of the index.
+	MutationQueueSize int64
+	// The state of the index, e.g. `ready`.
+	State string
+	// All the information returned by the server, including entries not mapped to a field of this struct.
+	Raw map[string]any
+}
+
+// FtProfileResult is the result of FT.PROFILE.
+type FtProfileResult struct {
+	// The result of the profiled query, when the query is an FT.SEARCH query.
+	SearchResult FtSearchResult
+	// The result of the profiled query, when the query is an FT.AGGREGATE query.
+	AggregateResult []map[string]any
+	// The profiling report, mapping each profiled stage to its statistics.
+	Profile map[string]any
+}
@@ -0,0 +1,30 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// HashFieldExpireResult is the outcome of setting the expiration of a hash field, as returned by HEXPIRE, HPEXPIRE,
+// HEXPIREAT and HPEXPIREAT.
+type HashFieldExpireResult int64
+
+const (
+	// The field doesn't exist, or the key doesn't exist.
+	HashFieldExpireNoSuchField HashFieldExpireResult = -2
+	// The expiration wasn't set, because the NX, XX, GT or LT condition wasn't met.
+	HashFieldExpireConditionNotMet HashFieldExpireResult = 0
+	// The expiration was set or updated.
+	HashFieldExpireSet HashFieldExpireResult = 1
+	// The field was deleted, because the expiration is in the past or is 0.
+	HashFieldExpireDeleted HashFieldExpireResult = 2
+)
+
+// HashFieldPersistResult is the outcome of removing the expiration of a hash field, as returned by HPERSIST.
+type HashFieldPersistResult int64
+
+const (
+	// The field doesn't exist, or the key doesn't exist.
+	HashFieldPersistNoSuchField HashFieldPersistResult = -2
+	// The field exists, but has no expiration.
+	HashFieldPersistNoExpiry HashFieldPersistResult = -1
+	// The expiration was removed.
+	HashFieldPersistRemoved HashFieldPersistResult = 1
+)
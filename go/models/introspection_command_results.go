@@ -0,0 +1,137 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// CommandInfo describes a command, as returned by COMMAND INFO.
+type CommandInfo struct {
+	// The name of the command in lowercase, e.g. `get` or `config|set` for a subcommand.
+	Name string
+	// The number of arguments of the command, including its name. A negative arity means that the command takes at least
+	// the absolute value of arguments.
+	Arity int64
+	// The flags of the command, e.g. `readonly`, `write` or `fast`.
+	Flags []string
+	// The position of the first key in the arguments, 0 if the command has no keys.
+	FirstKey int64
+	// The position of the last key in the arguments. A negative position is counted from the end of the arguments.
+	LastKey int64
+	// The step between the keys from the first key to the last key.
+	Step int64
+	// The ACL categories of the command, e.g. `@read` or `@string`. Only returned by Valkey 6.0 and above.
+	AclCategories []string
+	// The tips of the command for the clients, e.g. `request_policy:all_shards`. Only returned by Valkey 7.0 and above.
+	Tips []string
+	// The specifications of the keys of the command. Only returned by Valkey 7.0 and above.
+	KeySpecs []KeySpec
+	// The subcommands of the command, e.g. the subcommands of `config`. Only returned by Valkey 7.0 and above.
+	Subcommands []CommandInfo
+}
+
+// KeySpec describes how to find keys in the arguments of a command. The search starts at the position found by
+// [KeySpec.BeginSearch], from which [KeySpec.FindKeys] finds the keys.
+type KeySpec struct {
+	// Notes about the keys.
+	Notes string
+	// The flags of the keys, e.g. `RO`, `RW`, `access` or `update`.
+	Flags []string
+	// How to find the first argument from which keys are searched.
+	BeginSearch KeySpecBeginSearch
+	// How to find the keys from the first argument.
+	FindKeys KeySpecFindKeys
+}
+
+// KeySpecBeginSearch is the way to find the first argument from which keys are searched.
+type KeySpecBeginSearch struct {
+	// The type of search: `index`, `keyword` or `unknown`.
+	Type string
+	// The position of the first argument, for the `index` type.
+	Index int64
+	// The keyword preceding the first argument, for the `keyword` type.
+	Keyword string
+	// The position from which the keyword is searched, for the `keyword` type. A negative position is counted from the
+	// end of the arguments.
+	StartFrom int64
+}
+
+// KeySpecFindKeys is the way to find the keys from the first argument found by [KeySpecBeginSearch].
+type KeySpecFindKeys struct {
+	// The type of search: `range`, `keynum` or `unknown`.
+	Type string
+	// The position of the last key relative to the first argument, for the `range` type. A negative position is counted
+	// from the end of the arguments.
+	LastKey int64
+	// The step between the keys, for the `range` and `keynum` types.
+	KeyStep int64
+	// The divisor of the number of remaining arguments which are keys when LastKey is -1, for the `range` type.
+	Limit int64
+	// The position of the argument holding the number of keys relative to the first argument, for the `keynum` type.
+	KeyNumIdx int64
+	// The position of the first key relative to the first argument, for the `keynum` type.
+	FirstKey int64
+}
+
+// CommandDocs is the documentation of a command, as returned by COMMAND DOCS.
+type CommandDocs struct {
+	// The summary of the command.
+	Summary string
+	// The server version which added the command.
+	Since string
+	// The functional group of the command, e.g. `string` or `server`.
+	Group string
+	// The complexity of the command.
+	Complexity string
+	// The documentation flags of the command, e.g. `deprecated` or `syscmd`.
+	DocFlags []string
+	// The server version which deprecated the command, empty if the command isn't deprecated.
+	DeprecatedSince string
+	// The alternative of a deprecated command.
+	ReplacedBy string
+	// The changes of the behavior of the command.
+	History []CommandHistoryEntry
+	// The arguments of the command.
+	Arguments []CommandArgument
+	// The documentation of the subcommands, keyed by their name, e.g. `config|set`.
+	Subcommands map[string]CommandDocs
+}
+
+// CommandHistoryEntry is a change of the behavior of a command.
+type CommandHistoryEntry struct {
+	// The server version which changed the behavior.
+	Version string
+	// The description of the change.
+	Description string
+}
+
+// CommandArgument is an argument of a command, as returned by COMMAND DOCS.
+type CommandArgument struct {
+	// The name of the argument.
+	Name string
+	// The type of the argument, e.g. `key`, `string`, `integer`, `pure-token`, `oneof` or `block`.
+	Type string
+	// The name of the argument for display purposes.
+	DisplayText string
+	// The index of the key specification of the argument in [CommandInfo.KeySpecs], -1 if the argument isn't a key.
+	KeySpecIndex int64
+	// The constant literal preceding the argument, e.g. `EX`.
+	Token string
+	// The summary of the argument.
+	Summary string
+	// The server version which added the argument.
+	Since string
+	// The server version which deprecated the argument.
+	DeprecatedSince string
+	// The flags of the argument: `optional`, `multiple` or `multiple_token`.
+	Flags []string
+	// The value of the argument, for the types other than `oneof` and `block`.
+	Value string
+	// The nested arguments, for the `oneof` and `block` types.
+	Arguments []CommandArgument
+}
+
+// KeyWithFlags is a key of a command and the way the command accesses it, as returned by COMMAND GETKEYSANDFLAGS.
+type KeyWithFlags struct {
+	// The key.
+	Key string
+	// The flags of the key, e.g. `RO`, `RW`, `access` or `update`.
+	Flags []string
+}
@@ -0,0 +1,15 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// ModuleInfo is a module loaded by the server, as returned by MODULE LIST.
+type ModuleInfo struct {
+	// The name of the module.
+	Name string
+	// The version of the module.
+	Version int64
+	// The path of the module library. Only returned by Valkey 7.0 and above.
+	Path string
+	// The arguments which were given to the module when it was loaded. Only returned by Valkey 7.0 and above.
+	Args []string
+}
@@ -0,0 +1,118 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// SlowLogEntry is an entry of the slow log, as returned by SLOWLOG GET.
+type SlowLogEntry struct {
+	// The unique ID of the entry.
+	Id int64
+	// The UNIX timestamp at which the command was processed, in seconds.
+	Timestamp int64
+	// The time needed to execute the command, in microseconds.
+	Duration int64
+	// The command and its arguments. The server may trim long arguments and argument lists.
+	Args []string
+	// The address of the client which executed the command, in `ip:port` format.
+	ClientAddr string
+	// The name of the client which executed the command, empty if no name was set.
+	ClientName string
+}
+
+// LatencyEvent is the latest latency spike of an event, as returned by LATENCY LATEST.
+type LatencyEvent struct {
+	// The name of the event, e.g. `command` or `fast-command`.
+	Name string
+	// The UNIX timestamp of the latest latency spike of the event, in seconds.
+	Timestamp int64
+	// The latency of the latest spike, in milliseconds.
+	Latest int64
+	// The maximum latency of the event since the server started, in milliseconds.
+	Max int64
+}
+
+// LatencySample is a latency spike of an event, as returned by LATENCY HISTORY.
+type LatencySample struct {
+	// The UNIX timestamp of the latency spike, in seconds.
+	Timestamp int64
+	// The latency of the spike, in milliseconds.
+	Latency int64
+}
+
+// CommandLatencyHistogram is the latency distribution of a command, as returned by LATENCY HISTOGRAM.
+type CommandLatencyHistogram struct {
+	// The number of calls of the command.
+	Calls int64
+	// The cumulative number of calls by bucket, keyed by the upper bound of the latency of the bucket in microseconds.
+	// The buckets grow by a power of 2, and the empty buckets are omitted.
+	Histogram map[int64]int64
+}
+
+// MemoryStats is the memory usage of the server, as returned by MEMORY STATS. The sizes are in bytes.
+type MemoryStats struct {
+	// The peak memory consumed by the server.
+	PeakAllocated int64
+	// The total memory allocated by the server.
+	TotalAllocated int64
+	// The memory consumed by the server at startup.
+	StartupAllocated int64
+	// The size of the replication backlog.
+	ReplicationBacklog int64
+	// The size of the buffers of the replicas.
+	ClientsReplicas int64
+	// The size of the buffers of the other clients.
+	ClientsNormal int64
+	// The memory used by the links to the other nodes of the cluster.
+	ClusterLinks int64
+	// The size of the AOF buffers.
+	AofBuffer int64
+	// The size of the caches of the Lua scripts.
+	LuaCaches int64
+	// The size of the caches of the functions.
+	FunctionsCaches int64
+	// The memory used to manage the dataset, e.g. by the buffers and the hash tables.
+	OverheadTotal int64
+	// The number of keys in all the databases.
+	KeysCount int64
+	// The average memory used by a key.
+	KeysBytesPerKey int64
+	// The memory used by the dataset, which is the total memory minus the overhead.
+	DatasetBytes int64
+	// The percentage of the net memory usage used by the dataset.
+	DatasetPercentage float64
+	// The percentage of the peak memory used currently.
+	PeakPercentage float64
+	// The memory allocated by the allocator.
+	AllocatorAllocated int64
+	// The memory in the active pages of the allocator, including the external fragmentation.
+	AllocatorActive int64
+	// The memory resident in the allocator, including the pages which can be released to the system.
+	AllocatorResident int64
+	// The ratio of the active memory to the allocated memory of the allocator.
+	AllocatorFragmentationRatio float64
+	// The difference between the active memory and the allocated memory of the allocator.
+	AllocatorFragmentationBytes int64
+	// The ratio of the resident memory to the active memory of the allocator.
+	AllocatorRssRatio float64
+	// The difference between the resident memory and the active memory of the allocator.
+	AllocatorRssBytes int64
+	// The ratio of the resident memory of the process to the resident memory of the allocator.
+	RssOverheadRatio float64
+	// The difference between the resident memory of the process and the resident memory of the allocator.
+	RssOverheadBytes int64
+	// The ratio of the resident memory of the process to the memory used by the server.
+	Fragmentation float64
+	// The difference between the resident memory of the process and the memory used by the server.
+	FragmentationBytes int64
+	// The overhead of the hash tables of each database, keyed by the index of the database.
+	Databases map[int64]DatabaseMemoryOverhead
+	// All the fields of the reply, including the fields added in newer server versions.
+	Raw map[string]any
+}
+
+// DatabaseMemoryOverhead is the memory used by the hash tables of a database, in bytes.
+type DatabaseMemoryOverhead struct {
+	// The memory used by the hash table of the keys.
+	HashTableMain int64
+	// The memory used by the hash table of the expiration times.
+	HashTableExpires int64
+}
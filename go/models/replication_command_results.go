@@ -0,0 +1,62 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// ServerRole is the replication role of a server, as returned by ROLE.
+type ServerRole string
+
+const (
+	// The server is a primary, which may have replicas.
+	ServerRolePrimary ServerRole = "primary"
+	// The server is a replica of a primary.
+	ServerRoleReplica ServerRole = "replica"
+	// The server is a sentinel.
+	ServerRoleSentinel ServerRole = "sentinel"
+)
+
+// RoleInfo is the replication role of a server, as returned by ROLE. The fields which don't apply to the role are left
+// empty.
+type RoleInfo struct {
+	// The role of the server.
+	Role ServerRole
+	// The replication offset of a primary, or the offset of the replication stream received by a replica.
+	ReplicationOffset int64
+	// The connected replicas of a primary.
+	Replicas []ReplicaInfo
+	// The host of the primary of a replica.
+	PrimaryHost string
+	// The port of the primary of a replica.
+	PrimaryPort int64
+	// The state of the link of a replica to its primary, e.g. `connect`, `connecting`, `sync` or `connected`.
+	LinkState string
+	// The names of the primaries monitored by a sentinel.
+	MonitoredPrimaries []string
+}
+
+// IsPrimary returns whether the server is a primary.
+func (info RoleInfo) IsPrimary() bool {
+	return info.Role == ServerRolePrimary
+}
+
+// IsReplica returns whether the server is a replica.
+func (info RoleInfo) IsReplica() bool {
+	return info.Role == ServerRoleReplica
+}
+
+// ReplicaInfo is a replica connected to a primary, as returned by ROLE.
+type ReplicaInfo struct {
+	// The IP address of the replica.
+	Host string
+	// The port of the replica.
+	Port int64
+	// The replication offset acknowledged by the replica.
+	ReplicationOffset int64
+}
+
+// WaitAofResult is the number of servers which fsynced the writes to their AOF, as returned by WAITAOF.
+type WaitAofResult struct {
+	// 1 if the local server fsynced the writes, 0 otherwise.
+	Local int64
+	// The number of replicas which fsynced the writes.
+	Replicas int64
+}
@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+)
+
+func init() {
+	internal.ExecuteModuleCommand = executeModuleCommand
+}
+
+// executeModuleCommand executes a command of a module package, e.g. `json`, with its request type, so that the core
+// routes and handles it as the command of the batches.
+func executeModuleCommand(ctx context.Context, client any, requestType uint32, args []string) (any, error) {
+	var base *baseClient
+	switch c := client.(type) {
+	case *Client:
+		base = &c.baseClient
+	case *ClusterClient:
+		base = &c.baseClient
+	default:
+		return nil, fmt.Errorf("unsupported client type: %T", client)
+	}
+	result, err := base.executeCommand(ctx, C.RequestType(requestType), args)
+	if err != nil {
+		return nil, err
+	}
+	return handleInterfaceResponse(result)
+}
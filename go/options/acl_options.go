@@ -0,0 +1,211 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	AclOnRule             string = "on"             // Valkey API rule to enable an ACL user.
+	AclOffRule            string = "off"            // Valkey API rule to disable an ACL user.
+	AclNoPassRule         string = "nopass"         // Valkey API rule to allow any password for an ACL user.
+	AclResetPassRule      string = "resetpass"      // Valkey API rule to remove all the passwords of an ACL user.
+	AclAllKeysRule        string = "allkeys"        // Valkey API rule to allow access to all keys.
+	AclResetKeysRule      string = "resetkeys"      // Valkey API rule to remove all the key patterns of an ACL user.
+	AclAllChannelsRule    string = "allchannels"    // Valkey API rule to allow access to all Pub/Sub channels.
+	AclResetChannelsRule  string = "resetchannels"  // Valkey API rule to remove all the channel patterns of an ACL user.
+	AclAllCommandsRule    string = "allcommands"    // Valkey API rule to allow all commands.
+	AclNoCommandsRule     string = "nocommands"     // Valkey API rule to deny all commands.
+	AclClearSelectorsRule string = "clearselectors" // Valkey API rule to remove all the selectors of an ACL user.
+	AclResetRule          string = "reset"          // Valkey API rule to reset an ACL user to its default state.
+	AclLogResetKeyword    string = "RESET"          // Valkey API keyword to clear the ACL log.
+)
+
+// AclRules is a builder of the rules applied to a user by the ACL SETUSER command. The rules are applied in the order
+// they are added.
+//
+// Example:
+//
+//	rules := options.NewAclRules().
+//		Reset().
+//		On().
+//		AddPassword("secret").
+//		AddKeyPattern("service:*").
+//		AllowCategory("read").
+//		AllowCommand("set")
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/acl-setuser/
+type AclRules struct {
+	Rules []string
+	err   error
+}
+
+func NewAclRules() *AclRules {
+	return &AclRules{Rules: []string{}}
+}
+
+func (rules *AclRules) addRule(rule string) *AclRules {
+	rules.Rules = append(rules.Rules, rule)
+	return rules
+}
+
+func (rules *AclRules) addPatternRule(prefix string, value string, description string) *AclRules {
+	if value == "" && rules.err == nil {
+		rules.err = errors.New(description + " cannot be empty")
+	}
+	return rules.addRule(prefix + value)
+}
+
+// Enables the user. It's possible to authenticate as this user.
+func (rules *AclRules) On() *AclRules {
+	return rules.addRule(AclOnRule)
+}
+
+// Disables the user. It's no longer possible to authenticate as this user, but already authenticated connections still
+// work.
+func (rules *AclRules) Off() *AclRules {
+	return rules.addRule(AclOffRule)
+}
+
+// Allows the user to authenticate with any password.
+func (rules *AclRules) NoPass() *AclRules {
+	return rules.addRule(AclNoPassRule)
+}
+
+// Removes all the passwords of the user, and the `nopass` flag.
+func (rules *AclRules) ResetPass() *AclRules {
+	return rules.addRule(AclResetPassRule)
+}
+
+// Adds a password to the list of valid passwords of the user.
+func (rules *AclRules) AddPassword(password string) *AclRules {
+	return rules.addPatternRule(">", password, "password")
+}
+
+// Removes a password from the list of valid passwords of the user.
+func (rules *AclRules) RemovePassword(password string) *AclRules {
+	return rules.addPatternRule("<", password, "password")
+}
+
+// Adds a SHA-256 hashed password, in hex format, to the list of valid passwords of the user.
+func (rules *AclRules) AddHashedPassword(hash string) *AclRules {
+	return rules.addPatternRule("#", hash, "password hash")
+}
+
+// Removes a SHA-256 hashed password, in hex format, from the list of valid passwords of the user.
+func (rules *AclRules) RemoveHashedPassword(hash string) *AclRules {
+	return rules.addPatternRule("!", hash, "password hash")
+}
+
+// Allows the user to access all the keys. Same as `AddKeyPattern("*")`.
+func (rules *AclRules) AllKeys() *AclRules {
+	return rules.addRule(AclAllKeysRule)
+}
+
+// Allows the user to read and write the keys matching the glob-style pattern.
+func (rules *AclRules) AddKeyPattern(pattern string) *AclRules {
+	return rules.addPatternRule("~", pattern, "key pattern")
+}
+
+// Allows the user to read the keys matching the glob-style pattern. Supported since Valkey 7.0.
+func (rules *AclRules) AddReadKeyPattern(pattern string) *AclRules {
+	return rules.addPatternRule("%R~", pattern, "key pattern")
+}
+
+// Allows the user to write the keys matching the glob-style pattern. Supported since Valkey 7.0.
+func (rules *AclRules) AddWriteKeyPattern(pattern string) *AclRules {
+	return rules.addPatternRule("%W~", pattern, "key pattern")
+}
+
+// Removes all the key patterns of the user.
+func (rules *AclRules) ResetKeys() *AclRules {
+	return rules.addRule(AclResetKeysRule)
+}
+
+// Allows the user to access all the Pub/Sub channels. Same as `AddChannelPattern("*")`.
+func (rules *AclRules) AllChannels() *AclRules {
+	return rules.addRule(AclAllChannelsRule)
+}
+
+// Allows the user to access the Pub/Sub channels matching the glob-style pattern.
+func (rules *AclRules) AddChannelPattern(pattern string) *AclRules {
+	return rules.addPatternRule("&", pattern, "channel pattern")
+}
+
+// Removes all the channel patterns of the user.
+func (rules *AclRules) ResetChannels() *AclRules {
+	return rules.addRule(AclResetChannelsRule)
+}
+
+// Allows the user to execute all the commands. Same as `AllowCategory("all")`.
+func (rules *AclRules) AllCommands() *AclRules {
+	return rules.addRule(AclAllCommandsRule)
+}
+
+// Denies the user to execute any command. Same as `DenyCategory("all")`.
+func (rules *AclRules) NoCommands() *AclRules {
+	return rules.addRule(AclNoCommandsRule)
+}
+
+// Allows the user to execute the command, or the subcommand given as `command|subcommand`.
+func (rules *AclRules) AllowCommand(command string) *AclRules {
+	return rules.addPatternRule("+", command, "command")
+}
+
+// Denies the user to execute the command, or the subcommand given as `command|subcommand`.
+func (rules *AclRules) DenyCommand(command string) *AclRules {
+	return rules.addPatternRule("-", command, "command")
+}
+
+// Allows the user to execute all the commands of the category, see [ACL CAT].
+//
+// [ACL CAT]: https://valkey.io/commands/acl-cat/
+func (rules *AclRules) AllowCategory(category string) *AclRules {
+	return rules.addPatternRule("+@", category, "category")
+}
+
+// Denies the user to execute the commands of the category, see [ACL CAT].
+//
+// [ACL CAT]: https://valkey.io/commands/acl-cat/
+func (rules *AclRules) DenyCategory(category string) *AclRules {
+	return rules.addPatternRule("-@", category, "category")
+}
+
+// Adds a selector, a set of rules granting additional permissions to the user. Selectors can't contain passwords nor
+// flags. Supported since Valkey 7.0.
+func (rules *AclRules) AddSelector(selector AclRules) *AclRules {
+	if selector.err != nil && rules.err == nil {
+		rules.err = selector.err
+	}
+	if len(selector.Rules) == 0 && rules.err == nil {
+		rules.err = errors.New("selector cannot be empty")
+	}
+	return rules.addRule("(" + strings.Join(selector.Rules, " ") + ")")
+}
+
+// Removes all the selectors of the user. Supported since Valkey 7.0.
+func (rules *AclRules) ClearSelectors() *AclRules {
+	return rules.addRule(AclClearSelectorsRule)
+}
+
+// Resets the user to its default state: `resetpass`, `resetkeys`, `resetchannels`, `off`, `clearselectors`,
+// `-@all`.
+func (rules *AclRules) Reset() *AclRules {
+	return rules.addRule(AclResetRule)
+}
+
+// Adds a rule as is, for rules which have no dedicated builder method.
+func (rules *AclRules) AddRule(rule string) *AclRules {
+	return rules.addPatternRule("", rule, "rule")
+}
+
+func (rules *AclRules) ToArgs() ([]string, error) {
+	if rules.err != nil {
+		return nil, rules.err
+	}
+	return rules.Rules, nil
+}
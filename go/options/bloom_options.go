@@ -0,0 +1,138 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	BloomCapacityKeyword   string = "CAPACITY"   // Valkey API keyword for the capacity of a bloom filter.
+	BloomErrorKeyword      string = "ERROR"      // Valkey API keyword for the false positive rate of a bloom filter.
+	BloomExpansionKeyword  string = "EXPANSION"  // Valkey API keyword for the growth rate of a scaling bloom filter.
+	BloomNonScalingKeyword string = "NONSCALING" // Valkey API keyword to prevent a bloom filter from scaling out.
+	BloomNoCreateKeyword   string = "NOCREATE"   // Valkey API keyword to prevent BF.INSERT from creating a filter.
+	BloomItemsKeyword      string = "ITEMS"      // Valkey API keyword preceding the items of BF.INSERT.
+)
+
+// BloomReserveOptions represents optional arguments for the BF.RESERVE command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/bf.reserve/
+type BloomReserveOptions struct {
+	// The growth rate of the capacity when the filter scales out. The default of the server is used when 0.
+	Expansion int64
+	// Prevents the filter from scaling out once its capacity is reached. Can't be combined with Expansion.
+	NonScaling bool
+}
+
+func NewBloomReserveOptions() *BloomReserveOptions {
+	return &BloomReserveOptions{}
+}
+
+// Sets the growth rate of the capacity when the filter scales out.
+func (opts *BloomReserveOptions) SetExpansion(expansion int64) *BloomReserveOptions {
+	opts.Expansion = expansion
+	return opts
+}
+
+// Prevents the filter from scaling out once its capacity is reached.
+func (opts *BloomReserveOptions) SetNonScaling() *BloomReserveOptions {
+	opts.NonScaling = true
+	return opts
+}
+
+func (opts *BloomReserveOptions) ToArgs() ([]string, error) {
+	return bloomScalingArgs(opts.Expansion, opts.NonScaling)
+}
+
+// BloomInsertOptions represents optional arguments for the BF.INSERT command. The capacity, the error rate, the
+// expansion and NONSCALING only apply when the filter is created by the command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/bf.insert/
+type BloomInsertOptions struct {
+	// The number of items the filter can hold before scaling out. The default of the server is used when 0.
+	Capacity int64
+	// The false positive rate of the filter, between 0 and 1. The default of the server is used when 0.
+	ErrorRate float64
+	// The growth rate of the capacity when the filter scales out. The default of the server is used when 0.
+	Expansion int64
+	// Prevents the filter from scaling out once its capacity is reached. Can't be combined with Expansion.
+	NonScaling bool
+	// Prevents the command from creating the filter when it doesn't exist. Can't be combined with Capacity and ErrorRate.
+	NoCreate bool
+}
+
+func NewBloomInsertOptions() *BloomInsertOptions {
+	return &BloomInsertOptions{}
+}
+
+// Sets the number of items the filter can hold before scaling out.
+func (opts *BloomInsertOptions) SetCapacity(capacity int64) *BloomInsertOptions {
+	opts.Capacity = capacity
+	return opts
+}
+
+// Sets the false positive rate of the filter, between 0 and 1.
+func (opts *BloomInsertOptions) SetErrorRate(errorRate float64) *BloomInsertOptions {
+	opts.ErrorRate = errorRate
+	return opts
+}
+
+// Sets the growth rate of the capacity when the filter scales out.
+func (opts *BloomInsertOptions) SetExpansion(expansion int64) *BloomInsertOptions {
+	opts.Expansion = expansion
+	return opts
+}
+
+// Prevents the filter from scaling out once its capacity is reached.
+func (opts *BloomInsertOptions) SetNonScaling() *BloomInsertOptions {
+	opts.NonScaling = true
+	return opts
+}
+
+// Prevents the command from creating the filter when it doesn't exist.
+func (opts *BloomInsertOptions) SetNoCreate() *BloomInsertOptions {
+	opts.NoCreate = true
+	return opts
+}
+
+func (opts *BloomInsertOptions) ToArgs() ([]string, error) {
+	if opts.NoCreate && (opts.Capacity != 0 || opts.ErrorRate != 0) {
+		return nil, errors.New("NOCREATE can't be combined with CAPACITY or ERROR")
+	}
+	args := []string{}
+	if opts.Capacity != 0 {
+		args = append(args, BloomCapacityKeyword, utils.IntToString(opts.Capacity))
+	}
+	if opts.ErrorRate != 0 {
+		args = append(args, BloomErrorKeyword, utils.FloatToString(opts.ErrorRate))
+	}
+	scalingArgs, err := bloomScalingArgs(opts.Expansion, opts.NonScaling)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, scalingArgs...)
+	if opts.NoCreate {
+		args = append(args, BloomNoCreateKeyword)
+	}
+	return args, nil
+}
+
+func bloomScalingArgs(expansion int64, nonScaling bool) ([]string, error) {
+	if expansion != 0 && nonScaling {
+		return nil, errors.New("EXPANSION can't be combined with NONSCALING")
+	}
+	if expansion != 0 {
+		return []string{BloomExpansionKeyword, utils.IntToString(expansion)}, nil
+	}
+	if nonScaling {
+		return []string{BloomNonScalingKeyword}, nil
+	}
+	return []string{}, nil
+}
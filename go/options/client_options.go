@@ -0,0 +1,239 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// ClientType is the type of the client connections to filter with CLIENT LIST and CLIENT KILL.
+type ClientType string
+
+const (
+	ClientTypeNormal  ClientType = "NORMAL"
+	ClientTypePrimary ClientType = "MASTER"
+	ClientTypeReplica ClientType = "REPLICA"
+	ClientTypePubSub  ClientType = "PUBSUB"
+)
+
+// ClientPauseMode is the mode of CLIENT PAUSE.
+type ClientPauseMode string
+
+const (
+	// Pauses all the commands of the clients.
+	ClientPauseAll ClientPauseMode = "ALL"
+	// Pauses the commands which may modify the data.
+	ClientPauseWrite ClientPauseMode = "WRITE"
+)
+
+// ClientUnblockMode is the way a blocked client is unblocked by CLIENT UNBLOCK.
+type ClientUnblockMode string
+
+const (
+	// Unblocks the client as if the timeout of the command was reached.
+	ClientUnblockTimeout ClientUnblockMode = "TIMEOUT"
+	// Unblocks the client with an `UNBLOCKED` error.
+	ClientUnblockError ClientUnblockMode = "ERROR"
+)
+
+// ClientSetInfoAttribute is an attribute of the connection set by CLIENT SETINFO.
+type ClientSetInfoAttribute string
+
+const (
+	// The name of the client library.
+	ClientLibName ClientSetInfoAttribute = "LIB-NAME"
+	// The version of the client library.
+	ClientLibVersion ClientSetInfoAttribute = "LIB-VER"
+)
+
+// ClientFilter selects client connections for CLIENT LIST and CLIENT KILL. The connections must match all the filters.
+//
+// Filtering CLIENT LIST by anything but the type and the IDs is supported since Valkey 8.0.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+type ClientFilter struct {
+	Ids    []int64
+	User   string
+	Addr   string
+	LAddr  string
+	Type   ClientType
+	MaxAge int64
+	SkipMe *bool
+}
+
+func NewClientFilter() *ClientFilter {
+	return &ClientFilter{}
+}
+
+// Selects the connections with the given IDs. Multiple IDs for CLIENT KILL are supported since Valkey 8.0.
+func (filter *ClientFilter) SetIds(ids ...int64) *ClientFilter {
+	filter.Ids = ids
+	return filter
+}
+
+// Selects the connections authenticated with the given username.
+func (filter *ClientFilter) SetUser(user string) *ClientFilter {
+	filter.User = user
+	return filter
+}
+
+// Selects the connection from the given address, in `ip:port` format.
+func (filter *ClientFilter) SetAddr(addr string) *ClientFilter {
+	filter.Addr = addr
+	return filter
+}
+
+// Selects the connections to the given local address of the server, in `ip:port` format.
+func (filter *ClientFilter) SetLAddr(laddr string) *ClientFilter {
+	filter.LAddr = laddr
+	return filter
+}
+
+// Selects the connections of the given type.
+func (filter *ClientFilter) SetType(clientType ClientType) *ClientFilter {
+	filter.Type = clientType
+	return filter
+}
+
+// Selects the connections older than the given age, in seconds.
+func (filter *ClientFilter) SetMaxAge(seconds int64) *ClientFilter {
+	filter.MaxAge = seconds
+	return filter
+}
+
+// Sets whether the connection executing the command is skipped. By default, CLIENT KILL skips it.
+func (filter *ClientFilter) SetSkipMe(skipMe bool) *ClientFilter {
+	filter.SkipMe = &skipMe
+	return filter
+}
+
+func (filter *ClientFilter) ToArgs() ([]string, error) {
+	args := []string{}
+	if len(filter.Ids) > 0 {
+		args = append(args, "ID")
+		for _, id := range filter.Ids {
+			args = append(args, utils.IntToString(id))
+		}
+	}
+	if filter.User != "" {
+		args = append(args, "USER", filter.User)
+	}
+	if filter.Addr != "" {
+		args = append(args, "ADDR", filter.Addr)
+	}
+	if filter.LAddr != "" {
+		args = append(args, "LADDR", filter.LAddr)
+	}
+	if filter.Type != "" {
+		args = append(args, "TYPE", string(filter.Type))
+	}
+	if filter.MaxAge > 0 {
+		args = append(args, "MAXAGE", utils.IntToString(filter.MaxAge))
+	}
+	if filter.SkipMe != nil {
+		if *filter.SkipMe {
+			args = append(args, "SKIPME", "YES")
+		} else {
+			args = append(args, "SKIPME", "NO")
+		}
+	}
+	return args, nil
+}
+
+// ClientTrackingOptions are the options of the CLIENT TRACKING command, which controls the server assisted client side
+// caching.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/client-tracking/
+type ClientTrackingOptions struct {
+	Enabled bool
+	// The ID of the client to send the invalidation messages to, instead of the connection executing the command.
+	Redirect *int64
+	// The prefixes of the keys to send the invalidation messages for, in broadcasting mode.
+	Prefixes []string
+	// Sends the invalidation messages for all the keys matching the prefixes, instead of the keys read by the client.
+	Broadcast bool
+	// Tracks the keys read by the client only after CLIENT CACHING YES.
+	OptIn bool
+	// Tracks the keys read by the client unless CLIENT CACHING NO is sent.
+	OptOut bool
+	// Skips the invalidation messages of the keys modified by the client itself.
+	NoLoop bool
+}
+
+// Creates the options to enable or disable the tracking.
+func NewClientTrackingOptions(enabled bool) *ClientTrackingOptions {
+	return &ClientTrackingOptions{Enabled: enabled}
+}
+
+// Sets the ID of the client to send the invalidation messages to.
+func (opts *ClientTrackingOptions) SetRedirect(clientId int64) *ClientTrackingOptions {
+	opts.Redirect = &clientId
+	return opts
+}
+
+// Enables the broadcasting mode, for the keys matching any of the given prefixes, or for all the keys if none are given.
+func (opts *ClientTrackingOptions) SetBroadcast(prefixes ...string) *ClientTrackingOptions {
+	opts.Broadcast = true
+	opts.Prefixes = prefixes
+	return opts
+}
+
+// Tracks the keys read by the client only after CLIENT CACHING YES.
+func (opts *ClientTrackingOptions) SetOptIn() *ClientTrackingOptions {
+	opts.OptIn = true
+	return opts
+}
+
+// Tracks the keys read by the client unless CLIENT CACHING NO is sent.
+func (opts *ClientTrackingOptions) SetOptOut() *ClientTrackingOptions {
+	opts.OptOut = true
+	return opts
+}
+
+// Skips the invalidation messages of the keys modified by the client itself.
+func (opts *ClientTrackingOptions) SetNoLoop() *ClientTrackingOptions {
+	opts.NoLoop = true
+	return opts
+}
+
+func (opts *ClientTrackingOptions) ToArgs() ([]string, error) {
+	if !opts.Enabled {
+		return []string{"OFF"}, nil
+	}
+	if opts.OptIn && opts.OptOut {
+		return nil, errors.New("OPTIN and OPTOUT are mutually exclusive")
+	}
+	if len(opts.Prefixes) > 0 && !opts.Broadcast {
+		return nil, errors.New("prefixes are only supported in broadcasting mode")
+	}
+	if opts.Broadcast && (opts.OptIn || opts.OptOut) {
+		return nil, errors.New("OPTIN and OPTOUT aren't supported in broadcasting mode")
+	}
+
+	args := []string{"ON"}
+	if opts.Redirect != nil {
+		args = append(args, "REDIRECT", utils.IntToString(*opts.Redirect))
+	}
+	if opts.Broadcast {
+		args = append(args, "BCAST")
+		for _, prefix := range opts.Prefixes {
+			args = append(args, "PREFIX", prefix)
+		}
+	}
+	if opts.OptIn {
+		args = append(args, "OPTIN")
+	}
+	if opts.OptOut {
+		args = append(args, "OPTOUT")
+	}
+	if opts.NoLoop {
+		args = append(args, "NOLOOP")
+	}
+	return args, nil
+}
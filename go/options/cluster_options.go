@@ -0,0 +1,85 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "errors"
+
+// ClusterFailoverMode is the mode of a manual failover started by CLUSTER FAILOVER.
+type ClusterFailoverMode string
+
+const (
+	// Starts the failover without the handshake with the primary, for example when the primary is unreachable.
+	ClusterFailoverForce ClusterFailoverMode = "FORCE"
+	// Starts the failover without any agreement from the other primaries, the replica generates a new configuration
+	// epoch on its own.
+	ClusterFailoverTakeover ClusterFailoverMode = "TAKEOVER"
+)
+
+// ClusterResetMode is the mode of CLUSTER RESET.
+type ClusterResetMode string
+
+const (
+	// Forgets all the other nodes and the slots assignment, and makes the node a primary.
+	ClusterResetSoft ClusterResetMode = "SOFT"
+	// Like a soft reset, and also generates a new node ID and resets the epochs to 0.
+	ClusterResetHard ClusterResetMode = "HARD"
+)
+
+// ClusterSetSlotState is the subcommand of CLUSTER SETSLOT.
+type ClusterSetSlotState string
+
+const (
+	// Sets the slot in importing state, from the source node.
+	ClusterSetSlotImporting ClusterSetSlotState = "IMPORTING"
+	// Sets the slot in migrating state, to the destination node.
+	ClusterSetSlotMigrating ClusterSetSlotState = "MIGRATING"
+	// Assigns the slot to the node.
+	ClusterSetSlotNode ClusterSetSlotState = "NODE"
+	// Clears the importing or migrating state of the slot.
+	ClusterSetSlotStable ClusterSetSlotState = "STABLE"
+)
+
+// ClusterSetSlotOptions describes the state to set to a slot with the CLUSTER SETSLOT command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+type ClusterSetSlotOptions struct {
+	State ClusterSetSlotState
+	// The ID of the node, for all the states except [ClusterSetSlotStable].
+	NodeId string
+}
+
+// Sets the slot in importing state, from the node with the given ID.
+func NewClusterSetSlotImportingOptions(sourceNodeId string) *ClusterSetSlotOptions {
+	return &ClusterSetSlotOptions{State: ClusterSetSlotImporting, NodeId: sourceNodeId}
+}
+
+// Sets the slot in migrating state, to the node with the given ID.
+func NewClusterSetSlotMigratingOptions(destinationNodeId string) *ClusterSetSlotOptions {
+	return &ClusterSetSlotOptions{State: ClusterSetSlotMigrating, NodeId: destinationNodeId}
+}
+
+// Assigns the slot to the node with the given ID.
+func NewClusterSetSlotNodeOptions(nodeId string) *ClusterSetSlotOptions {
+	return &ClusterSetSlotOptions{State: ClusterSetSlotNode, NodeId: nodeId}
+}
+
+// Clears the importing or migrating state of the slot.
+func NewClusterSetSlotStableOptions() *ClusterSetSlotOptions {
+	return &ClusterSetSlotOptions{State: ClusterSetSlotStable}
+}
+
+func (opts *ClusterSetSlotOptions) ToArgs() ([]string, error) {
+	switch opts.State {
+	case ClusterSetSlotStable:
+		return []string{string(opts.State)}, nil
+	case ClusterSetSlotImporting, ClusterSetSlotMigrating, ClusterSetSlotNode:
+		if opts.NodeId == "" {
+			return nil, errors.New("node ID is required for the " + string(opts.State) + " state")
+		}
+		return []string{string(opts.State), opts.NodeId}, nil
+	default:
+		return nil, errors.New("invalid slot state: " + string(opts.State))
+	}
+}
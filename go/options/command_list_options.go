@@ -0,0 +1,60 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// CommandListFilterType is the kind of filter applied to the commands returned by COMMAND LIST.
+type CommandListFilterType string
+
+const (
+	// Returns the commands of a module.
+	FilterByModule CommandListFilterType = "MODULE"
+	// Returns the commands of an ACL category.
+	FilterByAclCategory CommandListFilterType = "ACLCAT"
+	// Returns the commands the names of which match a glob-style pattern.
+	FilterByPattern CommandListFilterType = "PATTERN"
+)
+
+// Optional arguments to `CommandListWithOptions`. Only one filter can be applied, so setting a filter replaces the
+// previous one.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/command-list/
+type CommandListOptions struct {
+	// The kind of filter, no filter is applied when empty.
+	FilterBy CommandListFilterType
+	// The module name, ACL category or pattern of the filter.
+	FilterValue string
+}
+
+func NewCommandListOptions() *CommandListOptions {
+	return &CommandListOptions{}
+}
+
+// Returns only the commands of the given module.
+func (opts *CommandListOptions) SetFilterByModule(module string) *CommandListOptions {
+	opts.FilterBy = FilterByModule
+	opts.FilterValue = module
+	return opts
+}
+
+// Returns only the commands of the given ACL category, e.g. `string` or `dangerous`.
+func (opts *CommandListOptions) SetFilterByAclCategory(category string) *CommandListOptions {
+	opts.FilterBy = FilterByAclCategory
+	opts.FilterValue = category
+	return opts
+}
+
+// Returns only the commands the names of which match the given glob-style pattern.
+func (opts *CommandListOptions) SetFilterByPattern(pattern string) *CommandListOptions {
+	opts.FilterBy = FilterByPattern
+	opts.FilterValue = pattern
+	return opts
+}
+
+func (opts *CommandListOptions) ToArgs() ([]string, error) {
+	if opts.FilterBy == "" {
+		return []string{}, nil
+	}
+	return []string{"FILTERBY", string(opts.FilterBy), opts.FilterValue}, nil
+}
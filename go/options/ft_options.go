@@ -0,0 +1,842 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	FtOnKeyword             string = "ON"              // Valkey API keyword for the data type of an index.
+	FtPrefixKeyword         string = "PREFIX"          // Valkey API keyword for the key prefixes of an index.
+	FtSchemaKeyword         string = "SCHEMA"          // Valkey API keyword for the schema of an index.
+	FtAsKeyword             string = "AS"              // Valkey API keyword for an alias of a field.
+	FtSeparatorKeyword      string = "SEPARATOR"       // Valkey API keyword for the separator of a TAG field.
+	FtCaseSensitiveKeyword  string = "CASESENSITIVE"   // Valkey API keyword for case sensitive TAG fields.
+	FtTypeKeyword           string = "TYPE"            // Valkey API keyword for the vector type of a VECTOR field.
+	FtDimKeyword            string = "DIM"             // Valkey API keyword for the dimensions of a VECTOR field.
+	FtDistanceMetricKeyword string = "DISTANCE_METRIC" // Valkey API keyword for the distance metric of a VECTOR field.
+	FtInitialCapKeyword     string = "INITIAL_CAP"     // Valkey API keyword for the initial capacity of a VECTOR field.
+	FtMKeyword              string = "M"               // Valkey API keyword for the number of edges of an HNSW vector.
+	FtEfConstructionKeyword string = "EF_CONSTRUCTION" // Valkey API keyword for the HNSW construction search size.
+	FtEfRuntimeKeyword      string = "EF_RUNTIME"      // Valkey API keyword for the HNSW runtime search size.
+	FtReturnKeyword         string = "RETURN"          // Valkey API keyword for the fields returned by FT.SEARCH.
+	FtTimeoutKeyword        string = "TIMEOUT"         // Valkey API keyword for the query timeout.
+	FtParamsKeyword         string = "PARAMS"          // Valkey API keyword for the query parameters.
+	FtNoContentKeyword      string = "NOCONTENT"       // Valkey API keyword to return only the document keys.
+	FtWithScoresKeyword     string = "WITHSCORES"      // Valkey API keyword to return the document scores.
+	FtCountKeyword          string = "COUNT"           // Valkey API keyword to return only the number of documents.
+	FtLoadKeyword           string = "LOAD"            // Valkey API keyword for the fields loaded by FT.AGGREGATE.
+	FtFilterKeyword         string = "FILTER"          // Valkey API keyword for the FILTER clause of FT.AGGREGATE.
+	FtGroupByKeyword        string = "GROUPBY"         // Valkey API keyword for the GROUPBY clause of FT.AGGREGATE.
+	FtReduceKeyword         string = "REDUCE"          // Valkey API keyword for a reducer of the GROUPBY clause.
+	FtSortByKeyword         string = "SORTBY"          // Valkey API keyword for the SORTBY clause of FT.AGGREGATE.
+	FtMaxKeyword            string = "MAX"             // Valkey API keyword for the maximum number of sorted rows.
+	FtApplyKeyword          string = "APPLY"           // Valkey API keyword for the APPLY clause of FT.AGGREGATE.
+	FtLimitedKeyword        string = "LIMITED"         // Valkey API keyword for a limited FT.PROFILE report.
+	FtQueryKeyword          string = "QUERY"           // Valkey API keyword for the query profiled by FT.PROFILE.
+)
+
+// FtDataType is the type of the data indexed by an index.
+type FtDataType string
+
+const (
+	// Index the data stored in hashes.
+	FtDataTypeHash FtDataType = "HASH"
+	// Index the data stored in JSON documents.
+	FtDataTypeJson FtDataType = "JSON"
+)
+
+// FtFieldType is the type of a field of an index schema.
+type FtFieldType string
+
+const (
+	// A field containing text.
+	FtFieldTypeText FtFieldType = "TEXT"
+	// A field containing a set of tags.
+	FtFieldTypeTag FtFieldType = "TAG"
+	// A field containing a number.
+	FtFieldTypeNumeric FtFieldType = "NUMERIC"
+	// A field containing a vector.
+	FtFieldTypeVector FtFieldType = "VECTOR"
+)
+
+// FtVectorAlgorithm is the algorithm used to index a vector field.
+type FtVectorAlgorithm string
+
+const (
+	// Hierarchical Navigable Small World, an approximate nearest neighbours algorithm.
+	FtVectorAlgorithmHnsw FtVectorAlgorithm = "HNSW"
+	// Brute force, an exact nearest neighbours algorithm.
+	FtVectorAlgorithmFlat FtVectorAlgorithm = "FLAT"
+)
+
+// FtDistanceMetric is the metric used to compute the distance between two vectors.
+type FtDistanceMetric string
+
+const (
+	// Euclidean distance.
+	FtDistanceMetricL2 FtDistanceMetric = "L2"
+	// Inner product.
+	FtDistanceMetricIp FtDistanceMetric = "IP"
+	// Cosine distance.
+	FtDistanceMetricCosine FtDistanceMetric = "COSINE"
+)
+
+// FtVectorType is the type of the vector elements. Only FLOAT32 is currently supported.
+type FtVectorType string
+
+const (
+	FtVectorTypeFloat32 FtVectorType = "FLOAT32"
+)
+
+// FtField is a field of an index schema, see [FtSchema].
+type FtField interface {
+	ToArgs() ([]string, error)
+}
+
+// FtTextField is a TEXT field of an index schema.
+type FtTextField struct {
+	// The name of the hash field or the JSON path to the field.
+	Name string
+	// An alias used to reference the field in queries.
+	Alias string
+}
+
+func NewFtTextField(name string) *FtTextField {
+	return &FtTextField{Name: name}
+}
+
+// Sets an alias used to reference the field in queries.
+func (field *FtTextField) SetAlias(alias string) *FtTextField {
+	field.Alias = alias
+	return field
+}
+
+func (field *FtTextField) ToArgs() ([]string, error) {
+	return append(ftFieldNameArgs(field.Name, field.Alias), string(FtFieldTypeText)), nil
+}
+
+// FtNumericField is a NUMERIC field of an index schema.
+type FtNumericField struct {
+	// The name of the hash field or the JSON path to the field.
+	Name string
+	// An alias used to reference the field in queries.
+	Alias string
+}
+
+func NewFtNumericField(name string) *FtNumericField {
+	return &FtNumericField{Name: name}
+}
+
+// Sets an alias used to reference the field in queries.
+func (field *FtNumericField) SetAlias(alias string) *FtNumericField {
+	field.Alias = alias
+	return field
+}
+
+func (field *FtNumericField) ToArgs() ([]string, error) {
+	return append(ftFieldNameArgs(field.Name, field.Alias), string(FtFieldTypeNumeric)), nil
+}
+
+// FtTagField is a TAG field of an index schema.
+type FtTagField struct {
+	// The name of the hash field or the JSON path to the field.
+	Name string
+	// An alias used to reference the field in queries.
+	Alias string
+	// The character used to split the field value into tags. The server default is `,`.
+	Separator string
+	// Whether tags are compared case sensitively.
+	CaseSensitive bool
+}
+
+func NewFtTagField(name string) *FtTagField {
+	return &FtTagField{Name: name}
+}
+
+// Sets an alias used to reference the field in queries.
+func (field *FtTagField) SetAlias(alias string) *FtTagField {
+	field.Alias = alias
+	return field
+}
+
+// Sets the character used to split the field value into tags. Must be a single character.
+func (field *FtTagField) SetSeparator(separator string) *FtTagField {
+	field.Separator = separator
+	return field
+}
+
+// Sets whether tags are compared case sensitively.
+func (field *FtTagField) SetCaseSensitive(caseSensitive bool) *FtTagField {
+	field.CaseSensitive = caseSensitive
+	return field
+}
+
+func (field *FtTagField) ToArgs() ([]string, error) {
+	args := append(ftFieldNameArgs(field.Name, field.Alias), string(FtFieldTypeTag))
+	if field.Separator != "" {
+		if len([]rune(field.Separator)) != 1 {
+			return nil, fmt.Errorf("separator of TAG field '%s' must be a single character", field.Name)
+		}
+		args = append(args, FtSeparatorKeyword, field.Separator)
+	}
+	if field.CaseSensitive {
+		args = append(args, FtCaseSensitiveKeyword)
+	}
+	return args, nil
+}
+
+// FtVectorField is a VECTOR field of an index schema. Use [NewFtVectorFieldHnsw] or [NewFtVectorFieldFlat] to create it.
+type FtVectorField struct {
+	// The name of the hash field or the JSON path to the field.
+	Name string
+	// An alias used to reference the field in queries.
+	Alias string
+	// The algorithm used to index the vectors.
+	Algorithm FtVectorAlgorithm
+	// The type of the vector elements. Defaults to [FtVectorTypeFloat32].
+	Type FtVectorType
+	// The number of dimensions of the vectors.
+	Dimensions int64
+	// The metric used to compute the distance between two vectors.
+	DistanceMetric FtDistanceMetric
+	// The initial capacity of the index.
+	InitialCapacity *int64
+	// HNSW only - the maximum number of outgoing edges of each node in the graph.
+	NumberOfEdges *int64
+	// HNSW only - the number of vectors examined while building the index.
+	VectorsExaminedOnConstruction *int64
+	// HNSW only - the number of vectors examined while running a query.
+	VectorsExaminedOnRuntime *int64
+}
+
+// Creates a VECTOR field indexed with the [FtVectorAlgorithmHnsw] algorithm.
+func NewFtVectorFieldHnsw(name string, distanceMetric FtDistanceMetric, dimensions int64) *FtVectorField {
+	return &FtVectorField{
+		Name:           name,
+		Algorithm:      FtVectorAlgorithmHnsw,
+		Type:           FtVectorTypeFloat32,
+		Dimensions:     dimensions,
+		DistanceMetric: distanceMetric,
+	}
+}
+
+// Creates a VECTOR field indexed with the [FtVectorAlgorithmFlat] algorithm.
+func NewFtVectorFieldFlat(name string, distanceMetric FtDistanceMetric, dimensions int64) *FtVectorField {
+	return &FtVectorField{
+		Name:           name,
+		Algorithm:      FtVectorAlgorithmFlat,
+		Type:           FtVectorTypeFloat32,
+		Dimensions:     dimensions,
+		DistanceMetric: distanceMetric,
+	}
+}
+
+// Sets an alias used to reference the field in queries.
+func (field *FtVectorField) SetAlias(alias string) *FtVectorField {
+	field.Alias = alias
+	return field
+}
+
+// Sets the initial capacity of the index.
+func (field *FtVectorField) SetInitialCapacity(initialCapacity int64) *FtVectorField {
+	field.InitialCapacity = &initialCapacity
+	return field
+}
+
+// Sets the maximum number of outgoing edges of each node in the graph. HNSW only.
+func (field *FtVectorField) SetNumberOfEdges(numberOfEdges int64) *FtVectorField {
+	field.NumberOfEdges = &numberOfEdges
+	return field
+}
+
+// Sets the number of vectors examined while building the index. HNSW only.
+func (field *FtVectorField) SetVectorsExaminedOnConstruction(vectorsExamined int64) *FtVectorField {
+	field.VectorsExaminedOnConstruction = &vectorsExamined
+	return field
+}
+
+// Sets the number of vectors examined while running a query. HNSW only.
+func (field *FtVectorField) SetVectorsExaminedOnRuntime(vectorsExamined int64) *FtVectorField {
+	field.VectorsExaminedOnRuntime = &vectorsExamined
+	return field
+}
+
+func (field *FtVectorField) ToArgs() ([]string, error) {
+	if field.Algorithm != FtVectorAlgorithmHnsw && field.Algorithm != FtVectorAlgorithmFlat {
+		return nil, fmt.Errorf("invalid algorithm '%s' for VECTOR field '%s'", field.Algorithm, field.Name)
+	}
+	if field.Dimensions <= 0 {
+		return nil, fmt.Errorf("dimensions of VECTOR field '%s' must be positive", field.Name)
+	}
+	if field.DistanceMetric == "" {
+		return nil, fmt.Errorf("distance metric of VECTOR field '%s' is not set", field.Name)
+	}
+	vectorType := field.Type
+	if vectorType == "" {
+		vectorType = FtVectorTypeFloat32
+	}
+	params := []string{
+		FtTypeKeyword, string(vectorType),
+		FtDimKeyword, utils.IntToString(field.Dimensions),
+		FtDistanceMetricKeyword, string(field.DistanceMetric),
+	}
+	if field.InitialCapacity != nil {
+		params = append(params, FtInitialCapKeyword, utils.IntToString(*field.InitialCapacity))
+	}
+	hnswParams := []string{}
+	if field.NumberOfEdges != nil {
+		hnswParams = append(hnswParams, FtMKeyword, utils.IntToString(*field.NumberOfEdges))
+	}
+	if field.VectorsExaminedOnConstruction != nil {
+		hnswParams = append(hnswParams, FtEfConstructionKeyword, utils.IntToString(*field.VectorsExaminedOnConstruction))
+	}
+	if field.VectorsExaminedOnRuntime != nil {
+		hnswParams = append(hnswParams, FtEfRuntimeKeyword, utils.IntToString(*field.VectorsExaminedOnRuntime))
+	}
+	if len(hnswParams) > 0 && field.Algorithm != FtVectorAlgorithmHnsw {
+		return nil, fmt.Errorf("M, EF_CONSTRUCTION and EF_RUNTIME are only supported by HNSW VECTOR field '%s'", field.Name)
+	}
+	params = append(params, hnswParams...)
+
+	args := append(ftFieldNameArgs(field.Name, field.Alias), string(FtFieldTypeVector), string(field.Algorithm))
+	args = append(args, utils.IntToString(int64(len(params))))
+	return append(args, params...), nil
+}
+
+func ftFieldNameArgs(name string, alias string) []string {
+	if alias != "" {
+		return []string{name, FtAsKeyword, alias}
+	}
+	return []string{name}
+}
+
+// FtSchema represents the schema of an index created with FT.CREATE.
+//
+// Example:
+//
+//	schema := options.NewFtSchema().
+//		AddField(options.NewFtTextField("title")).
+//		AddField(options.NewFtTagField("category").SetSeparator("|")).
+//		AddField(options.NewFtVectorFieldHnsw("vec", options.FtDistanceMetricCosine, 128).SetNumberOfEdges(16))
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/ft.create/
+type FtSchema struct {
+	Fields []FtField
+}
+
+func NewFtSchema() *FtSchema {
+	return &FtSchema{}
+}
+
+// Adds a field to the schema.
+func (schema *FtSchema) AddField(field FtField) *FtSchema {
+	schema.Fields = append(schema.Fields, field)
+	return schema
+}
+
+func (schema *FtSchema) ToArgs() ([]string, error) {
+	if len(schema.Fields) == 0 {
+		return nil, errors.New("index schema must contain at least one field")
+	}
+	args := []string{FtSchemaKeyword}
+	for _, field := range schema.Fields {
+		if field == nil {
+			return nil, errors.New("index schema field is nil")
+		}
+		fieldArgs, err := field.ToArgs()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fieldArgs...)
+	}
+	return args, nil
+}
+
+// FtCreateOptions represents optional arguments for the FT.CREATE command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/ft.create/
+type FtCreateOptions struct {
+	// The type of the indexed data. The server default is [FtDataTypeHash].
+	DataType FtDataType
+	// Only keys starting with one of the prefixes are indexed.
+	Prefixes []string
+}
+
+func NewFtCreateOptions() *FtCreateOptions {
+	return &FtCreateOptions{}
+}
+
+// Sets the type of the indexed data.
+func (opts *FtCreateOptions) SetDataType(dataType FtDataType) *FtCreateOptions {
+	opts.DataType = dataType
+	return opts
+}
+
+// Sets the prefixes of the indexed keys.
+func (opts *FtCreateOptions) SetPrefixes(prefixes ...string) *FtCreateOptions {
+	opts.Prefixes = prefixes
+	return opts
+}
+
+func (opts *FtCreateOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	switch opts.DataType {
+	case "":
+	case FtDataTypeHash, FtDataTypeJson:
+		args = append(args, FtOnKeyword, string(opts.DataType))
+	default:
+		return nil, fmt.Errorf("invalid data type for FT.CREATE: '%s'", opts.DataType)
+	}
+	if len(opts.Prefixes) > 0 {
+		args = append(args, FtPrefixKeyword, utils.IntToString(int64(len(opts.Prefixes))))
+		args = append(args, opts.Prefixes...)
+	}
+	return args, nil
+}
+
+// FtReturnField is a field returned by FT.SEARCH, see [FtSearchOptions.AddReturnField].
+type FtReturnField struct {
+	// The name of the field, or the JSON path to the field.
+	Field string
+	// The name the field is returned as.
+	Alias string
+}
+
+// FtSearchOptions represents optional arguments for the FT.SEARCH command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/ft.search/
+type FtSearchOptions struct {
+	// The fields returned for each document. All fields are returned if not set.
+	ReturnFields []FtReturnField
+	// The query timeout, in milliseconds precision.
+	Timeout time.Duration
+	// The parameters referenced in the query as `$name`.
+	Params map[string]string
+	// Limits the returned documents to the given range.
+	Limit *Limit
+	// Only the document keys are returned, without their fields.
+	NoContent bool
+	// The score of each document is returned along with its fields.
+	WithScores bool
+	// Only the number of matched documents is returned.
+	Count bool
+}
+
+func NewFtSearchOptions() *FtSearchOptions {
+	return &FtSearchOptions{}
+}
+
+// Adds a field to return for each document.
+func (opts *FtSearchOptions) AddReturnField(field string) *FtSearchOptions {
+	opts.ReturnFields = append(opts.ReturnFields, FtReturnField{Field: field})
+	return opts
+}
+
+// Adds a field to return for each document, under the given alias.
+func (opts *FtSearchOptions) AddReturnFieldWithAlias(field string, alias string) *FtSearchOptions {
+	opts.ReturnFields = append(opts.ReturnFields, FtReturnField{Field: field, Alias: alias})
+	return opts
+}
+
+// Sets the query timeout.
+func (opts *FtSearchOptions) SetTimeout(timeout time.Duration) *FtSearchOptions {
+	opts.Timeout = timeout
+	return opts
+}
+
+// Sets a parameter referenced in the query as `$name`. Vectors are passed as their binary representation.
+func (opts *FtSearchOptions) AddParam(name string, value string) *FtSearchOptions {
+	if opts.Params == nil {
+		opts.Params = map[string]string{}
+	}
+	opts.Params[name] = value
+	return opts
+}
+
+// Limits the returned documents to the given range.
+func (opts *FtSearchOptions) SetLimit(offset int64, count int64) *FtSearchOptions {
+	opts.Limit = &Limit{Offset: offset, Count: count}
+	return opts
+}
+
+// Only return the document keys, without their fields.
+func (opts *FtSearchOptions) SetNoContent() *FtSearchOptions {
+	opts.NoContent = true
+	return opts
+}
+
+// Return the score of each document along with its fields.
+func (opts *FtSearchOptions) SetWithScores() *FtSearchOptions {
+	opts.WithScores = true
+	return opts
+}
+
+// Only return the number of matched documents.
+func (opts *FtSearchOptions) SetCount() *FtSearchOptions {
+	opts.Count = true
+	return opts
+}
+
+func (opts *FtSearchOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if len(opts.ReturnFields) > 0 {
+		returnArgs := []string{}
+		for _, field := range opts.ReturnFields {
+			returnArgs = append(returnArgs, field.Field)
+			if field.Alias != "" {
+				returnArgs = append(returnArgs, FtAsKeyword, field.Alias)
+			}
+		}
+		args = append(args, FtReturnKeyword, utils.IntToString(int64(len(returnArgs))))
+		args = append(args, returnArgs...)
+	}
+	timeoutArgs, err := ftTimeoutArgs(opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, timeoutArgs...)
+	args = append(args, ftParamsArgs(opts.Params)...)
+	if opts.Limit != nil {
+		limitArgs, err := opts.Limit.toArgs()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, limitArgs...)
+	}
+	if opts.NoContent {
+		args = append(args, FtNoContentKeyword)
+	}
+	if opts.WithScores {
+		args = append(args, FtWithScoresKeyword)
+	}
+	if opts.Count {
+		args = append(args, FtCountKeyword)
+	}
+	return args, nil
+}
+
+func ftTimeoutArgs(timeout time.Duration) ([]string, error) {
+	if timeout == 0 {
+		return []string{}, nil
+	}
+	if timeout < 0 {
+		return nil, errors.New("query timeout must be positive")
+	}
+	return []string{FtTimeoutKeyword, utils.IntToString(timeout.Milliseconds())}, nil
+}
+
+func ftParamsArgs(params map[string]string) []string {
+	if len(params) == 0 {
+		return []string{}
+	}
+	return append([]string{FtParamsKeyword, utils.IntToString(int64(len(params) * 2))}, utils.MapToString(params)...)
+}
+
+// FtAggregateClause is a clause of the FT.AGGREGATE pipeline, see [FtAggregateOptions.AddClause].
+type FtAggregateClause interface {
+	ToArgs() ([]string, error)
+}
+
+// FtAggregateLimit limits the number of rows passed to the next clause.
+type FtAggregateLimit struct {
+	Offset int64
+	Count  int64
+}
+
+func NewFtAggregateLimit(offset int64, count int64) *FtAggregateLimit {
+	return &FtAggregateLimit{Offset: offset, Count: count}
+}
+
+func (clause *FtAggregateLimit) ToArgs() ([]string, error) {
+	return (&Limit{Offset: clause.Offset, Count: clause.Count}).toArgs()
+}
+
+// FtAggregateFilter filters the rows using a boolean expression.
+type FtAggregateFilter struct {
+	Expression string
+}
+
+func NewFtAggregateFilter(expression string) *FtAggregateFilter {
+	return &FtAggregateFilter{Expression: expression}
+}
+
+func (clause *FtAggregateFilter) ToArgs() ([]string, error) {
+	return []string{FtFilterKeyword, clause.Expression}, nil
+}
+
+// FtAggregateReducer reduces the rows of a group into a single value, see [FtAggregateGroupBy].
+type FtAggregateReducer struct {
+	// The name of the reducer function, e.g. `COUNT` or `SUM`.
+	Function string
+	// The arguments of the reducer function.
+	Args []string
+	// The name of the property holding the reduced value.
+	Name string
+}
+
+func NewFtAggregateReducer(function string, args ...string) *FtAggregateReducer {
+	return &FtAggregateReducer{Function: function, Args: args}
+}
+
+// Sets the name of the property holding the reduced value.
+func (reducer *FtAggregateReducer) SetName(name string) *FtAggregateReducer {
+	reducer.Name = name
+	return reducer
+}
+
+func (reducer *FtAggregateReducer) toArgs() []string {
+	args := append([]string{FtReduceKeyword, reducer.Function, utils.IntToString(int64(len(reducer.Args)))}, reducer.Args...)
+	if reducer.Name != "" {
+		args = append(args, FtAsKeyword, reducer.Name)
+	}
+	return args
+}
+
+// FtAggregateGroupBy groups the rows by the given properties, and reduces each group with the reducers.
+type FtAggregateGroupBy struct {
+	Properties []string
+	Reducers   []FtAggregateReducer
+}
+
+func NewFtAggregateGroupBy(properties ...string) *FtAggregateGroupBy {
+	return &FtAggregateGroupBy{Properties: properties}
+}
+
+// Adds a reducer applied to each group.
+func (clause *FtAggregateGroupBy) AddReducer(reducer FtAggregateReducer) *FtAggregateGroupBy {
+	clause.Reducers = append(clause.Reducers, reducer)
+	return clause
+}
+
+func (clause *FtAggregateGroupBy) ToArgs() ([]string, error) {
+	if len(clause.Properties) == 0 {
+		return nil, errors.New("GROUPBY clause must contain at least one property")
+	}
+	args := append([]string{FtGroupByKeyword, utils.IntToString(int64(len(clause.Properties)))}, clause.Properties...)
+	for _, reducer := range clause.Reducers {
+		args = append(args, reducer.toArgs()...)
+	}
+	return args, nil
+}
+
+// FtAggregateSortProperty is a property the rows are sorted by, see [FtAggregateSortBy].
+type FtAggregateSortProperty struct {
+	Property string
+	Order    OrderBy
+}
+
+// FtAggregateSortBy sorts the rows by the given properties.
+type FtAggregateSortBy struct {
+	Properties []FtAggregateSortProperty
+	// Only the first `Max` rows are sorted.
+	Max *int64
+}
+
+func NewFtAggregateSortBy() *FtAggregateSortBy {
+	return &FtAggregateSortBy{}
+}
+
+// Adds a property the rows are sorted by.
+func (clause *FtAggregateSortBy) AddProperty(property string, order OrderBy) *FtAggregateSortBy {
+	clause.Properties = append(clause.Properties, FtAggregateSortProperty{Property: property, Order: order})
+	return clause
+}
+
+// Only sort the first `max` rows.
+func (clause *FtAggregateSortBy) SetMax(max int64) *FtAggregateSortBy {
+	clause.Max = &max
+	return clause
+}
+
+func (clause *FtAggregateSortBy) ToArgs() ([]string, error) {
+	if len(clause.Properties) == 0 {
+		return nil, errors.New("SORTBY clause must contain at least one property")
+	}
+	args := []string{FtSortByKeyword, utils.IntToString(int64(len(clause.Properties) * 2))}
+	for _, property := range clause.Properties {
+		order := property.Order
+		if order == "" {
+			order = ASC
+		}
+		args = append(args, property.Property, string(order))
+	}
+	if clause.Max != nil {
+		args = append(args, FtMaxKeyword, utils.IntToString(*clause.Max))
+	}
+	return args, nil
+}
+
+// FtAggregateApply adds a property computed from an expression to each row.
+type FtAggregateApply struct {
+	Expression string
+	Name       string
+}
+
+func NewFtAggregateApply(expression string, name string) *FtAggregateApply {
+	return &FtAggregateApply{Expression: expression, Name: name}
+}
+
+func (clause *FtAggregateApply) ToArgs() ([]string, error) {
+	return []string{FtApplyKeyword, clause.Expression, FtAsKeyword, clause.Name}, nil
+}
+
+// FtAggregateOptions represents optional arguments for the FT.AGGREGATE command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/ft.aggregate/
+type FtAggregateOptions struct {
+	// Load all the fields of the documents.
+	LoadAll bool
+	// The fields of the documents to load.
+	LoadFields []string
+	// The query timeout, in milliseconds precision.
+	Timeout time.Duration
+	// The parameters referenced in the query as `$name`.
+	Params map[string]string
+	// The clauses of the aggregation pipeline, applied in order.
+	Clauses []FtAggregateClause
+}
+
+func NewFtAggregateOptions() *FtAggregateOptions {
+	return &FtAggregateOptions{}
+}
+
+// Load all the fields of the documents.
+func (opts *FtAggregateOptions) SetLoadAll() *FtAggregateOptions {
+	opts.LoadAll = true
+	opts.LoadFields = nil
+	return opts
+}
+
+// Sets the fields of the documents to load.
+func (opts *FtAggregateOptions) SetLoadFields(fields ...string) *FtAggregateOptions {
+	opts.LoadFields = fields
+	opts.LoadAll = false
+	return opts
+}
+
+// Sets the query timeout.
+func (opts *FtAggregateOptions) SetTimeout(timeout time.Duration) *FtAggregateOptions {
+	opts.Timeout = timeout
+	return opts
+}
+
+// Sets a parameter referenced in the query as `$name`.
+func (opts *FtAggregateOptions) AddParam(name string, value string) *FtAggregateOptions {
+	if opts.Params == nil {
+		opts.Params = map[string]string{}
+	}
+	opts.Params[name] = value
+	return opts
+}
+
+// Adds a clause to the aggregation pipeline.
+func (opts *FtAggregateOptions) AddClause(clause FtAggregateClause) *FtAggregateOptions {
+	opts.Clauses = append(opts.Clauses, clause)
+	return opts
+}
+
+func (opts *FtAggregateOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if opts.LoadAll {
+		args = append(args, FtLoadKeyword, "*")
+	} else if len(opts.LoadFields) > 0 {
+		args = append(args, FtLoadKeyword, utils.IntToString(int64(len(opts.LoadFields))))
+		args = append(args, opts.LoadFields...)
+	}
+	timeoutArgs, err := ftTimeoutArgs(opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, timeoutArgs...)
+	args = append(args, ftParamsArgs(opts.Params)...)
+	for _, clause := range opts.Clauses {
+		if clause == nil {
+			return nil, errors.New("aggregate clause is nil")
+		}
+		clauseArgs, err := clause.ToArgs()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, clauseArgs...)
+	}
+	return args, nil
+}
+
+// FtProfileQueryType is the type of the query profiled by FT.PROFILE.
+type FtProfileQueryType string
+
+const (
+	FtProfileQueryTypeSearch    FtProfileQueryType = "SEARCH"
+	FtProfileQueryTypeAggregate FtProfileQueryType = "AGGREGATE"
+)
+
+// FtProfileOptions represents the query profiled by the FT.PROFILE command. Use [NewFtProfileSearchOptions] or
+// [NewFtProfileAggregateOptions] to create it.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/ft.profile/
+type FtProfileOptions struct {
+	QueryType FtProfileQueryType
+	Query     string
+	// Removes details of the reader iterator from the report.
+	Limited          bool
+	SearchOptions    *FtSearchOptions
+	AggregateOptions *FtAggregateOptions
+}
+
+// Creates the options to profile an FT.SEARCH query.
+func NewFtProfileSearchOptions(query string, searchOptions FtSearchOptions) *FtProfileOptions {
+	return &FtProfileOptions{QueryType: FtProfileQueryTypeSearch, Query: query, SearchOptions: &searchOptions}
+}
+
+// Creates the options to profile an FT.AGGREGATE query.
+func NewFtProfileAggregateOptions(query string, aggregateOptions FtAggregateOptions) *FtProfileOptions {
+	return &FtProfileOptions{QueryType: FtProfileQueryTypeAggregate, Query: query, AggregateOptions: &aggregateOptions}
+}
+
+// Removes details of the reader iterator from the report.
+func (opts *FtProfileOptions) SetLimited() *FtProfileOptions {
+	opts.Limited = true
+	return opts
+}
+
+func (opts *FtProfileOptions) ToArgs() ([]string, error) {
+	var queryArgs []string
+	var err error
+	switch opts.QueryType {
+	case FtProfileQueryTypeSearch:
+		if opts.SearchOptions != nil {
+			queryArgs, err = opts.SearchOptions.ToArgs()
+		}
+	case FtProfileQueryTypeAggregate:
+		if opts.AggregateOptions != nil {
+			queryArgs, err = opts.AggregateOptions.ToArgs()
+		}
+	default:
+		return nil, fmt.Errorf("invalid query type for FT.PROFILE: '%s'", opts.QueryType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	args := []string{string(opts.QueryType)}
+	if opts.Limited {
+		args = append(args, FtLimitedKeyword)
+	}
+	args = append(args, FtQueryKeyword, opts.Query)
+	return append(args, queryArgs...), nil
+}
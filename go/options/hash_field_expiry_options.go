@@ -0,0 +1,130 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+)
+
+// HGetExOptions represents optional arguments for the `HGetEx` command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/hgetex/
+type HGetExOptions struct {
+	// If not set, the expiration of the fields is left unchanged.
+	// Supported ExpiryTypes ("EX", "PX", "EXAT", "PXAT", "PERSIST")
+	Expiry *Expiry
+}
+
+func NewHGetExOptions() *HGetExOptions {
+	return &HGetExOptions{}
+}
+
+func (hGetExOptions *HGetExOptions) SetExpiry(expiry *Expiry) *HGetExOptions {
+	hGetExOptions.Expiry = expiry
+	return hGetExOptions
+}
+
+func (opts *HGetExOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	var err error
+
+	if opts.Expiry != nil {
+		switch opts.Expiry.Type {
+		case constants.Seconds, constants.Milliseconds, constants.UnixSeconds, constants.UnixMilliseconds:
+			args = append(args, string(opts.Expiry.Type), strconv.FormatUint(opts.Expiry.GetTime(), 10))
+		case constants.Persist:
+			args = append(args, string(opts.Expiry.Type))
+		default:
+			err = errors.New("invalid expiry type")
+		}
+	}
+
+	return args, err
+}
+
+// HSetExOptions represents optional arguments for the `HSetEx` command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/hsetex/
+type HSetExOptions struct {
+	// If not set, the fields are set regardless of the existence of the key.
+	// Supported ConditionalSets ("NX", "XX")
+	ConditionalSet constants.ConditionalSet
+	// If not set, the fields are set regardless of their existence.
+	FieldConditionalSet constants.HashFieldConditionalSet
+	// If not set, the fields are set without expiration.
+	// Supported ExpiryTypes ("EX", "PX", "EXAT", "PXAT", "KEEPTTL")
+	Expiry *Expiry
+}
+
+func NewHSetExOptions() *HSetExOptions {
+	return &HSetExOptions{}
+}
+
+// Sets the fields only if the key already exists.
+func (hSetExOptions *HSetExOptions) SetOnlyIfKeyExists() *HSetExOptions {
+	hSetExOptions.ConditionalSet = constants.OnlyIfExists
+	return hSetExOptions
+}
+
+// Sets the fields only if the key doesn't exist yet.
+func (hSetExOptions *HSetExOptions) SetOnlyIfKeyDoesNotExist() *HSetExOptions {
+	hSetExOptions.ConditionalSet = constants.OnlyIfDoesNotExist
+	return hSetExOptions
+}
+
+// Sets the fields only if all of them already exist.
+func (hSetExOptions *HSetExOptions) SetOnlyIfAllFieldsExist() *HSetExOptions {
+	hSetExOptions.FieldConditionalSet = constants.OnlyIfAllFieldsExist
+	return hSetExOptions
+}
+
+// Sets the fields only if none of them already exist.
+func (hSetExOptions *HSetExOptions) SetOnlyIfNoFieldExists() *HSetExOptions {
+	hSetExOptions.FieldConditionalSet = constants.OnlyIfNoFieldExists
+	return hSetExOptions
+}
+
+func (hSetExOptions *HSetExOptions) SetExpiry(expiry *Expiry) *HSetExOptions {
+	hSetExOptions.Expiry = expiry
+	return hSetExOptions
+}
+
+func (opts *HSetExOptions) ToArgs() ([]string, error) {
+	args := []string{}
+
+	switch opts.ConditionalSet {
+	case "":
+	case constants.OnlyIfExists, constants.OnlyIfDoesNotExist:
+		args = append(args, string(opts.ConditionalSet))
+	default:
+		return nil, errors.New("invalid conditional set")
+	}
+
+	switch opts.FieldConditionalSet {
+	case "":
+	case constants.OnlyIfAllFieldsExist, constants.OnlyIfNoFieldExists:
+		args = append(args, string(opts.FieldConditionalSet))
+	default:
+		return nil, errors.New("invalid field conditional set")
+	}
+
+	if opts.Expiry != nil {
+		switch opts.Expiry.Type {
+		case constants.Seconds, constants.Milliseconds, constants.UnixSeconds, constants.UnixMilliseconds:
+			args = append(args, string(opts.Expiry.Type), strconv.FormatUint(opts.Expiry.GetTime(), 10))
+		case constants.KeepExisting:
+			args = append(args, string(opts.Expiry.Type))
+		default:
+			return nil, errors.New("invalid expiry type")
+		}
+	}
+
+	return args, nil
+}
@@ -0,0 +1,169 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	JsonIndentKeyword   string = "INDENT"   // Valkey API keyword for the indentation string of JSON.GET.
+	JsonNewlineKeyword  string = "NEWLINE"  // Valkey API keyword for the newline string of JSON.GET.
+	JsonSpaceKeyword    string = "SPACE"    // Valkey API keyword for the key-value separator string of JSON.GET.
+	JsonNoEscapeKeyword string = "NOESCAPE" // Valkey API keyword accepted by JSON.GET for compatibility with RedisJSON.
+)
+
+// JsonSetOptions represents optional arguments for the JSON.SET command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/json.set/
+type JsonSetOptions struct {
+	// Only set the value if the condition is met. Only [constants.OnlyIfExists] and [constants.OnlyIfDoesNotExist]
+	// are supported by JSON.SET.
+	ConditionalSet constants.ConditionalSet
+}
+
+func NewJsonSetOptions() *JsonSetOptions {
+	return &JsonSetOptions{}
+}
+
+// Sets the condition to [constants.OnlyIfExists]. The value will be set only if the path already exists.
+func (opts *JsonSetOptions) SetOnlyIfExists() *JsonSetOptions {
+	opts.ConditionalSet = constants.OnlyIfExists
+	return opts
+}
+
+// Sets the condition to [constants.OnlyIfDoesNotExist]. The value will be set only if the path does not exist.
+func (opts *JsonSetOptions) SetOnlyIfDoesNotExist() *JsonSetOptions {
+	opts.ConditionalSet = constants.OnlyIfDoesNotExist
+	return opts
+}
+
+func (opts *JsonSetOptions) ToArgs() ([]string, error) {
+	switch opts.ConditionalSet {
+	case "":
+		return []string{}, nil
+	case constants.OnlyIfExists, constants.OnlyIfDoesNotExist:
+		return []string{string(opts.ConditionalSet)}, nil
+	default:
+		return nil, errors.New("invalid conditional set for JSON.SET, only XX and NX are supported")
+	}
+}
+
+// JsonGetOptions represents optional arguments for the JSON.GET command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/json.get/
+type JsonGetOptions struct {
+	// The paths within the JSON document to retrieve. If not set, the root of the document is returned.
+	Paths []string
+	// Sets an indentation string for nested levels.
+	Indent string
+	// Sets a string that's printed at the end of each line.
+	Newline string
+	// Sets a string that's put between a key and a value.
+	Space string
+	// Allowed to be present for legacy compatibility and has no other effect.
+	NoEscape bool
+}
+
+func NewJsonGetOptions() *JsonGetOptions {
+	return &JsonGetOptions{}
+}
+
+// Sets the paths within the JSON document to retrieve.
+func (opts *JsonGetOptions) SetPaths(paths ...string) *JsonGetOptions {
+	opts.Paths = paths
+	return opts
+}
+
+// Sets an indentation string for nested levels.
+func (opts *JsonGetOptions) SetIndent(indent string) *JsonGetOptions {
+	opts.Indent = indent
+	return opts
+}
+
+// Sets a string that's printed at the end of each line.
+func (opts *JsonGetOptions) SetNewline(newline string) *JsonGetOptions {
+	opts.Newline = newline
+	return opts
+}
+
+// Sets a string that's put between a key and a value.
+func (opts *JsonGetOptions) SetSpace(space string) *JsonGetOptions {
+	opts.Space = space
+	return opts
+}
+
+// Sets the NOESCAPE flag, which is accepted for legacy compatibility and has no other effect.
+func (opts *JsonGetOptions) SetNoEscape(noEscape bool) *JsonGetOptions {
+	opts.NoEscape = noEscape
+	return opts
+}
+
+func (opts *JsonGetOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if opts.Indent != "" {
+		args = append(args, JsonIndentKeyword, opts.Indent)
+	}
+	if opts.Newline != "" {
+		args = append(args, JsonNewlineKeyword, opts.Newline)
+	}
+	if opts.Space != "" {
+		args = append(args, JsonSpaceKeyword, opts.Space)
+	}
+	if opts.NoEscape {
+		args = append(args, JsonNoEscapeKeyword)
+	}
+	args = append(args, opts.Paths...)
+	return args, nil
+}
+
+// JsonArrIndexOptions represents optional arguments for the JSON.ARRINDEX command.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/json.arrindex/
+type JsonArrIndexOptions struct {
+	// The inclusive start index of the search range. Negative values count from the end of the array.
+	Start *int64
+	// The exclusive end index of the search range. `0` or `-1` means the last element is included.
+	// Can only be set together with [JsonArrIndexOptions.Start].
+	End *int64
+}
+
+func NewJsonArrIndexOptions() *JsonArrIndexOptions {
+	return &JsonArrIndexOptions{}
+}
+
+// Sets the inclusive start index of the search range.
+func (opts *JsonArrIndexOptions) SetStart(start int64) *JsonArrIndexOptions {
+	opts.Start = &start
+	return opts
+}
+
+// Sets the exclusive end index of the search range.
+func (opts *JsonArrIndexOptions) SetEnd(end int64) *JsonArrIndexOptions {
+	opts.End = &end
+	return opts
+}
+
+func (opts *JsonArrIndexOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if opts.Start == nil {
+		if opts.End != nil {
+			return nil, errors.New("end index of JSON.ARRINDEX can only be set together with the start index")
+		}
+		return args, nil
+	}
+	args = append(args, utils.IntToString(*opts.Start))
+	if opts.End != nil {
+		args = append(args, utils.IntToString(*opts.End))
+	}
+	return args, nil
+}
@@ -0,0 +1,35 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// Optional arguments to `MemoryUsage`.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/memory-usage/
+type MemoryUsageOptions struct {
+	Samples *int64
+}
+
+func NewMemoryUsageOptions() *MemoryUsageOptions {
+	return &MemoryUsageOptions{}
+}
+
+// Sets the number of nested values sampled to estimate the memory usage of an aggregate value, `0` to sample all of them.
+// By default, 5 values are sampled.
+func (opts *MemoryUsageOptions) SetSamples(samples int64) *MemoryUsageOptions {
+	opts.Samples = &samples
+	return opts
+}
+
+func (opts *MemoryUsageOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if opts.Samples != nil {
+		args = append(args, "SAMPLES", utils.IntToString(*opts.Samples))
+	}
+	return args, nil
+}
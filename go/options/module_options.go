@@ -0,0 +1,49 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// ModuleConfig is a configuration parameter of a module, set when the module is loaded.
+type ModuleConfig struct {
+	Name  string
+	Value string
+}
+
+// Optional arguments to `ModuleLoadEx`.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/module-loadex/
+type ModuleLoadExOptions struct {
+	// The configuration parameters of the module, in the order in which they are applied.
+	Configs []ModuleConfig
+	// The arguments given to the module.
+	Args []string
+}
+
+func NewModuleLoadExOptions() *ModuleLoadExOptions {
+	return &ModuleLoadExOptions{}
+}
+
+// Adds a configuration parameter of the module.
+func (opts *ModuleLoadExOptions) AddConfig(name string, value string) *ModuleLoadExOptions {
+	opts.Configs = append(opts.Configs, ModuleConfig{Name: name, Value: value})
+	return opts
+}
+
+// Sets the arguments given to the module.
+func (opts *ModuleLoadExOptions) SetArgs(args ...string) *ModuleLoadExOptions {
+	opts.Args = args
+	return opts
+}
+
+func (opts *ModuleLoadExOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	for _, config := range opts.Configs {
+		args = append(args, "CONFIG", config.Name, config.Value)
+	}
+	if len(opts.Args) > 0 {
+		args = append(args, "ARGS")
+		args = append(args, opts.Args...)
+	}
+	return args, nil
+}
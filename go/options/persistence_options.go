@@ -0,0 +1,131 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "errors"
+
+// Optional arguments to `BgSave`.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+type BgSaveOptions struct {
+	// Schedules the save to run once the AOF rewrite in progress completes, instead of failing.
+	Schedule bool
+}
+
+func NewBgSaveOptions() *BgSaveOptions {
+	return &BgSaveOptions{}
+}
+
+// Schedules the save to run once the AOF rewrite in progress completes, instead of failing.
+func (opts *BgSaveOptions) SetSchedule() *BgSaveOptions {
+	opts.Schedule = true
+	return opts
+}
+
+// Optional arguments to `BgSave` in cluster mode.
+type ClusterBgSaveOptions struct {
+	*BgSaveOptions
+	// Specifies the routing configuration for the command.
+	// The client will route the command to the nodes defined by Route.
+	// The command will be routed to all primary nodes, unless Route is provided.
+	*RouteOption
+}
+
+func (opts *BgSaveOptions) ToArgs() ([]string, error) {
+	if opts != nil && opts.Schedule {
+		return []string{"SCHEDULE"}, nil
+	}
+	return []string{}, nil
+}
+
+// ShutDownSaveMode decides whether the server saves the dataset before shutting down.
+type ShutDownSaveMode string
+
+const (
+	// Saves the dataset, even if no save points are configured.
+	ShutDownSave ShutDownSaveMode = "SAVE"
+	// Doesn't save the dataset, even if save points are configured.
+	ShutDownNoSave ShutDownSaveMode = "NOSAVE"
+)
+
+// Optional arguments to `ShutDown`.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+type ShutDownOptions struct {
+	// Whether the dataset is saved. By default, it is saved if save points are configured.
+	SaveMode ShutDownSaveMode
+	// Skips waiting for the lagging replicas.
+	Now bool
+	// Ignores the errors preventing the server from exiting, e.g. a failure to save the dataset.
+	Force bool
+	// Aborts a shutdown in progress.
+	Abort bool
+}
+
+func NewShutDownOptions() *ShutDownOptions {
+	return &ShutDownOptions{}
+}
+
+// Sets whether the dataset is saved before shutting down.
+func (opts *ShutDownOptions) SetSaveMode(mode ShutDownSaveMode) *ShutDownOptions {
+	opts.SaveMode = mode
+	return opts
+}
+
+// Skips waiting for the lagging replicas.
+func (opts *ShutDownOptions) SetNow() *ShutDownOptions {
+	opts.Now = true
+	return opts
+}
+
+// Ignores the errors preventing the server from exiting, e.g. a failure to save the dataset.
+func (opts *ShutDownOptions) SetForce() *ShutDownOptions {
+	opts.Force = true
+	return opts
+}
+
+// Aborts a shutdown in progress. Can't be combined with the other options.
+func (opts *ShutDownOptions) SetAbort() *ShutDownOptions {
+	opts.Abort = true
+	return opts
+}
+
+// Optional arguments to `ShutDown` in cluster mode.
+type ClusterShutDownOptions struct {
+	*ShutDownOptions
+	// Specifies the routing configuration for the command.
+	// The client will route the command to the node defined by Route, which must be a single node.
+	*RouteOption
+}
+
+// IsAbort returns whether the options abort a shutdown in progress.
+func (opts *ShutDownOptions) IsAbort() bool {
+	return opts != nil && opts.Abort
+}
+
+func (opts *ShutDownOptions) ToArgs() ([]string, error) {
+	if opts == nil {
+		return []string{}, nil
+	}
+	if opts.Abort {
+		if opts.SaveMode != "" || opts.Now || opts.Force {
+			return nil, errors.New("ABORT can't be combined with the other options of SHUTDOWN")
+		}
+		return []string{"ABORT"}, nil
+	}
+	args := []string{}
+	if opts.SaveMode != "" {
+		args = append(args, string(opts.SaveMode))
+	}
+	if opts.Now {
+		args = append(args, "NOW")
+	}
+	if opts.Force {
+		args = append(args, "FORCE")
+	}
+	return args, nil
+}
@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// Optional arguments to `FailOver`.
+//
+// See [valkey.io]
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+type FailOverOptions struct {
+	// The replica to fail over to. By default, any replica in sync may be chosen.
+	Host string
+	Port int64
+	// Fails over to the target replica once the timeout is reached, even if it isn't in sync. Requires a target and a
+	// timeout.
+	Force bool
+	// The maximum time to wait for a replica to be in sync. By default, the server waits indefinitely.
+	Timeout time.Duration
+	// Aborts a failover in progress.
+	Abort bool
+}
+
+func NewFailOverOptions() *FailOverOptions {
+	return &FailOverOptions{}
+}
+
+// Sets the replica to fail over to.
+func (opts *FailOverOptions) SetTo(host string, port int64) *FailOverOptions {
+	opts.Host = host
+	opts.Port = port
+	return opts
+}
+
+// Fails over to the target replica once the timeout is reached, even if it isn't in sync.
+func (opts *FailOverOptions) SetForce() *FailOverOptions {
+	opts.Force = true
+	return opts
+}
+
+// Sets the maximum time to wait for a replica to be in sync, in milliseconds precision.
+func (opts *FailOverOptions) SetTimeout(timeout time.Duration) *FailOverOptions {
+	opts.Timeout = timeout
+	return opts
+}
+
+// Aborts a failover in progress. Can't be combined with the other options.
+func (opts *FailOverOptions) SetAbort() *FailOverOptions {
+	opts.Abort = true
+	return opts
+}
+
+func (opts *FailOverOptions) ToArgs() ([]string, error) {
+	if opts.Abort {
+		if opts.Host != "" || opts.Force || opts.Timeout != 0 {
+			return nil, errors.New("ABORT can't be combined with the other options of FAILOVER")
+		}
+		return []string{"ABORT"}, nil
+	}
+	if opts.Force && (opts.Host == "" || opts.Timeout <= 0) {
+		return nil, errors.New("FORCE requires a target replica and a timeout")
+	}
+	args := []string{}
+	if opts.Host != "" {
+		args = append(args, "TO", opts.Host, utils.IntToString(opts.Port))
+		if opts.Force {
+			args = append(args, "FORCE")
+		}
+	}
+	if opts.Timeout > 0 {
+		args = append(args, "TIMEOUT", utils.IntToString(opts.Timeout.Milliseconds()))
+	}
+	return args, nil
+}
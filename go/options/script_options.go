@@ -6,6 +6,8 @@ import (
 	"errors"
 	"sync"
 	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
 )
 
 // #include "../lib.h"
@@ -91,6 +93,7 @@ type Script struct {
 func NewScript(code string) *Script {
 	// In Go implementation, we'd convert code to bytes and store the script
 	hash := storeScript(getBytes(code))
+	utils.StoreScriptCode(hash, code)
 	return &Script{
 		hash:      hash,
 		isDropped: false,
@@ -110,6 +113,7 @@ func (s *Script) Close() error {
 
 	if !s.isDropped {
 		dropScript(s.hash)
+		utils.DropScriptCode(s.hash)
 		s.isDropped = true
 	}
 	return nil
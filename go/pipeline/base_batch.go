@@ -870,6 +870,479 @@ func (b *BaseBatch[T]) HRandFieldWithCountWithValues(key string, count int64) *T
 	)
 }
 
+// Sets a timeout on fields of the hash stored at `key`, in seconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, a whole number of seconds.
+//	fields - The fields to expire.
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hexpire/
+func (b *BaseBatch[T]) HExpire(key string, expireTime time.Duration, fields []string) *T {
+	seconds, err := utils.DurationToSeconds(expireTime)
+	if err != nil {
+		return b.addError("HExpire", err)
+	}
+	return b.addHashFieldExpireCmd(C.HExpire, key, []string{seconds}, fields)
+}
+
+// Sets a timeout on fields of the hash stored at `key`, in seconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, a whole number of seconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hexpire/
+func (b *BaseBatch[T]) HExpireWithOptions(
+	key string,
+	expireTime time.Duration,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) *T {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError("HExpireWithOptions", err)
+	}
+	seconds, err := utils.DurationToSeconds(expireTime)
+	if err != nil {
+		return b.addError("HExpireWithOptions", err)
+	}
+	args := []string{seconds, expireConditionStr}
+	return b.addHashFieldExpireCmd(C.HExpire, key, args, fields)
+}
+
+// Sets a timeout on fields of the hash stored at `key`, in milliseconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, sent in milliseconds.
+//	fields - The fields to expire.
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hpexpire/
+func (b *BaseBatch[T]) HPExpire(key string, expireTime time.Duration, fields []string) *T {
+	return b.addHashFieldExpireCmd(C.HPExpire, key, []string{utils.IntToString(expireTime.Milliseconds())}, fields)
+}
+
+// Sets a timeout on fields of the hash stored at `key`, in milliseconds. After the timeout has expired, the fields will
+// automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The duration for the fields to expire, sent in milliseconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hpexpire/
+func (b *BaseBatch[T]) HPExpireWithOptions(
+	key string,
+	expireTime time.Duration,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) *T {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError("HPExpireWithOptions", err)
+	}
+	args := []string{utils.IntToString(expireTime.Milliseconds()), expireConditionStr}
+	return b.addHashFieldExpireCmd(C.HPExpire, key, args, fields)
+}
+
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in seconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in seconds.
+//	fields - The fields to expire.
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hexpireat/
+func (b *BaseBatch[T]) HExpireAt(key string, expireTime time.Time, fields []string) *T {
+	return b.addHashFieldExpireCmd(C.HExpireAt, key, []string{utils.IntToString(expireTime.Unix())}, fields)
+}
+
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in seconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in seconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hexpireat/
+func (b *BaseBatch[T]) HExpireAtWithOptions(
+	key string,
+	expireTime time.Time,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) *T {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError("HExpireAtWithOptions", err)
+	}
+	args := []string{utils.IntToString(expireTime.Unix()), expireConditionStr}
+	return b.addHashFieldExpireCmd(C.HExpireAt, key, args, fields)
+}
+
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in milliseconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in milliseconds.
+//	fields - The fields to expire.
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hpexpireat/
+func (b *BaseBatch[T]) HPExpireAt(key string, expireTime time.Time, fields []string) *T {
+	return b.addHashFieldExpireCmd(C.HPExpireAt, key, []string{utils.IntToString(expireTime.UnixMilli())}, fields)
+}
+
+// Sets the expiration of fields of the hash stored at `key` to an absolute Unix timestamp, in milliseconds. Once the
+// timestamp is reached, the fields will automatically be deleted from the hash.
+// The expiration is only set on the fields which meet `expireCondition`.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	expireTime - The timestamp for the fields to expire, sent as a Unix time in milliseconds.
+//	fields - The fields to expire.
+//	expireCondition - The option to set expiry, see [constants.ExpireCondition].
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldExpireResult].
+//
+// [valkey.io]: https://valkey.io/commands/hpexpireat/
+func (b *BaseBatch[T]) HPExpireAtWithOptions(
+	key string,
+	expireTime time.Time,
+	fields []string,
+	expireCondition constants.ExpireCondition,
+) *T {
+	expireConditionStr, err := expireCondition.ToString()
+	if err != nil {
+		return b.addError("HPExpireAtWithOptions", err)
+	}
+	args := []string{utils.IntToString(expireTime.UnixMilli()), expireConditionStr}
+	return b.addHashFieldExpireCmd(C.HPExpireAt, key, args, fields)
+}
+
+func (b *BaseBatch[T]) addHashFieldExpireCmd(requestType C.RequestType, key string, opts []string, fields []string) *T {
+	return b.addCmdAndConverter(
+		requestType,
+		utils.ConvertToHashFieldsArgs(key, opts, fields),
+		reflect.Slice,
+		false,
+		func(data any) (any, error) { return internal.ConvertHashFieldExpireResults(data) },
+	)
+}
+
+// Removes the expiration of fields of the hash stored at `key`, so that they are kept until they are deleted.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	fields - The fields to persist.
+//
+// Command Response:
+//
+//	The status of each field, in the order of `fields`, as a slice of [models.HashFieldPersistResult].
+//
+// [valkey.io]: https://valkey.io/commands/hpersist/
+func (b *BaseBatch[T]) HPersist(key string, fields []string) *T {
+	return b.addCmdAndConverter(
+		C.HPersist,
+		utils.ConvertToHashFieldsArgs(key, []string{}, fields),
+		reflect.Slice,
+		false,
+		func(data any) (any, error) { return internal.ConvertHashFieldPersistResults(data) },
+	)
+}
+
+// Returns the remaining time to live of fields of the hash stored at `key`, in seconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	fields - The fields to query.
+//
+// Command Response:
+//
+//	The remaining time to live of each field in seconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/httl/
+func (b *BaseBatch[T]) HTTL(key string, fields []string) *T {
+	return b.addCmdAndConverter(
+		C.HTtl,
+		utils.ConvertToHashFieldsArgs(key, []string{}, fields),
+		reflect.Slice,
+		false,
+		internal.ConvertArrayOf[int64],
+	)
+}
+
+// Returns the remaining time to live of fields of the hash stored at `key`, in milliseconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	fields - The fields to query.
+//
+// Command Response:
+//
+//	The remaining time to live of each field in milliseconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/hpttl/
+func (b *BaseBatch[T]) HPTTL(key string, fields []string) *T {
+	return b.addCmdAndConverter(
+		C.HPTtl,
+		utils.ConvertToHashFieldsArgs(key, []string{}, fields),
+		reflect.Slice,
+		false,
+		internal.ConvertArrayOf[int64],
+	)
+}
+
+// Returns the absolute Unix timestamp at which fields of the hash stored at `key` will expire, in seconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	fields - The fields to query.
+//
+// Command Response:
+//
+//	The expiration Unix timestamp of each field in seconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/hexpiretime/
+func (b *BaseBatch[T]) HExpireTime(key string, fields []string) *T {
+	return b.addCmdAndConverter(
+		C.HExpireTime,
+		utils.ConvertToHashFieldsArgs(key, []string{}, fields),
+		reflect.Slice,
+		false,
+		internal.ConvertArrayOf[int64],
+	)
+}
+
+// Returns the absolute Unix timestamp at which fields of the hash stored at `key` will expire, in
+// milliseconds.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	fields - The fields to query.
+//
+// Command Response:
+//
+//	The expiration Unix timestamp of each field in milliseconds, in the order of `fields`. `-1` if the field has no
+//	expiration, and `-2` if the field or the key doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/hpexpiretime/
+func (b *BaseBatch[T]) HPExpireTime(key string, fields []string) *T {
+	return b.addCmdAndConverter(
+		C.HPExpireTime,
+		utils.ConvertToHashFieldsArgs(key, []string{}, fields),
+		reflect.Slice,
+		false,
+		internal.ConvertArrayOf[int64],
+	)
+}
+
+// Returns the values of fields of the hash stored at `key`, and optionally sets or removes their expiration.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	fields - The fields to retrieve.
+//	options - The [options.HGetExOptions].
+//
+// Command Response:
+//
+//	The values of the fields, in the order of `fields`. For every field that does not exist in the hash, a
+//	[models.CreateNilStringResult()] is returned.
+//
+// [valkey.io]: https://valkey.io/commands/hgetex/
+func (b *BaseBatch[T]) HGetEx(key string, fields []string, options options.HGetExOptions) *T {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError("HGetEx", err)
+	}
+	return b.addCmdAndConverter(
+		C.HGetEx,
+		utils.ConvertToHashFieldsArgs(key, optionArgs, fields),
+		reflect.Slice,
+		false,
+		internal.ConvertArrayOfNilOr[string],
+	)
+}
+
+// Sets the specified fields to their respective values in the hash stored at `key`, and optionally sets their
+// expiration. If key doesn't exist, a new key holding a hash is created.
+//
+// Since:
+//
+//	Valkey 9.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the hash.
+//	values - A map of field-value pairs to set in the hash.
+//	options - The [options.HSetExOptions].
+//
+// Command Response:
+//
+//	`true` if all the fields were set. `false` if no field was set, because a condition of `options` wasn't met.
+//
+// [valkey.io]: https://valkey.io/commands/hsetex/
+func (b *BaseBatch[T]) HSetEx(key string, values map[string]string, options options.HSetExOptions) *T {
+	optionArgs, err := options.ToArgs()
+	if err != nil {
+		return b.addError("HSetEx", err)
+	}
+	args := utils.Concat(
+		[]string{key},
+		optionArgs,
+		[]string{constants.FieldsKeyword, strconv.Itoa(len(values))},
+		utils.MapToString(values),
+	)
+	return b.addCmdAndConverter(C.HSetEx, args, reflect.Int64, false, func(data any) (any, error) {
+		return data.(int64) == 1, nil
+	})
+}
+
 // Inserts all the specified values at the head of the list stored at key. elements are inserted one after the other to the
 // head of the list, from the leftmost element to the rightmost element. If key does not exist, it is created as an empty
 // list before performing the push operation.
@@ -6268,6 +6741,252 @@ func (b *BaseBatch[T]) FCallWithKeysAndArgs(function string, keys []string, args
 	return b.addCmd(C.FCall, commandArgs)
 }
 
+// Invokes a Lua script, with `EVALSHA`.
+//
+// Before sending the first batch of a script, the client checks with `SCRIPT EXISTS` whether it's loaded on the nodes
+// of the route of the batch, and loads it with `SCRIPT LOAD` otherwise, so that the script runs at its position in the
+// batch. If the script was flushed since, the client loads it and retries the command once the batch completes when
+// the batch is non-atomic, while the command of an atomic batch fails. The script object must not be closed before
+// the batch is executed.
+//
+// See [EXISTS], [LOAD] and [EVALSHA] for details.
+//
+// Parameters:
+//
+//	script - The Lua script to execute.
+//
+// Command Response:
+//
+//	The result of the script execution.
+//
+// [EXISTS]: https://valkey.io/commands/script-exists/
+// [LOAD]: https://valkey.io/commands/script-load/
+// [EVALSHA]: https://valkey.io/commands/evalsha/
+func (b *BaseBatch[T]) InvokeScript(script options.Script) *T {
+	return b.InvokeScriptWithOptions(script, *options.NewScriptOptions())
+}
+
+// Invokes a Lua script with keys and arguments, with `EVALSHA`.
+//
+// Before sending the first batch of a script, the client checks with `SCRIPT EXISTS` whether it's loaded on the nodes
+// of the route of the batch, and loads it with `SCRIPT LOAD` otherwise, so that the script runs at its position in the
+// batch. If the script was flushed since, the client loads it and retries the command once the batch completes when
+// the batch is non-atomic, while the command of an atomic batch fails. The script object must not be closed before
+// the batch is executed.
+//
+// Note:
+//
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
+//
+// See [EXISTS], [LOAD] and [EVALSHA] for details.
+//
+// Parameters:
+//
+//	script - The Lua script to execute.
+//	scriptOptions - Options for script execution including keys and arguments.
+//
+// Command Response:
+//
+//	The result of the script execution.
+//
+// [EXISTS]: https://valkey.io/commands/script-exists/
+// [LOAD]: https://valkey.io/commands/script-load/
+// [EVALSHA]: https://valkey.io/commands/evalsha/
+func (b *BaseBatch[T]) InvokeScriptWithOptions(script options.Script, scriptOptions options.ScriptOptions) *T {
+	cmd := internal.MakeCmd(
+		uint32(C.EvalSha),
+		scriptArgs(script.GetHash(), scriptOptions),
+		func(res any) (any, error) { return res, nil },
+	)
+	cmd.ScriptHash = script.GetHash()
+	b.Batch.Commands = append(b.Batch.Commands, cmd)
+	return b.self
+}
+
+// Executes a Lua script with `EVAL`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	script - The source code of the Lua script.
+//
+// Command Response:
+//
+//	The result of the script execution.
+//
+// [valkey.io]: https://valkey.io/commands/eval/
+func (b *BaseBatch[T]) Eval(script string) *T {
+	return b.EvalWithOptions(script, *options.NewScriptOptions())
+}
+
+// Executes a Lua script with `EVAL`, with keys and arguments.
+//
+// Note:
+//
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	script - The source code of the Lua script.
+//	scriptOptions - Options for script execution including keys and arguments.
+//
+// Command Response:
+//
+//	The result of the script execution.
+//
+// [valkey.io]: https://valkey.io/commands/eval/
+func (b *BaseBatch[T]) EvalWithOptions(script string, scriptOptions options.ScriptOptions) *T {
+	return b.addCmd(C.Eval, scriptArgs(script, scriptOptions))
+}
+
+// Executes a read-only Lua script with `EVAL_RO`. The script fails if it modifies the data.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	script - The source code of the Lua script.
+//
+// Command Response:
+//
+//	The result of the script execution.
+//
+// [valkey.io]: https://valkey.io/commands/eval_ro/
+func (b *BaseBatch[T]) EvalReadOnly(script string) *T {
+	return b.EvalReadOnlyWithOptions(script, *options.NewScriptOptions())
+}
+
+// Executes a read-only Lua script with `EVAL_RO`, with keys and arguments. The script fails if it modifies the data.
+//
+// Note:
+//
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	script - The source code of the Lua script.
+//	scriptOptions - Options for script execution including keys and arguments.
+//
+// Command Response:
+//
+//	The result of the script execution.
+//
+// [valkey.io]: https://valkey.io/commands/eval_ro/
+func (b *BaseBatch[T]) EvalReadOnlyWithOptions(script string, scriptOptions options.ScriptOptions) *T {
+	return b.addCmd(C.EvalReadOnly, scriptArgs(script, scriptOptions))
+}
+
+// Executes a Lua script loaded on the server, by its SHA1 digest, with `EVALSHA`. Unlike [BaseBatch.InvokeScript],
+// the client doesn't load the script when it's missing.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	sha1 - The SHA1 digest of the script, as returned by `ScriptLoad`.
+//
+// Command Response:
+//
+//	The result of the script execution, or a `NoScriptError` if the script isn't loaded.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (b *BaseBatch[T]) EvalSha(sha1 string) *T {
+	return b.EvalShaWithOptions(sha1, *options.NewScriptOptions())
+}
+
+// Executes a Lua script loaded on the server, by its SHA1 digest, with `EVALSHA`, with keys and arguments. Unlike
+// [BaseBatch.InvokeScriptWithOptions], the client doesn't load the script when it's missing.
+//
+// Note:
+//
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	sha1 - The SHA1 digest of the script, as returned by `ScriptLoad`.
+//	scriptOptions - Options for script execution including keys and arguments.
+//
+// Command Response:
+//
+//	The result of the script execution, or a `NoScriptError` if the script isn't loaded.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha/
+func (b *BaseBatch[T]) EvalShaWithOptions(sha1 string, scriptOptions options.ScriptOptions) *T {
+	return b.addCmd(C.EvalSha, scriptArgs(sha1, scriptOptions))
+}
+
+// Executes a read-only Lua script loaded on the server, by its SHA1 digest, with `EVALSHA_RO`. The script fails if it
+// modifies the data.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	sha1 - The SHA1 digest of the script, as returned by `ScriptLoad`.
+//
+// Command Response:
+//
+//	The result of the script execution, or a `NoScriptError` if the script isn't loaded.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha_ro/
+func (b *BaseBatch[T]) EvalShaReadOnly(sha1 string) *T {
+	return b.EvalShaReadOnlyWithOptions(sha1, *options.NewScriptOptions())
+}
+
+// Executes a read-only Lua script loaded on the server, by its SHA1 digest, with `EVALSHA_RO`, with keys and
+// arguments. The script fails if it modifies the data.
+//
+// Note:
+//
+//	When in cluster mode, all `keys` in `scriptOptions` must map to the same hash slot.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	sha1 - The SHA1 digest of the script, as returned by `ScriptLoad`.
+//	scriptOptions - Options for script execution including keys and arguments.
+//
+// Command Response:
+//
+//	The result of the script execution, or a `NoScriptError` if the script isn't loaded.
+//
+// [valkey.io]: https://valkey.io/commands/evalsha_ro/
+func (b *BaseBatch[T]) EvalShaReadOnlyWithOptions(sha1 string, scriptOptions options.ScriptOptions) *T {
+	return b.addCmd(C.EvalShaReadOnly, scriptArgs(sha1, scriptOptions))
+}
+
+// The arguments of `EVAL` and of its variants: the script or its SHA1 digest, the number of keys, the keys and the
+// arguments.
+func scriptArgs(scriptOrSha1 string, scriptOptions options.ScriptOptions) []string {
+	args := []string{scriptOrSha1, strconv.Itoa(len(scriptOptions.Keys))}
+	args = append(args, scriptOptions.Keys...)
+	return append(args, scriptOptions.Args...)
+}
+
 // Invokes a previously loaded read-only function.
 //
 // Since:
@@ -6511,6 +7230,23 @@ func (b *BaseBatch[T]) Publish(channel string, message string) *T {
 	return b.addCmdAndTypeChecker(C.Publish, []string{channel, message}, reflect.Int64, false)
 }
 
+// Loads a Lua script into the script cache of the server, without executing it.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	script - The source code of the Lua script.
+//
+// Command Response:
+//
+//	The SHA1 digest of the script.
+//
+// [valkey.io]: https://valkey.io/commands/script-load/
+func (b *BaseBatch[T]) ScriptLoad(script string) *T {
+	return b.addCmdAndTypeChecker(C.ScriptLoad, []string{script}, reflect.String, false)
+}
+
 // Checks existence of scripts in the script cache by their SHA1 digest.
 //
 // See [valkey.io] for details.
@@ -6946,3 +7682,105 @@ func (b *BaseBatch[T]) RandomKey() *T {
 func (b *BaseBatch[T]) FunctionStats() *T {
 	return b.addCmdAndConverter(C.FunctionStats, []string{}, reflect.Map, false, internal.ConvertFunctionStatsResponse)
 }
+
+// Saves the dataset to disk synchronously, blocking the server until the save completes.
+//
+// See [valkey.io] for details.
+//
+// Command Response:
+//
+//	"OK" when the dataset was saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (b *BaseBatch[T]) Save() *T {
+	return b.addCmdAndTypeChecker(C.Save, []string{}, reflect.String, false)
+}
+
+// Saves the dataset to disk in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Command Response:
+//
+//	"Background saving started" when the save started.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (b *BaseBatch[T]) BgSave() *T {
+	return b.addCmdAndTypeChecker(C.BgSave, []string{}, reflect.String, false)
+}
+
+// Saves the dataset to disk in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	opts - The options of the command, see [options.BgSaveOptions].
+//
+// Command Response:
+//
+//	"Background saving started" when the save started, or "Background saving scheduled" when the save was scheduled.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (b *BaseBatch[T]) BgSaveWithOptions(opts options.BgSaveOptions) *T {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("BgSaveWithOptions", err)
+	}
+	return b.addCmdAndTypeChecker(C.BgSave, args, reflect.String, false)
+}
+
+// Rewrites the append-only file in the background, in a forked process.
+//
+// See [valkey.io] for details.
+//
+// Command Response:
+//
+//	A message confirming that the rewrite started, or that it was scheduled.
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (b *BaseBatch[T]) BgRewriteAof() *T {
+	return b.addCmdAndTypeChecker(C.BgRewriteAof, []string{}, reflect.String, false)
+}
+
+// Blocks the current client until all the previous writes of the connection are fsynced to the append-only file of the
+// local server and of the replicas, or until the timeout is reached.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	numLocal - The number of local servers to reach, `0` or `1`.
+//	numReplicas - The number of replicas to reach.
+//	timeout - The timeout value. A value of `0` will block indefinitely.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// Command Response:
+//
+//	The number of local servers and of replicas which fsynced the writes, as [models.WaitAofResult].
+//
+// [valkey.io]: https://valkey.io/commands/waitaof/
+func (b *BaseBatch[T]) WaitAof(numLocal int64, numReplicas int64, timeout time.Duration) *T {
+	args := []string{utils.IntToString(numLocal), utils.IntToString(numReplicas), utils.IntToString(timeout.Milliseconds())}
+	return b.addCmdAndConverter(C.WaitAof, args, reflect.Slice, false, func(data any) (any, error) {
+		return internal.ConvertWaitAofResult(data)
+	})
+}
+
+// Returns the replication role of the server, with the replicas of a primary or the primary of a replica.
+//
+// See [valkey.io] for details.
+//
+// Command Response:
+//
+//	The role of the server, as [models.RoleInfo].
+//
+// [valkey.io]: https://valkey.io/commands/role/
+func (b *BaseBatch[T]) Role() *T {
+	return b.addCmdAndConverter(C.Role, []string{}, reflect.Slice, false, func(data any) (any, error) {
+		return internal.ConvertRoleInfo(data)
+	})
+}
@@ -298,3 +298,88 @@ func (b *ClusterBatch) PubSubShardChannelsWithPattern(pattern string) *ClusterBa
 func (b *ClusterBatch) PubSubShardNumSub(channels ...string) *ClusterBatch {
 	return b.addCmdAndConverter(C.PubSubShardNumSub, channels, reflect.Map, false, internal.ConvertMapOf[int64])
 }
+
+// Makes the server a replica of another server. The dataset of the server is discarded and replaced by the dataset of
+// the new primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	host - The host of the new primary.
+//	port - The port of the new primary.
+//
+// Command Response:
+//
+//	"OK" when the replication was configured.
+//
+// [valkey.io]: https://valkey.io/commands/replicaof/
+func (b *StandaloneBatch) ReplicaOf(host string, port int64) *StandaloneBatch {
+	return b.addCmdAndTypeChecker(C.ReplicaOf, []string{host, utils.IntToString(port)}, reflect.String, false)
+}
+
+// Stops the replication of the server and promotes it to a primary, keeping its dataset.
+//
+// See [valkey.io] for details.
+//
+// Command Response:
+//
+//	"OK" when the replication was stopped.
+//
+// [valkey.io]: https://valkey.io/commands/replicaof/
+func (b *StandaloneBatch) ReplicaOfNoOne() *StandaloneBatch {
+	return b.addCmdAndTypeChecker(C.ReplicaOf, []string{"NO", "ONE"}, reflect.String, false)
+}
+
+// Starts a coordinated failover from the primary to one of its replicas in sync.
+//
+// See [valkey.io] for details.
+//
+// Command Response:
+//
+//	"OK" when the failover started.
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+func (b *StandaloneBatch) FailOver() *StandaloneBatch {
+	return b.addCmdAndTypeChecker(C.FailOver, []string{}, reflect.String, false)
+}
+
+// Starts a coordinated failover from the primary to one of its replicas, or aborts a failover in progress.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	opts - The options of the command, see [options.FailOverOptions].
+//
+// Command Response:
+//
+//	"OK" when the failover started or was aborted.
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+func (b *StandaloneBatch) FailOverWithOptions(opts options.FailOverOptions) *StandaloneBatch {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("FailOverWithOptions", err)
+	}
+	return b.addCmdAndTypeChecker(C.FailOver, args, reflect.String, false)
+}
+
+// Swaps two databases, so that the clients connected to one database see the data of the other.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	index1 - The index of the first database.
+//	index2 - The index of the second database.
+//
+// Command Response:
+//
+//	"OK" when the databases were swapped.
+//
+// [valkey.io]: https://valkey.io/commands/swapdb/
+func (b *StandaloneBatch) SwapDb(index1 int64, index2 int64) *StandaloneBatch {
+	args := []string{utils.IntToString(index1), utils.IntToString(index2)}
+	return b.addCmdAndTypeChecker(C.SwapDb, args, reflect.String, false)
+}
@@ -0,0 +1,251 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package pipeline
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"reflect"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func (b *BaseBatch[T]) addBloomBoolCmd(request C.RequestType, args []string) *T {
+	return b.addCmdAndConverter(
+		request,
+		args,
+		reflect.Int64,
+		false,
+		func(data any) (any, error) { return internal.ConvertBloomBool(data) },
+	)
+}
+
+func (b *BaseBatch[T]) addBloomBoolArrayCmd(request C.RequestType, args []string) *T {
+	return b.addCmdAndConverter(
+		request,
+		args,
+		reflect.Slice,
+		false,
+		internal.ConvertBloomBatchBools,
+	)
+}
+
+// Adds an item to the bloom filter stored at `key`. The filter is created with the default capacity and error rate of
+// the server if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	item - The item to add.
+//
+// Command Response:
+//
+//	`true` if the item was added, `false` if it possibly already exists in the filter.
+//
+// [valkey.io]: https://valkey.io/commands/bf.add/
+func (b *BaseBatch[T]) BfAdd(key string, item string) *T {
+	return b.addBloomBoolCmd(C.BfAdd, []string{key, item})
+}
+
+// Adds items to the bloom filter stored at `key`. The filter is created with the default capacity and error rate of
+// the server if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//
+// Command Response:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item was added, `false` if it possibly
+//	already exists in the filter.
+//	An error if an item can't be added, e.g. when a non-scaling filter is full.
+//
+// [valkey.io]: https://valkey.io/commands/bf.madd/
+func (b *BaseBatch[T]) BfMAdd(key string, items []string) *T {
+	return b.addBloomBoolArrayCmd(C.BfMAdd, append([]string{key}, items...))
+}
+
+// Checks whether an item was added to the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	item - The item to check.
+//
+// Command Response:
+//
+//	`true` if the item possibly exists in the filter, `false` if it certainly doesn't or if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.exists/
+func (b *BaseBatch[T]) BfExists(key string, item string) *T {
+	return b.addBloomBoolCmd(C.BfExists, []string{key, item})
+}
+
+// Checks whether items were added to the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	items - The items to check.
+//
+// Command Response:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item possibly exists in the filter,
+//	`false` if it certainly doesn't or if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.mexists/
+func (b *BaseBatch[T]) BfMExists(key string, items []string) *T {
+	return b.addBloomBoolArrayCmd(C.BfMExists, append([]string{key}, items...))
+}
+
+// Creates an empty bloom filter at `key`, with the given false positive rate and capacity.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	errorRate - The false positive rate of the filter, between 0 and 1.
+//	capacity - The number of items the filter can hold before scaling out.
+//
+// Command Response:
+//
+//	`"OK"` if the filter is created. An error if `key` already exists.
+//
+// [valkey.io]: https://valkey.io/commands/bf.reserve/
+func (b *BaseBatch[T]) BfReserve(key string, errorRate float64, capacity int64) *T {
+	return b.BfReserveWithOptions(key, errorRate, capacity, *options.NewBloomReserveOptions())
+}
+
+// Creates an empty bloom filter at `key`, with the given false positive rate and capacity.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	errorRate - The false positive rate of the filter, between 0 and 1.
+//	capacity - The number of items the filter can hold before scaling out.
+//	opts - The [options.BloomReserveOptions], i.e. how the filter scales out.
+//
+// Command Response:
+//
+//	`"OK"` if the filter is created. An error if `key` already exists.
+//
+// [valkey.io]: https://valkey.io/commands/bf.reserve/
+func (b *BaseBatch[T]) BfReserveWithOptions(
+	key string,
+	errorRate float64,
+	capacity int64,
+	opts options.BloomReserveOptions,
+) *T {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("BfReserveWithOptions", err)
+	}
+	args := append([]string{key, utils.FloatToString(errorRate), utils.IntToString(capacity)}, optionArgs...)
+	return b.addCmdAndTypeChecker(C.BfReserve, args, reflect.String, false)
+}
+
+// Adds items to the bloom filter stored at `key`. The filter is created with the default capacity and error rate of
+// the server if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//
+// Command Response:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item was added, `false` if it possibly
+//	already exists in the filter.
+//	An error if an item can't be added, e.g. when a non-scaling filter is full.
+//
+// [valkey.io]: https://valkey.io/commands/bf.insert/
+func (b *BaseBatch[T]) BfInsert(key string, items []string) *T {
+	return b.BfInsertWithOptions(key, items, *options.NewBloomInsertOptions())
+}
+
+// Adds items to the bloom filter stored at `key`. Unless [options.BloomInsertOptions.NoCreate] is set, the filter is
+// created with the given options if it doesn't exist.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//	items - The items to add.
+//	opts - The [options.BloomInsertOptions], i.e. the capacity, the error rate and how the filter scales out.
+//
+// Command Response:
+//
+//	A slice with a value per item, in the same order as `items`: `true` if the item was added, `false` if it possibly
+//	already exists in the filter.
+//	An error if an item can't be added, e.g. when a non-scaling filter is full, or if `key` doesn't exist and
+//	[options.BloomInsertOptions.NoCreate] is set.
+//
+// [valkey.io]: https://valkey.io/commands/bf.insert/
+func (b *BaseBatch[T]) BfInsertWithOptions(key string, items []string, opts options.BloomInsertOptions) *T {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("BfInsertWithOptions", err)
+	}
+	return b.addBloomBoolArrayCmd(
+		C.BfInsert,
+		utils.Concat([]string{key}, optionArgs, []string{options.BloomItemsKeyword}, items),
+	)
+}
+
+// Returns the properties of the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//
+// Command Response:
+//
+//	The properties of the filter as a [models.BloomInfo]. An error if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.info/
+func (b *BaseBatch[T]) BfInfo(key string) *T {
+	return b.addCmdAndConverter(
+		C.BfInfo,
+		[]string{key},
+		reflect.Slice,
+		false,
+		func(data any) (any, error) { return internal.ConvertBloomInfo(data) },
+	)
+}
+
+// Returns the number of items added to the bloom filter stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the bloom filter.
+//
+// Command Response:
+//
+//	The number of items added to the filter, or `0` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/bf.card/
+func (b *BaseBatch[T]) BfCard(key string) *T {
+	return b.addCmdAndTypeChecker(C.BfCard, []string{key}, reflect.Int64, false)
+}
@@ -0,0 +1,953 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package pipeline
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"reflect"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// JSON commands reply with an array for JSONPath (paths starting with `$`) and with a single value for legacy paths.
+func jsonPathKind(path string, legacyKind reflect.Kind) reflect.Kind {
+	if internal.IsJsonPath(path) {
+		return reflect.Slice
+	}
+	return legacyKind
+}
+
+// Sets the JSON value at the specified `path` stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - Represents the path within the JSON document where the value will be set.
+//	value - The value to set at the specific path, in JSON formatted string.
+//
+// Command Response:
+//
+//	`"OK"` if the value is successfully set.
+//
+// [valkey.io]: https://valkey.io/commands/json.set/
+func (b *BaseBatch[T]) JsonSet(key string, path string, value string) *T {
+	return b.addCmdAndTypeChecker(C.JsonSet, []string{key, path, value}, reflect.String, false)
+}
+
+// Sets the JSON value at the specified `path` stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - Represents the path within the JSON document where the value will be set.
+//	value - The value to set at the specific path, in JSON formatted string.
+//	opts - The [options.JsonSetOptions].
+//
+// Command Response:
+//
+//	`"OK"` if the value is successfully set.
+//	If the value isn't set because of the [options.JsonSetOptions.ConditionalSet] condition, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.set/
+func (b *BaseBatch[T]) JsonSetWithOptions(key string, path string, value string, opts options.JsonSetOptions) *T {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("JsonSetWithOptions", err)
+	}
+	return b.addCmdAndTypeChecker(C.JsonSet, append([]string{key, path, value}, optionArgs...), reflect.String, true)
+}
+
+// Retrieves the JSON value stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	The JSON document stored at `key`, serialized to a string, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.get/
+func (b *BaseBatch[T]) JsonGet(key string) *T {
+	return b.addCmdAndTypeChecker(C.JsonGet, []string{key}, reflect.String, true)
+}
+
+// Retrieves the JSON value at the specified paths stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	opts - The [options.JsonGetOptions], including the paths to retrieve and the formatting of the returned value.
+//
+// Command Response:
+//
+//	The value at the requested paths, serialized to a string, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.get/
+func (b *BaseBatch[T]) JsonGetWithOptions(key string, opts options.JsonGetOptions) *T {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("JsonGetWithOptions", err)
+	}
+	return b.addCmdAndTypeChecker(C.JsonGet, append([]string{key}, optionArgs...), reflect.String, true)
+}
+
+// Retrieves the JSON values at the specified `path` stored at multiple `keys`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	keys - The keys of the JSON documents.
+//	path - The path within the JSON documents.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with a value per key, serialized to a string. A nil [models.Result] is returned for
+//	keys which don't exist or when the path doesn't match.
+//
+// [valkey.io]: https://valkey.io/commands/json.mget/
+func (b *BaseBatch[T]) JsonMGet(keys []string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonMGet,
+		utils.Concat(keys, []string{path}),
+		reflect.Slice,
+		false,
+		internal.ConvertArrayOfNilOr[string],
+	)
+}
+
+// Appends one or more `values` to the JSON array at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	values - The JSON values to be appended to the array, in JSON formatted strings.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the new length of each array matched by `path`. A nil [models.Result] is
+//	returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrappend/
+func (b *BaseBatch[T]) JsonArrAppend(key string, path string, values []string) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrAppend,
+		append([]string{key, path}, values...),
+		jsonPathKind(path, reflect.Int64),
+		false,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Searches for the first occurrence of a `scalar` JSON value in the arrays at the `path`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	scalar - The scalar value to search for, in JSON formatted string.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the index of the first occurrence of `scalar` in each array matched by `path`,
+//	or `-1` if it's not found. A nil [models.Result] is returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrindex/
+func (b *BaseBatch[T]) JsonArrIndex(key string, path string, scalar string) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrIndex,
+		[]string{key, path, scalar},
+		jsonPathKind(path, reflect.Int64),
+		false,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Searches for the first occurrence of a `scalar` JSON value in the arrays at the `path`, within the range given by
+// `opts`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	scalar - The scalar value to search for, in JSON formatted string.
+//	opts - The [options.JsonArrIndexOptions].
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the index of the first occurrence of `scalar` in each array matched by `path`,
+//	or `-1` if it's not found. A nil [models.Result] is returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrindex/
+func (b *BaseBatch[T]) JsonArrIndexWithOptions(
+	key string,
+	path string,
+	scalar string,
+	opts options.JsonArrIndexOptions,
+) *T {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return b.addError("JsonArrIndexWithOptions", err)
+	}
+	return b.addCmdAndConverter(
+		C.JsonArrIndex,
+		append([]string{key, path, scalar}, optionArgs...),
+		jsonPathKind(path, reflect.Int64),
+		false,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Inserts one or more `values` into the array at the specified `path` within the JSON document stored at `key`, before
+// the given `index`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	index - The array index before which values are inserted. Negative values count from the end of the array.
+//	values - The JSON values to be inserted into the array, in JSON formatted strings.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the new length of each array matched by `path`. A nil [models.Result] is
+//	returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrinsert/
+func (b *BaseBatch[T]) JsonArrInsert(key string, path string, index int64, values []string) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrInsert,
+		append([]string{key, path, utils.IntToString(index)}, values...),
+		jsonPathKind(path, reflect.Int64),
+		false,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Retrieves the length of the array at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the length of the array, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrlen/
+func (b *BaseBatch[T]) JsonArrLen(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrLen,
+		[]string{key},
+		reflect.Int64,
+		true,
+		internal.MakeConvertJsonPathResult[int64](internal.JsonLegacyRootPath),
+	)
+}
+
+// Retrieves the length of the arrays at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the length of each array matched by `path`, or `nil` if `key` doesn't exist.
+//	A nil [models.Result] is returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrlen/
+func (b *BaseBatch[T]) JsonArrLenWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrLen,
+		[]string{key, path},
+		jsonPathKind(path, reflect.Int64),
+		true,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Pops the last element from the array at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the popped JSON value, serialized to a string.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrpop/
+func (b *BaseBatch[T]) JsonArrPop(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrPop,
+		[]string{key},
+		reflect.String,
+		true,
+		internal.MakeConvertJsonPathResult[string](internal.JsonLegacyRootPath),
+	)
+}
+
+// Pops an element from the arrays at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	index - The index of the element to pop. Negative values count from the end of the array.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the popped JSON value from each array matched by `path`, serialized to a
+//	string. A nil [models.Result] is returned for each matched value which is not an array or is an empty array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrpop/
+func (b *BaseBatch[T]) JsonArrPopWithPathAndIndex(key string, path string, index int64) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrPop,
+		[]string{key, path, utils.IntToString(index)},
+		jsonPathKind(path, reflect.String),
+		true,
+		internal.MakeConvertJsonPathResult[string](path),
+	)
+}
+
+// Trims the arrays at the specified `path` within the JSON document stored at `key` so that they become subarrays
+// [`start`, `end`], both inclusive.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	start - The start index, inclusive.
+//	end - The end index, inclusive.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the new length of each array matched by `path`. A nil [models.Result] is
+//	returned for each matched value which is not an array.
+//
+// [valkey.io]: https://valkey.io/commands/json.arrtrim/
+func (b *BaseBatch[T]) JsonArrTrim(key string, path string, start int64, end int64) *T {
+	return b.addCmdAndConverter(
+		C.JsonArrTrim,
+		[]string{key, path, utils.IntToString(start), utils.IntToString(end)},
+		jsonPathKind(path, reflect.Int64),
+		false,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Clears the arrays and objects at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	The number of containers cleared.
+//
+// [valkey.io]: https://valkey.io/commands/json.clear/
+func (b *BaseBatch[T]) JsonClear(key string) *T {
+	return b.addCmdAndTypeChecker(C.JsonClear, []string{key}, reflect.Int64, false)
+}
+
+// Clears the arrays and objects at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	The number of containers cleared.
+//
+// [valkey.io]: https://valkey.io/commands/json.clear/
+func (b *BaseBatch[T]) JsonClearWithPath(key string, path string) *T {
+	return b.addCmdAndTypeChecker(C.JsonClear, []string{key, path}, reflect.Int64, false)
+}
+
+// Reports the memory usage in bytes of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the memory usage, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func (b *BaseBatch[T]) JsonDebugMemory(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonDebug,
+		[]string{"MEMORY", key},
+		reflect.Int64,
+		true,
+		internal.MakeConvertJsonPathResult[int64](internal.JsonLegacyRootPath),
+	)
+}
+
+// Reports the memory usage in bytes of the JSON values at the specified `path` within the JSON document stored at
+// `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the memory usage of each value matched by `path`, or `nil` if `key` doesn't
+//	exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func (b *BaseBatch[T]) JsonDebugMemoryWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonDebug,
+		[]string{"MEMORY", key, path},
+		jsonPathKind(path, reflect.Int64),
+		true,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Reports the number of fields of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the number of fields, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func (b *BaseBatch[T]) JsonDebugFields(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonDebug,
+		[]string{"FIELDS", key},
+		reflect.Int64,
+		true,
+		internal.MakeConvertJsonPathResult[int64](internal.JsonLegacyRootPath),
+	)
+}
+
+// Reports the number of fields of the JSON values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the number of fields of each value matched by `path`, or `nil` if `key`
+//	doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.debug/
+func (b *BaseBatch[T]) JsonDebugFieldsWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonDebug,
+		[]string{"FIELDS", key, path},
+		jsonPathKind(path, reflect.Int64),
+		true,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Deletes the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	The number of elements deleted. `0` if the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.del/
+func (b *BaseBatch[T]) JsonDel(key string) *T {
+	return b.addCmdAndTypeChecker(C.JsonDel, []string{key}, reflect.Int64, false)
+}
+
+// Deletes the JSON values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document. If the path is the root, the whole key is deleted.
+//
+// Command Response:
+//
+//	The number of elements deleted. `0` if the key does not exist, or if the path doesn't match.
+//
+// [valkey.io]: https://valkey.io/commands/json.del/
+func (b *BaseBatch[T]) JsonDelWithPath(key string, path string) *T {
+	return b.addCmdAndTypeChecker(C.JsonDel, []string{key, path}, reflect.Int64, false)
+}
+
+// Deletes the JSON document stored at `key`. An alias of [BaseBatch.JsonDel].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	The number of elements deleted. `0` if the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.forget/
+func (b *BaseBatch[T]) JsonForget(key string) *T {
+	return b.addCmdAndTypeChecker(C.JsonForget, []string{key}, reflect.Int64, false)
+}
+
+// Deletes the JSON values at the specified `path` within the JSON document stored at `key`. An alias of
+// [BaseBatch.JsonDelWithPath].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document. If the path is the root, the whole key is deleted.
+//
+// Command Response:
+//
+//	The number of elements deleted. `0` if the key does not exist, or if the path doesn't match.
+//
+// [valkey.io]: https://valkey.io/commands/json.forget/
+func (b *BaseBatch[T]) JsonForgetWithPath(key string, path string) *T {
+	return b.addCmdAndTypeChecker(C.JsonForget, []string{key, path}, reflect.Int64, false)
+}
+
+// Increments the numbers at the specified `path` within the JSON document stored at `key` by `number`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	number - The number to increment by.
+//
+// Command Response:
+//
+//	For a JSONPath, a JSON array of the new values, with `null` for each matched value which is not a number.
+//	For a legacy path, the new value.
+//
+// [valkey.io]: https://valkey.io/commands/json.numincrby/
+func (b *BaseBatch[T]) JsonNumIncrBy(key string, path string, number float64) *T {
+	return b.addCmdAndTypeChecker(
+		C.JsonNumIncrBy,
+		[]string{key, path, utils.FloatToString(number)},
+		reflect.String,
+		false,
+	)
+}
+
+// Multiplies the numbers at the specified `path` within the JSON document stored at `key` by `number`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	number - The number to multiply by.
+//
+// Command Response:
+//
+//	For a JSONPath, a JSON array of the new values, with `null` for each matched value which is not a number.
+//	For a legacy path, the new value.
+//
+// [valkey.io]: https://valkey.io/commands/json.nummultby/
+func (b *BaseBatch[T]) JsonNumMultBy(key string, path string, number float64) *T {
+	return b.addCmdAndTypeChecker(
+		C.JsonNumMultBy,
+		[]string{key, path, utils.FloatToString(number)},
+		reflect.String,
+		false,
+	)
+}
+
+// Retrieves the key names of the object at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the key names of the object, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.objkeys/
+func (b *BaseBatch[T]) JsonObjKeys(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonObjKeys,
+		[]string{key},
+		reflect.Slice,
+		true,
+		internal.MakeConvertJsonPathResult[[]string](internal.JsonLegacyRootPath),
+	)
+}
+
+// Retrieves the key names of the objects at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the key names of each object matched by `path`, or `nil` if `key` doesn't
+//	exist. A nil [models.Result] is returned for each matched value which is not an object.
+//
+// [valkey.io]: https://valkey.io/commands/json.objkeys/
+func (b *BaseBatch[T]) JsonObjKeysWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonObjKeys,
+		[]string{key, path},
+		reflect.Slice,
+		true,
+		internal.MakeConvertJsonPathResult[[]string](path),
+	)
+}
+
+// Retrieves the number of keys of the object at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the number of keys of the object, or `nil` if `key` doesn't
+//	exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.objlen/
+func (b *BaseBatch[T]) JsonObjLen(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonObjLen,
+		[]string{key},
+		reflect.Int64,
+		true,
+		internal.MakeConvertJsonPathResult[int64](internal.JsonLegacyRootPath),
+	)
+}
+
+// Retrieves the number of keys of the objects at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the number of keys of each object matched by `path`, or `nil` if `key`
+//	doesn't exist. A nil [models.Result] is returned for each matched value which is not an object.
+//
+// [valkey.io]: https://valkey.io/commands/json.objlen/
+func (b *BaseBatch[T]) JsonObjLenWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonObjLen,
+		[]string{key, path},
+		jsonPathKind(path, reflect.Int64),
+		true,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Retrieves the JSON document stored at `key` in the Valkey Serialization Protocol (RESP) form.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	The JSON document in its RESP form, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.resp/
+func (b *BaseBatch[T]) JsonResp(key string) *T {
+	return b.addCmd(C.JsonResp, []string{key})
+}
+
+// Retrieves the JSON values at the specified `path` within the JSON document stored at `key` in the Valkey
+// Serialization Protocol (RESP) form.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	For a JSONPath, a slice with the RESP form of each matched value.
+//	For a legacy path, the RESP form of the matched value.
+//	If `key` doesn't exist, returns `nil`.
+//
+// [valkey.io]: https://valkey.io/commands/json.resp/
+func (b *BaseBatch[T]) JsonRespWithPath(key string, path string) *T {
+	return b.addCmd(C.JsonResp, []string{key, path})
+}
+
+// Appends the `value` to the string at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	value - The value to append to the string, in JSON formatted string (e.g. `"\"foo\""`).
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the new length of the string.
+//
+// [valkey.io]: https://valkey.io/commands/json.strappend/
+func (b *BaseBatch[T]) JsonStrAppend(key string, value string) *T {
+	return b.addCmdAndConverter(
+		C.JsonStrAppend,
+		[]string{key, value},
+		reflect.Int64,
+		false,
+		internal.MakeConvertJsonPathResult[int64](internal.JsonLegacyRootPath),
+	)
+}
+
+// Appends the `value` to the strings at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//	value - The value to append to the string, in JSON formatted string (e.g. `"\"foo\""`).
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the new length of each string matched by `path`. A nil [models.Result] is
+//	returned for each matched value which is not a string.
+//
+// [valkey.io]: https://valkey.io/commands/json.strappend/
+func (b *BaseBatch[T]) JsonStrAppendWithPath(key string, path string, value string) *T {
+	return b.addCmdAndConverter(
+		C.JsonStrAppend,
+		[]string{key, path, value},
+		jsonPathKind(path, reflect.Int64),
+		false,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Retrieves the length of the string at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the length of the string, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.strlen/
+func (b *BaseBatch[T]) JsonStrLen(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonStrLen,
+		[]string{key},
+		reflect.Int64,
+		true,
+		internal.MakeConvertJsonPathResult[int64](internal.JsonLegacyRootPath),
+	)
+}
+
+// Retrieves the length of the strings at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the length of each string matched by `path`, or `nil` if `key` doesn't
+//	exist. A nil [models.Result] is returned for each matched value which is not a string.
+//
+// [valkey.io]: https://valkey.io/commands/json.strlen/
+func (b *BaseBatch[T]) JsonStrLenWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonStrLen,
+		[]string{key, path},
+		jsonPathKind(path, reflect.Int64),
+		true,
+		internal.MakeConvertJsonPathResult[int64](path),
+	)
+}
+
+// Toggles the boolean value at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the new boolean value.
+//
+// [valkey.io]: https://valkey.io/commands/json.toggle/
+func (b *BaseBatch[T]) JsonToggle(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonToggle,
+		[]string{key},
+		reflect.Bool,
+		false,
+		internal.MakeConvertJsonPathResult[bool](internal.JsonLegacyRootPath),
+	)
+}
+
+// Toggles the boolean values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the new value of each boolean matched by `path`. A nil [models.Result] is
+//	returned for each matched value which is not a boolean.
+//
+// [valkey.io]: https://valkey.io/commands/json.toggle/
+func (b *BaseBatch[T]) JsonToggleWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonToggle,
+		[]string{key, path},
+		jsonPathKind(path, reflect.Bool),
+		false,
+		internal.MakeConvertJsonPathResult[bool](path),
+	)
+}
+
+// Retrieves the type of the JSON value at the root of the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//
+// Command Response:
+//
+//	A single-element slice of [models.Result] with the type of the value, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.type/
+func (b *BaseBatch[T]) JsonType(key string) *T {
+	return b.addCmdAndConverter(
+		C.JsonType,
+		[]string{key},
+		reflect.String,
+		true,
+		internal.MakeConvertJsonPathResult[string](internal.JsonLegacyRootPath),
+	)
+}
+
+// Retrieves the type of the JSON values at the specified `path` within the JSON document stored at `key`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the JSON document.
+//	path - The path within the JSON document.
+//
+// Command Response:
+//
+//	A slice of [models.Result] with the type of each value matched by `path`, or `nil` if `key` doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/json.type/
+func (b *BaseBatch[T]) JsonTypeWithPath(key string, path string) *T {
+	return b.addCmdAndConverter(
+		C.JsonType,
+		[]string{key, path},
+		jsonPathKind(path, reflect.String),
+		true,
+		internal.MakeConvertJsonPathResult[string](path),
+	)
+}
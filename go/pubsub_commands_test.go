@@ -353,3 +353,82 @@ func ExampleClusterClient_PubSubShardNumSub() {
 	// news.sports: 1
 	// news.weather: 2
 }
+
+func ExampleClient_Subscribe() {
+	var publisher *Client = getExampleClient() // example helper function
+	// subscribing at runtime requires a subscription configuration
+	var subscriber *Client = getExampleClientWithSubscriptionConfig(config.NewStandaloneSubscriptionConfig())
+	defer closeAllClients()
+
+	err := subscriber.Subscribe(context.Background(), "my_channel")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	queue, err := subscriber.GetQueue()
+	if err != nil {
+		fmt.Println("Failed to get queue: ", err)
+		return
+	}
+
+	result, err := publisher.Publish(context.Background(), "my_channel", "Hello, World!")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	msg := <-queue.WaitForMessage()
+	fmt.Println(msg.Message)
+
+	// Output:
+	// 1
+	// Hello, World!
+}
+
+func ExampleClient_Unsubscribe() {
+	var publisher *Client = getExampleClient() // example helper function
+	defer closeAllClients()
+
+	subscriber := getExampleClientWithSubscription(config.ExactChannelMode, "my_channel")
+	err := subscriber.Unsubscribe(context.Background(), "my_channel")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	result, err := publisher.Publish(context.Background(), "my_channel", "Hello, World!")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 0
+}
+
+func ExampleClusterClient_SSubscribe() {
+	var publisher *ClusterClient = getExampleClusterClient() // example helper function
+	// subscribing at runtime requires a subscription configuration
+	var subscriber *ClusterClient = getExampleClusterClientWithSubscriptionConfig(config.NewClusterSubscriptionConfig())
+	defer closeAllClients()
+
+	err := subscriber.SSubscribe(context.Background(), "my_shard_channel")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	queue, err := subscriber.GetQueue()
+	if err != nil {
+		fmt.Println("Failed to get queue: ", err)
+		return
+	}
+
+	result, err := publisher.Publish(context.Background(), "my_shard_channel", "Hello, World!", true)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	msg := <-queue.WaitForMessage()
+	fmt.Println(msg.Message)
+
+	// Output:
+	// 1
+	// Hello, World!
+}
@@ -0,0 +1,277 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	// The number of attempts to restore the subscriptions after a disconnection.
+	resubscribeAttempts = 10
+	// The delay before the first attempt to restore the subscriptions, doubled on each attempt.
+	resubscribeInitialDelay = 100 * time.Millisecond
+	// The timeout of each subscription command sent while restoring the subscriptions.
+	resubscribeTimeout = 5 * time.Second
+)
+
+// The request types of the subscribe and unsubscribe commands, by the mode of the subscription.
+var (
+	subscribeRequestTypes = map[config.PubSubClusterChannelMode]C.RequestType{
+		config.ExactClusterChannelMode:   C.Subscribe,
+		config.PatternClusterChannelMode: C.PSubscribe,
+		config.ShardedClusterChannelMode: C.SSubscribe,
+	}
+	unsubscribeRequestTypes = map[config.PubSubClusterChannelMode]C.RequestType{
+		config.ExactClusterChannelMode:   C.Unsubscribe,
+		config.PatternClusterChannelMode: C.PUnsubscribe,
+		config.ShardedClusterChannelMode: C.SUnsubscribe,
+	}
+)
+
+// pubSubState tracks the message handler and the active subscriptions of a client.
+//
+// The subscriptions given in the client configuration are restored by the core on reconnection, while the
+// subscriptions changed at runtime are restored by the client once it is notified of a disconnection.
+type pubSubState struct {
+	mu      sync.Mutex
+	handler *MessageHandler
+	active  map[config.PubSubClusterChannelMode]map[string]struct{}
+	initial map[config.PubSubClusterChannelMode]map[string]struct{}
+	// Set while the subscriptions are being restored, so that concurrent disconnections don't restore them twice.
+	restoring bool
+}
+
+func newPubSubState() *pubSubState {
+	return &pubSubState{
+		active:  map[config.PubSubClusterChannelMode]map[string]struct{}{},
+		initial: map[config.PubSubClusterChannelMode]map[string]struct{}{},
+	}
+}
+
+// setInitialSubscriptions records the subscriptions made upon connection establishment.
+func (state *pubSubState) setInitialSubscriptions(subscriptions map[config.PubSubClusterChannelMode][]string) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	for mode, channels := range subscriptions {
+		for _, channel := range channels {
+			addSubscription(state.initial, mode, channel)
+			addSubscription(state.active, mode, channel)
+		}
+	}
+}
+
+func (state *pubSubState) setHandler(handler *MessageHandler) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.handler = handler
+}
+
+func (state *pubSubState) getHandler() *MessageHandler {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return state.handler
+}
+
+func (state *pubSubState) add(mode config.PubSubClusterChannelMode, channel string) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	addSubscription(state.active, mode, channel)
+}
+
+func (state *pubSubState) remove(mode config.PubSubClusterChannelMode, channel string) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	delete(state.active[mode], channel)
+}
+
+// channels returns the active channels or patterns of the given mode.
+func (state *pubSubState) channels(mode config.PubSubClusterChannelMode) []string {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	channels := make([]string, 0, len(state.active[mode]))
+	for channel := range state.active[mode] {
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// changes returns the subscriptions added and removed since the connection establishment.
+func (state *pubSubState) changes() (added, removed map[config.PubSubClusterChannelMode][]string) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	added = map[config.PubSubClusterChannelMode][]string{}
+	removed = map[config.PubSubClusterChannelMode][]string{}
+	for mode, channels := range state.active {
+		for channel := range channels {
+			if _, ok := state.initial[mode][channel]; !ok {
+				added[mode] = append(added[mode], channel)
+			}
+		}
+	}
+	for mode, channels := range state.initial {
+		for channel := range channels {
+			if _, ok := state.active[mode][channel]; !ok {
+				removed[mode] = append(removed[mode], channel)
+			}
+		}
+	}
+	return added, removed
+}
+
+func (state *pubSubState) startRestoring() bool {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.restoring {
+		return false
+	}
+	state.restoring = true
+	return true
+}
+
+func (state *pubSubState) stopRestoring() {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.restoring = false
+}
+
+// fromStandaloneSubscriptions converts the subscriptions of a standalone client, the modes of which have the same ordinals
+// as the cluster modes.
+func fromStandaloneSubscriptions(
+	subscriptions map[config.PubSubChannelMode][]string,
+) map[config.PubSubClusterChannelMode][]string {
+	converted := make(map[config.PubSubClusterChannelMode][]string, len(subscriptions))
+	for mode, channels := range subscriptions {
+		converted[config.PubSubClusterChannelMode(mode)] = channels
+	}
+	return converted
+}
+
+// groupBySlot groups the channels or patterns by their hash slot, so that a single command is sent per slot.
+func groupBySlot(channels []string) map[uint16][]string {
+	groups := map[uint16][]string{}
+	for _, channel := range channels {
+		slot := utils.HashSlot(channel)
+		groups[slot] = append(groups[slot], channel)
+	}
+	return groups
+}
+
+func addSubscription(
+	subscriptions map[config.PubSubClusterChannelMode]map[string]struct{},
+	mode config.PubSubClusterChannelMode,
+	channel string,
+) {
+	if subscriptions[mode] == nil {
+		subscriptions[mode] = map[string]struct{}{}
+	}
+	subscriptions[mode][channel] = struct{}{}
+}
+
+// subscribe subscribes the client to the given channels or patterns. The commands are routed by the slot of the channels,
+// so that the subscribe and unsubscribe commands of a channel reach the same node in cluster mode.
+func (client *baseClient) subscribe(ctx context.Context, mode config.PubSubClusterChannelMode, channels []string) error {
+	if len(channels) == 0 {
+		return errors.New("at least one channel or pattern is required")
+	}
+	// The core only forwards the messages to the clients created with a subscription configuration
+	if client.pubsub.getHandler() == nil {
+		return errors.New("subscribing at runtime requires a subscription configuration in the client configuration")
+	}
+	for _, slotChannels := range groupBySlot(channels) {
+		if err := client.sendSubscriptionCommand(ctx, subscribeRequestTypes[mode], slotChannels); err != nil {
+			return err
+		}
+		for _, channel := range slotChannels {
+			client.pubsub.add(mode, channel)
+		}
+	}
+	return nil
+}
+
+// unsubscribe unsubscribes the client from the given channels or patterns, or from all of them if none are given.
+func (client *baseClient) unsubscribe(ctx context.Context, mode config.PubSubClusterChannelMode, channels []string) error {
+	if len(channels) == 0 {
+		channels = client.pubsub.channels(mode)
+	}
+	for _, slotChannels := range groupBySlot(channels) {
+		if err := client.sendSubscriptionCommand(ctx, unsubscribeRequestTypes[mode], slotChannels); err != nil {
+			return err
+		}
+		for _, channel := range slotChannels {
+			client.pubsub.remove(mode, channel)
+		}
+	}
+	return nil
+}
+
+// Sends a subscription command for channels of the same slot, routed to the primary of the slot.
+func (client *baseClient) sendSubscriptionCommand(ctx context.Context, requestType C.RequestType, channels []string) error {
+	route := config.NewSlotKeyRoute(config.SlotTypePrimary, channels[0])
+	result, err := client.executeCommandWithRoute(ctx, requestType, channels, route)
+	if err != nil {
+		return err
+	}
+	_, err = handleAnyArrayOrNilResponse(result)
+	return err
+}
+
+// restoreSubscriptions reapplies the subscriptions changed at runtime after a disconnection, retrying with an exponential
+// backoff until the client is reconnected.
+func (client *baseClient) restoreSubscriptions() {
+	if !client.pubsub.startRestoring() {
+		return
+	}
+	defer client.pubsub.stopRestoring()
+
+	added, removed := client.pubsub.changes()
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	delay := resubscribeInitialDelay
+	for attempt := 0; attempt < resubscribeAttempts; attempt++ {
+		time.Sleep(delay)
+		if !client.isRegistered() {
+			return
+		}
+		err := client.resubscribe(added, removed)
+		if err == nil {
+			return
+		}
+		var closingErr *ClosingError
+		if errors.As(err, &closingErr) {
+			return
+		}
+		delay *= 2
+	}
+	logError("failed to restore the pub/sub subscriptions after a disconnection")
+}
+
+func (client *baseClient) resubscribe(added, removed map[config.PubSubClusterChannelMode][]string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), resubscribeTimeout)
+	defer cancel()
+	for mode, channels := range added {
+		for _, slotChannels := range groupBySlot(channels) {
+			if err := client.sendSubscriptionCommand(ctx, subscribeRequestTypes[mode], slotChannels); err != nil {
+				return err
+			}
+		}
+	}
+	for mode, channels := range removed {
+		for _, slotChannels := range groupBySlot(channels) {
+			if err := client.sendSubscriptionCommand(ctx, unsubscribeRequestTypes[mode], slotChannels); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	assert.Equal(t, uint32(0), requestTimeout(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	timeout := requestTimeout(ctx)
+	assert.Greater(t, timeout, uint32(0))
+	assert.LessOrEqual(t, timeout, uint32(50))
+
+	// an exceeded deadline still sets a timeout, instead of the request timeout of the client
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	assert.Equal(t, uint32(1), requestTimeout(expired))
+
+	// a deadline beyond the range of the timeout uses the request timeout of the client
+	distant, cancelDistant := context.WithTimeout(context.Background(), 100*24*time.Hour)
+	defer cancelDistant()
+	assert.Equal(t, uint32(0), requestTimeout(distant))
+}
+
+func TestContextError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+	err := contextError(ctx)
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	assert.Equal(t, context.Canceled, contextError(cancelled))
+}
+
+func TestRequestError(t *testing.T) {
+	coreTimeout := NewTimeoutError("timed out")
+	assert.Same(t, coreTimeout, requestError(context.Background(), coreTimeout))
+
+	// the core timed out at the deadline of the context, before the context is done
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Millisecond))
+	defer cancel()
+	err := requestError(ctx, coreTimeout)
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the request timeout of the client is shorter than the deadline
+	distant, cancelDistant := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelDistant()
+	assert.Same(t, coreTimeout, requestError(distant, coreTimeout))
+
+	otherErr := errors.New("ERR")
+	assert.Same(t, otherErr, requestError(ctx, otherErr))
+}
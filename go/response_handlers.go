@@ -146,7 +146,7 @@ func parseInterface(response *C.struct_CommandResponse) (any, error) {
 		if !ok {
 			return nil, errors.New("error message isn't a string")
 		}
-		return errors.New(errStrString), nil
+		return serverError(errStrString), nil
 	}
 
 	return nil, errors.New("unexpected return type from Valkey")
@@ -185,7 +185,7 @@ func parseMap(response *C.struct_CommandResponse) (any, error) {
 
 	value_map := make(map[string]any, response.array_value_len)
 	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
-		res_key, err := parseString(v.map_key)
+		res_key, err := parseInterface(v.map_key)
 		if err != nil {
 			return nil, err
 		}
@@ -193,7 +193,12 @@ func parseMap(response *C.struct_CommandResponse) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		value_map[res_key.(string)] = res_val
+		// The keys are strings, except in a few replies like the buckets of LATENCY HISTOGRAM
+		key, ok := res_key.(string)
+		if !ok {
+			key = fmt.Sprint(res_key)
+		}
+		value_map[key] = res_val
 	}
 	return value_map, nil
 }
@@ -215,6 +220,56 @@ func parseSet(response *C.struct_CommandResponse) (any, error) {
 	return slice, nil
 }
 
+// Under RESP2, the server replies with arrays in place of maps and sets, with strings in place of doubles and with
+// integers in place of booleans. The core converts the replies of most commands, and the helpers below convert the
+// others, so that the handlers return the same Go types under both protocols.
+
+// checkMapResponseType checks that the response is a map, or the flat array of keys and values sent under RESP2.
+func checkMapResponseType(response *C.struct_CommandResponse, isNilable bool) error {
+	if response != nil && response.response_type == uint32(C.Array) {
+		return nil
+	}
+	return checkResponseType(response, C.Map, isNilable)
+}
+
+// parseMapResponse parses a map, or the flat array of keys and values sent under RESP2, into a `map[string]any`.
+func parseMapResponse(response *C.struct_CommandResponse) (any, error) {
+	if response.response_type != uint32(C.Array) {
+		return parseMap(response)
+	}
+	data, err := parseArray(response)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return internal.ConvertToStringAnyMap(data)
+}
+
+// parseFloatResponse parses a double, or its string representation sent under RESP2.
+func parseFloatResponse(response *C.struct_CommandResponse) (float64, error) {
+	if response != nil && response.response_type == uint32(C.String) {
+		str, err := convertCharArrayToString(response, false)
+		if err != nil {
+			return float64(0), err
+		}
+		return strconv.ParseFloat(str.Value(), 64)
+	}
+	if err := checkResponseType(response, C.Float, false); err != nil {
+		return float64(0), err
+	}
+	return float64(response.float_value), nil
+}
+
+// parseBoolResponse parses a boolean, or the `1` or `0` integer sent under RESP2.
+func parseBoolResponse(response *C.struct_CommandResponse) (bool, error) {
+	if response != nil && response.response_type == uint32(C.Int) {
+		return response.int_value == 1, nil
+	}
+	if err := checkResponseType(response, C.Bool, false); err != nil {
+		return false, err
+	}
+	return bool(response.bool_value), nil
+}
+
 // convert (typecast) untyped response into a typed value
 // for example, an arbitrary array `[]any` into `[]string`
 type responseConverter interface {
@@ -236,10 +291,14 @@ func (node mapConverter[T]) convert(data any) (any, error) {
 			return nil, fmt.Errorf("unexpected type received: nil, expected: map[string]%v", internal.GetType[T]())
 		}
 	}
-	result := make(map[string]T)
+	aMap, err := internal.ConvertToStringAnyMap(data)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]T, len(aMap))
 
 	// Iterate over the map and convert each value to T
-	for key, value := range data.(map[string]any) {
+	for key, value := range aMap {
 		if node.next == nil {
 			// try direct conversion to T when there is no next converter
 			valueT, ok := value.(T)
@@ -366,6 +425,27 @@ func handleOkResponse(response *C.struct_CommandResponse) (string, error) {
 	return "OK", nil
 }
 
+// Handles an `OK` response of a command routed to one or to multiple nodes. Commands without a response policy return
+// a map of node addresses to `OK` when routed to multiple nodes.
+func handleOkClusterResponse(response *C.struct_CommandResponse) (string, error) {
+	if response == nil || response.response_type != uint32(C.Map) {
+		return handleOkResponse(response)
+	}
+	defer C.free_command_response(response)
+
+	data, err := parseMap(response)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	nodes, _ := data.(map[string]any)
+	for node, value := range nodes {
+		if value != "OK" {
+			return models.DefaultStringResponse, fmt.Errorf("unexpected response from node %s: %v", node, value)
+		}
+	}
+	return "OK", nil
+}
+
 func handleOkOrStringOrNilResponse(response *C.struct_CommandResponse) (models.Result[string], error) {
 	defer C.free_command_response(response)
 
@@ -567,25 +647,20 @@ func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.R
 func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Float, false)
-	if typeErr != nil {
-		return float64(0), typeErr
-	}
-
-	return float64(response.float_value), nil
+	return parseFloatResponse(response)
 }
 
 func handleFloatOrNilResponse(response *C.struct_CommandResponse) (models.Result[float64], error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Float, true)
-	if typeErr != nil {
-		return models.CreateNilFloat64Result(), typeErr
-	}
-	if response.response_type == C.Null {
+	if response == nil || response.response_type == C.Null {
 		return models.CreateNilFloat64Result(), nil
 	}
-	return models.CreateFloat64Result(float64(response.float_value)), nil
+	value, err := parseFloatResponse(response)
+	if err != nil {
+		return models.CreateNilFloat64Result(), err
+	}
+	return models.CreateFloat64Result(value), nil
 }
 
 // elements in the array could be `null`, but array isn't
@@ -604,12 +679,12 @@ func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]models
 			continue
 		}
 
-		err := checkResponseType(&v, C.Float, false)
+		value, err := parseFloatResponse(&v)
 		if err != nil {
 			return nil, err
 		}
 
-		slice = append(slice, models.CreateFloat64Result(float64(v.float_value)))
+		slice = append(slice, models.CreateFloat64Result(value))
 	}
 
 	return slice, nil
@@ -634,10 +709,13 @@ func handleRankAndScoreOrNilResponse(
 	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
 		if v.response_type == C.Int {
 			rank = int64(v.int_value)
+			continue
 		}
-		if v.response_type == C.Float {
-			score = float64(v.float_value)
+		value, err := parseFloatResponse(&v)
+		if err != nil {
+			return models.CreateNilRankAndScoreResult(), err
 		}
+		score = value
 	}
 
 	return models.CreateRankAndScoreResult(rank, score), nil
@@ -646,12 +724,7 @@ func handleRankAndScoreOrNilResponse(
 func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Bool, false)
-	if typeErr != nil {
-		return false, typeErr
-	}
-
-	return bool(response.bool_value), nil
+	return parseBoolResponse(response)
 }
 
 func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error) {
@@ -664,11 +737,11 @@ func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error)
 
 	slice := make([]bool, 0, response.array_value_len)
 	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
-		err := checkResponseType(&v, C.Bool, false)
+		value, err := parseBoolResponse(&v)
 		if err != nil {
 			return nil, err
 		}
-		slice = append(slice, bool(v.bool_value))
+		slice = append(slice, value)
 	}
 	return slice, nil
 }
@@ -676,12 +749,12 @@ func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error)
 func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[string]float64, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -703,12 +776,12 @@ func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[stri
 func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[string]string, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -730,12 +803,12 @@ func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[st
 func handleStringToStringOrNilMapResponse(response *C.struct_CommandResponse) (map[string]models.Result[string], error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -759,7 +832,7 @@ func handleStringToStringArrayMapOrNilResponse(
 ) (map[string][]string, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, true)
+	typeErr := checkMapResponseType(response, true)
 	if typeErr != nil {
 		return nil, typeErr
 	}
@@ -768,7 +841,7 @@ func handleStringToStringArrayMapOrNilResponse(
 		return nil, nil
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -794,7 +867,7 @@ func handleKeyValuesArrayOrNilResponse(
 ) ([]models.KeyValues, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, true)
+	typeErr := checkMapResponseType(response, true)
 	if typeErr != nil {
 		return nil, typeErr
 	}
@@ -803,7 +876,7 @@ func handleKeyValuesArrayOrNilResponse(
 		return nil, nil
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -814,13 +887,20 @@ func handleKeyValuesArrayOrNilResponse(
 func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]struct{}, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Sets, false)
-	if typeErr != nil {
-		return nil, typeErr
+	var values []C.struct_CommandResponse
+	if response != nil && response.response_type == uint32(C.Array) {
+		// The server replies with an array in place of a set under RESP2
+		values = unsafe.Slice(response.array_value, response.array_value_len)
+	} else {
+		typeErr := checkResponseType(response, C.Sets, false)
+		if typeErr != nil {
+			return nil, typeErr
+		}
+		values = unsafe.Slice(response.sets_value, response.sets_value_len)
 	}
 
-	slice := make(map[string]struct{}, response.sets_value_len)
-	for _, v := range unsafe.Slice(response.sets_value, response.sets_value_len) {
+	slice := make(map[string]struct{}, len(values))
+	for _, v := range values {
 		res, err := convertCharArrayToString(&v, true)
 		if err != nil {
 			return nil, err
@@ -944,11 +1024,11 @@ func handleScanResponse(response *C.struct_CommandResponse) (models.ScanResult,
 func handleXClaimResponse(response *C.struct_CommandResponse) (map[string]models.XClaimResponse, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -965,11 +1045,11 @@ func handleXRangeResponse(response *C.struct_CommandResponse, reverse bool) ([]m
 		return nil, nil
 	}
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
-	mapData, err := parseMap(response)
+	mapData, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1045,7 +1125,7 @@ func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (models.
 
 func handleStreamResponse(response *C.struct_CommandResponse) (map[string]models.StreamResponse, error) {
 	defer C.free_command_response(response)
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1249,12 +1329,12 @@ func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]models.XIn
 func handleStringToAnyMapResponse(response *C.struct_CommandResponse) (map[string]any, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	result, err := parseMap(response)
+	result, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1315,12 +1395,12 @@ func handleLCSMatchResponse(
 
 func handleRawStringArrayMapResponse(response *C.struct_CommandResponse) (map[string][]string, error) {
 	defer C.free_command_response(response)
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1342,12 +1422,12 @@ func handleRawStringArrayMapResponse(response *C.struct_CommandResponse) (map[st
 
 func handleMapOfStringMapResponse(response *C.struct_CommandResponse) (map[string]map[string]string, error) {
 	defer C.free_command_response(response)
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1392,12 +1472,12 @@ func handleTimeClusterResponse(response *C.struct_CommandResponse) (models.Clust
 func handleStringIntMapResponse(response *C.struct_CommandResponse) (map[string]int64, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1556,12 +1636,12 @@ func handleFunctionListMultiNodeResponse(response *C.struct_CommandResponse) (ma
 func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, reverse bool) ([]models.MemberAndScore, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
+	data, err := parseMapResponse(response)
 	if err != nil {
 		return nil, err
 	}
@@ -1606,11 +1686,11 @@ func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, rever
 func handleXInfoStreamCResponse(response *C.struct_CommandResponse) (any, error) {
 	defer C.free_command_response(response)
 
-	typeErr := checkResponseType(response, C.Map, false)
+	typeErr := checkMapResponseType(response, false)
 	if typeErr != nil {
 		return models.XInfoStreamResponse{}, typeErr
 	}
-	return parseMap(response)
+	return parseMapResponse(response)
 }
 
 func handleXInfoStreamResponse(response *C.struct_CommandResponse) (models.XInfoStreamResponse, error) {
@@ -1633,3 +1713,138 @@ func handleXInfoStreamFullOptionsResponse(response *C.struct_CommandResponse) (m
 	streamInfo, err := internal.ConvertXInfoStreamFullResponse(result)
 	return streamInfo.(models.XInfoStreamFullOptionsResponse), err
 }
+
+// Converts a response of a command routed to one or to multiple nodes into a [models.ClusterValue].
+// A multi-node response is a map of node addresses to the values returned by each node.
+func handleClusterValueResponse[T any](
+	response *C.struct_CommandResponse,
+	opts options.RouteOption,
+	converter func(data any) (T, error),
+) (models.ClusterValue[T], error) {
+	defer C.free_command_response(response)
+
+	data, err := parseInterface(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[T](), err
+	}
+	if opts.Route != nil && opts.Route.IsMultiNode() {
+		nodes, ok := data.(map[string]any)
+		if !ok {
+			return models.CreateEmptyClusterValue[T](), fmt.Errorf(
+				"unexpected type received: %T, expected: map[string]any", data)
+		}
+		values := make(map[string]T, len(nodes))
+		for node, nodeData := range nodes {
+			value, err := converter(nodeData)
+			if err != nil {
+				return models.CreateEmptyClusterValue[T](), err
+			}
+			values[node] = value
+		}
+		return models.CreateClusterMultiValue(values), nil
+	}
+	value, err := converter(data)
+	if err != nil {
+		return models.CreateEmptyClusterValue[T](), err
+	}
+	return models.CreateClusterSingleValue(value), nil
+}
+
+// Adapts an untyped converter, like [internal.ConvertArrayOf], to be used with [handleClusterValueResponse].
+func typedConverter[T any](converter func(data any) (any, error)) func(data any) (T, error) {
+	return func(data any) (T, error) {
+		var empty T
+		res, err := converter(data)
+		if err != nil {
+			return empty, err
+		}
+		value, ok := res.(T)
+		if !ok {
+			return empty, fmt.Errorf("unexpected type received: %T, expected: %T", res, empty)
+		}
+		return value, nil
+	}
+}
+
+func handleAclUserResponse(response *C.struct_CommandResponse) (models.Result[models.AclUser], error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkMapResponseType(response, true)
+	if typeErr != nil {
+		return models.CreateNilResultOf[models.AclUser](), typeErr
+	}
+	data, err := parseInterface(response)
+	if err != nil {
+		return models.CreateNilResultOf[models.AclUser](), err
+	}
+	return internal.ConvertAclUser(data)
+}
+
+func handleAclLogResponse(response *C.struct_CommandResponse) ([]models.AclLogEntry, error) {
+	defer C.free_command_response(response)
+
+	typeErr := checkResponseType(response, C.Array, false)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+	data, err := parseArray(response)
+	if err != nil {
+		return nil, err
+	}
+	return internal.ConvertAclLogEntries(data)
+}
+
+func handleStringClusterResponse(
+	response *C.struct_CommandResponse,
+	opts options.RouteOption,
+) (models.ClusterValue[string], error) {
+	return handleClusterValueResponse(response, opts, typedConverter[string](func(data any) (any, error) {
+		return data, nil
+	}))
+}
+
+func handleStringArrayClusterResponse(
+	response *C.struct_CommandResponse,
+	opts options.RouteOption,
+) (models.ClusterValue[[]string], error) {
+	return handleClusterValueResponse(response, opts, typedConverter[[]string](internal.ConvertArrayOf[string]))
+}
+
+func handleAclUserClusterResponse(
+	response *C.struct_CommandResponse,
+	opts options.RouteOption,
+) (models.ClusterValue[models.Result[models.AclUser]], error) {
+	return handleClusterValueResponse(response, opts, internal.ConvertAclUser)
+}
+
+func handleAclLogClusterResponse(
+	response *C.struct_CommandResponse,
+	opts options.RouteOption,
+) (models.ClusterValue[[]models.AclLogEntry], error) {
+	return handleClusterValueResponse(response, opts, internal.ConvertAclLogEntries)
+}
+
+// Converts a response with the given converter, for the responses which need to be parsed into a model.
+func handleConvertedResponse[T any](response *C.struct_CommandResponse, converter func(data any) (T, error)) (T, error) {
+	defer C.free_command_response(response)
+
+	data, err := parseInterface(response)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return converter(data)
+}
+
+// handleShutDownResponse handles the reply of SHUTDOWN. The server exits without replying, unless the shutdown failed
+// or was aborted, so a disconnection means the server exited.
+func handleShutDownResponse(response *C.struct_CommandResponse, err error, abort bool) (string, error) {
+	if err != nil {
+		var disconnectErr *DisconnectError
+		if !abort && errors.As(err, &disconnectErr) {
+			return "OK", nil
+		}
+		return models.DefaultStringResponse, err
+	}
+	return handleOkClusterResponse(response)
+}
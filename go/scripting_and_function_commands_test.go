@@ -4,6 +4,7 @@ package glide
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -1505,3 +1506,65 @@ func ExampleClusterClient_ScriptShow() {
 	// Output:
 	// return 'Hello World'
 }
+
+func ExampleClient_Eval() {
+	client := getExampleClient()
+
+	scriptOptions := options.NewScriptOptions().WithKeys([]string{"key"}).WithArgs([]string{"value"})
+	result, err := client.EvalWithOptions(
+		context.Background(),
+		"redis.call('SET', KEYS[1], ARGV[1]) return redis.call('GET', KEYS[1])",
+		*scriptOptions,
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+		return
+	}
+	fmt.Println(result)
+
+	// Output: value
+}
+
+func ExampleClient_EvalSha() {
+	client := getExampleClient()
+
+	sha1, err := client.ScriptLoad(context.Background(), "return 'Hello'")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+		return
+	}
+	result, err := client.EvalSha(context.Background(), sha1)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+		return
+	}
+	fmt.Println(sha1)
+	fmt.Println(result)
+
+	// The script must be loaded again once the script cache is flushed
+	client.ScriptFlush(context.Background())
+	_, err = client.EvalSha(context.Background(), sha1)
+	var noScriptErr *NoScriptError
+	fmt.Println(errors.As(err, &noScriptErr))
+
+	// Output:
+	// af6b5d19da06755d789858a67760f34bbd2e9e52
+	// Hello
+	// true
+}
+
+func ExampleClusterClient_EvalWithClusterOptions() {
+	client := getExampleClusterClient()
+
+	opts := options.NewClusterScriptOptions().
+		WithScriptArgOptions(options.NewScriptArgOptions().WithArgs([]string{"Hello"})).
+		WithRouteOptions(&options.RouteOption{Route: config.RandomRoute})
+	result, err := client.EvalWithClusterOptions(context.Background(), "return ARGV[1]", *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+		return
+	}
+	fmt.Println(result.SingleValue())
+
+	// Output: Hello
+}
@@ -439,3 +439,177 @@ func ExampleClusterClient_ConfigRewriteWithOptions() {
 	// Random route result: OK
 	// Multi node route result: OK
 }
+
+func ExampleClusterClient_AclSetUserWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	username := "example-user-" + uuid.NewString()
+	opts := options.RouteOption{Route: config.AllNodes}
+	rules := options.NewAclRules().Reset().On().NoPass().AllKeys().AllowCategory("read")
+	result, err := client.AclSetUserWithOptions(context.Background(), username, *rules, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.AclDelUserWithOptions(context.Background(), []string{username}, opts)
+
+	// Output: OK
+}
+
+func ExampleClusterClient_AclWhoamiWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.AclWhoamiWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, username := range result.MultiValue() {
+		fmt.Println(username)
+		break
+	}
+
+	// Output: default
+}
+
+func ExampleClusterClient_ClusterInfo() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.ClusterInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.State)
+	fmt.Println(result.SlotsAssigned)
+
+	// Output:
+	// ok
+	// 16384
+}
+
+func ExampleClusterClient_ClusterNodes() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.ClusterNodes(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	var slots int64
+	for _, node := range result {
+		for _, slotRange := range node.Slots {
+			slots += slotRange.End - slotRange.Start + 1
+		}
+	}
+	fmt.Println(slots)
+
+	// Output: 16384
+}
+
+func ExampleClusterClient_ClusterMyIdWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.RandomRoute}
+	result, err := client.ClusterMyIdWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result.SingleValue()))
+
+	// Output: 40
+}
+
+func ExampleClusterClient_ClusterCountKeysInSlot() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.Set(context.Background(), "{example-slot}key", "value")
+	slot, _ := client.CustomCommand(context.Background(), []string{"CLUSTER", "KEYSLOT", "{example-slot}key"})
+	result, err := client.ClusterCountKeysInSlot(context.Background(), slot.SingleValue().(int64))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result > 0)
+
+	// Output: true
+}
+
+func ExampleClusterClient_ClusterSetSlot() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	route := config.NewSlotIdRoute(config.SlotTypePrimary, 42)
+	result, err := client.ClusterSetSlot(context.Background(), 42, *options.NewClusterSetSlotStableOptions(), route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleClusterClient_SlowLogLen() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	lengths, err := client.SlowLogLen(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(lengths.MultiValue()) > 0)
+
+	// Output: true
+}
+
+func ExampleClusterClient_SlowLogGetWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")}
+	client.SlowLogResetWithOptions(context.Background(), route)
+	entries, err := client.SlowLogGetWithOptions(context.Background(), 10, route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(entries.SingleValue()))
+
+	// Output: 0
+}
+
+func ExampleClusterClient_LatencyHistory() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.LatencyReset(context.Background())
+	samples, err := client.LatencyHistory(context.Background(), "command")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, nodeSamples := range samples.MultiValue() {
+		fmt.Println(len(nodeSamples))
+		break
+	}
+
+	// Output: 0
+}
+
+func ExampleClusterClient_MemoryStats() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	stats, err := client.MemoryStats(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, nodeStats := range stats.MultiValue() {
+		fmt.Println(nodeStats.TotalAllocated > 0)
+		break
+	}
+
+	// Output: true
+}
+
+func ExampleClusterClient_RoleWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	route := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, "key")}
+	role, err := client.RoleWithOptions(context.Background(), route)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(role.SingleValue().Role)
+
+	// Output: primary
+}
+
+func ExampleClusterClient_CommandGetKeysAndFlags() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	keys, err := client.CommandGetKeysAndFlags(context.Background(), []string{"SET", "key", "value"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(keys[0].Key, keys[0].Flags)
+
+	// Output: key [OW update]
+}
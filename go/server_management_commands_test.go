@@ -265,3 +265,221 @@ func ExampleClient_ConfigRewrite() {
 	// Output:
 	// OK
 }
+
+func ExampleClient_AclSetUser() {
+	var client *Client = getExampleClient() // example helper function
+	username := "example-user-" + uuid.NewString()
+	rules := options.NewAclRules().Reset().On().AddPassword("secret").AddKeyPattern("service:*").AllowCategory("read")
+	result, err := client.AclSetUser(context.Background(), username, *rules)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	client.AclDelUser(context.Background(), []string{username})
+
+	// Output: OK
+}
+
+func ExampleClient_AclGetUser() {
+	var client *Client = getExampleClient() // example helper function
+	username := "example-user-" + uuid.NewString()
+	rules := options.NewAclRules().Reset().On().NoPass().AddKeyPattern("service:*").AllowCommand("get")
+	client.AclSetUser(context.Background(), username, *rules)
+	result, err := client.AclGetUser(context.Background(), username)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value().Keys)
+	fmt.Println(result.Value().Commands)
+	client.AclDelUser(context.Background(), []string{username})
+
+	// Output:
+	// ~service:*
+	// -@all +get
+}
+
+func ExampleClient_AclDelUser() {
+	var client *Client = getExampleClient() // example helper function
+	username := "example-user-" + uuid.NewString()
+	client.AclSetUser(context.Background(), username, *options.NewAclRules().On().NoPass())
+	result, err := client.AclDelUser(context.Background(), []string{username, "nonexistent-" + username})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 1
+}
+
+func ExampleClient_AclGenPassWithBits() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.AclGenPassWithBits(context.Background(), 32)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(result))
+
+	// Output: 8
+}
+
+func ExampleClient_AclWhoami() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.AclWhoami(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: default
+}
+
+func ExampleClient_SlowLogGetWithCount() {
+	var client *Client = getExampleClient() // example helper function
+	client.CustomCommand(context.Background(), []string{"CONFIG", "SET", "slowlog-log-slower-than", "0"})
+	client.SlowLogReset(context.Background())
+	client.Set(context.Background(), "slowlog-example-key", "value")
+	entries, err := client.SlowLogGetWithCount(context.Background(), -1)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, entry := range entries {
+		if entry.Args[0] == "SET" {
+			fmt.Println(entry.Args)
+		}
+	}
+	client.CustomCommand(context.Background(), []string{"CONFIG", "SET", "slowlog-log-slower-than", "10000"})
+
+	// Output: [SET slowlog-example-key value]
+}
+
+func ExampleClient_SlowLogReset() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.SlowLogReset(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleClient_LatencyLatest() {
+	var client *Client = getExampleClient() // example helper function
+	client.LatencyReset(context.Background())
+	events, err := client.LatencyLatest(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(events))
+
+	// Output: 0
+}
+
+func ExampleClient_LatencyDoctor() {
+	var client *Client = getExampleClient() // example helper function
+	report, err := client.LatencyDoctor(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(report) > 0)
+
+	// Output: true
+}
+
+func ExampleClient_MemoryStats() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	stats, err := client.MemoryStats(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(stats.KeysCount > 0, stats.Fragmentation > 0)
+
+	// Output: true true
+}
+
+func ExampleClient_Role() {
+	var client *Client = getExampleClient() // example helper function
+	role, err := client.Role(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(role.Role, role.IsPrimary())
+
+	// Output: primary true
+}
+
+func ExampleClient_SwapDb() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	result, err := client.SwapDb(context.Background(), 0, 1)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	value, _ := client.Get(context.Background(), "key1")
+	fmt.Println(result, value.IsNil())
+	client.SwapDb(context.Background(), 0, 1)
+
+	// Output: OK true
+}
+
+func ExampleClient_BgSaveWithOptions() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.BgSaveWithOptions(context.Background(), *options.NewBgSaveOptions().SetSchedule())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(strings.HasPrefix(result, "Background saving"))
+
+	// Output: true
+}
+
+func ExampleClient_CommandGetKeys() {
+	var client *Client = getExampleClient() // example helper function
+	keys, err := client.CommandGetKeys(context.Background(), []string{"MSET", "key1", "value1", "key2", "value2"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(keys)
+
+	// Output: [key1 key2]
+}
+
+func ExampleClient_CommandInfo() {
+	var client *Client = getExampleClient() // example helper function
+	infos, err := client.CommandInfo(context.Background(), "get")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(infos["get"].Arity, infos["get"].FirstKey, infos["get"].LastKey, infos["get"].Step)
+
+	// Output: 2 1 1 1
+}
+
+func ExampleClient_CommandListWithOptions() {
+	var client *Client = getExampleClient() // example helper function
+	opts := options.NewCommandListOptions().SetFilterByPattern("xrev*")
+	names, err := client.CommandListWithOptions(context.Background(), *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(names)
+
+	// Output: [xrevrange]
+}
+
+func ExampleClient_ModuleList() {
+	var client *Client = getExampleClient() // example helper function
+	modules, err := client.ModuleList(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	// the loaded modules depend on the server, but every module has a name
+	named := true
+	for _, module := range modules {
+		named = named && module.Name != ""
+	}
+	fmt.Println(named)
+
+	// Output: true
+}